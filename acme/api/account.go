@@ -4,8 +4,11 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/log"
+	"github.com/go-jose/go-jose/v4"
 )
 
 type AccountService service
@@ -27,14 +30,26 @@ func (a *AccountService) New(req acme.Account) (acme.ExtendedAccount, error) {
 	return acme.ExtendedAccount{Account: account, Location: location}, nil
 }
 
-// NewEAB Creates a new account with an External Account Binding.
+// NewEAB Creates a new account with an External Account Binding (HS256).
 func (a *AccountService) NewEAB(accMsg acme.Account, kid, hmacEncoded string) (acme.ExtendedAccount, error) {
-	hmac, err := base64.RawURLEncoding.DecodeString(hmacEncoded)
+	return a.NewEABWithAlgorithm(accMsg, kid, hmacEncoded, string(jose.HS256))
+}
+
+// NewEABWithAlgorithm Creates a new account with an External Account Binding
+// signed with the given HMAC algorithm (HS256, HS384, HS512).
+// If the algorithm is empty, HS256 is used.
+func (a *AccountService) NewEABWithAlgorithm(accMsg acme.Account, kid, hmacEncoded, algorithm string) (acme.ExtendedAccount, error) {
+	alg, err := parseEABAlgorithm(algorithm)
 	if err != nil {
-		return acme.ExtendedAccount{}, fmt.Errorf("acme: could not decode hmac key: %w", err)
+		return acme.ExtendedAccount{}, err
 	}
 
-	eabJWS, err := a.core.signEABContent(a.core.GetDirectory().NewAccountURL, kid, hmac)
+	hmac, err := decodeEABHMAC(hmacEncoded, alg)
+	if err != nil {
+		return acme.ExtendedAccount{}, err
+	}
+
+	eabJWS, err := a.core.signEABContent(a.core.GetDirectory().NewAccountURL, kid, hmac, alg)
 	if err != nil {
 		return acme.ExtendedAccount{}, fmt.Errorf("acme: error signing eab content: %w", err)
 	}
@@ -44,6 +59,60 @@ func (a *AccountService) NewEAB(accMsg acme.Account, kid, hmacEncoded string) (a
 	return a.New(accMsg)
 }
 
+func parseEABAlgorithm(algorithm string) (jose.SignatureAlgorithm, error) {
+	switch alg := jose.SignatureAlgorithm(strings.ToUpper(algorithm)); alg {
+	case "":
+		return jose.HS256, nil
+	case jose.HS256, jose.HS384, jose.HS512:
+		return alg, nil
+	default:
+		return "", fmt.Errorf("acme: unsupported EAB algorithm %q: HS256, HS384, or HS512 are expected", algorithm)
+	}
+}
+
+// decodeEABHMAC decodes the base64url (without padding) HMAC key,
+// and checks its size against the algorithm (RFC 7518 section 3.2).
+// A short HS256 key is only reported with a warning, for compatibility.
+func decodeEABHMAC(hmacEncoded string, alg jose.SignatureAlgorithm) ([]byte, error) {
+	if hmacEncoded == "" {
+		return nil, errors.New("acme: the EAB HMAC key is empty")
+	}
+
+	if strings.HasSuffix(hmacEncoded, "=") {
+		return nil, errors.New("acme: could not decode hmac key: the EAB HMAC key must be base64url encoded without padding ('=')")
+	}
+
+	hmac, err := base64.RawURLEncoding.DecodeString(hmacEncoded)
+	if err != nil {
+		if strings.ContainsAny(hmacEncoded, "+/") {
+			return nil, fmt.Errorf("acme: could not decode hmac key: the EAB HMAC key must be base64url encoded ('-' and '_' instead of '+' and '/'): %w", err)
+		}
+
+		return nil, fmt.Errorf("acme: could not decode hmac key: %w", err)
+	}
+
+	// HS256 keys shorter than 32 bytes are issued by some CAs and have always been accepted:
+	// the minimum size is only enforced for HS384 and HS512.
+	if alg == jose.HS256 {
+		if len(hmac) < 32 {
+			log.Warnf("acme: the EAB HMAC key is shorter than recommended for %s: %d bytes, at least 32 bytes are recommended", alg, len(hmac))
+		}
+
+		return hmac, nil
+	}
+
+	minSizes := map[jose.SignatureAlgorithm]int{
+		jose.HS384: 48,
+		jose.HS512: 64,
+	}
+
+	if len(hmac) < minSizes[alg] {
+		return nil, fmt.Errorf("acme: the EAB HMAC key is too short for %s: %d bytes, at least %d bytes are expected", alg, len(hmac), minSizes[alg])
+	}
+
+	return hmac, nil
+}
+
 // Get Retrieves an account.
 func (a *AccountService) Get(accountURL string) (acme.Account, error) {
 	if accountURL == "" {
@@ -0,0 +1,182 @@
+package api
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountService_NewEABWithAlgorithm(t *testing.T) {
+	// small value keeps test fast
+	privateKey, errK := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, errK, "Could not generate test key")
+
+	hmac := bytes.Repeat([]byte{0x2a}, 64)
+	hmacEncoded := base64.RawURLEncoding.EncodeToString(hmac)
+
+	testCases := []struct {
+		desc      string
+		algorithm string
+		expected  jose.SignatureAlgorithm
+	}{
+		{
+			desc:     "default",
+			expected: jose.HS256,
+		},
+		{
+			desc:      "HS256",
+			algorithm: "HS256",
+			expected:  jose.HS256,
+		},
+		{
+			desc:      "HS384",
+			algorithm: "hs384",
+			expected:  jose.HS384,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			mux, apiURL := tester.SetupFakeAPI(t)
+
+			mux.HandleFunc("/account", func(w http.ResponseWriter, r *http.Request) {
+				body, err := readSignedBody(r, privateKey)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				var account acme.Account
+				err = json.Unmarshal(body, &account)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				eab, err := jose.ParseSigned(string(account.ExternalAccountBinding), []jose.SignatureAlgorithm{test.expected})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				header := eab.Signatures[0].Protected
+				if header.KeyID != "kid-123" || header.ExtraHeaders["url"] != apiURL+"/account" {
+					http.Error(w, "invalid EAB protected header", http.StatusBadRequest)
+					return
+				}
+
+				payload, err := eab.Verify(hmac)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				var jwk jose.JSONWebKey
+				err = jwk.UnmarshalJSON(payload)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				if !privateKey.PublicKey.Equal(jwk.Key) {
+					http.Error(w, "the EAB payload must be the account public key", http.StatusBadRequest)
+					return
+				}
+
+				w.Header().Set("Location", apiURL+"/account/1")
+
+				err = tester.WriteJSONResponse(w, acme.Account{Status: acme.StatusValid})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+			})
+
+			core, err := New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+			require.NoError(t, err)
+
+			account, err := core.Accounts.NewEABWithAlgorithm(acme.Account{TermsOfServiceAgreed: true}, "kid-123", hmacEncoded, test.algorithm)
+			require.NoError(t, err)
+
+			assert.Equal(t, apiURL+"/account/1", account.Location)
+			assert.Equal(t, acme.StatusValid, account.Status)
+		})
+	}
+}
+
+func TestAccountService_NewEABWithAlgorithm_errors(t *testing.T) {
+	_, apiURL := tester.SetupFakeAPI(t)
+
+	// small value keeps test fast
+	privateKey, errK := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, errK, "Could not generate test key")
+
+	core, err := New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc        string
+		hmacEncoded string
+		algorithm   string
+		expected    string
+	}{
+		{
+			desc:     "empty key",
+			expected: "acme: the EAB HMAC key is empty",
+		},
+		{
+			desc:        "padding",
+			hmacEncoded: "YWJjZA==",
+			expected:    "acme: could not decode hmac key: the EAB HMAC key must be base64url encoded without padding ('=')",
+		},
+		{
+			desc:        "standard base64",
+			hmacEncoded: "ab+/cd",
+			expected:    "acme: could not decode hmac key: the EAB HMAC key must be base64url encoded ('-' and '_' instead of '+' and '/'): illegal base64 data at input byte 2",
+		},
+		{
+			desc:        "invalid characters",
+			hmacEncoded: "ab.cd",
+			expected:    "acme: could not decode hmac key: illegal base64 data at input byte 2",
+		},
+		{
+			desc:        "too short",
+			hmacEncoded: base64.RawURLEncoding.EncodeToString(bytes.Repeat([]byte{0x2a}, 32)),
+			algorithm:   "HS384",
+			expected:    "acme: the EAB HMAC key is too short for HS384: 32 bytes, at least 48 bytes are expected",
+		},
+		{
+			desc:        "unsupported algorithm",
+			hmacEncoded: base64.RawURLEncoding.EncodeToString(bytes.Repeat([]byte{0x2a}, 32)),
+			algorithm:   "RS256",
+			expected:    `acme: unsupported EAB algorithm "RS256": HS256, HS384, or HS512 are expected`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := core.Accounts.NewEABWithAlgorithm(acme.Account{}, "kid-123", test.hmacEncoded, test.algorithm)
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func Test_decodeEABHMAC_shortHS256(t *testing.T) {
+	// Some CAs issue HS256 keys shorter than 32 bytes.
+	hmac := bytes.Repeat([]byte{0x2a}, 16)
+
+	decoded, err := decodeEABHMAC(base64.RawURLEncoding.EncodeToString(hmac), jose.HS256)
+	require.NoError(t, err)
+
+	assert.Equal(t, hmac, decoded)
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -15,6 +16,22 @@ import (
 	"github.com/go-acme/lego/v4/acme/api/internal/secure"
 	"github.com/go-acme/lego/v4/acme/api/internal/sender"
 	"github.com/go-acme/lego/v4/log"
+	"github.com/go-jose/go-jose/v4"
+)
+
+// DefaultMaxBadNonceRetries is the default number of retries of a request rejected because of an invalid nonce.
+const DefaultMaxBadNonceRetries = 5
+
+// maxFinalizeBadNonceRetries caps the number of retries of a finalization request.
+// A request rejected with a badNonce error has not been processed by the CA (RFC 8555 section 6.5),
+// but the finalization is not idempotent: the retries are kept to a minimum.
+const maxFinalizeBadNonceRetries = 1
+
+const (
+	// DefaultMaxRateLimitRetries is the default number of retries of a request rejected with a 429 status code.
+	DefaultMaxRateLimitRetries = 3
+	// DefaultMaxRateLimitWait is the default maximum total time spent waiting for the rate limits of a request.
+	DefaultMaxRateLimitWait = 2 * time.Minute
 )
 
 // Core ACME/LE core API.
@@ -25,6 +42,11 @@ type Core struct {
 	directory    acme.Directory
 	HTTPClient   *http.Client
 
+	maxBadNonceRetries int
+
+	maxRateLimitRetries int
+	maxRateLimitWait    time.Duration
+
 	common         service // Reuse a single struct instead of allocating one for each service on the heap.
 	Accounts       *AccountService
 	Authorizations *AuthorizationService
@@ -33,10 +55,25 @@ type Core struct {
 	Orders         *OrderService
 }
 
+// Option is an option of the creation of a Core.
+type Option func(doer *sender.Doer)
+
+// WithUserAgentSuffix defines the identifier appended to the User-Agent of the requests sent to the CA.
+// It overrides the value of the environment variable LEGO_USER_AGENT_SUFFIX.
+func WithUserAgentSuffix(suffix string) Option {
+	return func(doer *sender.Doer) {
+		doer.SetUserAgentSuffix(suffix)
+	}
+}
+
 // New Creates a new Core.
-func New(httpClient *http.Client, userAgent, caDirURL, kid string, privateKey crypto.PrivateKey) (*Core, error) {
+func New(httpClient *http.Client, userAgent, caDirURL, kid string, privateKey crypto.PrivateKey, opts ...Option) (*Core, error) {
 	doer := sender.NewDoer(httpClient, userAgent)
 
+	for _, opt := range opts {
+		opt(doer)
+	}
+
 	dir, err := getDirectory(doer, caDirURL)
 	if err != nil {
 		return nil, err
@@ -46,41 +83,181 @@ func New(httpClient *http.Client, userAgent, caDirURL, kid string, privateKey cr
 
 	jws := secure.NewJWS(privateKey, kid, nonceManager)
 
-	c := &Core{doer: doer, nonceManager: nonceManager, jws: jws, directory: dir, HTTPClient: httpClient}
+	c := &Core{
+		doer:               doer,
+		nonceManager:       nonceManager,
+		jws:                jws,
+		directory:          dir,
+		HTTPClient:         httpClient,
+		maxBadNonceRetries: DefaultMaxBadNonceRetries,
+
+		maxRateLimitRetries: DefaultMaxRateLimitRetries,
+		maxRateLimitWait:    DefaultMaxRateLimitWait,
+	}
 
-	c.common.core = c
-	c.Accounts = (*AccountService)(&c.common)
-	c.Authorizations = (*AuthorizationService)(&c.common)
-	c.Certificates = (*CertificateService)(&c.common)
-	c.Challenges = (*ChallengeService)(&c.common)
-	c.Orders = (*OrderService)(&c.common)
+	c.initServices()
 
 	return c, nil
 }
 
+// WithAccount returns a Core bound to another account (key identifier and private key) of the same CA.
+// The directory, the HTTP client, the nonces, and the retry settings are shared with the original Core:
+// no request is sent to the CA.
+// The kid can be empty for an account not registered yet.
+func (a *Core) WithAccount(kid string, privateKey crypto.PrivateKey) (*Core, error) {
+	if privateKey == nil {
+		return nil, errors.New("private key was nil")
+	}
+
+	c := &Core{
+		doer:               a.doer,
+		nonceManager:       a.nonceManager,
+		jws:                secure.NewJWS(privateKey, kid, a.nonceManager),
+		directory:          a.directory,
+		HTTPClient:         a.HTTPClient,
+		maxBadNonceRetries: a.maxBadNonceRetries,
+
+		maxRateLimitRetries: a.maxRateLimitRetries,
+		maxRateLimitWait:    a.maxRateLimitWait,
+	}
+
+	c.initServices()
+
+	return c, nil
+}
+
+func (a *Core) initServices() {
+	a.common.core = a
+	a.Accounts = (*AccountService)(&a.common)
+	a.Authorizations = (*AuthorizationService)(&a.common)
+	a.Certificates = (*CertificateService)(&a.common)
+	a.Challenges = (*ChallengeService)(&a.common)
+	a.Orders = (*OrderService)(&a.common)
+}
+
+// SetMaxBadNonceRetries sets the number of retries of a request rejected because of an invalid nonce (badNonce error).
+// Each retry uses a fresh nonce: the one provided by the CA with the error, or a new one from the newNonce endpoint.
+// The finalization requests are retried at most once.
+// 0 disables the retries.
+func (a *Core) SetMaxBadNonceRetries(n int) error {
+	if n < 0 {
+		return fmt.Errorf("invalid number of bad nonce retries: %d", n)
+	}
+
+	a.maxBadNonceRetries = n
+
+	return nil
+}
+
+// SetNonceURL overrides the URL of the newNonce endpoint provided by the directory.
+func (a *Core) SetNonceURL(uri string) error {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return fmt.Errorf("invalid nonce URL: %w", err)
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid nonce URL: %q", uri)
+	}
+
+	a.nonceManager.SetNonceURL(uri)
+
+	return nil
+}
+
+// SetNoncePoolSize sets the number of nonces pre-fetched from the newNonce endpoint.
+// The nonces are fetched in the background when the pool is empty,
+// to reduce the round trips of the concurrent requests.
+// 0 disables the pre-fetching.
+func (a *Core) SetNoncePoolSize(size int) error {
+	if size < 0 {
+		return fmt.Errorf("invalid nonce pool size: %d", size)
+	}
+
+	a.nonceManager.SetPoolSize(size)
+
+	return nil
+}
+
+// SetRateLimitRetries sets how the requests rejected with a 429 status code (rate limited) are retried.
+// A request is retried only if the response contains a Retry-After header,
+// after waiting for the time requested by the CA.
+// The request fails as soon as the next wait would exceed the maximum total wait (maxWait),
+// or when the number of retries (maxRetries) is reached.
+// 0 retries disables the retries.
+func (a *Core) SetRateLimitRetries(maxRetries int, maxWait time.Duration) error {
+	if maxRetries < 0 {
+		return fmt.Errorf("invalid number of rate limit retries: %d", maxRetries)
+	}
+
+	if maxWait < 0 {
+		return fmt.Errorf("invalid maximum rate limit wait: %s", maxWait)
+	}
+
+	a.maxRateLimitRetries = maxRetries
+	a.maxRateLimitWait = maxWait
+
+	return nil
+}
+
 // post performs an HTTP POST request and parses the response body as JSON,
 // into the provided respBody object.
 func (a *Core) post(uri string, reqBody, response interface{}) (*http.Response, error) {
+	return a.postWithRetries(uri, reqBody, response, a.maxBadNonceRetries)
+}
+
+// postFinalize is like post but caps the number of retries for the non-idempotent finalization requests.
+func (a *Core) postFinalize(uri string, reqBody, response interface{}) (*http.Response, error) {
+	return a.postWithRetries(uri, reqBody, response, min(a.maxBadNonceRetries, maxFinalizeBadNonceRetries))
+}
+
+func (a *Core) postWithRetries(uri string, reqBody, response interface{}, retries int) (*http.Response, error) {
 	content, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, errors.New("failed to marshal message")
 	}
 
-	return a.retrievablePost(uri, content, response)
+	return a.retrievablePost(uri, content, response, retries)
 }
 
 // postAsGet performs an HTTP POST ("POST-as-GET") request.
 // https://www.rfc-editor.org/rfc/rfc8555.html#section-6.3
 func (a *Core) postAsGet(uri string, response interface{}) (*http.Response, error) {
-	return a.retrievablePost(uri, []byte{}, response)
+	return a.retrievablePost(uri, []byte{}, response, a.maxBadNonceRetries)
+}
+
+// retrievablePost performs a signed POST request,
+// and retries it when the CA rejects the nonce or applies a rate limit with a Retry-After header.
+func (a *Core) retrievablePost(uri string, content []byte, response interface{}, retries int) (*http.Response, error) {
+	var waited time.Duration
+
+	for attempt := 0; ; attempt++ {
+		resp, err := a.badNonceRetrievablePost(uri, content, response, retries)
+		if err == nil || attempt >= a.maxRateLimitRetries {
+			return resp, err
+		}
+
+		wait, ok := getRateLimitWait(resp)
+		if !ok || waited+wait > a.maxRateLimitWait {
+			return resp, err
+		}
+
+		log.Infof("acme: rate limited on %s, retrying in %s: %v", uri, wait, err)
+
+		time.Sleep(wait)
+
+		waited += wait
+	}
 }
 
-func (a *Core) retrievablePost(uri string, content []byte, response interface{}) (*http.Response, error) {
+func (a *Core) badNonceRetrievablePost(uri string, content []byte, response interface{}, retries int) (*http.Response, error) {
 	// during tests, allow to support ~90% of bad nonce with a minimum of attempts.
-	bo := backoff.NewExponentialBackOff()
-	bo.InitialInterval = 200 * time.Millisecond
-	bo.MaxInterval = 5 * time.Second
-	bo.MaxElapsedTime = 20 * time.Second
+	eb := backoff.NewExponentialBackOff()
+	eb.InitialInterval = 200 * time.Millisecond
+	eb.MaxInterval = 5 * time.Second
+	eb.MaxElapsedTime = 20 * time.Second
+
+	bo := backoff.WithMaxRetries(eb, uint64(max(retries, 0)))
 
 	var resp *http.Response
 	operation := func() error {
@@ -130,8 +307,8 @@ func (a *Core) signedPost(uri string, content []byte, response interface{}) (*ht
 	return resp, err
 }
 
-func (a *Core) signEABContent(newAccountURL, kid string, hmac []byte) ([]byte, error) {
-	eabJWS, err := a.jws.SignEABContent(newAccountURL, kid, hmac)
+func (a *Core) signEABContent(newAccountURL, kid string, hmac []byte, algorithm jose.SignatureAlgorithm) ([]byte, error) {
+	eabJWS, err := a.jws.SignEABContent(newAccountURL, kid, hmac, algorithm)
 	if err != nil {
 		return nil, err
 	}
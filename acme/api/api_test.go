@@ -0,0 +1,452 @@
+package api
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupBadNonceServer creates a server rejecting the nonce from the newNonce endpoint ("12345"),
+// and providing a fresh nonce with the badNonce error.
+// When rejectAll is true, all the nonces are rejected.
+func setupBadNonceServer(t *testing.T, pattern string, rejectAll bool) (string, *atomic.Int32) {
+	t.Helper()
+
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	var attempts atomic.Int32
+
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		count := attempts.Add(1)
+
+		raw, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		jws, err := jose.ParseSigned(string(raw), []jose.SignatureAlgorithm{jose.RS256})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Replay-Nonce", fmt.Sprintf("fresh-%d", count))
+
+		if rejectAll || jws.Signatures[0].Protected.Nonce == "12345" {
+			w.Header().Set("Content-Type", "application/problem+json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintf(w, `{"type":%q,"detail":"JWS has an invalid anti-replay nonce"}`, acme.BadNonceErr)
+
+			return
+		}
+
+		err = tester.WriteJSONResponse(w, acme.Order{Status: acme.StatusValid})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	return apiURL, &attempts
+}
+
+func newTestCore(t *testing.T, apiURL string) *Core {
+	t.Helper()
+
+	// small value keeps test fast
+	privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, err, "Could not generate test key")
+
+	core, err := New(http.DefaultClient, "lego-test", apiURL+"/dir", apiURL+"/account/1", privateKey)
+	require.NoError(t, err)
+
+	return core
+}
+
+func TestCore_post_badNonce(t *testing.T) {
+	apiURL, attempts := setupBadNonceServer(t, "/order/1", false)
+
+	core := newTestCore(t, apiURL)
+
+	var order acme.Order
+	_, err := core.postAsGet(apiURL+"/order/1", &order)
+	require.NoError(t, err)
+
+	assert.Equal(t, acme.StatusValid, order.Status)
+	assert.EqualValues(t, 2, attempts.Load())
+}
+
+func TestCore_post_badNonce_retriesDisabled(t *testing.T) {
+	apiURL, attempts := setupBadNonceServer(t, "/order/1", false)
+
+	core := newTestCore(t, apiURL)
+
+	err := core.SetMaxBadNonceRetries(0)
+	require.NoError(t, err)
+
+	_, err = core.postAsGet(apiURL+"/order/1", &acme.Order{})
+	require.Error(t, err)
+
+	var nonceErr *acme.NonceError
+	require.ErrorAs(t, err, &nonceErr)
+
+	assert.EqualValues(t, 1, attempts.Load())
+}
+
+func TestCore_post_badNonce_maxRetries(t *testing.T) {
+	apiURL, attempts := setupBadNonceServer(t, "/order/1", true)
+
+	core := newTestCore(t, apiURL)
+
+	err := core.SetMaxBadNonceRetries(2)
+	require.NoError(t, err)
+
+	_, err = core.postAsGet(apiURL+"/order/1", &acme.Order{})
+	require.Error(t, err)
+
+	assert.EqualValues(t, 3, attempts.Load())
+}
+
+func TestCore_post_badNonce_finalize(t *testing.T) {
+	apiURL, attempts := setupBadNonceServer(t, "/finalize/1", true)
+
+	core := newTestCore(t, apiURL)
+
+	_, err := core.Orders.UpdateForCSR(apiURL+"/finalize/1", []byte("csr"))
+	require.Error(t, err)
+
+	// The finalization is retried only once.
+	assert.EqualValues(t, 1+maxFinalizeBadNonceRetries, attempts.Load())
+}
+
+func TestCore_SetMaxBadNonceRetries_invalid(t *testing.T) {
+	core := &Core{}
+
+	err := core.SetMaxBadNonceRetries(-1)
+	require.EqualError(t, err, "invalid number of bad nonce retries: -1")
+}
+
+// setupRateLimitServer creates a server rejecting the first requests (limited) with a 429 status code.
+// A negative limited value rejects all the requests.
+func setupRateLimitServer(t *testing.T, pattern string, limited int32, retryAfter string) (string, *atomic.Int32) {
+	t.Helper()
+
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	var attempts atomic.Int32
+
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		count := attempts.Add(1)
+
+		w.Header().Set("Replay-Nonce", fmt.Sprintf("fresh-%d", count))
+
+		if limited < 0 || count <= limited {
+			w.Header().Set("Content-Type", "application/problem+json")
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = fmt.Fprintf(w, `{"type":%q,"detail":"too many new orders recently"}`, acme.RateLimitedErr)
+
+			return
+		}
+
+		err := tester.WriteJSONResponse(w, acme.Order{Status: acme.StatusValid})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	return apiURL, &attempts
+}
+
+func TestCore_post_rateLimited(t *testing.T) {
+	apiURL, attempts := setupRateLimitServer(t, "/order/1", 1, "0")
+
+	core := newTestCore(t, apiURL)
+
+	var order acme.Order
+	_, err := core.postAsGet(apiURL+"/order/1", &order)
+	require.NoError(t, err)
+
+	assert.Equal(t, acme.StatusValid, order.Status)
+	assert.EqualValues(t, 2, attempts.Load())
+}
+
+func TestCore_post_rateLimited_finalize(t *testing.T) {
+	apiURL, attempts := setupRateLimitServer(t, "/finalize/1", 1, "0")
+
+	core := newTestCore(t, apiURL)
+
+	order, err := core.Orders.UpdateForCSR(apiURL+"/finalize/1", []byte("csr"))
+	require.NoError(t, err)
+
+	assert.Equal(t, acme.StatusValid, order.Status)
+	assert.EqualValues(t, 2, attempts.Load())
+}
+
+func TestCore_post_rateLimited_maxRetries(t *testing.T) {
+	apiURL, attempts := setupRateLimitServer(t, "/order/1", -1, "0")
+
+	core := newTestCore(t, apiURL)
+
+	err := core.SetRateLimitRetries(2, time.Minute)
+	require.NoError(t, err)
+
+	_, err = core.postAsGet(apiURL+"/order/1", &acme.Order{})
+	require.Error(t, err)
+
+	var problem *acme.ProblemDetails
+	require.ErrorAs(t, err, &problem)
+
+	assert.Equal(t, http.StatusTooManyRequests, problem.HTTPStatus)
+	assert.EqualValues(t, 3, attempts.Load())
+}
+
+func TestCore_post_rateLimited_maxWait(t *testing.T) {
+	apiURL, attempts := setupRateLimitServer(t, "/order/1", -1, "3600")
+
+	core := newTestCore(t, apiURL)
+
+	_, err := core.postAsGet(apiURL+"/order/1", &acme.Order{})
+	require.Error(t, err)
+
+	var problem *acme.ProblemDetails
+	require.ErrorAs(t, err, &problem)
+
+	assert.Equal(t, acme.RateLimitedErr, problem.Type)
+	// The wait requested by the CA exceeds the maximum total wait: the request is not retried.
+	assert.EqualValues(t, 1, attempts.Load())
+}
+
+func TestCore_post_rateLimited_noRetryAfter(t *testing.T) {
+	apiURL, attempts := setupRateLimitServer(t, "/order/1", 1, "")
+
+	core := newTestCore(t, apiURL)
+
+	_, err := core.postAsGet(apiURL+"/order/1", &acme.Order{})
+	require.Error(t, err)
+
+	assert.EqualValues(t, 1, attempts.Load())
+}
+
+func TestCore_SetRateLimitRetries_invalid(t *testing.T) {
+	core := &Core{}
+
+	err := core.SetRateLimitRetries(-1, time.Minute)
+	require.EqualError(t, err, "invalid number of rate limit retries: -1")
+
+	err = core.SetRateLimitRetries(1, -time.Second)
+	require.EqualError(t, err, "invalid maximum rate limit wait: -1s")
+}
+
+func TestCore_SetNonceURL(t *testing.T) {
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	var nonceCalls atomic.Int32
+
+	mux.HandleFunc("/custom-nonce", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Replay-Nonce", fmt.Sprintf("custom-%d", nonceCalls.Add(1)))
+	})
+
+	mux.HandleFunc("/order/1", func(w http.ResponseWriter, _ *http.Request) {
+		err := tester.WriteJSONResponse(w, acme.Order{Status: acme.StatusValid})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	core := newTestCore(t, apiURL)
+
+	err := core.SetNonceURL(apiURL + "/custom-nonce")
+	require.NoError(t, err)
+
+	_, err = core.postAsGet(apiURL+"/order/1", &acme.Order{})
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 1, nonceCalls.Load())
+}
+
+func TestCore_SetNonceURL_invalid(t *testing.T) {
+	core := &Core{}
+
+	err := core.SetNonceURL("ftp://example.com/nonce")
+	require.EqualError(t, err, `invalid nonce URL: "ftp://example.com/nonce"`)
+
+	err = core.SetNonceURL("/nonce")
+	require.EqualError(t, err, `invalid nonce URL: "/nonce"`)
+}
+
+func TestCore_SetNoncePoolSize_invalid(t *testing.T) {
+	core := &Core{}
+
+	err := core.SetNoncePoolSize(-1)
+	require.EqualError(t, err, "invalid nonce pool size: -1")
+}
+
+func TestCore_postAsGet_resources(t *testing.T) {
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	// small value keeps test fast
+	privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, err, "Could not generate test key")
+
+	resources := map[string]any{
+		"/account/1": acme.Account{Status: acme.StatusValid},
+		"/order/1":   acme.Order{Status: acme.StatusValid},
+		"/authz/1":   acme.Authorization{Status: acme.StatusValid},
+		"/chall/1":   acme.Challenge{Status: acme.StatusValid, URL: apiURL + "/chall/1"},
+		"/cert/1":    certResponseMock,
+	}
+
+	for pattern, resource := range resources {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			// Rejects plain GET requests, as some CAs do.
+			if r.Method != http.MethodPost {
+				http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+				return
+			}
+
+			body, err := readSignedBody(r, privateKey)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			if len(body) != 0 {
+				http.Error(w, fmt.Sprintf("POST-as-GET with a non-empty payload: %s", body), http.StatusBadRequest)
+				return
+			}
+
+			if cert, ok := resource.(string); ok {
+				_, _ = fmt.Fprint(w, cert)
+				return
+			}
+
+			err = tester.WriteJSONResponse(w, resource)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		})
+	}
+
+	core, err := New(http.DefaultClient, "lego-test", apiURL+"/dir", apiURL+"/account/1", privateKey)
+	require.NoError(t, err)
+
+	account, err := core.Accounts.Get(apiURL + "/account/1")
+	require.NoError(t, err)
+	assert.Equal(t, acme.StatusValid, account.Status)
+
+	order, err := core.Orders.Get(apiURL + "/order/1")
+	require.NoError(t, err)
+	assert.Equal(t, acme.StatusValid, order.Status)
+
+	authz, err := core.Authorizations.Get(apiURL + "/authz/1")
+	require.NoError(t, err)
+	assert.Equal(t, acme.StatusValid, authz.Status)
+
+	chlg, err := core.Challenges.Get(apiURL + "/chall/1")
+	require.NoError(t, err)
+	assert.Equal(t, acme.StatusValid, chlg.Status)
+
+	cert, issuer, err := core.Certificates.Get(apiURL+"/cert/1", false)
+	require.NoError(t, err)
+	assert.NotEmpty(t, cert)
+	assert.Equal(t, issuerMock, string(issuer))
+}
+
+func TestCore_WithAccount(t *testing.T) {
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	// small value keeps test fast
+	privateKeys := map[string]*rsa.PrivateKey{}
+
+	for _, account := range []string{"1", "2"} {
+		privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+		require.NoError(t, err, "Could not generate test key")
+
+		privateKeys[apiURL+"/account/"+account] = privateKey
+	}
+
+	mux.HandleFunc("POST /order/1", func(w http.ResponseWriter, r *http.Request) {
+		raw, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		jws, err := jose.ParseSigned(string(raw), []jose.SignatureAlgorithm{jose.RS256})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		kid := jws.Signatures[0].Protected.KeyID
+
+		privateKey, ok := privateKeys[kid]
+		if !ok {
+			http.Error(w, fmt.Sprintf("unknown account: %s", kid), http.StatusUnauthorized)
+			return
+		}
+
+		// The request must be signed with the key of the account.
+		_, err = jws.Verify(&jose.JSONWebKey{Key: privateKey.Public(), Algorithm: "RSA"})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		err = tester.WriteJSONResponse(w, acme.Order{Status: acme.StatusValid, Identifiers: []acme.Identifier{{Type: "dns", Value: kid}}})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	core, err := New(http.DefaultClient, "lego-test", apiURL+"/dir", apiURL+"/account/1", privateKeys[apiURL+"/account/1"])
+	require.NoError(t, err)
+
+	other, err := core.WithAccount(apiURL+"/account/2", privateKeys[apiURL+"/account/2"])
+	require.NoError(t, err)
+
+	assert.Equal(t, core.GetDirectory(), other.GetDirectory())
+
+	order, err := core.Orders.Get(apiURL + "/order/1")
+	require.NoError(t, err)
+	assert.Equal(t, apiURL+"/account/1", order.Identifiers[0].Value)
+
+	order, err = other.Orders.Get(apiURL + "/order/1")
+	require.NoError(t, err)
+	assert.Equal(t, apiURL+"/account/2", order.Identifiers[0].Value)
+
+	// The key authorizations depend on the key of the account.
+	keyAuth, err := core.GetKeyAuthorization("token")
+	require.NoError(t, err)
+
+	otherKeyAuth, err := other.GetKeyAuthorization("token")
+	require.NoError(t, err)
+
+	assert.NotEqual(t, keyAuth, otherKeyAuth)
+}
+
+func TestCore_WithAccount_nilKey(t *testing.T) {
+	_, apiURL := tester.SetupFakeAPI(t)
+
+	core := newTestCore(t, apiURL)
+
+	_, err := core.WithAccount(apiURL+"/account/2", nil)
+	require.EqualError(t, err, "private key was nil")
+}
@@ -22,6 +22,21 @@ func (c *AuthorizationService) Get(authzURL string) (acme.Authorization, error)
 	return authz, nil
 }
 
+// Poll Gets an authorization, with the Retry-After header sent by the CA to pace the polling of its status.
+func (c *AuthorizationService) Poll(authzURL string) (acme.ExtendedAuthorization, error) {
+	if authzURL == "" {
+		return acme.ExtendedAuthorization{}, errors.New("authorization[poll]: empty URL")
+	}
+
+	var authz acme.Authorization
+	resp, err := c.core.postAsGet(authzURL, &authz)
+	if err != nil {
+		return acme.ExtendedAuthorization{}, err
+	}
+
+	return acme.ExtendedAuthorization{Authorization: authz, RetryAfter: getRetryAfter(resp)}, nil
+}
+
 // Deactivate Deactivates an authorization.
 func (c *AuthorizationService) Deactivate(authzURL string) error {
 	if authzURL == "" {
@@ -10,10 +10,17 @@ import (
 )
 
 // Manager Manages nonces.
+//
+// The nonces provided by the CA with the responses are kept for the next requests.
+// When a pool size is defined, the Manager also pre-fetches nonces from the newNonce endpoint,
+// in the background, each time the pool is empty.
+// A nonce is never returned twice.
 type Manager struct {
 	do       *sender.Doer
 	nonceURL string
 	nonces   []string
+	poolSize int
+	filling  bool
 	sync.Mutex
 }
 
@@ -25,6 +32,24 @@ func NewManager(do *sender.Doer, nonceURL string) *Manager {
 	}
 }
 
+// SetNonceURL overrides the URL of the newNonce endpoint.
+func (n *Manager) SetNonceURL(nonceURL string) {
+	n.Lock()
+	defer n.Unlock()
+
+	n.nonceURL = nonceURL
+}
+
+// SetPoolSize sets the number of nonces kept and pre-fetched by the Manager.
+// 0 disables the pre-fetching and doesn't limit the number of nonces kept.
+func (n *Manager) SetPoolSize(size int) {
+	n.Lock()
+	defer n.Unlock()
+
+	n.poolSize = max(size, 0)
+	n.trim()
+}
+
 // Pop Pops a nonce.
 func (n *Manager) Pop() (string, bool) {
 	n.Lock()
@@ -40,10 +65,20 @@ func (n *Manager) Pop() (string, bool) {
 }
 
 // Push Pushes a nonce.
+// When the pool is full, the oldest nonce is dropped.
 func (n *Manager) Push(nonce string) {
 	n.Lock()
 	defer n.Unlock()
 	n.nonces = append(n.nonces, nonce)
+	n.trim()
+}
+
+// Len returns the number of available nonces.
+func (n *Manager) Len() int {
+	n.Lock()
+	defer n.Unlock()
+
+	return len(n.nonces)
 }
 
 // Nonce implement jose.NonceSource.
@@ -51,11 +86,68 @@ func (n *Manager) Nonce() (string, error) {
 	if nonce, ok := n.Pop(); ok {
 		return nonce, nil
 	}
+
+	n.fill()
+
 	return n.getNonce()
 }
 
+// Fill fetches nonces from the newNonce endpoint until the pool is full.
+func (n *Manager) Fill() error {
+	for {
+		n.Lock()
+		full := len(n.nonces) >= n.poolSize
+		n.Unlock()
+
+		if full {
+			return nil
+		}
+
+		nonce, err := n.getNonce()
+		if err != nil {
+			return err
+		}
+
+		n.Push(nonce)
+	}
+}
+
+// fill refills the pool in the background.
+// Only one refill is running at a time.
+func (n *Manager) fill() {
+	n.Lock()
+	defer n.Unlock()
+
+	if n.poolSize == 0 || n.filling {
+		return
+	}
+
+	n.filling = true
+
+	go func() {
+		// The errors are ignored: the next requests will fetch their nonces directly.
+		_ = n.Fill()
+
+		n.Lock()
+		n.filling = false
+		n.Unlock()
+	}()
+}
+
+// trim drops the oldest nonces exceeding the pool size.
+// The lock must be held by the caller.
+func (n *Manager) trim() {
+	if n.poolSize > 0 && len(n.nonces) > n.poolSize {
+		n.nonces = n.nonces[len(n.nonces)-n.poolSize:]
+	}
+}
+
 func (n *Manager) getNonce() (string, error) {
-	resp, err := n.do.Head(n.nonceURL)
+	n.Lock()
+	nonceURL := n.nonceURL
+	n.Unlock()
+
+	resp, err := n.do.Head(nonceURL)
 	if err != nil {
 		return "", fmt.Errorf("failed to get nonce from HTTP HEAD: %w", err)
 	}
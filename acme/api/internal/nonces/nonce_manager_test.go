@@ -1,14 +1,19 @@
 package nonces
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/go-acme/lego/v4/acme"
 	"github.com/go-acme/lego/v4/acme/api/internal/sender"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNotHoldingLockWhileMakingHTTPRequests(t *testing.T) {
@@ -53,3 +58,165 @@ func TestNotHoldingLockWhileMakingHTTPRequests(t *testing.T) {
 		t.Fatal("JWS is probably holding a lock while making HTTP request")
 	}
 }
+
+// setupNonceServer creates a server providing a unique nonce for each request.
+func setupNonceServer(t testing.TB) (string, *atomic.Int32) {
+	t.Helper()
+
+	var counter atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Replay-Nonce", fmt.Sprintf("nonce-%d", counter.Add(1)))
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL, &counter
+}
+
+func TestManager_Nonce_concurrent(t *testing.T) {
+	nonceURL, _ := setupNonceServer(t)
+
+	manager := NewManager(sender.NewDoer(http.DefaultClient, "lego-test"), nonceURL)
+	manager.SetPoolSize(5)
+
+	var (
+		mu   sync.Mutex
+		seen = map[string]int{}
+		wg   sync.WaitGroup
+	)
+
+	for range 50 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			nonce, err := manager.Nonce()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			// Simulates the nonce returned by the CA with the response.
+			manager.Push(nonce + "-response")
+
+			mu.Lock()
+			seen[nonce]++
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Len(t, seen, 50)
+
+	for nonce, count := range seen {
+		assert.Equal(t, 1, count, "the nonce %s has been used %d times", nonce, count)
+	}
+}
+
+func TestManager_Nonce_prefetch(t *testing.T) {
+	nonceURL, counter := setupNonceServer(t)
+
+	manager := NewManager(sender.NewDoer(http.DefaultClient, "lego-test"), nonceURL)
+	manager.SetPoolSize(3)
+
+	nonce, err := manager.Nonce()
+	require.NoError(t, err)
+	assert.NotEmpty(t, nonce)
+
+	// The pool is refilled in the background.
+	assert.Eventually(t, func() bool { return manager.Len() == 3 }, time.Second, 10*time.Millisecond)
+
+	for range 3 {
+		_, err = manager.Nonce()
+		require.NoError(t, err)
+	}
+
+	// The nonces come from the pool.
+	assert.EqualValues(t, 4, counter.Load())
+}
+
+func TestManager_Nonce_noPrefetch(t *testing.T) {
+	nonceURL, counter := setupNonceServer(t)
+
+	manager := NewManager(sender.NewDoer(http.DefaultClient, "lego-test"), nonceURL)
+
+	nonce, err := manager.Nonce()
+	require.NoError(t, err)
+	assert.Equal(t, "nonce-1", nonce)
+
+	time.Sleep(50 * time.Millisecond)
+
+	assert.Equal(t, 0, manager.Len())
+	assert.EqualValues(t, 1, counter.Load())
+}
+
+func TestManager_Fill(t *testing.T) {
+	nonceURL, _ := setupNonceServer(t)
+
+	manager := NewManager(sender.NewDoer(http.DefaultClient, "lego-test"), nonceURL)
+	manager.SetPoolSize(2)
+
+	err := manager.Fill()
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, manager.Len())
+}
+
+func TestManager_Push_poolSize(t *testing.T) {
+	manager := NewManager(nil, "")
+	manager.SetPoolSize(2)
+
+	manager.Push("a")
+	manager.Push("b")
+	manager.Push("c")
+
+	// The oldest nonce is dropped.
+	assert.Equal(t, 2, manager.Len())
+
+	nonce, ok := manager.Pop()
+	require.True(t, ok)
+	assert.Equal(t, "c", nonce)
+
+	nonce, ok = manager.Pop()
+	require.True(t, ok)
+	assert.Equal(t, "b", nonce)
+
+	_, ok = manager.Pop()
+	assert.False(t, ok)
+}
+
+func TestManager_SetNonceURL(t *testing.T) {
+	nonceURL, counter := setupNonceServer(t)
+
+	manager := NewManager(sender.NewDoer(http.DefaultClient, "lego-test"), "http://invalid.localhost")
+	manager.SetNonceURL(nonceURL)
+
+	nonce, err := manager.Nonce()
+	require.NoError(t, err)
+
+	assert.Equal(t, "nonce-1", nonce)
+	assert.EqualValues(t, 1, counter.Load())
+}
+
+func BenchmarkManager_Nonce(b *testing.B) {
+	for _, size := range []int{0, 10} {
+		b.Run(fmt.Sprintf("pool size %d", size), func(b *testing.B) {
+			nonceURL, _ := setupNonceServer(b)
+
+			manager := NewManager(sender.NewDoer(http.DefaultClient, "lego-test"), nonceURL)
+			manager.SetPoolSize(size)
+
+			b.ResetTimer()
+
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					_, err := manager.Nonce()
+					if err != nil {
+						b.Error(err)
+					}
+				}
+			})
+		})
+	}
+}
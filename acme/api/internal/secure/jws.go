@@ -33,6 +33,11 @@ func (j *JWS) SetKid(kid string) {
 	j.kid = kid
 }
 
+// GetKid Gets the key identifier.
+func (j *JWS) GetKid() string {
+	return j.kid
+}
+
 // SignContent Signs a content with the JWS.
 func (j *JWS) SignContent(url string, content []byte) (*jose.JSONWebSignature, error) {
 	var alg jose.SignatureAlgorithm
@@ -76,7 +81,8 @@ func (j *JWS) SignContent(url string, content []byte) (*jose.JSONWebSignature, e
 }
 
 // SignEABContent Signs an external account binding content with the JWS.
-func (j *JWS) SignEABContent(url, kid string, hmac []byte) (*jose.JSONWebSignature, error) {
+// The algorithm must be an HMAC algorithm (HS256, HS384, HS512).
+func (j *JWS) SignEABContent(url, kid string, hmac []byte, algorithm jose.SignatureAlgorithm) (*jose.JSONWebSignature, error) {
 	jwk := jose.JSONWebKey{Key: j.privKey}
 	jwkJSON, err := jwk.Public().MarshalJSON()
 	if err != nil {
@@ -84,7 +90,7 @@ func (j *JWS) SignEABContent(url, kid string, hmac []byte) (*jose.JSONWebSignatu
 	}
 
 	signer, err := jose.NewSigner(
-		jose.SigningKey{Algorithm: jose.HS256, Key: hmac},
+		jose.SigningKey{Algorithm: algorithm, Key: hmac},
 		&jose.SignerOptions{
 			EmbedJWK: false,
 			ExtraHeaders: map[jose.HeaderKey]interface{}{
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"runtime"
 	"strings"
 
 	"github.com/go-acme/lego/v4/acme"
 )
 
+// EnvUserAgentSuffix is the environment variable used to append an identifier to the User-Agent of all the requests.
+const EnvUserAgentSuffix = "LEGO_USER_AGENT_SUFFIX"
+
 type RequestOption func(*http.Request) error
 
 func contentType(ct string) RequestOption {
@@ -21,8 +25,9 @@ func contentType(ct string) RequestOption {
 }
 
 type Doer struct {
-	httpClient *http.Client
-	userAgent  string
+	httpClient      *http.Client
+	userAgent       string
+	userAgentSuffix string
 }
 
 // NewDoer Creates a new Doer.
@@ -33,6 +38,12 @@ func NewDoer(client *http.Client, userAgent string) *Doer {
 	}
 }
 
+// SetUserAgentSuffix defines the identifier appended to the User-Agent.
+// It overrides the value of the environment variable LEGO_USER_AGENT_SUFFIX.
+func (d *Doer) SetUserAgentSuffix(suffix string) {
+	d.userAgentSuffix = suffix
+}
+
 // Get performs a GET request with a proper User-Agent string.
 // If "response" is not provided, callers should close resp.Body when done reading from it.
 func (d *Doer) Get(url string, response interface{}) (*http.Response, error) {
@@ -113,7 +124,12 @@ func (d *Doer) do(req *http.Request, response interface{}) (*http.Response, erro
 
 // formatUserAgent builds and returns the User-Agent string to use in requests.
 func (d *Doer) formatUserAgent() string {
-	ua := fmt.Sprintf("%s %s (%s; %s; %s)", d.userAgent, ourUserAgent, ourUserAgentComment, runtime.GOOS, runtime.GOARCH)
+	suffix := d.userAgentSuffix
+	if suffix == "" {
+		suffix = os.Getenv(EnvUserAgentSuffix)
+	}
+
+	ua := fmt.Sprintf("%s %s (%s; %s; %s) %s", d.userAgent, ourUserAgent, ourUserAgentComment, runtime.GOOS, runtime.GOARCH, suffix)
 	return strings.TrimSpace(ua)
 }
 
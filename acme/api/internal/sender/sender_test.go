@@ -65,3 +65,49 @@ func TestDo_CustomUserAgent(t *testing.T) {
 	}
 	assert.Len(t, strings.Split(ua, " "), 5)
 }
+
+func TestDo_UserAgentSuffix(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		env      string
+		suffix   string
+		expected string
+	}{
+		{
+			desc:     "environment variable",
+			env:      "gateway/1.0",
+			expected: ") gateway/1.0",
+		},
+		{
+			desc:     "override",
+			env:      "gateway/1.0",
+			suffix:   "client/2.0",
+			expected: ") client/2.0",
+		},
+		{
+			desc:     "no suffix",
+			expected: ")",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Setenv(EnvUserAgentSuffix, test.env)
+
+			var ua string
+			server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				ua = r.Header.Get("User-Agent")
+			}))
+			t.Cleanup(server.Close)
+
+			doer := NewDoer(http.DefaultClient, "MyApp/1.2.3")
+			doer.SetUserAgentSuffix(test.suffix)
+
+			_, err := doer.Get(server.URL, nil)
+			require.NoError(t, err)
+
+			assert.True(t, strings.HasPrefix(ua, "MyApp/1.2.3 "+ourUserAgent), ua)
+			assert.True(t, strings.HasSuffix(ua, test.expected), ua)
+		})
+	}
+}
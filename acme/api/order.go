@@ -3,10 +3,14 @@ package api
 import (
 	"encoding/base64"
 	"errors"
+	"fmt"
 	"net"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/log"
 )
 
 // OrderOptions used to create an order (optional).
@@ -17,8 +21,32 @@ type OrderOptions struct {
 	// order is intended to replace.
 	// - https://datatracker.ietf.org/doc/html/draft-ietf-acme-ari-03#section-5
 	ReplacesCertID string
+	// The name of a profile advertised in the directory meta.
+	// - https://datatracker.ietf.org/doc/draft-aaron-acme-profiles/
+	Profile string
+	// The parameters of a STAR (Short-Term, Automatically Renewed) order.
+	// - https://www.rfc-editor.org/rfc/rfc8739.html
+	AutoRenewal *AutoRenewalParams
 }
 
+// AutoRenewalParams the parameters of a STAR order.
+// - https://www.rfc-editor.org/rfc/rfc8739.html#section-3.1.1
+type AutoRenewalParams struct {
+	// The earliest date of validity of the first certificate (optional).
+	StartDate time.Time
+	// The latest date of validity of the last certificate (required).
+	EndDate time.Time
+	// The validity period of each certificate (required).
+	Lifetime time.Duration
+	// The amount of "left pad" added to each certificate (optional).
+	LifetimeAdjust time.Duration
+	// Allows to fetch the certificates with unauthenticated GET requests.
+	AllowCertificateGet bool
+}
+
+// maxOrdersListPages limits the number of pages fetched when listing the orders of an account.
+const maxOrdersListPages = 10
+
 type OrderService service
 
 // New Creates a new order.
@@ -28,18 +56,7 @@ func (o *OrderService) New(domains []string) (acme.ExtendedOrder, error) {
 
 // NewWithOptions Creates a new order.
 func (o *OrderService) NewWithOptions(domains []string, opts *OrderOptions) (acme.ExtendedOrder, error) {
-	var identifiers []acme.Identifier
-	for _, domain := range domains {
-		ident := acme.Identifier{Value: domain, Type: "dns"}
-
-		if net.ParseIP(domain) != nil {
-			ident.Type = "ip"
-		}
-
-		identifiers = append(identifiers, ident)
-	}
-
-	orderReq := acme.Order{Identifiers: identifiers}
+	orderReq := acme.Order{Identifiers: createIdentifiers(domains)}
 
 	if opts != nil {
 		if !opts.NotAfter.IsZero() {
@@ -53,10 +70,38 @@ func (o *OrderService) NewWithOptions(domains []string, opts *OrderOptions) (acm
 		if o.core.GetDirectory().RenewalInfo != "" {
 			orderReq.Replaces = opts.ReplacesCertID
 		}
+
+		if opts.Profile != "" {
+			err := checkProfile(o.core.GetDirectory().Meta, opts.Profile)
+			if err != nil {
+				return acme.ExtendedOrder{}, err
+			}
+
+			orderReq.Profile = opts.Profile
+		}
+
+		if opts.AutoRenewal != nil {
+			autoRenewal, err := createAutoRenewal(o.core.GetDirectory().Meta, opts.AutoRenewal)
+			if err != nil {
+				return acme.ExtendedOrder{}, err
+			}
+
+			orderReq.AutoRenewal = autoRenewal
+		}
 	}
 
 	var order acme.Order
 	resp, err := o.core.post(o.core.GetDirectory().NewOrderURL, orderReq, &order)
+	if err != nil && (orderReq.NotBefore != "" || orderReq.NotAfter != "") && isValidityUnsupported(err) {
+		// The requested validity is only a hint: the order is created with the validity chosen by the CA.
+		log.Warnf("acme: the CA doesn't support the notBefore and notAfter fields, the order is created without them: %v", err)
+
+		orderReq.NotBefore = ""
+		orderReq.NotAfter = ""
+
+		resp, err = o.core.post(o.core.GetDirectory().NewOrderURL, orderReq, &order)
+	}
+
 	if err != nil {
 		return acme.ExtendedOrder{}, err
 	}
@@ -74,29 +119,184 @@ func (o *OrderService) Get(orderURL string) (acme.ExtendedOrder, error) {
 	}
 
 	var order acme.Order
-	_, err := o.core.postAsGet(orderURL, &order)
+	resp, err := o.core.postAsGet(orderURL, &order)
 	if err != nil {
 		return acme.ExtendedOrder{}, err
 	}
 
-	return acme.ExtendedOrder{Order: order}, nil
+	return acme.ExtendedOrder{Order: order, RetryAfter: getRetryAfter(resp)}, nil
+}
+
+// List Lists the URLs of the orders of an account.
+// The pages are followed through the `next` links.
+// - https://www.rfc-editor.org/rfc/rfc8555.html#section-7.1.2.1
+func (o *OrderService) List(ordersURL string) ([]string, error) {
+	if ordersURL == "" {
+		return nil, errors.New("order[list]: empty URL")
+	}
+
+	var orders []string
+
+	for page := 0; ordersURL != "" && page < maxOrdersListPages; page++ {
+		var list acme.OrdersList
+		resp, err := o.core.postAsGet(ordersURL, &list)
+		if err != nil {
+			return nil, err
+		}
+
+		orders = append(orders, list.Orders...)
+
+		ordersURL = getLink(resp.Header, "next")
+	}
+
+	return orders, nil
+}
+
+// ListForAccount Lists the URLs of the orders of the current account.
+// Returns an empty list if the CA doesn't expose the orders of the account.
+func (o *OrderService) ListForAccount() ([]string, error) {
+	accountURL := o.core.jws.GetKid()
+	if accountURL == "" {
+		return nil, errors.New("order[list]: no account URL")
+	}
+
+	account, err := o.core.Accounts.Get(accountURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.Orders == "" {
+		return nil, nil
+	}
+
+	return o.List(account.Orders)
 }
 
 // UpdateForCSR Updates an order for a CSR.
+// When the CA marks the order as invalid, the order is returned along with the error of the order.
 func (o *OrderService) UpdateForCSR(orderURL string, csr []byte) (acme.ExtendedOrder, error) {
 	csrMsg := acme.CSRMessage{
 		Csr: base64.RawURLEncoding.EncodeToString(csr),
 	}
 
 	var order acme.Order
-	_, err := o.core.post(orderURL, csrMsg, &order)
+	resp, err := o.core.postFinalize(orderURL, csrMsg, &order)
 	if err != nil {
 		return acme.ExtendedOrder{}, err
 	}
 
 	if order.Status == acme.StatusInvalid {
-		return acme.ExtendedOrder{}, order.Error
+		// The invalid order is returned with the error, to expose its state to the caller.
+		if order.Error != nil {
+			return acme.ExtendedOrder{Order: order}, order.Error
+		}
+
+		return acme.ExtendedOrder{Order: order}, errors.New("order[finalize]: the order is invalid")
+	}
+
+	return acme.ExtendedOrder{Order: order, RetryAfter: getRetryAfter(resp)}, nil
+}
+
+// createIdentifiers creates the identifiers of an order:
+// the IP literals are "ip" identifiers (RFC 8738), the other values are "dns" identifiers.
+func createIdentifiers(domains []string) []acme.Identifier {
+	var identifiers []acme.Identifier
+
+	for _, domain := range domains {
+		ident := acme.Identifier{Value: domain, Type: "dns"}
+
+		if ip := net.ParseIP(domain); ip != nil {
+			// RFC 8738 section 3: the textual form of the address (RFC 5952 for IPv6).
+			ident = acme.Identifier{Value: ip.String(), Type: "ip"}
+		}
+
+		identifiers = append(identifiers, ident)
+	}
+
+	return identifiers
+}
+
+// isValidityUnsupported checks if the order has been rejected because of the notBefore and notAfter fields.
+// There is no way to know if a CA supports these fields before the creation of an order:
+// the CAs that don't support them reject the order with a malformed error (e.g. "NotBefore and NotAfter are not supported").
+func isValidityUnsupported(err error) bool {
+	var problem *acme.ProblemDetails
+	if !errors.As(err, &problem) || problem.Type != acme.MalformedErr {
+		return false
+	}
+
+	detail := strings.ToLower(problem.Detail)
+
+	return strings.Contains(detail, "notbefore") || strings.Contains(detail, "notafter")
+}
+
+// checkProfile checks that the profile is advertised in the directory meta.
+func checkProfile(meta acme.Meta, profile string) error {
+	if _, ok := meta.Profiles[profile]; ok {
+		return nil
+	}
+
+	if len(meta.Profiles) == 0 {
+		return fmt.Errorf("order[new]: the profile %q is requested but the CA doesn't advertise any profile", profile)
+	}
+
+	var names []string
+	for name := range meta.Profiles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return fmt.Errorf("order[new]: the profile %q is not advertised by the CA (available profiles: %s)", profile, strings.Join(names, ", "))
+}
+
+// createAutoRenewal checks the parameters of a STAR order against the directory meta.
+func createAutoRenewal(meta acme.Meta, params *AutoRenewalParams) (*acme.AutoRenewal, error) {
+	if meta.AutoRenewal == nil {
+		return nil, errors.New("order[new]: auto-renewal is requested but the CA doesn't support STAR certificates")
+	}
+
+	if params.EndDate.IsZero() {
+		return nil, errors.New("order[new]: auto-renewal: the end date is required")
+	}
+
+	if params.Lifetime <= 0 {
+		return nil, fmt.Errorf("order[new]: auto-renewal: invalid lifetime: %s", params.Lifetime)
+	}
+
+	minLifetime := time.Duration(meta.AutoRenewal.MinLifetime) * time.Second
+	if params.Lifetime < minLifetime {
+		return nil, fmt.Errorf("order[new]: auto-renewal: the lifetime (%s) is lower than the minimum lifetime allowed by the CA (%s)", params.Lifetime, minLifetime)
+	}
+
+	start := params.StartDate
+	if start.IsZero() {
+		start = time.Now()
+	}
+
+	if !params.EndDate.After(start) {
+		return nil, errors.New("order[new]: auto-renewal: the end date must be after the start date")
+	}
+
+	maxDuration := time.Duration(meta.AutoRenewal.MaxDuration) * time.Second
+	if maxDuration > 0 && params.EndDate.Sub(start) > maxDuration {
+		return nil, fmt.Errorf("order[new]: auto-renewal: the duration (%s) is greater than the maximum duration allowed by the CA (%s)", params.EndDate.Sub(start), maxDuration)
+	}
+
+	if params.AllowCertificateGet && !meta.AutoRenewal.AllowCertificateGet {
+		return nil, errors.New("order[new]: auto-renewal: the CA doesn't allow to fetch the certificates with GET requests")
+	}
+
+	autoRenewal := &acme.AutoRenewal{
+		EndDate:             params.EndDate.Format(time.RFC3339),
+		Lifetime:            int(params.Lifetime / time.Second),
+		LifetimeAdjust:      int(params.LifetimeAdjust / time.Second),
+		AllowCertificateGet: params.AllowCertificateGet,
+	}
+
+	if !params.StartDate.IsZero() {
+		autoRenewal.StartDate = params.StartDate.Format(time.RFC3339)
 	}
 
-	return acme.ExtendedOrder{Order: order}, nil
+	return autoRenewal, nil
 }
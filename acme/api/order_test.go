@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"sort"
 	"testing"
 	"time"
 
@@ -64,6 +66,7 @@ func TestOrderService_NewWithOptions(t *testing.T) {
 
 	testCases := []struct {
 		desc     string
+		domains  []string
 		opts     *OrderOptions
 		expected acme.ExtendedOrder
 	}{
@@ -76,6 +79,20 @@ func TestOrderService_NewWithOptions(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc:    "DNS name and IP address",
+			domains: []string{"example.com", "192.0.2.1", "2001:DB8::0:1"},
+			expected: acme.ExtendedOrder{
+				Order: acme.Order{
+					Status: "valid",
+					Identifiers: []acme.Identifier{
+						{Type: "dns", Value: "example.com"},
+						{Type: "ip", Value: "192.0.2.1"},
+						{Type: "ip", Value: "2001:db8::1"},
+					},
+				},
+			},
+		},
 		{
 			desc: "with options",
 			opts: &OrderOptions{
@@ -97,7 +114,12 @@ func TestOrderService_NewWithOptions(t *testing.T) {
 		t.Run(test.desc, func(t *testing.T) {
 			t.Parallel()
 
-			order, err := core.Orders.NewWithOptions([]string{"example.com"}, test.opts)
+			domains := test.domains
+			if len(domains) == 0 {
+				domains = []string{"example.com"}
+			}
+
+			order, err := core.Orders.NewWithOptions(domains, test.opts)
 			require.NoError(t, err)
 
 			assert.Equal(t, test.expected, order)
@@ -105,6 +127,432 @@ func TestOrderService_NewWithOptions(t *testing.T) {
 	}
 }
 
+func TestOrderService_NewWithOptions_profile(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	// small value keeps test fast
+	privateKey, errK := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, errK, "Could not generate test key")
+
+	mux.HandleFunc("/dir", func(w http.ResponseWriter, _ *http.Request) {
+		err := tester.WriteJSONResponse(w, acme.Directory{
+			NewNonceURL:   server.URL + "/nonce",
+			NewAccountURL: server.URL + "/account",
+			NewOrderURL:   server.URL + "/newOrder",
+			Meta: acme.Meta{
+				Profiles: map[string]string{
+					"classic":    "https://example.com/docs/classic",
+					"shortlived": "https://example.com/docs/shortlived",
+				},
+			},
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	mux.HandleFunc("/nonce", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Replay-Nonce", "12345")
+	})
+
+	mux.HandleFunc("/newOrder", func(w http.ResponseWriter, r *http.Request) {
+		body, err := readSignedBody(r, privateKey)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		order := acme.Order{}
+		err = json.Unmarshal(body, &order)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = tester.WriteJSONResponse(w, acme.Order{
+			Status:      acme.StatusPending,
+			Identifiers: order.Identifiers,
+			Profile:     order.Profile,
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	core, err := New(http.DefaultClient, "lego-test", server.URL+"/dir", "", privateKey)
+	require.NoError(t, err)
+
+	order, err := core.Orders.NewWithOptions([]string{"example.com"}, &OrderOptions{Profile: "shortlived"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "shortlived", order.Profile)
+
+	_, err = core.Orders.NewWithOptions([]string{"example.com"}, &OrderOptions{Profile: "unknown"})
+	require.EqualError(t, err, `order[new]: the profile "unknown" is not advertised by the CA (available profiles: classic, shortlived)`)
+}
+
+func TestOrderService_NewWithOptions_profileNotSupported(t *testing.T) {
+	_, apiURL := tester.SetupFakeAPI(t)
+
+	// small value keeps test fast
+	privateKey, errK := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, errK, "Could not generate test key")
+
+	core, err := New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+	require.NoError(t, err)
+
+	_, err = core.Orders.NewWithOptions([]string{"example.com"}, &OrderOptions{Profile: "classic"})
+	require.EqualError(t, err, `order[new]: the profile "classic" is requested but the CA doesn't advertise any profile`)
+}
+
+func TestOrderService_NewWithOptions_validity(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		supported     bool
+		opts          *OrderOptions
+		expectedKeys  [][]string
+		expectedOrder acme.Order
+	}{
+		{
+			desc:         "zero values",
+			opts:         &OrderOptions{},
+			expectedKeys: [][]string{{"identifiers"}},
+		},
+		{
+			desc:      "supported",
+			supported: true,
+			opts: &OrderOptions{
+				NotBefore: time.Date(2023, 1, 1, 1, 0, 0, 0, time.UTC),
+				NotAfter:  time.Date(2023, 1, 2, 1, 0, 0, 0, time.UTC),
+			},
+			expectedKeys: [][]string{{"identifiers", "notAfter", "notBefore"}},
+			expectedOrder: acme.Order{
+				NotBefore: "2023-01-01T01:00:00Z",
+				NotAfter:  "2023-01-02T01:00:00Z",
+			},
+		},
+		{
+			desc: "only notAfter",
+			opts: &OrderOptions{
+				NotAfter: time.Date(2023, 1, 2, 1, 0, 0, 0, time.UTC),
+			},
+			supported:     true,
+			expectedKeys:  [][]string{{"identifiers", "notAfter"}},
+			expectedOrder: acme.Order{NotAfter: "2023-01-02T01:00:00Z"},
+		},
+		{
+			desc: "not supported",
+			opts: &OrderOptions{
+				NotBefore: time.Date(2023, 1, 1, 1, 0, 0, 0, time.UTC),
+				NotAfter:  time.Date(2023, 1, 2, 1, 0, 0, 0, time.UTC),
+			},
+			// The order is created again without the notBefore and notAfter fields.
+			expectedKeys: [][]string{{"identifiers", "notAfter", "notBefore"}, {"identifiers"}},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			mux, apiURL := tester.SetupFakeAPI(t)
+
+			// small value keeps test fast
+			privateKey, errK := rsa.GenerateKey(rand.Reader, 512)
+			require.NoError(t, errK, "Could not generate test key")
+
+			var keys [][]string
+
+			mux.HandleFunc("/newOrder", func(w http.ResponseWriter, r *http.Request) {
+				body, err := readSignedBody(r, privateKey)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				fields := map[string]json.RawMessage{}
+				err = json.Unmarshal(body, &fields)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				var names []string
+				for name := range fields {
+					names = append(names, name)
+				}
+
+				sort.Strings(names)
+				keys = append(keys, names)
+
+				order := acme.Order{}
+				err = json.Unmarshal(body, &order)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				if !test.supported && (order.NotBefore != "" || order.NotAfter != "") {
+					w.Header().Set("Content-Type", "application/problem+json")
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = w.Write([]byte(`{"type":"urn:ietf:params:acme:error:malformed","detail":"NotBefore and NotAfter are not supported","status":400}`))
+					return
+				}
+
+				err = tester.WriteJSONResponse(w, acme.Order{
+					Status:      acme.StatusPending,
+					Identifiers: order.Identifiers,
+					NotBefore:   order.NotBefore,
+					NotAfter:    order.NotAfter,
+				})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+				}
+			})
+
+			core, err := New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+			require.NoError(t, err)
+
+			order, err := core.Orders.NewWithOptions([]string{"example.com"}, test.opts)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedKeys, keys)
+			assert.Equal(t, test.expectedOrder.NotBefore, order.NotBefore)
+			assert.Equal(t, test.expectedOrder.NotAfter, order.NotAfter)
+		})
+	}
+}
+
+func TestOrderService_NewWithOptions_validityOtherError(t *testing.T) {
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	// small value keeps test fast
+	privateKey, errK := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, errK, "Could not generate test key")
+
+	var calls int
+
+	mux.HandleFunc("/newOrder", func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"type":"urn:ietf:params:acme:error:malformed","detail":"invalid identifiers","status":400}`))
+	})
+
+	core, err := New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+	require.NoError(t, err)
+
+	_, err = core.Orders.NewWithOptions([]string{"example.com"}, &OrderOptions{NotAfter: time.Now().Add(24 * time.Hour)})
+	require.ErrorContains(t, err, "invalid identifiers")
+
+	// The other errors are not retried without the validity fields.
+	assert.Equal(t, 1, calls)
+}
+
+func TestOrderService_NewWithOptions_autoRenewal(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	// small value keeps test fast
+	privateKey, errK := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, errK, "Could not generate test key")
+
+	mux.HandleFunc("/dir", func(w http.ResponseWriter, _ *http.Request) {
+		err := tester.WriteJSONResponse(w, acme.Directory{
+			NewNonceURL:   server.URL + "/nonce",
+			NewAccountURL: server.URL + "/account",
+			NewOrderURL:   server.URL + "/newOrder",
+			Meta: acme.Meta{
+				AutoRenewal: &acme.AutoRenewalMeta{
+					MinLifetime: 86400,
+					MaxDuration: 31536000,
+				},
+			},
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	mux.HandleFunc("/nonce", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Replay-Nonce", "12345")
+	})
+
+	var received *acme.AutoRenewal
+
+	mux.HandleFunc("/newOrder", func(w http.ResponseWriter, r *http.Request) {
+		body, err := readSignedBody(r, privateKey)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		order := acme.Order{}
+		err = json.Unmarshal(body, &order)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		received = order.AutoRenewal
+
+		err = tester.WriteJSONResponse(w, acme.Order{
+			Status:          acme.StatusValid,
+			Identifiers:     order.Identifiers,
+			AutoRenewal:     order.AutoRenewal,
+			StarCertificate: server.URL + "/star/123",
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	core, err := New(http.DefaultClient, "lego-test", server.URL+"/dir", "", privateKey)
+	require.NoError(t, err)
+
+	start := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	params := &AutoRenewalParams{
+		StartDate:      start,
+		EndDate:        start.Add(30 * 24 * time.Hour),
+		Lifetime:       4 * 24 * time.Hour,
+		LifetimeAdjust: time.Hour,
+	}
+
+	order, err := core.Orders.NewWithOptions([]string{"example.com"}, &OrderOptions{AutoRenewal: params})
+	require.NoError(t, err)
+
+	expected := &acme.AutoRenewal{
+		StartDate:      "2030-01-01T00:00:00Z",
+		EndDate:        "2030-01-31T00:00:00Z",
+		Lifetime:       345600,
+		LifetimeAdjust: 3600,
+	}
+
+	assert.Equal(t, expected, received)
+	assert.Equal(t, server.URL+"/star/123", order.StarCertificate)
+
+	testCases := []struct {
+		desc     string
+		params   AutoRenewalParams
+		expected string
+	}{
+		{
+			desc:     "missing end date",
+			params:   AutoRenewalParams{Lifetime: 4 * 24 * time.Hour},
+			expected: "order[new]: auto-renewal: the end date is required",
+		},
+		{
+			desc:     "lifetime lower than the minimum",
+			params:   AutoRenewalParams{StartDate: start, EndDate: start.Add(48 * time.Hour), Lifetime: time.Hour},
+			expected: "order[new]: auto-renewal: the lifetime (1h0m0s) is lower than the minimum lifetime allowed by the CA (24h0m0s)",
+		},
+		{
+			desc:     "duration greater than the maximum",
+			params:   AutoRenewalParams{StartDate: start, EndDate: start.Add(400 * 24 * time.Hour), Lifetime: 48 * time.Hour},
+			expected: "order[new]: auto-renewal: the duration (9600h0m0s) is greater than the maximum duration allowed by the CA (8760h0m0s)",
+		},
+		{
+			desc:     "GET not allowed",
+			params:   AutoRenewalParams{StartDate: start, EndDate: start.Add(48 * time.Hour), Lifetime: 24 * time.Hour, AllowCertificateGet: true},
+			expected: "order[new]: auto-renewal: the CA doesn't allow to fetch the certificates with GET requests",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := core.Orders.NewWithOptions([]string{"example.com"}, &OrderOptions{AutoRenewal: &test.params})
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func TestOrderService_NewWithOptions_autoRenewalNotSupported(t *testing.T) {
+	_, apiURL := tester.SetupFakeAPI(t)
+
+	// small value keeps test fast
+	privateKey, errK := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, errK, "Could not generate test key")
+
+	core, err := New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+	require.NoError(t, err)
+
+	params := &AutoRenewalParams{
+		EndDate:  time.Now().Add(30 * 24 * time.Hour),
+		Lifetime: 4 * 24 * time.Hour,
+	}
+
+	_, err = core.Orders.NewWithOptions([]string{"example.com"}, &OrderOptions{AutoRenewal: params})
+	require.EqualError(t, err, "order[new]: auto-renewal is requested but the CA doesn't support STAR certificates")
+}
+
+func TestOrderService_ListForAccount(t *testing.T) {
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	// small value keeps test fast
+	privateKey, errK := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, errK, "Could not generate test key")
+
+	mux.HandleFunc("/account/1", func(w http.ResponseWriter, _ *http.Request) {
+		err := tester.WriteJSONResponse(w, acme.Account{Status: acme.StatusValid, Orders: apiURL + "/account/1/orders"})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	mux.HandleFunc("/account/1/orders", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") == "" {
+			w.Header().Set("Link", "<"+apiURL+`/account/1/orders?cursor=2>;rel="next"`)
+
+			err := tester.WriteJSONResponse(w, acme.OrdersList{Orders: []string{apiURL + "/order/1", apiURL + "/order/2"}})
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		err := tester.WriteJSONResponse(w, acme.OrdersList{Orders: []string{apiURL + "/order/3"}})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	core, err := New(http.DefaultClient, "lego-test", apiURL+"/dir", apiURL+"/account/1", privateKey)
+	require.NoError(t, err)
+
+	orders, err := core.Orders.ListForAccount()
+	require.NoError(t, err)
+
+	expected := []string{apiURL + "/order/1", apiURL + "/order/2", apiURL + "/order/3"}
+	assert.Equal(t, expected, orders)
+}
+
+func TestOrderService_ListForAccount_notSupported(t *testing.T) {
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	// small value keeps test fast
+	privateKey, errK := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, errK, "Could not generate test key")
+
+	mux.HandleFunc("/account/1", func(w http.ResponseWriter, _ *http.Request) {
+		err := tester.WriteJSONResponse(w, acme.Account{Status: acme.StatusValid})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	core, err := New(http.DefaultClient, "lego-test", apiURL+"/dir", apiURL+"/account/1", privateKey)
+	require.NoError(t, err)
+
+	orders, err := core.Orders.ListForAccount()
+	require.NoError(t, err)
+
+	assert.Empty(t, orders)
+}
+
 func readSignedBody(r *http.Request, privateKey *rsa.PrivateKey) ([]byte, error) {
 	reqBody, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -3,6 +3,9 @@ package api
 import (
 	"net/http"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type service struct {
@@ -54,3 +57,34 @@ func getRetryAfter(resp *http.Response) string {
 
 	return resp.Header.Get("Retry-After")
 }
+
+// getRateLimitWait gets the time to wait before retrying a request rejected with a 429 status code.
+// The value of the header Retry-After can be a number of seconds or an HTTP date.
+// https://www.rfc-editor.org/rfc/rfc8555.html#section-6.6
+func getRateLimitWait(resp *http.Response) (time.Duration, bool) {
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	return ParseRetryAfter(getRetryAfter(resp))
+}
+
+// ParseRetryAfter parses the value of the header Retry-After: a number of seconds or an HTTP date.
+// https://www.rfc-editor.org/rfc/rfc8555.html#section-8.2
+func ParseRetryAfter(value string) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(time.Until(date), 0), true
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -53,3 +54,91 @@ func Test_getLink(t *testing.T) {
 		})
 	}
 }
+
+func Test_getRateLimitWait(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		statusCode int
+		retryAfter string
+		expected   time.Duration
+		assert     assert.BoolAssertionFunc
+	}{
+		{
+			desc:       "seconds",
+			statusCode: http.StatusTooManyRequests,
+			retryAfter: "120",
+			expected:   2 * time.Minute,
+			assert:     assert.True,
+		},
+		{
+			desc:       "date in the past",
+			statusCode: http.StatusTooManyRequests,
+			retryAfter: "Wed, 21 Oct 2015 07:28:00 GMT",
+			assert:     assert.True,
+		},
+		{
+			desc:       "negative seconds",
+			statusCode: http.StatusTooManyRequests,
+			retryAfter: "-10",
+			assert:     assert.True,
+		},
+		{
+			desc:       "invalid value",
+			statusCode: http.StatusTooManyRequests,
+			retryAfter: "soon",
+			assert:     assert.False,
+		},
+		{
+			desc:       "no header",
+			statusCode: http.StatusTooManyRequests,
+			assert:     assert.False,
+		},
+		{
+			desc:       "not rate limited",
+			statusCode: http.StatusServiceUnavailable,
+			retryAfter: "120",
+			assert:     assert.False,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &http.Response{StatusCode: test.statusCode, Header: http.Header{}}
+			if test.retryAfter != "" {
+				resp.Header.Set("Retry-After", test.retryAfter)
+			}
+
+			wait, ok := getRateLimitWait(resp)
+			test.assert(t, ok)
+
+			assert.Equal(t, test.expected, wait)
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{desc: "empty"},
+		{desc: "seconds", value: "3", expected: 3 * time.Second, ok: true},
+		{desc: "negative", value: "-3", ok: true},
+		{desc: "past date", value: "Wed, 21 Oct 2015 07:28:00 GMT", ok: true},
+		{desc: "invalid", value: "soon"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			wait, ok := ParseRetryAfter(test.value)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, wait)
+		})
+	}
+}
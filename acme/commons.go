@@ -74,6 +74,32 @@ type Meta struct {
 	// then the CA requires that all new-account requests include an "externalAccountBinding" field
 	// associating the new account with an external account.
 	ExternalAccountRequired bool `json:"externalAccountRequired"`
+
+	// profiles (optional, object):
+	// A map of profile names to human-readable descriptions of those profiles.
+	// - https://datatracker.ietf.org/doc/draft-aaron-acme-profiles/
+	Profiles map[string]string `json:"profiles"`
+
+	// auto-renewal (optional, object):
+	// Advertises the support of the STAR (Short-Term, Automatically Renewed) certificates.
+	// - https://www.rfc-editor.org/rfc/rfc8739.html#section-3.1.1
+	AutoRenewal *AutoRenewalMeta `json:"auto-renewal,omitempty"`
+}
+
+// AutoRenewalMeta the ACME auto-renewal meta object (related to Meta).
+// - https://www.rfc-editor.org/rfc/rfc8739.html#section-3.1.1
+type AutoRenewalMeta struct {
+	// min-lifetime (required, integer):
+	// Minimum acceptable value for auto-renewal lifetime, in seconds.
+	MinLifetime int `json:"min-lifetime"`
+
+	// max-duration (required, integer):
+	// Maximum allowed delta between the end-date and start-date attributes of the order's auto-renewal object, in seconds.
+	MaxDuration int `json:"max-duration"`
+
+	// allow-certificate-get (optional, boolean):
+	// Indicates if the server supports unauthenticated GET requests for the STAR certificates.
+	AllowCertificateGet bool `json:"allow-certificate-get,omitempty"`
 }
 
 // ExtendedAccount an extended Account.
@@ -128,6 +154,9 @@ type ExtendedOrder struct {
 
 	// The order URL, contains the value of the response header `Location`
 	Location string `json:"-"`
+
+	// Contains the value of the response header `Retry-After`
+	RetryAfter string `json:"-"`
 }
 
 // Order the ACME order Object.
@@ -187,6 +216,55 @@ type Order struct {
 	// previously-issued certificate which this order is intended to replace.
 	// - https://datatracker.ietf.org/doc/html/draft-ietf-acme-ari-03#section-5
 	Replaces string `json:"replaces,omitempty"`
+
+	// profile (string, optional):
+	// A string containing the name of one of the profiles advertised in the directory meta.
+	// - https://datatracker.ietf.org/doc/draft-aaron-acme-profiles/
+	Profile string `json:"profile,omitempty"`
+
+	// auto-renewal (optional, object):
+	// The parameters of a STAR (Short-Term, Automatically Renewed) order.
+	// - https://www.rfc-editor.org/rfc/rfc8739.html#section-3.1.1
+	AutoRenewal *AutoRenewal `json:"auto-renewal,omitempty"`
+
+	// star-certificate (optional, string):
+	// A URL for the STAR certificate that has been issued in response to this order,
+	// the certificate is periodically renewed by the server at this URL.
+	// - https://www.rfc-editor.org/rfc/rfc8739.html#section-3.1.1
+	StarCertificate string `json:"star-certificate,omitempty"`
+}
+
+// AutoRenewal the ACME auto-renewal object (related to Order).
+// - https://www.rfc-editor.org/rfc/rfc8739.html#section-3.1.1
+type AutoRenewal struct {
+	// start-date (optional, string):
+	// The earliest date of validity of the first certificate issued, in RFC 3339 format.
+	// When omitted, the start date is as soon as authorization is complete.
+	StartDate string `json:"start-date,omitempty"`
+
+	// end-date (required, string):
+	// The latest date of validity of the last certificate issued, in RFC 3339 format.
+	EndDate string `json:"end-date"`
+
+	// lifetime (required, integer):
+	// The maximum validity period of each STAR certificate, in seconds.
+	Lifetime int `json:"lifetime"`
+
+	// lifetime-adjust (optional, integer):
+	// The amount of "left pad" added to each STAR certificate, in seconds.
+	LifetimeAdjust int `json:"lifetime-adjust,omitempty"`
+
+	// allow-certificate-get (optional, boolean):
+	// Allows the unauthenticated GET requests to the STAR certificate URL.
+	AllowCertificateGet bool `json:"allow-certificate-get,omitempty"`
+}
+
+// OrdersList the ACME orders list object.
+// - https://www.rfc-editor.org/rfc/rfc8555.html#section-7.1.2.1
+type OrdersList struct {
+	// orders (required, array of string):
+	// An array of URLs, each identifying an order belonging to the account.
+	Orders []string `json:"orders"`
 }
 
 // Authorization the ACME authorization object.
@@ -222,6 +300,13 @@ type Authorization struct {
 	Wildcard bool `json:"wildcard,omitempty"`
 }
 
+// ExtendedAuthorization a extended Authorization.
+type ExtendedAuthorization struct {
+	Authorization
+	// Contains the value of the response header `Retry-After`
+	RetryAfter string `json:"-"`
+}
+
 // ExtendedChallenge a extended Challenge.
 type ExtendedChallenge struct {
 	Challenge
@@ -5,9 +5,18 @@ import (
 )
 
 // Errors types.
+// - https://www.rfc-editor.org/rfc/rfc8555.html#section-6.7
 const (
-	errNS       = "urn:ietf:params:acme:error:"
-	BadNonceErr = errNS + "badNonce"
+	errNS                 = "urn:ietf:params:acme:error:"
+	BadNonceErr           = errNS + "badNonce"
+	RateLimitedErr        = errNS + "rateLimited"
+	MalformedErr          = errNS + "malformed"
+	CAAErr                = errNS + "caa"
+	DNSErr                = errNS + "dns"
+	ConnectionErr         = errNS + "connection"
+	IncorrectResponseErr  = errNS + "incorrectResponse"
+	RejectedIdentifierErr = errNS + "rejectedIdentifier"
+	UnauthorizedErr       = errNS + "unauthorized"
 )
 
 // ProblemDetails the problem details object.
@@ -13,9 +13,12 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"net"
+	"os"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -116,6 +119,77 @@ func ParsePEMPrivateKey(key []byte) (crypto.PrivateKey, error) {
 	return nil, errors.New("failed to parse private key")
 }
 
+// ReadPrivateKey reads a PEM-encoded private key (RSA, ECDSA, or Ed25519).
+// If keyType is not empty, the type of the private key must match it (see CheckPrivateKeyType).
+func ReadPrivateKey(r io.Reader, keyType KeyType) (crypto.PrivateKey, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the private key: %w", err)
+	}
+
+	privateKey, err := ParsePEMPrivateKey(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyType == "" {
+		return privateKey, nil
+	}
+
+	err = CheckPrivateKeyType(privateKey, keyType)
+	if err != nil {
+		return nil, err
+	}
+
+	return privateKey, nil
+}
+
+// ReadPrivateKeyFile reads a PEM-encoded private key (RSA, ECDSA, or Ed25519) from a file.
+// If keyType is not empty, the type of the private key must match it (see CheckPrivateKeyType).
+func ReadPrivateKeyFile(filename string, keyType KeyType) (crypto.PrivateKey, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = file.Close() }()
+
+	return ReadPrivateKey(file, keyType)
+}
+
+// CheckPrivateKeyType checks that the private key matches the key type (algorithm and size or curve).
+func CheckPrivateKeyType(privateKey crypto.PrivateKey, keyType KeyType) error {
+	actual := getPrivateKeyType(privateKey)
+
+	if actual != keyType {
+		return fmt.Errorf("the private key type (%s) does not match the requested key type (%s)", actual, keyType)
+	}
+
+	return nil
+}
+
+// getPrivateKeyType returns the key type of the private key.
+// The key types without a KeyType constant (e.g. Ed25519) are only used to describe the private key.
+func getPrivateKeyType(privateKey crypto.PrivateKey) KeyType {
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		return KeyType(strconv.Itoa(key.N.BitLen()))
+	case *ecdsa.PrivateKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return EC256
+		case elliptic.P384():
+			return EC384
+		default:
+			return KeyType(key.Curve.Params().Name)
+		}
+	case ed25519.PrivateKey:
+		return "Ed25519"
+	default:
+		return KeyType(fmt.Sprintf("%T", privateKey))
+	}
+}
+
 func GeneratePrivateKey(keyType KeyType) (crypto.PrivateKey, error) {
 	switch keyType {
 	case EC256:
@@ -174,6 +248,9 @@ func PEMBlock(data interface{}) *pem.Block {
 		pemBlock = &pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}
 	case *rsa.PrivateKey:
 		pemBlock = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+	case ed25519.PrivateKey:
+		keyBytes, _ := x509.MarshalPKCS8PrivateKey(key)
+		pemBlock = &pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}
 	case *x509.CertificateRequest:
 		pemBlock = &pem.Block{Type: "CERTIFICATE REQUEST", Bytes: key.Raw}
 	case DERCertificateBytes:
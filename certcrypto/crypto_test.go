@@ -3,10 +3,16 @@ package certcrypto
 import (
 	"bytes"
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/pem"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -167,6 +173,123 @@ func TestParsePEMPrivateKey(t *testing.T) {
 	require.Errorf(t, err, "Expected to return an error for non-PEM input")
 }
 
+func TestReadPrivateKey(t *testing.T) {
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	p521Key, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc     string
+		keyType  KeyType
+		key      func(t *testing.T) crypto.PrivateKey
+		expected string
+	}{
+		{
+			desc:    "RSA",
+			keyType: RSA2048,
+			key:     generateTestKey(RSA2048),
+		},
+		{
+			desc:    "ECDSA P256",
+			keyType: EC256,
+			key:     generateTestKey(EC256),
+		},
+		{
+			desc:    "ECDSA P384",
+			keyType: EC384,
+			key:     generateTestKey(EC384),
+		},
+		{
+			desc: "Ed25519",
+			key:  func(_ *testing.T) crypto.PrivateKey { return ed25519Key },
+		},
+		{
+			desc:     "mismatch: RSA size",
+			keyType:  RSA4096,
+			key:      generateTestKey(RSA2048),
+			expected: "the private key type (2048) does not match the requested key type (4096)",
+		},
+		{
+			desc:     "mismatch: ECDSA curve",
+			keyType:  EC384,
+			key:      generateTestKey(EC256),
+			expected: "the private key type (P256) does not match the requested key type (P384)",
+		},
+		{
+			desc:     "mismatch: algorithm",
+			keyType:  RSA2048,
+			key:      generateTestKey(EC256),
+			expected: "the private key type (P256) does not match the requested key type (2048)",
+		},
+		{
+			desc:     "mismatch: Ed25519",
+			keyType:  EC256,
+			key:      func(_ *testing.T) crypto.PrivateKey { return ed25519Key },
+			expected: "the private key type (Ed25519) does not match the requested key type (P256)",
+		},
+		{
+			desc:     "mismatch: unsupported curve",
+			keyType:  EC384,
+			key:      func(_ *testing.T) crypto.PrivateKey { return p521Key },
+			expected: "the private key type (P-521) does not match the requested key type (P384)",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			privateKey := test.key(t)
+
+			key, err := ReadPrivateKey(bytes.NewReader(PEMEncode(privateKey)), test.keyType)
+			if test.expected != "" {
+				require.EqualError(t, err, test.expected)
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, privateKey, key)
+		})
+	}
+}
+
+func TestReadPrivateKey_invalid(t *testing.T) {
+	_, err := ReadPrivateKey(strings.NewReader("This is not PEM"), "")
+	require.EqualError(t, err, "invalid PEM block")
+}
+
+func TestReadPrivateKeyFile(t *testing.T) {
+	privateKey, err := GeneratePrivateKey(EC256)
+	require.NoError(t, err)
+
+	filename := filepath.Join(t.TempDir(), "private.key")
+
+	err = os.WriteFile(filename, PEMEncode(privateKey), 0o600)
+	require.NoError(t, err)
+
+	key, err := ReadPrivateKeyFile(filename, EC256)
+	require.NoError(t, err)
+
+	assert.Equal(t, privateKey, key)
+
+	_, err = ReadPrivateKeyFile(filename, RSA2048)
+	require.EqualError(t, err, "the private key type (P256) does not match the requested key type (2048)")
+}
+
+func generateTestKey(keyType KeyType) func(t *testing.T) crypto.PrivateKey {
+	return func(t *testing.T) crypto.PrivateKey {
+		t.Helper()
+
+		privateKey, err := GeneratePrivateKey(keyType)
+		require.NoError(t, err)
+
+		return privateKey
+	}
+}
+
 type MockRandReader struct {
 	b *bytes.Buffer
 }
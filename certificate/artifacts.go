@@ -0,0 +1,119 @@
+package certificate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/certcrypto"
+)
+
+const artifactFilePerm os.FileMode = 0o600
+
+// Artifacts contains the exact data exchanged with the CA during an issuance.
+type Artifacts struct {
+	// OrderURL is the location of the order.
+	OrderURL string
+	// Order is the order as returned by the CA before the finalization.
+	Order acme.ExtendedOrder
+	// Finalize is the order returned by the CA in response to the finalization request.
+	Finalize acme.ExtendedOrder
+	// CSR is the DER-encoded CSR sent to the CA.
+	CSR []byte
+	// Certificate is the PEM-encoded certificate (or bundle) received from the CA.
+	Certificate []byte
+	// IssuerCertificate is the PEM-encoded issuer certificate received from the CA.
+	IssuerCertificate []byte
+}
+
+// ArtifactStore persists the artifacts of an issuance.
+type ArtifactStore interface {
+	Save(artifacts *Artifacts) error
+}
+
+// DirArtifactStore stores the artifacts of each issuance inside a dedicated subdirectory.
+//
+// The subdirectory is named after the serial number of the certificate,
+// or after a hash of the order URL if the certificate cannot be parsed.
+type DirArtifactStore struct {
+	root string
+}
+
+// NewDirArtifactStore creates a DirArtifactStore.
+func NewDirArtifactStore(root string) (*DirArtifactStore, error) {
+	if root == "" {
+		return nil, errors.New("artifacts: the root directory is empty")
+	}
+
+	return &DirArtifactStore{root: root}, nil
+}
+
+// Save writes the artifacts to the file system.
+func (s *DirArtifactStore) Save(artifacts *Artifacts) error {
+	if artifacts == nil {
+		return errors.New("artifacts: nil artifacts")
+	}
+
+	dir := filepath.Join(s.root, artifactsKey(artifacts))
+
+	err := os.MkdirAll(dir, 0o700)
+	if err != nil {
+		return fmt.Errorf("artifacts: could not create the directory %s: %w", dir, err)
+	}
+
+	order, err := json.MarshalIndent(artifacts.Order, "", "\t")
+	if err != nil {
+		return fmt.Errorf("artifacts: %w", err)
+	}
+
+	finalize, err := json.MarshalIndent(artifacts.Finalize, "", "\t")
+	if err != nil {
+		return fmt.Errorf("artifacts: %w", err)
+	}
+
+	files := map[string][]byte{
+		"order.json":    order,
+		"finalize.json": finalize,
+		"request.csr":   pemEncodeCSR(artifacts.CSR),
+		"cert.pem":      artifacts.Certificate,
+		"issuer.pem":    artifacts.IssuerCertificate,
+	}
+
+	for name, content := range files {
+		if len(content) == 0 {
+			continue
+		}
+
+		err = os.WriteFile(filepath.Join(dir, name), content, artifactFilePerm)
+		if err != nil {
+			return fmt.Errorf("artifacts: could not write %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func artifactsKey(artifacts *Artifacts) string {
+	cert, err := certcrypto.ParsePEMCertificate(artifacts.Certificate)
+	if err == nil {
+		return cert.SerialNumber.Text(16)
+	}
+
+	sum := sha256.Sum256([]byte(artifacts.OrderURL))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func pemEncodeCSR(der []byte) []byte {
+	if len(der) == 0 {
+		return nil
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+}
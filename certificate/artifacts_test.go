@@ -0,0 +1,67 @@
+package certificate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirArtifactStore_Save(t *testing.T) {
+	root := t.TempDir()
+
+	store, err := NewDirArtifactStore(root)
+	require.NoError(t, err)
+
+	artifacts := &Artifacts{
+		OrderURL: "https://example.com/acme/order/123",
+		Order: acme.ExtendedOrder{
+			Location: "https://example.com/acme/order/123",
+			Order:    acme.Order{Status: acme.StatusReady},
+		},
+		Finalize: acme.ExtendedOrder{
+			Order: acme.Order{Status: acme.StatusValid, Certificate: "https://example.com/acme/cert/123"},
+		},
+		CSR:               []byte("csr"),
+		Certificate:       []byte(certResponseMock),
+		IssuerCertificate: []byte(issuerMock),
+	}
+
+	err = store.Save(artifacts)
+	require.NoError(t, err)
+
+	cert, err := certcrypto.ParsePEMCertificate([]byte(certResponseMock))
+	require.NoError(t, err)
+
+	dir := filepath.Join(root, cert.SerialNumber.Text(16))
+
+	for _, name := range []string{"order.json", "finalize.json", "request.csr", "cert.pem", "issuer.pem"} {
+		assert.FileExists(t, filepath.Join(dir, name))
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "cert.pem"))
+	require.NoError(t, err)
+
+	assert.Equal(t, certResponseMock, string(content))
+}
+
+func TestDirArtifactStore_Save_invalidCertificate(t *testing.T) {
+	root := t.TempDir()
+
+	store, err := NewDirArtifactStore(root)
+	require.NoError(t, err)
+
+	err = store.Save(&Artifacts{OrderURL: "https://example.com/acme/order/123"})
+	require.NoError(t, err)
+
+	entries, err := os.ReadDir(root)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	assert.FileExists(t, filepath.Join(root, entries[0].Name(), "order.json"))
+	assert.NoFileExists(t, filepath.Join(root, entries[0].Name(), "cert.pem"))
+}
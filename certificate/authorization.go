@@ -1,12 +1,43 @@
 package certificate
 
 import (
+	"slices"
 	"time"
 
 	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/log"
 )
 
+// AuthorizationStats describes how the authorizations of an order were satisfied.
+type AuthorizationStats struct {
+	// Solved are the identifiers for which a challenge was solved.
+	Solved []string `json:"solved,omitempty"`
+	// Reused are the identifiers of the authorizations already valid (recycled by the CA): no challenge was solved.
+	Reused []string `json:"reused,omitempty"`
+}
+
+// newAuthorizationStats classifies the authorizations, as they were before solving the challenges.
+func newAuthorizationStats(authorizations []acme.Authorization) *AuthorizationStats {
+	stats := &AuthorizationStats{}
+
+	for _, authz := range authorizations {
+		domain := challenge.GetTargetedDomain(authz)
+
+		if authz.Status == acme.StatusValid {
+			stats.Reused = append(stats.Reused, domain)
+		} else {
+			stats.Solved = append(stats.Solved, domain)
+		}
+	}
+
+	// The authorizations are fetched concurrently, so their order is not stable.
+	slices.Sort(stats.Solved)
+	slices.Sort(stats.Reused)
+
+	return stats
+}
+
 func (c *Certifier) getAuthorizations(order acme.ExtendedOrder) ([]acme.Authorization, error) {
 	resc, errc := make(chan acme.Authorization), make(chan domainError)
 
@@ -48,14 +79,65 @@ func (c *Certifier) getAuthorizations(order acme.ExtendedOrder) ([]acme.Authoriz
 	return responses, failures.Join()
 }
 
-func (c *Certifier) deactivateAuthorizations(order acme.ExtendedOrder, force bool) {
+// fetchAuthorizations fetches the current state of the authorizations of the order, by URL,
+// to share it between withAuthorizationProblems and deactivateAuthorizations after a failure.
+// The authorizations that cannot be fetched are missing.
+func (c *Certifier) fetchAuthorizations(order acme.ExtendedOrder) map[string]acme.Authorization {
+	authorizations := make(map[string]acme.Authorization, len(order.Authorizations))
+
 	for _, authzURL := range order.Authorizations {
-		auth, err := c.core.Authorizations.Get(authzURL)
+		authz, err := c.core.Authorizations.Get(authzURL)
 		if err != nil {
 			log.Infof("Unable to get the authorization for: %s", authzURL)
 			continue
 		}
 
+		authorizations[authzURL] = authz
+	}
+
+	return authorizations
+}
+
+// withAuthorizationProblems returns a ProblemError carrying the problems of the invalid authorizations of the order,
+// or the error itself when no authorization is invalid.
+// The authorizations are the ones fetched by fetchAuthorizations.
+func (c *Certifier) withAuthorizationProblems(order acme.ExtendedOrder, authorizations map[string]acme.Authorization, err error) error {
+	var problems []AuthorizationProblem
+
+	for _, authzURL := range order.Authorizations {
+		authz, ok := authorizations[authzURL]
+		if !ok || authz.Status != acme.StatusInvalid {
+			continue
+		}
+
+		problem := AuthorizationProblem{URL: authzURL, Identifier: authz.Identifier}
+
+		for _, chlg := range authz.Challenges {
+			if chlg.Status == acme.StatusInvalid && chlg.Error != nil {
+				problem.Problem = chlg.Error
+				break
+			}
+		}
+
+		problems = append(problems, problem)
+	}
+
+	if len(problems) == 0 {
+		return err
+	}
+
+	return &ProblemError{OrderURL: order.Location, Authorizations: problems, err: err}
+}
+
+// deactivateAuthorizations deactivates the authorizations of the order fetched by fetchAuthorizations.
+// The valid authorizations are only deactivated when force is true.
+func (c *Certifier) deactivateAuthorizations(order acme.ExtendedOrder, authorizations map[string]acme.Authorization, force bool) {
+	for _, authzURL := range order.Authorizations {
+		auth, ok := authorizations[authzURL]
+		if !ok {
+			continue
+		}
+
 		if auth.Status == acme.StatusValid && !force {
 			log.Infof("Skipping deactivating of valid auth: %s", authzURL)
 			continue
@@ -0,0 +1,281 @@
+package certificate
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/acme/api"
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertifier_Obtain_authorizationStats(t *testing.T) {
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	writeJSON := func(w http.ResponseWriter, v any) {
+		err := tester.WriteJSONResponse(w, v)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	mux.HandleFunc("POST /newOrder", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Location", apiURL+"/order")
+		w.WriteHeader(http.StatusCreated)
+
+		writeJSON(w, acme.Order{
+			Status: acme.StatusPending,
+			Identifiers: []acme.Identifier{
+				{Type: "dns", Value: "example.com"},
+				{Type: "dns", Value: "www.example.com"},
+			},
+			Authorizations: []string{apiURL + "/authz/1", apiURL + "/authz/2"},
+			Finalize:       apiURL + "/finalize",
+		})
+	})
+
+	mux.HandleFunc("POST /authz/1", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, acme.Authorization{
+			Status:     acme.StatusPending,
+			Identifier: acme.Identifier{Type: "dns", Value: "example.com"},
+		})
+	})
+
+	mux.HandleFunc("POST /authz/2", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, acme.Authorization{
+			Status:     acme.StatusValid,
+			Identifier: acme.Identifier{Type: "dns", Value: "www.example.com"},
+		})
+	})
+
+	mux.HandleFunc("POST /finalize", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, acme.Order{Status: acme.StatusValid, Certificate: apiURL + "/certificate"})
+	})
+
+	mux.HandleFunc("POST /certificate", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(certResponseMock))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", key)
+	require.NoError(t, err)
+
+	certifier := NewCertifier(core, &resolverMock{}, CertifierOptions{KeyType: certcrypto.EC256})
+
+	certRes, err := certifier.Obtain(ObtainRequest{Domains: []string{"example.com", "www.example.com"}})
+	require.NoError(t, err)
+
+	expected := &AuthorizationStats{
+		Solved: []string{"example.com"},
+		Reused: []string{"www.example.com"},
+	}
+
+	assert.Equal(t, expected, certRes.Authorizations)
+}
+
+func TestCertifier_Obtain_invalidAuthorizations(t *testing.T) {
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	writeJSON := func(w http.ResponseWriter, v any) {
+		err := tester.WriteJSONResponse(w, v)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+
+	var gets, deactivations atomic.Int32
+
+	// counting counts the POST-as-GET requests (empty payload) and the deactivations of the authorizations.
+	counting := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			raw, err := io.ReadAll(req.Body)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			jws, err := jose.ParseSigned(string(raw), []jose.SignatureAlgorithm{jose.RS256})
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			if len(jws.UnsafePayloadWithoutVerification()) > 0 {
+				deactivations.Add(1)
+			} else {
+				gets.Add(1)
+			}
+
+			next(w, req)
+		}
+	}
+
+	mux.HandleFunc("POST /newOrder", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Location", apiURL+"/order")
+		w.WriteHeader(http.StatusCreated)
+
+		writeJSON(w, acme.Order{
+			Status: acme.StatusPending,
+			Identifiers: []acme.Identifier{
+				{Type: "dns", Value: "example.com"},
+				{Type: "dns", Value: "www.example.com"},
+				{Type: "dns", Value: "api.example.com"},
+			},
+			Authorizations: []string{apiURL + "/authz/1", apiURL + "/authz/2", apiURL + "/authz/3"},
+			Finalize:       apiURL + "/finalize",
+		})
+	})
+
+	mux.HandleFunc("POST /authz/1", counting(func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, acme.Authorization{
+			Status:     acme.StatusInvalid,
+			Identifier: acme.Identifier{Type: "dns", Value: "example.com"},
+			Challenges: []acme.Challenge{{
+				Type:   "dns-01",
+				Status: acme.StatusInvalid,
+				Error: &acme.ProblemDetails{
+					Type:   acme.UnauthorizedErr,
+					Detail: "Some of the identifiers are forbidden by CAA",
+					SubProblems: []acme.SubProblem{
+						{Type: acme.CAAErr, Detail: "CAA record for example.com prevents issuance", Identifier: acme.Identifier{Type: "dns", Value: "example.com"}},
+						{Type: acme.CAAErr, Detail: "CAA record for www.example.com prevents issuance", Identifier: acme.Identifier{Type: "dns", Value: "www.example.com"}},
+					},
+				},
+			}},
+		})
+	}))
+
+	mux.HandleFunc("POST /authz/2", counting(func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, acme.Authorization{
+			Status:     acme.StatusInvalid,
+			Identifier: acme.Identifier{Type: "dns", Value: "www.example.com"},
+			Challenges: []acme.Challenge{{
+				Type:   "dns-01",
+				Status: acme.StatusInvalid,
+				Error:  &acme.ProblemDetails{Type: acme.DNSErr, Detail: "NXDOMAIN looking up TXT for _acme-challenge.www.example.com"},
+			}},
+		})
+	}))
+
+	mux.HandleFunc("POST /authz/3", counting(func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, acme.Authorization{
+			Status:     acme.StatusValid,
+			Identifier: acme.Identifier{Type: "dns", Value: "api.example.com"},
+		})
+	}))
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", key)
+	require.NoError(t, err)
+
+	solveErr := errors.New("solve error")
+
+	certifier := NewCertifier(core, &resolverMock{error: solveErr}, CertifierOptions{KeyType: certcrypto.EC256})
+
+	_, err = certifier.Obtain(ObtainRequest{Domains: []string{"example.com", "www.example.com", "api.example.com"}})
+	require.EqualError(t, err, "solve error")
+	require.ErrorIs(t, err, solveErr)
+
+	var problemErr *ProblemError
+	require.ErrorAs(t, err, &problemErr)
+
+	assert.Equal(t, apiURL+"/order", problemErr.OrderURL)
+	assert.Nil(t, problemErr.Order)
+	require.Len(t, problemErr.Authorizations, 2)
+	assert.Equal(t, apiURL+"/authz/1", problemErr.Authorizations[0].URL)
+	assert.Equal(t, apiURL+"/authz/2", problemErr.Authorizations[1].URL)
+
+	assert.Equal(t, []string{"example.com", "www.example.com"}, problemErr.Identifiers())
+
+	expected := []acme.SubProblem{
+		{Type: acme.UnauthorizedErr, Detail: "Some of the identifiers are forbidden by CAA", Identifier: acme.Identifier{Type: "dns", Value: "example.com"}},
+		{Type: acme.CAAErr, Detail: "CAA record for example.com prevents issuance", Identifier: acme.Identifier{Type: "dns", Value: "example.com"}},
+	}
+
+	assert.Equal(t, expected, problemErr.Problems("example.com"))
+
+	expected = []acme.SubProblem{
+		{Type: acme.CAAErr, Detail: "CAA record for www.example.com prevents issuance", Identifier: acme.Identifier{Type: "dns", Value: "www.example.com"}},
+		{Type: acme.DNSErr, Detail: "NXDOMAIN looking up TXT for _acme-challenge.www.example.com", Identifier: acme.Identifier{Type: "dns", Value: "www.example.com"}},
+	}
+
+	assert.Equal(t, expected, problemErr.Problems("www.example.com"))
+
+	assert.Empty(t, problemErr.Problems("api.example.com"))
+
+	// Each authorization is fetched once to be solved, and once to collect its problem and to deactivate it.
+	assert.EqualValues(t, 6, gets.Load())
+	// The valid authorization is not deactivated.
+	assert.EqualValues(t, 2, deactivations.Load())
+}
+
+func Test_newAuthorizationStats(t *testing.T) {
+	testCases := []struct {
+		desc           string
+		authorizations []acme.Authorization
+		expected       *AuthorizationStats
+	}{
+		{
+			desc:     "no authorizations",
+			expected: &AuthorizationStats{},
+		},
+		{
+			desc: "all pending",
+			authorizations: []acme.Authorization{
+				{Status: acme.StatusPending, Identifier: acme.Identifier{Type: "dns", Value: "b.example.com"}},
+				{Status: acme.StatusPending, Identifier: acme.Identifier{Type: "dns", Value: "a.example.com"}},
+			},
+			expected: &AuthorizationStats{
+				Solved: []string{"a.example.com", "b.example.com"},
+			},
+		},
+		{
+			desc: "all valid",
+			authorizations: []acme.Authorization{
+				{Status: acme.StatusValid, Identifier: acme.Identifier{Type: "dns", Value: "a.example.com"}},
+			},
+			expected: &AuthorizationStats{
+				Reused: []string{"a.example.com"},
+			},
+		},
+		{
+			desc: "pending and valid",
+			authorizations: []acme.Authorization{
+				{Status: acme.StatusValid, Identifier: acme.Identifier{Type: "dns", Value: "c.example.com"}},
+				{Status: acme.StatusPending, Identifier: acme.Identifier{Type: "dns", Value: "b.example.com"}},
+				{Status: acme.StatusValid, Identifier: acme.Identifier{Type: "dns", Value: "a.example.com"}},
+				{Status: acme.StatusPending, Identifier: acme.Identifier{Type: "dns", Value: "example.com"}, Wildcard: true},
+			},
+			expected: &AuthorizationStats{
+				Solved: []string{"*.example.com", "b.example.com"},
+				Reused: []string{"a.example.com", "c.example.com"},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			stats := newAuthorizationStats(test.authorizations)
+
+			assert.Equal(t, test.expected, stats)
+		})
+	}
+}
@@ -2,14 +2,18 @@ package certificate
 
 import (
 	"bytes"
+	"context"
 	"crypto"
 	"crypto/x509"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-acme/lego/v4/acme"
@@ -17,7 +21,6 @@ import (
 	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/log"
-	"github.com/go-acme/lego/v4/platform/wait"
 	"golang.org/x/crypto/ocsp"
 	"golang.org/x/net/idna"
 )
@@ -49,6 +52,26 @@ type Resource struct {
 	Certificate       []byte `json:"-"`
 	IssuerCertificate []byte `json:"-"`
 	CSR               []byte `json:"-"`
+	// Subject is the subject of the CSR (only set by ObtainForCSR).
+	Subject string `json:"-"`
+	// StarCertificateURL is the URL of the STAR certificate, renewed by the CA (only set for an auto-renewal order).
+	StarCertificateURL string `json:"starCertificateUrl,omitempty"`
+	// Chain is the certificate chain split into its parts (only set when SplitChain is requested).
+	Chain *Chain `json:"-"`
+	// Order contains the URLs of the ACME order used to obtain the certificate, for auditing.
+	Order *OrderInfo `json:"order,omitempty"`
+	// Authorizations describes which authorizations were solved and which were already valid (only set by Obtain and ObtainForCSR).
+	Authorizations *AuthorizationStats `json:"-"`
+}
+
+// OrderInfo contains the URLs of an ACME order.
+type OrderInfo struct {
+	// OrderURL is the URL of the order.
+	OrderURL string `json:"orderUrl"`
+	// AuthorizationURLs are the URLs of the authorizations of the order, one by identifier.
+	AuthorizationURLs []string `json:"authorizationUrls,omitempty"`
+	// FinalizeURL is the URL used to finalize the order.
+	FinalizeURL string `json:"finalizeUrl"`
 }
 
 // ObtainRequest The request to obtain certificate.
@@ -59,11 +82,15 @@ type Resource struct {
 // A new private key is generated for every invocation of the function Obtain.
 // If you do not want that you can supply your own private key in the privateKey parameter.
 // If this parameter is non-nil it will be used instead of generating a new one.
+// A PEM-encoded private key can be loaded with certcrypto.ReadPrivateKey or certcrypto.ReadPrivateKeyFile.
 //
 // If `Bundle` is true, the `[]byte` contains both the issuer certificate and your issued certificate as a bundle.
 //
 // If `AlwaysDeactivateAuthorizations` is true, the authorizations are also relinquished if the obtain request was successful.
 // See https://datatracker.ietf.org/doc/html/rfc8555#section-7.5.2.
+//
+// `NotBefore` and `NotAfter` are hints of the requested validity, only sent in the order when they are non-zero.
+// If the CA rejects them, the order is created without them.
 type ObtainRequest struct {
 	Domains    []string
 	PrivateKey crypto.PrivateKey
@@ -78,6 +105,18 @@ type ObtainRequest struct {
 	// order is intended to replace.
 	// - https://datatracker.ietf.org/doc/html/draft-ietf-acme-ari-03#section-5
 	ReplacesCertID string
+	// The name of a certificate profile advertised by the CA (see lego.Client.GetProfiles).
+	// - https://datatracker.ietf.org/doc/draft-aaron-acme-profiles/
+	Profile string
+	// The parameters of a STAR (Short-Term, Automatically Renewed) order,
+	// the CA must advertise the auto-renewal support in its directory meta.
+	// - https://www.rfc-editor.org/rfc/rfc8739.html
+	AutoRenewal *api.AutoRenewalParams
+	// SplitChain also returns the leaf, the intermediates, and the root (if included by the CA) as separate parts (see Resource.Chain).
+	SplitChain bool
+	// VerifySANs checks that the issued certificate contains all the requested domains and IP addresses,
+	// an error is returned if the CA issued a certificate without some of them.
+	VerifySANs bool
 }
 
 // ObtainForCSRRequest The request to obtain a certificate matching the CSR passed into it.
@@ -86,6 +125,9 @@ type ObtainRequest struct {
 //
 // If `AlwaysDeactivateAuthorizations` is true, the authorizations are also relinquished if the obtain request was successful.
 // See https://datatracker.ietf.org/doc/html/rfc8555#section-7.5.2.
+//
+// `NotBefore` and `NotAfter` are hints of the requested validity, only sent in the order when they are non-zero.
+// If the CA rejects them, the order is created without them.
 type ObtainForCSRRequest struct {
 	CSR *x509.CertificateRequest
 
@@ -98,16 +140,58 @@ type ObtainForCSRRequest struct {
 	// order is intended to replace.
 	// - https://datatracker.ietf.org/doc/html/draft-ietf-acme-ari-03#section-5
 	ReplacesCertID string
+	// The name of a certificate profile advertised by the CA (see lego.Client.GetProfiles).
+	// - https://datatracker.ietf.org/doc/draft-aaron-acme-profiles/
+	Profile string
+	// The parameters of a STAR (Short-Term, Automatically Renewed) order,
+	// the CA must advertise the auto-renewal support in its directory meta.
+	// - https://www.rfc-editor.org/rfc/rfc8739.html
+	AutoRenewal *api.AutoRenewalParams
+	// SplitChain also returns the leaf, the intermediates, and the root (if included by the CA) as separate parts (see Resource.Chain).
+	SplitChain bool
+	// VerifySANs checks that the issued certificate contains all the requested domains and IP addresses,
+	// an error is returned if the CA issued a certificate without some of them.
+	VerifySANs bool
 }
 
 type resolver interface {
 	Solve(authorizations []acme.Authorization) error
 }
 
+// resolverContext is implemented by the resolvers that can pass a context to the challenge providers.
+type resolverContext interface {
+	SolveContext(ctx context.Context, authorizations []acme.Authorization) error
+}
+
 type CertifierOptions struct {
-	KeyType             certcrypto.KeyType
-	Timeout             time.Duration
-	OverallRequestLimit int
+	KeyType certcrypto.KeyType
+	// Timeout is the maximum time to wait for the certificate after the finalization of an order.
+	// If 0, DefaultFinalizeTimeout is used.
+	Timeout time.Duration
+	// FinalizePollInterval is the initial interval between two polls of an order being processed by the CA,
+	// the interval grows exponentially up to FinalizeMaxPollInterval.
+	// If 0, Timeout/60 is used.
+	FinalizePollInterval time.Duration
+	// FinalizeMaxPollInterval is the maximum interval between two polls of an order.
+	// A Retry-After header sent by the CA takes precedence.
+	// If 0, DefaultFinalizeMaxPollInterval is used.
+	FinalizeMaxPollInterval time.Duration
+	OverallRequestLimit     int
+	// ArtifactStore, if not nil, persists the CSR, the order, and the chain of each issuance.
+	ArtifactStore ArtifactStore
+	// ReuseOrders allows to reuse a pending or ready order of the account with the same identifiers,
+	// instead of creating a new order (requires a CA exposing the orders of the account).
+	// The authorizations of a reusable order are not deactivated when the request fails,
+	// unless AlwaysDeactivateAuthorizations is true.
+	ReuseOrders bool
+	// OrderReuseWindow, if not 0, keeps the orders created by the Certifier in memory during this window:
+	// a new request with the same identifiers and the same options (profile, validity window, ARI replacement)
+	// resumes the order if it is still pending or ready,
+	// instead of creating a new order (i.e. a quick retry after a failure of a challenge provider).
+	// The authorizations of an order kept for reuse are not deactivated when the request fails,
+	// unless AlwaysDeactivateAuthorizations is true (the order is then forgotten).
+	// An order is forgotten once its finalization has been requested.
+	OrderReuseWindow time.Duration
 }
 
 // Certifier A service to obtain/renew/revoke certificates.
@@ -116,6 +200,9 @@ type Certifier struct {
 	resolver            resolver
 	options             CertifierOptions
 	overallRequestLimit int
+
+	orders   map[string]cachedOrder
+	ordersMu sync.Mutex
 }
 
 // NewCertifier creates a Certifier.
@@ -124,6 +211,7 @@ func NewCertifier(core *api.Core, resolver resolver, options CertifierOptions) *
 		core:     core,
 		resolver: resolver,
 		options:  options,
+		orders:   make(map[string]cachedOrder),
 	}
 
 	c.overallRequestLimit = options.OverallRequestLimit
@@ -139,6 +227,12 @@ func NewCertifier(core *api.Core, resolver resolver, options CertifierOptions) *
 // This function will never return a partial certificate.
 // If one domain in the list fails, the whole certificate will fail.
 func (c *Certifier) Obtain(request ObtainRequest) (*Resource, error) {
+	return c.ObtainContext(context.Background(), request)
+}
+
+// ObtainContext is like Obtain, but the context is passed to the challenge providers,
+// and stops the polling of the order after its finalization.
+func (c *Certifier) ObtainContext(ctx context.Context, request ObtainRequest) (*Resource, error) {
 	if len(request.Domains) == 0 {
 		return nil, errors.New("no domains to obtain a certificate for")
 	}
@@ -155,9 +249,11 @@ func (c *Certifier) Obtain(request ObtainRequest) (*Resource, error) {
 		NotBefore:      request.NotBefore,
 		NotAfter:       request.NotAfter,
 		ReplacesCertID: request.ReplacesCertID,
+		Profile:        request.Profile,
+		AutoRenewal:    request.AutoRenewal,
 	}
 
-	order, err := c.core.Orders.NewWithOptions(domains, orderOpts)
+	order, err := c.newOrder(domains, orderOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -165,34 +261,63 @@ func (c *Certifier) Obtain(request ObtainRequest) (*Resource, error) {
 	authz, err := c.getAuthorizations(order)
 	if err != nil {
 		// If any challenge fails, return. Do not generate partial SAN certificates.
-		c.deactivateAuthorizations(order, request.AlwaysDeactivateAuthorizations)
+		c.abandonOrder(order, nil, request.AlwaysDeactivateAuthorizations)
 		return nil, err
 	}
 
-	err = c.resolver.Solve(authz)
+	err = c.solve(ctx, authz)
 	if err != nil {
+		// The authorizations are fetched once, to collect their problems and to deactivate them.
+		authorizations := c.fetchAuthorizations(order)
+
+		err = c.withAuthorizationProblems(order, authorizations, err)
+
 		// If any challenge fails, return. Do not generate partial SAN certificates.
-		c.deactivateAuthorizations(order, request.AlwaysDeactivateAuthorizations)
+		c.abandonOrder(order, authorizations, request.AlwaysDeactivateAuthorizations)
 		return nil, err
 	}
 
 	log.Infof("[%s] acme: Validations succeeded; requesting certificates", strings.Join(domains, ", "))
 
 	failures := newObtainError()
-	cert, err := c.getForOrder(domains, order, request.Bundle, request.PrivateKey, request.MustStaple, request.PreferredChain)
+	cert, err := c.getForOrder(ctx, domains, order, request.Bundle, request.PrivateKey, request.MustStaple, request.PreferredChain)
+
+	c.forgetOrder(order.Location)
+	if err == nil && request.VerifySANs {
+		err = verifySANs(cert, domains)
+	}
+
 	if err != nil {
 		for _, auth := range authz {
 			failures.Add(challenge.GetTargetedDomain(auth), err)
 		}
+	} else if request.SplitChain {
+		splitChain(cert, request.Bundle)
+	}
+
+	if cert != nil {
+		cert.Authorizations = newAuthorizationStats(authz)
 	}
 
 	if request.AlwaysDeactivateAuthorizations {
-		c.deactivateAuthorizations(order, true)
+		c.deactivateAuthorizations(order, c.fetchAuthorizations(order), true)
 	}
 
 	return cert, failures.Join()
 }
 
+func (c *Certifier) solve(ctx context.Context, authz []acme.Authorization) error {
+	if r, ok := c.resolver.(resolverContext); ok {
+		return r.SolveContext(ctx, authz)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return c.resolver.Solve(authz)
+}
+
 // ObtainForCSR tries to obtain a certificate matching the CSR passed into it.
 //
 // The domains are inferred from the CommonName and SubjectAltNames, if any.
@@ -203,27 +328,40 @@ func (c *Certifier) Obtain(request ObtainRequest) (*Resource, error) {
 // This function will never return a partial certificate.
 // If one domain in the list fails, the whole certificate will fail.
 func (c *Certifier) ObtainForCSR(request ObtainForCSRRequest) (*Resource, error) {
+	return c.ObtainForCSRContext(context.Background(), request)
+}
+
+// ObtainForCSRContext is like ObtainForCSR, but the context is passed to the challenge providers,
+// and stops the polling of the order after its finalization.
+func (c *Certifier) ObtainForCSRContext(ctx context.Context, request ObtainForCSRRequest) (*Resource, error) {
 	if request.CSR == nil {
 		return nil, errors.New("cannot obtain resource for CSR: CSR is missing")
 	}
 
+	err := checkCSR(request.CSR)
+	if err != nil {
+		return nil, fmt.Errorf("cannot obtain resource for CSR: %w", err)
+	}
+
 	// figure out what domains it concerns
 	// start with the common name
 	domains := certcrypto.ExtractDomainsCSR(request.CSR)
 
 	if request.Bundle {
-		log.Infof("[%s] acme: Obtaining bundled SAN certificate given a CSR", strings.Join(domains, ", "))
+		log.Infof("[%s] acme: Obtaining bundled SAN certificate given a CSR (subject: %s)", strings.Join(domains, ", "), request.CSR.Subject)
 	} else {
-		log.Infof("[%s] acme: Obtaining SAN certificate given a CSR", strings.Join(domains, ", "))
+		log.Infof("[%s] acme: Obtaining SAN certificate given a CSR (subject: %s)", strings.Join(domains, ", "), request.CSR.Subject)
 	}
 
 	orderOpts := &api.OrderOptions{
 		NotBefore:      request.NotBefore,
 		NotAfter:       request.NotAfter,
 		ReplacesCertID: request.ReplacesCertID,
+		Profile:        request.Profile,
+		AutoRenewal:    request.AutoRenewal,
 	}
 
-	order, err := c.core.Orders.NewWithOptions(domains, orderOpts)
+	order, err := c.newOrder(domains, orderOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -231,40 +369,58 @@ func (c *Certifier) ObtainForCSR(request ObtainForCSRRequest) (*Resource, error)
 	authz, err := c.getAuthorizations(order)
 	if err != nil {
 		// If any challenge fails, return. Do not generate partial SAN certificates.
-		c.deactivateAuthorizations(order, request.AlwaysDeactivateAuthorizations)
+		c.abandonOrder(order, nil, request.AlwaysDeactivateAuthorizations)
 		return nil, err
 	}
 
-	err = c.resolver.Solve(authz)
+	err = c.solve(ctx, authz)
 	if err != nil {
+		// The authorizations are fetched once, to collect their problems and to deactivate them.
+		authorizations := c.fetchAuthorizations(order)
+
+		err = c.withAuthorizationProblems(order, authorizations, err)
+
 		// If any challenge fails, return. Do not generate partial SAN certificates.
-		c.deactivateAuthorizations(order, request.AlwaysDeactivateAuthorizations)
+		c.abandonOrder(order, authorizations, request.AlwaysDeactivateAuthorizations)
 		return nil, err
 	}
 
 	log.Infof("[%s] acme: Validations succeeded; requesting certificates", strings.Join(domains, ", "))
 
 	failures := newObtainError()
-	cert, err := c.getForCSR(domains, order, request.Bundle, request.CSR.Raw, nil, request.PreferredChain)
+	cert, err := c.getForCSR(ctx, domains, order, request.Bundle, request.CSR.Raw, nil, request.PreferredChain)
+
+	c.forgetOrder(order.Location)
+	if err == nil && request.VerifySANs {
+		err = verifySANs(cert, domains)
+	}
+
 	if err != nil {
 		for _, auth := range authz {
 			failures.Add(challenge.GetTargetedDomain(auth), err)
 		}
+	} else if request.SplitChain {
+		splitChain(cert, request.Bundle)
+	}
+
+	if cert != nil {
+		cert.Authorizations = newAuthorizationStats(authz)
 	}
 
 	if request.AlwaysDeactivateAuthorizations {
-		c.deactivateAuthorizations(order, true)
+		c.deactivateAuthorizations(order, c.fetchAuthorizations(order), true)
 	}
 
 	if cert != nil {
 		// Add the CSR to the certificate so that it can be used for renewals.
 		cert.CSR = certcrypto.PEMEncode(request.CSR)
+		cert.Subject = request.CSR.Subject.String()
 	}
 
 	return cert, failures.Join()
 }
 
-func (c *Certifier) getForOrder(domains []string, order acme.ExtendedOrder, bundle bool, privateKey crypto.PrivateKey, mustStaple bool, preferredChain string) (*Resource, error) {
+func (c *Certifier) getForOrder(ctx context.Context, domains []string, order acme.ExtendedOrder, bundle bool, privateKey crypto.PrivateKey, mustStaple bool, preferredChain string) (*Resource, error) {
 	if privateKey == nil {
 		var err error
 		privateKey, err = certcrypto.GeneratePrivateKey(c.options.KeyType)
@@ -273,8 +429,9 @@ func (c *Certifier) getForOrder(domains []string, order acme.ExtendedOrder, bund
 		}
 	}
 
+	// An IP address is only added as a SAN.
 	commonName := ""
-	if len(domains[0]) <= 64 {
+	if len(domains[0]) <= 64 && net.ParseIP(domains[0]) == nil {
 		commonName = domains[0]
 	}
 
@@ -302,12 +459,17 @@ func (c *Certifier) getForOrder(domains []string, order acme.ExtendedOrder, bund
 		return nil, err
 	}
 
-	return c.getForCSR(domains, order, bundle, csr, certcrypto.PEMEncode(privateKey), preferredChain)
+	return c.getForCSR(ctx, domains, order, bundle, csr, certcrypto.PEMEncode(privateKey), preferredChain)
 }
 
-func (c *Certifier) getForCSR(domains []string, order acme.ExtendedOrder, bundle bool, csr, privateKeyPem []byte, preferredChain string) (*Resource, error) {
+func (c *Certifier) getForCSR(ctx context.Context, domains []string, order acme.ExtendedOrder, bundle bool, csr, privateKeyPem []byte, preferredChain string) (*Resource, error) {
 	respOrder, err := c.core.Orders.UpdateForCSR(order.Finalize, csr)
 	if err != nil {
+		if respOrder.Status == acme.StatusInvalid {
+			respOrder.Location = order.Location
+			return nil, newOrderProblemError(respOrder)
+		}
+
 		return nil, err
 	}
 
@@ -315,6 +477,11 @@ func (c *Certifier) getForCSR(domains []string, order acme.ExtendedOrder, bundle
 		Domain:     domains[0],
 		CertURL:    respOrder.Certificate,
 		PrivateKey: privateKeyPem,
+		Order: &OrderInfo{
+			OrderURL:          order.Location,
+			AuthorizationURLs: order.Authorizations,
+			FinalizeURL:       order.Finalize,
+		},
 	}
 
 	if respOrder.Status == acme.StatusValid {
@@ -325,30 +492,92 @@ func (c *Certifier) getForCSR(domains []string, order acme.ExtendedOrder, bundle
 		}
 
 		if ok {
+			c.saveArtifacts(order, respOrder, csr, certRes)
+
 			return certRes, nil
 		}
 	}
 
-	timeout := c.options.Timeout
-	if c.options.Timeout <= 0 {
-		timeout = 30 * time.Second
+	err = c.waitForCertificate(ctx, order, respOrder, certRes, bundle, preferredChain)
+	if err != nil {
+		return certRes, err
 	}
 
-	err = wait.For("certificate", timeout, timeout/60, func() (bool, error) {
-		ord, errW := c.core.Orders.Get(order.Location)
-		if errW != nil {
-			return false, errW
-		}
+	c.saveArtifacts(order, respOrder, csr, certRes)
+
+	return certRes, nil
+}
 
-		done, errW := c.checkResponse(ord, certRes, bundle, preferredChain)
-		if errW != nil {
-			return false, errW
+// saveArtifacts persists the data exchanged with the CA, if an ArtifactStore is configured.
+// A failure to persist the artifacts doesn't fail the issuance.
+func (c *Certifier) saveArtifacts(order, respOrder acme.ExtendedOrder, csr []byte, certRes *Resource) {
+	if c.options.ArtifactStore == nil {
+		return
+	}
+
+	artifacts := &Artifacts{
+		OrderURL:          order.Location,
+		Order:             order,
+		Finalize:          respOrder,
+		CSR:               csr,
+		Certificate:       certRes.Certificate,
+		IssuerCertificate: certRes.IssuerCertificate,
+	}
+
+	err := c.options.ArtifactStore.Save(artifacts)
+	if err != nil {
+		log.Warnf("[%s] acme: could not save the issuance artifacts: %v", certRes.Domain, err)
+	}
+}
+
+// splitChain sets the parts of the chain of the certificate.
+// A failure to split the chain doesn't fail the issuance.
+func splitChain(certRes *Resource, bundle bool) {
+	pemBundle := certRes.Certificate
+	if !bundle {
+		pemBundle = append(bytes.Clone(certRes.Certificate), certRes.IssuerCertificate...)
+	}
+
+	chain, err := SplitChain(pemBundle)
+	if err != nil {
+		log.Warnf("[%s] acme: could not split the certificate chain: %v", certRes.Domain, err)
+		return
+	}
+
+	certRes.Chain = chain
+}
+
+// verifySANs checks that the leaf certificate contains all the domains (and IP addresses).
+// A wildcard domain and its base domain are distinct names: each one must be in the SANs.
+func verifySANs(certRes *Resource, domains []string) error {
+	leaf, err := certcrypto.ParsePEMCertificate(certRes.Certificate)
+	if err != nil {
+		return fmt.Errorf("could not parse the issued certificate: %w", err)
+	}
+
+	var missing []string
+
+	for _, domain := range domains {
+		if !hasSAN(leaf, domain) {
+			missing = append(missing, domain)
 		}
+	}
 
-		return done, nil
-	})
+	if len(missing) > 0 {
+		return fmt.Errorf("the issued certificate doesn't contain the requested SANs: %s", strings.Join(missing, ", "))
+	}
 
-	return certRes, err
+	return nil
+}
+
+func hasSAN(cert *x509.Certificate, domain string) bool {
+	if ip := net.ParseIP(domain); ip != nil {
+		return slices.ContainsFunc(cert.IPAddresses, ip.Equal)
+	}
+
+	return slices.ContainsFunc(cert.DNSNames, func(name string) bool {
+		return strings.EqualFold(name, domain)
+	})
 }
 
 // checkResponse checks to see if the certificate is ready and a link is contained in the response.
@@ -365,16 +594,26 @@ func (c *Certifier) checkResponse(order acme.ExtendedOrder, certRes *Resource, b
 		return valid, err
 	}
 
-	certs, err := c.core.Certificates.GetAll(order.Certificate, bundle)
+	// The certificate of a STAR order is only exposed through the star-certificate URL.
+	certURL := order.Certificate
+	if order.StarCertificate != "" {
+		certRes.StarCertificateURL = order.StarCertificate
+
+		if certURL == "" {
+			certURL = order.StarCertificate
+		}
+	}
+
+	certs, err := c.core.Certificates.GetAll(certURL, bundle)
 	if err != nil {
 		return false, err
 	}
 
 	// Set the default certificate
-	certRes.IssuerCertificate = certs[order.Certificate].Issuer
-	certRes.Certificate = certs[order.Certificate].Cert
-	certRes.CertURL = order.Certificate
-	certRes.CertStableURL = order.Certificate
+	certRes.IssuerCertificate = certs[certURL].Issuer
+	certRes.Certificate = certs[certURL].Cert
+	certRes.CertURL = certURL
+	certRes.CertStableURL = certURL
 
 	if preferredChain == "" {
 		log.Infof("[%s] Server responded with a certificate.", certRes.Domain)
@@ -411,15 +650,59 @@ func (c *Certifier) Revoke(cert []byte) error {
 }
 
 // RevokeWithReason takes a PEM encoded certificate or bundle and tries to revoke it at the CA.
+// The reason must be one of the CRL reason codes (see acme.CRLReasonUnspecified), or nil.
 func (c *Certifier) RevokeWithReason(cert []byte, reason *uint) error {
-	certificates, err := certcrypto.ParsePEMBundle(cert)
+	revokeMsg, _, err := newRevokeMessage(cert, reason)
+	if err != nil {
+		return err
+	}
+
+	return c.core.Certificates.Revoke(revokeMsg)
+}
+
+// RevokeWithCertificateKey takes a PEM encoded certificate or bundle and tries to revoke it at the CA.
+// The request is signed with the private key of the certificate instead of the account key,
+// this allows to revoke a certificate issued to another account (e.g. when the key is compromised).
+// The reason must be one of the CRL reason codes (see acme.CRLReasonUnspecified), or nil.
+// - https://www.rfc-editor.org/rfc/rfc8555.html#section-7.6
+func (c *Certifier) RevokeWithCertificateKey(cert []byte, privateKey crypto.PrivateKey, reason *uint) error {
+	revokeMsg, x509Cert, err := newRevokeMessage(cert, reason)
+	if err != nil {
+		return err
+	}
+
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return errors.New("unsupported private key type")
+	}
+
+	publicKey, ok := signer.Public().(interface{ Equal(x crypto.PublicKey) bool })
+	if !ok || !publicKey.Equal(x509Cert.PublicKey) {
+		return errors.New("the private key does not match the public key of the certificate")
+	}
+
+	// The JWK of the certificate key is embedded in the request (no key identifier).
+	core, err := c.core.WithAccount("", privateKey)
 	if err != nil {
 		return err
 	}
 
+	return core.Certificates.Revoke(revokeMsg)
+}
+
+func newRevokeMessage(cert []byte, reason *uint) (acme.RevokeCertMessage, *x509.Certificate, error) {
+	if reason != nil && !isValidRevocationReason(*reason) {
+		return acme.RevokeCertMessage{}, nil, fmt.Errorf("invalid revocation reason: %d", *reason)
+	}
+
+	certificates, err := certcrypto.ParsePEMBundle(cert)
+	if err != nil {
+		return acme.RevokeCertMessage{}, nil, err
+	}
+
 	x509Cert := certificates[0]
 	if x509Cert.IsCA {
-		return errors.New("certificate bundle starts with a CA certificate")
+		return acme.RevokeCertMessage{}, nil, errors.New("certificate bundle starts with a CA certificate")
 	}
 
 	revokeMsg := acme.RevokeCertMessage{
@@ -427,7 +710,26 @@ func (c *Certifier) RevokeWithReason(cert []byte, reason *uint) error {
 		Reason:      reason,
 	}
 
-	return c.core.Certificates.Revoke(revokeMsg)
+	return revokeMsg, x509Cert, nil
+}
+
+// isValidRevocationReason checks the reason against the CRL reason codes of RFC 5280 (the value 7 is not used).
+func isValidRevocationReason(reason uint) bool {
+	switch reason {
+	case acme.CRLReasonUnspecified,
+		acme.CRLReasonKeyCompromise,
+		acme.CRLReasonCACompromise,
+		acme.CRLReasonAffiliationChanged,
+		acme.CRLReasonSuperseded,
+		acme.CRLReasonCessationOfOperation,
+		acme.CRLReasonCertificateHold,
+		acme.CRLReasonRemoveFromCRL,
+		acme.CRLReasonPrivilegeWithdrawn,
+		acme.CRLReasonAACompromise:
+		return true
+	default:
+		return false
+	}
 }
 
 // RenewOptions options used by Certifier.RenewWithOptions.
@@ -668,7 +970,7 @@ func checkOrderStatus(order acme.ExtendedOrder) (bool, error) {
 	case acme.StatusValid:
 		return true, nil
 	case acme.StatusInvalid:
-		return false, order.Error
+		return false, newOrderProblemError(order)
 	default:
 		return false, nil
 	}
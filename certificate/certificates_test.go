@@ -1,17 +1,26 @@
 package certificate
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"fmt"
+	"io"
+	"math/big"
+	"net"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/go-acme/lego/v4/acme"
 	"github.com/go-acme/lego/v4/acme/api"
 	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-jose/go-jose/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -214,6 +223,42 @@ func Test_checkResponse(t *testing.T) {
 	assert.Equal(t, issuerMock, string(certRes.IssuerCertificate), "IssuerCertificate")
 }
 
+func Test_checkResponse_starCertificate(t *testing.T) {
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	mux.HandleFunc("/star-certificate", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(certResponseMock))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err, "Could not generate test key")
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", key)
+	require.NoError(t, err)
+
+	certifier := NewCertifier(core, &resolverMock{}, CertifierOptions{KeyType: certcrypto.RSA2048})
+
+	order := acme.ExtendedOrder{
+		Order: acme.Order{
+			Status:          acme.StatusValid,
+			StarCertificate: apiURL + "/star-certificate",
+		},
+	}
+	certRes := &Resource{}
+
+	valid, err := certifier.checkResponse(order, certRes, true, "")
+	require.NoError(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, apiURL+"/star-certificate", certRes.StarCertificateURL)
+	assert.Equal(t, apiURL+"/star-certificate", certRes.CertURL)
+	assert.Equal(t, certResponseMock, string(certRes.Certificate), "Certificate")
+	assert.Equal(t, issuerMock, string(certRes.IssuerCertificate), "IssuerCertificate")
+}
+
 func Test_checkResponse_issuerRelUp(t *testing.T) {
 	mux, apiURL := tester.SetupFakeAPI(t)
 
@@ -389,6 +434,204 @@ func Test_Get(t *testing.T) {
 	assert.Equal(t, issuerMock, string(certRes.IssuerCertificate), "IssuerCertificate")
 }
 
+// revokeRequest is the revocation request received by the fake API.
+type revokeRequest struct {
+	kid     string
+	jwk     *jose.JSONWebKey
+	message acme.RevokeCertMessage
+}
+
+func setupRevokeTest(t *testing.T) (*Certifier, *revokeRequest) {
+	t.Helper()
+
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	received := &revokeRequest{}
+
+	mux.HandleFunc("POST /revokeCert", func(w http.ResponseWriter, r *http.Request) {
+		raw, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		jws, err := jose.ParseSigned(string(raw), []jose.SignatureAlgorithm{jose.RS256, jose.ES256})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		received.kid = jws.Signatures[0].Protected.KeyID
+		received.jwk = jws.Signatures[0].Protected.JSONWebKey
+
+		err = json.Unmarshal(jws.UnsafePayloadWithoutVerification(), &received.message)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	})
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", apiURL+"/account/1", key)
+	require.NoError(t, err)
+
+	return NewCertifier(core, &resolverMock{}, CertifierOptions{}), received
+}
+
+func TestCertifier_RevokeWithReason(t *testing.T) {
+	cert, _ := createTestCertificate(t, "example.com", nil, nil, false)
+
+	reasons := []uint{
+		acme.CRLReasonUnspecified,
+		acme.CRLReasonKeyCompromise,
+		acme.CRLReasonCACompromise,
+		acme.CRLReasonAffiliationChanged,
+		acme.CRLReasonSuperseded,
+		acme.CRLReasonCessationOfOperation,
+		acme.CRLReasonCertificateHold,
+		acme.CRLReasonRemoveFromCRL,
+		acme.CRLReasonPrivilegeWithdrawn,
+		acme.CRLReasonAACompromise,
+	}
+
+	for _, reason := range reasons {
+		t.Run(fmt.Sprintf("reason %d", reason), func(t *testing.T) {
+			certifier, received := setupRevokeTest(t)
+
+			err := certifier.RevokeWithReason(cert, &reason)
+			require.NoError(t, err)
+
+			require.NotNil(t, received.message.Reason)
+			assert.Equal(t, reason, *received.message.Reason)
+			assert.NotEmpty(t, received.message.Certificate)
+			assert.NotEmpty(t, received.kid)
+		})
+	}
+}
+
+func TestCertifier_RevokeWithReason_noReason(t *testing.T) {
+	cert, _ := createTestCertificate(t, "example.com", nil, nil, false)
+
+	certifier, received := setupRevokeTest(t)
+
+	err := certifier.Revoke(cert)
+	require.NoError(t, err)
+
+	assert.Nil(t, received.message.Reason)
+}
+
+func TestCertifier_RevokeWithReason_invalidReason(t *testing.T) {
+	cert, _ := createTestCertificate(t, "example.com", nil, nil, false)
+
+	for _, reason := range []uint{7, 11} {
+		t.Run(fmt.Sprintf("reason %d", reason), func(t *testing.T) {
+			certifier, received := setupRevokeTest(t)
+
+			err := certifier.RevokeWithReason(cert, &reason)
+			require.EqualError(t, err, fmt.Sprintf("invalid revocation reason: %d", reason))
+
+			assert.Empty(t, received.message.Certificate)
+		})
+	}
+}
+
+func TestCertifier_RevokeWithCertificateKey(t *testing.T) {
+	cert, certKey := createTestCertificate(t, "example.com", nil, nil, false)
+
+	certifier, received := setupRevokeTest(t)
+
+	reason := acme.CRLReasonKeyCompromise
+
+	err := certifier.RevokeWithCertificateKey(cert, certKey, &reason)
+	require.NoError(t, err)
+
+	require.NotNil(t, received.message.Reason)
+	assert.Equal(t, reason, *received.message.Reason)
+
+	// The request is signed with the key of the certificate, not the account key.
+	assert.Empty(t, received.kid)
+	require.NotNil(t, received.jwk)
+	assert.True(t, certKey.Public().(*ecdsa.PublicKey).Equal(received.jwk.Key))
+}
+
+func TestCertifier_RevokeWithCertificateKey_keyMismatch(t *testing.T) {
+	cert, _ := createTestCertificate(t, "example.com", nil, nil, false)
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	certifier, received := setupRevokeTest(t)
+
+	err = certifier.RevokeWithCertificateKey(cert, otherKey, nil)
+	require.EqualError(t, err, "the private key does not match the public key of the certificate")
+
+	assert.Empty(t, received.message.Certificate)
+}
+
+func Test_verifySANs(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"*.example.com", "Foo.example.org"},
+		IPAddresses:  []net.IP{net.ParseIP("192.0.2.1")},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, privateKey.Public(), privateKey)
+	require.NoError(t, err)
+
+	certRes := &Resource{Certificate: certcrypto.PEMEncode(certcrypto.DERCertificateBytes(der))}
+
+	testCases := []struct {
+		desc     string
+		domains  []string
+		expected string
+	}{
+		{
+			desc:    "all SANs",
+			domains: []string{"*.example.com", "foo.example.org", "192.0.2.1"},
+		},
+		{
+			desc:     "base domain of a wildcard",
+			domains:  []string{"example.com", "*.example.com"},
+			expected: "the issued certificate doesn't contain the requested SANs: example.com",
+		},
+		{
+			desc:     "subdomain covered by a wildcard",
+			domains:  []string{"bar.example.com"},
+			expected: "the issued certificate doesn't contain the requested SANs: bar.example.com",
+		},
+		{
+			desc:     "missing domain and IP address",
+			domains:  []string{"foo.example.org", "bar.example.org", "192.0.2.2"},
+			expected: "the issued certificate doesn't contain the requested SANs: bar.example.org, 192.0.2.2",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifySANs(certRes, test.domains)
+			if test.expected == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expected)
+			}
+		})
+	}
+}
+
+func Test_verifySANs_invalidCertificate(t *testing.T) {
+	err := verifySANs(&Resource{Certificate: []byte("foo")}, []string{"example.com"})
+	require.EqualError(t, err, "could not parse the issued certificate: PEM decode did not yield a valid block. Is the certificate in the right format?")
+}
+
 type resolverMock struct {
 	error error
 }
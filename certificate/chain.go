@@ -0,0 +1,83 @@
+package certificate
+
+import (
+	"bytes"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+)
+
+// Chain is a certificate chain split into its parts, each part is PEM-encoded.
+type Chain struct {
+	// Leaf is the issued certificate.
+	Leaf []byte
+	// Intermediates are the intermediate certificates, from the issuer of the leaf to the root.
+	Intermediates []byte
+	// Root is the self-signed root certificate, only set if the CA included it in the chain.
+	Root []byte
+}
+
+// SplitChain splits a PEM bundle, starting with the leaf certificate, into its parts.
+func SplitChain(bundle []byte) (*Chain, error) {
+	certs, err := certcrypto.ParsePEMBundle(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	if certs[0].IsCA {
+		return nil, errors.New("the certificate bundle starts with a CA certificate")
+	}
+
+	chain := &Chain{Leaf: certcrypto.PEMEncode(certcrypto.DERCertificateBytes(certs[0].Raw))}
+
+	issuers := certs[1:]
+
+	if len(issuers) > 0 && isSelfSigned(issuers[len(issuers)-1]) {
+		chain.Root = certcrypto.PEMEncode(certcrypto.DERCertificateBytes(issuers[len(issuers)-1].Raw))
+		issuers = issuers[:len(issuers)-1]
+	}
+
+	for _, cert := range issuers {
+		chain.Intermediates = append(chain.Intermediates, certcrypto.PEMEncode(certcrypto.DERCertificateBytes(cert.Raw))...)
+	}
+
+	return chain, nil
+}
+
+// WriteFiles writes the parts of the chain inside the directory:
+// leaf.pem, intermediates.pem, and root.pem (only if the root is included).
+func (c *Chain) WriteFiles(dir string) error {
+	files := []struct {
+		name    string
+		content []byte
+	}{
+		{name: "leaf.pem", content: c.Leaf},
+		{name: "intermediates.pem", content: c.Intermediates},
+		{name: "root.pem", content: c.Root},
+	}
+
+	for _, file := range files {
+		if len(file.content) == 0 {
+			continue
+		}
+
+		err := os.WriteFile(filepath.Join(dir, file.name), file.content, artifactFilePerm)
+		if err != nil {
+			return fmt.Errorf("could not write %s: %w", file.name, err)
+		}
+	}
+
+	return nil
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	if !bytes.Equal(cert.RawIssuer, cert.RawSubject) {
+		return false
+	}
+
+	return cert.CheckSignatureFrom(cert) == nil
+}
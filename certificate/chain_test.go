@@ -0,0 +1,188 @@
+package certificate
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitChain(t *testing.T) {
+	root, rootKey := createTestCertificate(t, "root", nil, nil, true)
+	intermediate, intermediateKey := createTestCertificate(t, "intermediate", root, rootKey, true)
+	leaf, _ := createTestCertificate(t, "example.com", intermediate, intermediateKey, false)
+
+	testCases := []struct {
+		desc     string
+		bundle   [][]byte
+		expected *Chain
+	}{
+		{
+			desc:     "leaf only",
+			bundle:   [][]byte{leaf},
+			expected: &Chain{Leaf: leaf},
+		},
+		{
+			desc:     "leaf and intermediate",
+			bundle:   [][]byte{leaf, intermediate},
+			expected: &Chain{Leaf: leaf, Intermediates: intermediate},
+		},
+		{
+			desc:     "leaf, intermediate, and root",
+			bundle:   [][]byte{leaf, intermediate, root},
+			expected: &Chain{Leaf: leaf, Intermediates: intermediate, Root: root},
+		},
+		{
+			desc:     "leaf and root",
+			bundle:   [][]byte{leaf, root},
+			expected: &Chain{Leaf: leaf, Root: root},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var bundle []byte
+			for _, cert := range test.bundle {
+				bundle = append(bundle, cert...)
+			}
+
+			chain, err := SplitChain(bundle)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, chain)
+		})
+	}
+}
+
+func TestSplitChain_error(t *testing.T) {
+	root, rootKey := createTestCertificate(t, "root", nil, nil, true)
+	intermediate, _ := createTestCertificate(t, "intermediate", root, rootKey, true)
+
+	testCases := []struct {
+		desc     string
+		bundle   []byte
+		expected string
+	}{
+		{
+			desc:     "empty",
+			expected: "no certificates were found while parsing the bundle",
+		},
+		{
+			desc:     "starts with a CA certificate",
+			bundle:   append(intermediate, root...),
+			expected: "the certificate bundle starts with a CA certificate",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := SplitChain(test.bundle)
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func Test_splitChain(t *testing.T) {
+	root, rootKey := createTestCertificate(t, "root", nil, nil, true)
+	intermediate, intermediateKey := createTestCertificate(t, "intermediate", root, rootKey, true)
+	leaf, _ := createTestCertificate(t, "example.com", intermediate, intermediateKey, false)
+
+	issuer := append(bytes.Clone(intermediate), root...)
+
+	testCases := []struct {
+		desc    string
+		bundle  bool
+		certRes *Resource
+	}{
+		{
+			desc:    "bundle",
+			bundle:  true,
+			certRes: &Resource{Certificate: append(bytes.Clone(leaf), issuer...), IssuerCertificate: issuer},
+		},
+		{
+			desc:    "no bundle",
+			certRes: &Resource{Certificate: leaf, IssuerCertificate: issuer},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			splitChain(test.certRes, test.bundle)
+
+			expected := &Chain{Leaf: leaf, Intermediates: intermediate, Root: root}
+
+			assert.Equal(t, expected, test.certRes.Chain)
+		})
+	}
+}
+
+func TestChain_WriteFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	chain := &Chain{
+		Leaf:          []byte("leaf"),
+		Intermediates: []byte("intermediates"),
+	}
+
+	err := chain.WriteFiles(dir)
+	require.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(dir, "leaf.pem"))
+	assert.FileExists(t, filepath.Join(dir, "intermediates.pem"))
+	assert.NoFileExists(t, filepath.Join(dir, "root.pem"))
+
+	content, err := os.ReadFile(filepath.Join(dir, "intermediates.pem"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "intermediates", string(content))
+}
+
+// createTestCertificate creates a PEM-encoded certificate signed by the parent,
+// or a self-signed certificate if the parent is nil.
+func createTestCertificate(t *testing.T, name string, parent []byte, parentKey crypto.Signer, isCA bool) ([]byte, crypto.Signer) {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+
+	parentCert, signer := template, crypto.Signer(privateKey)
+
+	if parent != nil {
+		parentCert, err = certcrypto.ParsePEMCertificate(parent)
+		require.NoError(t, err)
+
+		signer = parentKey
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, privateKey.Public(), signer)
+	require.NoError(t, err)
+
+	return certcrypto.PEMEncode(certcrypto.DERCertificateBytes(der)), privateKey
+}
@@ -0,0 +1,29 @@
+package certificate
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+)
+
+// checkCSR checks that the CSR can be submitted as is to the CA.
+//
+// The CSR is sent without any modification:
+// the SANs (DNS names and IP addresses) and the extensions (i.e. the TLS Feature "must staple") are preserved.
+//
+// The key algorithm is not checked (the ACME directory metadata doesn't advertise the supported key algorithms):
+// a CA rejecting the key of the CSR reports it during the finalization of the order.
+func checkCSR(csr *x509.CertificateRequest) error {
+	err := csr.CheckSignature()
+	if err != nil {
+		return fmt.Errorf("invalid CSR signature: %w", err)
+	}
+
+	if len(certcrypto.ExtractDomainsCSR(csr)) == 0 {
+		return errors.New("the CSR doesn't contain any domain or IP address")
+	}
+
+	return nil
+}
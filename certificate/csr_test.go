@@ -0,0 +1,131 @@
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/go-acme/lego/v4/acme/api"
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_checkCSR(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	raw, err := certcrypto.GenerateCSR(privateKey, "example.com", []string{"example.com", "www.example.com", "*.example.org", "192.0.2.1"}, true)
+	require.NoError(t, err)
+
+	csr, err := x509.ParseCertificateRequest(raw)
+	require.NoError(t, err)
+
+	err = checkCSR(csr)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"example.com", "www.example.com", "*.example.org", "192.0.2.1"}, certcrypto.ExtractDomainsCSR(csr))
+	assert.Len(t, csr.Extensions, 2) // SANs and TLS Feature (must staple).
+}
+
+func Test_checkCSR_ed25519(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	csr := createCSR(t, privateKey, &x509.CertificateRequest{DNSNames: []string{"example.com"}})
+
+	err = checkCSR(csr)
+	require.NoError(t, err)
+
+	assert.Equal(t, x509.Ed25519, csr.PublicKeyAlgorithm)
+}
+
+func Test_checkCSR_errors(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc     string
+		csr      func(t *testing.T) *x509.CertificateRequest
+		expected string
+	}{
+		{
+			desc: "invalid signature",
+			csr: func(t *testing.T) *x509.CertificateRequest {
+				t.Helper()
+
+				csr := createCSR(t, ecKey, &x509.CertificateRequest{DNSNames: []string{"example.com"}})
+				csr.Signature[len(csr.Signature)-1] ^= 0xff
+
+				return csr
+			},
+			expected: "invalid CSR signature: x509: ECDSA verification failure",
+		},
+		{
+			desc: "no domains",
+			csr: func(t *testing.T) *x509.CertificateRequest {
+				t.Helper()
+
+				return createCSR(t, ecKey, &x509.CertificateRequest{})
+			},
+			expected: "the CSR doesn't contain any domain or IP address",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			err := checkCSR(test.csr(t))
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func TestCertifier_ObtainForCSR_invalidCSR(t *testing.T) {
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	mux.HandleFunc("/newOrder", func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("the order must not be created")
+	})
+
+	// small value keeps test fast
+	privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, err)
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+	require.NoError(t, err)
+
+	certifier := NewCertifier(core, &resolverMock{}, CertifierOptions{KeyType: certcrypto.RSA2048})
+
+	csrKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	csr := createCSR(t, csrKey, &x509.CertificateRequest{
+		Subject:     pkix.Name{CommonName: "example.com"},
+		DNSNames:    []string{"example.com", "www.example.com"},
+		IPAddresses: []net.IP{net.ParseIP("192.0.2.1")},
+	})
+	csr.Signature[len(csr.Signature)-1] ^= 0xff
+
+	_, err = certifier.ObtainForCSR(ObtainForCSRRequest{CSR: csr})
+	require.EqualError(t, err, "cannot obtain resource for CSR: invalid CSR signature: x509: ECDSA verification failure")
+}
+
+func createCSR(t *testing.T, privateKey any, template *x509.CertificateRequest) *x509.CertificateRequest {
+	t.Helper()
+
+	raw, err := x509.CreateCertificateRequest(rand.Reader, template, privateKey)
+	require.NoError(t, err)
+
+	csr, err := x509.ParseCertificateRequest(raw)
+	require.NoError(t, err)
+
+	return csr
+}
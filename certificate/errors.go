@@ -3,6 +3,9 @@ package certificate
 import (
 	"errors"
 	"fmt"
+	"slices"
+
+	"github.com/go-acme/lego/v4/acme"
 )
 
 type obtainError struct {
@@ -38,3 +41,145 @@ type domainError struct {
 	Domain string
 	Error  error
 }
+
+// ProblemError is returned when the CA marks an order or some authorizations as invalid.
+// It carries the problem documents of the CA,
+// so the callers can tell which identifier failed and why (e.g. CAA, DNS):
+//
+//	var problemErr *certificate.ProblemError
+//	if errors.As(err, &problemErr) {
+//		for _, identifier := range problemErr.Identifiers() {
+//			for _, problem := range problemErr.Problems(identifier) {
+//				// problem.Type, problem.Detail
+//			}
+//		}
+//	}
+type ProblemError struct {
+	// OrderURL the URL of the order.
+	OrderURL string
+	// Order the problem document of the invalid order,
+	// nil when the order has no error, or when only some authorizations are invalid.
+	Order *acme.ProblemDetails
+	// Authorizations the invalid authorizations of the order.
+	Authorizations []AuthorizationProblem
+
+	// err the error returned while solving the challenges, if any.
+	err error
+}
+
+// AuthorizationProblem the problem of an invalid authorization.
+type AuthorizationProblem struct {
+	// URL the URL of the authorization.
+	URL string
+	// Identifier the identifier of the authorization.
+	Identifier acme.Identifier
+	// Problem the error of the invalid challenge of the authorization, if any.
+	Problem *acme.ProblemDetails
+}
+
+func newOrderProblemError(order acme.ExtendedOrder) *ProblemError {
+	return &ProblemError{OrderURL: order.Location, Order: order.Error}
+}
+
+func (e *ProblemError) Error() string {
+	if e.err != nil {
+		return e.err.Error()
+	}
+
+	msg := "the order is invalid"
+	if e.OrderURL != "" {
+		msg = fmt.Sprintf("the order %s is invalid", e.OrderURL)
+	}
+
+	if e.Order != nil {
+		msg += ": " + e.Order.Error()
+	}
+
+	return msg
+}
+
+func (e *ProblemError) Unwrap() []error {
+	var errs []error
+
+	if e.err != nil {
+		errs = append(errs, e.err)
+	}
+
+	if e.Order != nil {
+		errs = append(errs, e.Order)
+	}
+
+	return errs
+}
+
+// Identifiers returns the values of the identifiers having a problem, sorted.
+func (e *ProblemError) Identifiers() []string {
+	var identifiers []string
+
+	add := func(value string) {
+		if value != "" && !slices.Contains(identifiers, value) {
+			identifiers = append(identifiers, value)
+		}
+	}
+
+	for _, sub := range e.orderSubProblems() {
+		add(sub.Identifier.Value)
+	}
+
+	for _, authz := range e.Authorizations {
+		add(authz.Identifier.Value)
+
+		if authz.Problem != nil {
+			for _, sub := range authz.Problem.SubProblems {
+				add(sub.Identifier.Value)
+			}
+		}
+	}
+
+	slices.Sort(identifiers)
+
+	return identifiers
+}
+
+// Problems returns the problems of an identifier:
+// the subproblems of the order about the identifier, the problem of the authorization of the identifier,
+// and the subproblems of the authorizations about the identifier.
+func (e *ProblemError) Problems(identifier string) []acme.SubProblem {
+	var problems []acme.SubProblem
+
+	for _, sub := range e.orderSubProblems() {
+		if sub.Identifier.Value == identifier {
+			problems = append(problems, sub)
+		}
+	}
+
+	for _, authz := range e.Authorizations {
+		if authz.Problem == nil {
+			continue
+		}
+
+		if authz.Identifier.Value == identifier {
+			problems = append(problems, acme.SubProblem{
+				Type:       authz.Problem.Type,
+				Detail:     authz.Problem.Detail,
+				Identifier: authz.Identifier,
+			})
+		}
+
+		for _, sub := range authz.Problem.SubProblems {
+			if sub.Identifier.Value == identifier {
+				problems = append(problems, sub)
+			}
+		}
+	}
+
+	return problems
+}
+
+func (e *ProblemError) orderSubProblems() []acme.SubProblem {
+	if e.Order == nil {
+		return nil
+	}
+
+	return e.Order.SubProblems
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -67,3 +69,43 @@ func Test_obtainError_Join_same_domain(t *testing.T) {
 	ca := &CarrotError{}
 	require.ErrorAs(t, err, &ca)
 }
+
+func TestProblemError_order(t *testing.T) {
+	problemErr := &ProblemError{
+		OrderURL: "https://ca.example.com/order/1",
+		Order: &acme.ProblemDetails{
+			Type:   "urn:ietf:params:acme:error:rejectedIdentifier",
+			Detail: "Error creating new order",
+			SubProblems: []acme.SubProblem{
+				{Type: acme.RejectedIdentifierErr, Detail: "forbidden", Identifier: acme.Identifier{Type: "dns", Value: "b.example.com"}},
+				{Type: acme.CAAErr, Detail: "CAA record prevents issuance", Identifier: acme.Identifier{Type: "dns", Value: "a.example.com"}},
+			},
+		},
+	}
+
+	require.EqualError(t, problemErr, `the order https://ca.example.com/order/1 is invalid: acme: error: 0 :: urn:ietf:params:acme:error:rejectedIdentifier :: Error creating new order, `+
+		`problem: "urn:ietf:params:acme:error:rejectedIdentifier" :: forbidden, problem: "urn:ietf:params:acme:error:caa" :: CAA record prevents issuance`)
+
+	assert.Equal(t, []string{"a.example.com", "b.example.com"}, problemErr.Identifiers())
+
+	expected := []acme.SubProblem{
+		{Type: acme.CAAErr, Detail: "CAA record prevents issuance", Identifier: acme.Identifier{Type: "dns", Value: "a.example.com"}},
+	}
+
+	assert.Equal(t, expected, problemErr.Problems("a.example.com"))
+	assert.Empty(t, problemErr.Problems("c.example.com"))
+
+	problem := &acme.ProblemDetails{}
+	require.ErrorAs(t, problemErr, &problem)
+
+	assert.Equal(t, "Error creating new order", problem.Detail)
+}
+
+func TestProblemError_noOrderError(t *testing.T) {
+	problemErr := &ProblemError{OrderURL: "https://ca.example.com/order/1"}
+
+	require.EqualError(t, problemErr, "the order https://ca.example.com/order/1 is invalid")
+
+	assert.Empty(t, problemErr.Identifiers())
+	assert.Empty(t, problemErr.Unwrap())
+}
@@ -0,0 +1,276 @@
+package certificate
+
+import (
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/acme/api"
+	"github.com/go-acme/lego/v4/log"
+)
+
+// maxReusableOrderCandidates limits the number of orders inspected when looking for a reusable order.
+const maxReusableOrderCandidates = 50
+
+// cachedOrder an order created by the Certifier (see CertifierOptions.OrderReuseWindow).
+type cachedOrder struct {
+	location string
+	created  time.Time
+}
+
+// newOrder creates a new order,
+// or reuses a pending/ready order of the account with the same identifiers if ReuseOrders is enabled,
+// or resumes an order created recently with the same identifiers if OrderReuseWindow is defined.
+func (c *Certifier) newOrder(domains []string, opts *api.OrderOptions) (acme.ExtendedOrder, error) {
+	// A STAR order is never reused: the auto-renewal parameters of the existing orders are not compared.
+	reusable := opts == nil || opts.AutoRenewal == nil
+
+	var profile string
+	if opts != nil {
+		profile = opts.Profile
+	}
+
+	key := orderCacheKey(domains, opts)
+
+	if reusable && c.options.OrderReuseWindow > 0 {
+		order, ok := c.findCachedOrder(key, domains)
+		if ok {
+			log.Infof("[%s] acme: Resuming the order %s", strings.Join(domains, ", "), order.Location)
+
+			return order, nil
+		}
+	}
+
+	// The validity window and the ARI replacement of the existing orders are not compared:
+	// an order requested with them is never reused from the orders of the account.
+	listReusable := reusable && (opts == nil || (opts.NotBefore.IsZero() && opts.NotAfter.IsZero() && opts.ReplacesCertID == ""))
+
+	if listReusable && c.options.ReuseOrders {
+		order, ok := c.findReusableOrder(domains, profile)
+		if ok {
+			log.Infof("[%s] acme: Reusing the existing order %s", strings.Join(domains, ", "), order.Location)
+
+			return order, nil
+		}
+	}
+
+	order, err := c.core.Orders.NewWithOptions(domains, opts)
+	if err != nil {
+		return acme.ExtendedOrder{}, err
+	}
+
+	if reusable && c.options.OrderReuseWindow > 0 {
+		c.ordersMu.Lock()
+		c.orders[key] = cachedOrder{location: order.Location, created: time.Now()}
+		c.ordersMu.Unlock()
+	}
+
+	return order, nil
+}
+
+// findCachedOrder looks for an order created by the Certifier during the reuse window, that can still be finalized.
+func (c *Certifier) findCachedOrder(key string, domains []string) (acme.ExtendedOrder, bool) {
+	c.ordersMu.Lock()
+	cached, ok := c.orders[key]
+	c.ordersMu.Unlock()
+
+	if !ok {
+		return acme.ExtendedOrder{}, false
+	}
+
+	if time.Since(cached.created) > c.options.OrderReuseWindow {
+		c.forgetCachedOrder(key)
+		return acme.ExtendedOrder{}, false
+	}
+
+	order, err := c.core.Orders.Get(cached.location)
+	if err != nil {
+		log.Infof("[%s] acme: could not get the order %s: %v", strings.Join(domains, ", "), cached.location, err)
+		c.forgetCachedOrder(key)
+
+		return acme.ExtendedOrder{}, false
+	}
+
+	if !isReusableOrder(order.Order, domains) {
+		c.forgetCachedOrder(key)
+		return acme.ExtendedOrder{}, false
+	}
+
+	order.Location = cached.location
+
+	return order, true
+}
+
+func (c *Certifier) forgetCachedOrder(key string) {
+	c.ordersMu.Lock()
+	delete(c.orders, key)
+	c.ordersMu.Unlock()
+}
+
+// forgetOrder removes an order from the orders created during the reuse window:
+// once its finalization has been requested (a finalized order, valid or invalid, cannot be resumed),
+// or when its authorizations are deactivated.
+func (c *Certifier) forgetOrder(location string) {
+	if c.options.OrderReuseWindow <= 0 {
+		return
+	}
+
+	c.ordersMu.Lock()
+	defer c.ordersMu.Unlock()
+
+	for key, cached := range c.orders {
+		if cached.location == location {
+			delete(c.orders, key)
+		}
+	}
+}
+
+// isCachedOrder checks if the order is kept in memory to be resumed during the reuse window.
+func (c *Certifier) isCachedOrder(location string) bool {
+	c.ordersMu.Lock()
+	defer c.ordersMu.Unlock()
+
+	for _, cached := range c.orders {
+		if cached.location == location {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isListReusableOrder checks if the order can be reused from the orders of the account (see CertifierOptions.ReuseOrders):
+// the STAR orders, and the orders with a validity window or an ARI replacement, are never reused.
+func (c *Certifier) isListReusableOrder(order acme.Order) bool {
+	return c.options.ReuseOrders &&
+		order.AutoRenewal == nil && order.NotBefore == "" && order.NotAfter == "" && order.Replaces == ""
+}
+
+// abandonOrder deactivates the authorizations of an order after a failure,
+// unless the order is kept to be resumed (see CertifierOptions.OrderReuseWindow and CertifierOptions.ReuseOrders):
+// its pending authorizations must stay usable by the next request.
+// When force is true, the authorizations are always deactivated, and the order is forgotten.
+// The authorizations are the ones already fetched by fetchAuthorizations, they are only fetched when nil.
+func (c *Certifier) abandonOrder(order acme.ExtendedOrder, authorizations map[string]acme.Authorization, force bool) {
+	if !force && (c.isCachedOrder(order.Location) || c.isListReusableOrder(order.Order)) {
+		log.Infof("acme: Keeping the authorizations of the order %s to resume it", order.Location)
+		return
+	}
+
+	c.forgetOrder(order.Location)
+
+	if authorizations == nil {
+		authorizations = c.fetchAuthorizations(order)
+	}
+
+	c.deactivateAuthorizations(order, authorizations, force)
+}
+
+// orderCacheKey builds the key of an order from its identifiers (whatever their order) and its options
+// (profile, validity window, and ARI replacement).
+func orderCacheKey(domains []string, opts *api.OrderOptions) string {
+	idents := make([]string, len(domains))
+	for i, domain := range domains {
+		idents[i] = strings.ToLower(domain)
+	}
+
+	slices.Sort(idents)
+
+	var o api.OrderOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	return strings.Join([]string{
+		strings.Join(idents, ","),
+		o.Profile,
+		formatCacheKeyTime(o.NotBefore),
+		formatCacheKeyTime(o.NotAfter),
+		o.ReplacesCertID,
+	}, "|")
+}
+
+func formatCacheKeyTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+// findReusableOrder looks for an order, covering the exact same identifiers, that can still be finalized.
+// Valid orders are not reused: the certificate is already issued for a private key that may be unknown.
+// If a profile is requested, only the orders with this profile are reused.
+// Any error (i.e. the CA doesn't support the orders list) leads to the creation of a new order.
+func (c *Certifier) findReusableOrder(domains []string, profile string) (acme.ExtendedOrder, bool) {
+	orderURLs, err := c.core.Orders.ListForAccount()
+	if err != nil {
+		log.Infof("[%s] acme: could not list the orders of the account: %v", strings.Join(domains, ", "), err)
+		return acme.ExtendedOrder{}, false
+	}
+
+	// Most CAs list the most recent orders last.
+	slices.Reverse(orderURLs)
+
+	for i, orderURL := range orderURLs {
+		if i >= maxReusableOrderCandidates {
+			break
+		}
+
+		order, err := c.core.Orders.Get(orderURL)
+		if err != nil {
+			log.Infof("[%s] acme: could not get the order %s: %v", strings.Join(domains, ", "), orderURL, err)
+			continue
+		}
+
+		if !isReusableOrder(order.Order, domains) {
+			continue
+		}
+
+		if profile != "" && order.Profile != profile {
+			continue
+		}
+
+		order.Location = orderURL
+
+		return order, true
+	}
+
+	return acme.ExtendedOrder{}, false
+}
+
+func isReusableOrder(order acme.Order, domains []string) bool {
+	if order.Status != acme.StatusPending && order.Status != acme.StatusReady {
+		return false
+	}
+
+	if order.Expires != "" {
+		expires, err := time.Parse(time.RFC3339, order.Expires)
+		if err != nil || time.Now().Add(time.Minute).After(expires) {
+			return false
+		}
+	}
+
+	if len(order.Identifiers) != len(domains) {
+		return false
+	}
+
+	for _, ident := range order.Identifiers {
+		if !slices.ContainsFunc(domains, func(domain string) bool { return matchIdentifier(ident, domain) }) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchIdentifier checks if the identifier of an order is the one requested for the domain (DNS name or IP address).
+func matchIdentifier(ident acme.Identifier, domain string) bool {
+	if ip := net.ParseIP(domain); ip != nil {
+		return ident.Type == "ip" && ip.Equal(net.ParseIP(ident.Value))
+	}
+
+	return ident.Type == "dns" && strings.EqualFold(domain, ident.Value)
+}
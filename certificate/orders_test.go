@@ -0,0 +1,566 @@
+package certificate
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/acme/api"
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_isReusableOrder(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour).Format(time.RFC3339)
+	past := time.Now().Add(-24 * time.Hour).Format(time.RFC3339)
+
+	identifiers := []acme.Identifier{
+		{Type: "dns", Value: "example.com"},
+		{Type: "dns", Value: "www.example.com"},
+	}
+
+	testCases := []struct {
+		desc     string
+		order    acme.Order
+		domains  []string
+		expected bool
+	}{
+		{
+			desc:     "ready with same identifiers",
+			order:    acme.Order{Status: acme.StatusReady, Expires: future, Identifiers: identifiers},
+			domains:  []string{"www.example.com", "example.com"},
+			expected: true,
+		},
+		{
+			desc:     "pending with same identifiers",
+			order:    acme.Order{Status: acme.StatusPending, Expires: future, Identifiers: identifiers},
+			domains:  []string{"example.com", "WWW.example.com"},
+			expected: true,
+		},
+		{
+			desc:    "valid",
+			order:   acme.Order{Status: acme.StatusValid, Expires: future, Identifiers: identifiers},
+			domains: []string{"example.com", "www.example.com"},
+		},
+		{
+			desc:    "invalid",
+			order:   acme.Order{Status: acme.StatusInvalid, Expires: future, Identifiers: identifiers},
+			domains: []string{"example.com", "www.example.com"},
+		},
+		{
+			desc:    "expired",
+			order:   acme.Order{Status: acme.StatusReady, Expires: past, Identifiers: identifiers},
+			domains: []string{"example.com", "www.example.com"},
+		},
+		{
+			desc:    "subset of identifiers",
+			order:   acme.Order{Status: acme.StatusReady, Expires: future, Identifiers: identifiers},
+			domains: []string{"example.com"},
+		},
+		{
+			desc:    "different identifiers",
+			order:   acme.Order{Status: acme.StatusReady, Expires: future, Identifiers: identifiers},
+			domains: []string{"example.com", "example.org"},
+		},
+		{
+			desc: "IP address",
+			order: acme.Order{Status: acme.StatusReady, Expires: future, Identifiers: []acme.Identifier{
+				{Type: "ip", Value: "2001:db8::1"},
+			}},
+			domains:  []string{"2001:0db8:0:0:0:0:0:1"},
+			expected: true,
+		},
+		{
+			desc: "different identifier type",
+			order: acme.Order{Status: acme.StatusReady, Expires: future, Identifiers: []acme.Identifier{
+				{Type: "dns", Value: "192.0.2.1"},
+			}},
+			domains: []string{"192.0.2.1"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, isReusableOrder(test.order, test.domains))
+		})
+	}
+}
+
+func TestCertifier_newOrder_orderReuseWindow(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		window        time.Duration
+		status        string
+		age           time.Duration
+		secondDomains []string
+		expected      int32
+	}{
+		{
+			desc:          "resumed within the window",
+			window:        time.Hour,
+			status:        acme.StatusPending,
+			secondDomains: []string{"www.example.com", "EXAMPLE.com"},
+			expected:      1,
+		},
+		{
+			desc:          "ready order resumed",
+			window:        time.Hour,
+			status:        acme.StatusReady,
+			secondDomains: []string{"example.com", "www.example.com"},
+			expected:      1,
+		},
+		{
+			desc:          "window disabled",
+			status:        acme.StatusPending,
+			secondDomains: []string{"example.com", "www.example.com"},
+			expected:      2,
+		},
+		{
+			desc:          "window elapsed",
+			window:        time.Hour,
+			status:        acme.StatusPending,
+			age:           2 * time.Hour,
+			secondDomains: []string{"example.com", "www.example.com"},
+			expected:      2,
+		},
+		{
+			desc:          "invalid order",
+			window:        time.Hour,
+			status:        acme.StatusInvalid,
+			secondDomains: []string{"example.com", "www.example.com"},
+			expected:      2,
+		},
+		{
+			desc:          "other identifiers",
+			window:        time.Hour,
+			status:        acme.StatusPending,
+			secondDomains: []string{"example.com"},
+			expected:      2,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			mux, apiURL := tester.SetupFakeAPI(t)
+
+			var created atomic.Int32
+
+			mux.HandleFunc("POST /newOrder", func(w http.ResponseWriter, _ *http.Request) {
+				created.Add(1)
+
+				w.Header().Set("Location", apiURL+"/order")
+				w.WriteHeader(http.StatusCreated)
+
+				err := tester.WriteJSONResponse(w, acme.Order{Status: acme.StatusPending})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+				}
+			})
+
+			mux.HandleFunc("POST /order", func(w http.ResponseWriter, _ *http.Request) {
+				err := tester.WriteJSONResponse(w, acme.Order{
+					Status: test.status,
+					Identifiers: []acme.Identifier{
+						{Type: "dns", Value: "example.com"},
+						{Type: "dns", Value: "www.example.com"},
+					},
+				})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+				}
+			})
+
+			key, err := rsa.GenerateKey(rand.Reader, 1024)
+			require.NoError(t, err)
+
+			core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", key)
+			require.NoError(t, err)
+
+			certifier := NewCertifier(core, &resolverMock{}, CertifierOptions{OrderReuseWindow: test.window})
+
+			first, err := certifier.newOrder([]string{"example.com", "www.example.com"}, nil)
+			require.NoError(t, err)
+
+			for k, cached := range certifier.orders {
+				cached.created = cached.created.Add(-test.age)
+				certifier.orders[k] = cached
+			}
+
+			second, err := certifier.newOrder(test.secondDomains, nil)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, created.Load())
+			assert.Equal(t, first.Location, second.Location)
+		})
+	}
+}
+
+func TestCertifier_newOrder_reuseOrders(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		opts     *api.OrderOptions
+		expected int32
+	}{
+		{
+			desc:     "reused",
+			expected: 0,
+		},
+		{
+			desc:     "same profile",
+			opts:     &api.OrderOptions{Profile: "shortlived"},
+			expected: 0,
+		},
+		{
+			desc:     "ARI replacement",
+			opts:     &api.OrderOptions{ReplacesCertID: "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"},
+			expected: 1,
+		},
+		{
+			desc:     "validity window",
+			opts:     &api.OrderOptions{NotAfter: time.Now().Add(24 * time.Hour)},
+			expected: 1,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			mux, apiURL := tester.SetupFakeAPI(t)
+
+			var created atomic.Int32
+
+			mux.HandleFunc("POST /newOrder", func(w http.ResponseWriter, _ *http.Request) {
+				created.Add(1)
+
+				w.Header().Set("Location", apiURL+"/new-order")
+				w.WriteHeader(http.StatusCreated)
+
+				err := tester.WriteJSONResponse(w, acme.Order{Status: acme.StatusPending})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+				}
+			})
+
+			mux.HandleFunc("POST /account", func(w http.ResponseWriter, _ *http.Request) {
+				err := tester.WriteJSONResponse(w, acme.Account{Status: acme.StatusValid, Orders: apiURL + "/orders"})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+				}
+			})
+
+			mux.HandleFunc("POST /orders", func(w http.ResponseWriter, _ *http.Request) {
+				err := tester.WriteJSONResponse(w, acme.OrdersList{Orders: []string{apiURL + "/order"}})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+				}
+			})
+
+			mux.HandleFunc("POST /order", func(w http.ResponseWriter, _ *http.Request) {
+				err := tester.WriteJSONResponse(w, acme.Order{
+					Status:      acme.StatusReady,
+					Profile:     "shortlived",
+					Identifiers: []acme.Identifier{{Type: "dns", Value: "example.com"}},
+				})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+				}
+			})
+
+			key, err := rsa.GenerateKey(rand.Reader, 1024)
+			require.NoError(t, err)
+
+			core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", apiURL+"/account", key)
+			require.NoError(t, err)
+
+			certifier := NewCertifier(core, &resolverMock{}, CertifierOptions{ReuseOrders: true})
+
+			_, err = certifier.newOrder([]string{"example.com"}, test.opts)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, created.Load())
+		})
+	}
+}
+
+func Test_orderCacheKey(t *testing.T) {
+	notAfter := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	domains := []string{"example.com", "www.example.com"}
+
+	base := orderCacheKey(domains, nil)
+
+	assert.Equal(t, base, orderCacheKey([]string{"WWW.example.com", "example.com"}, &api.OrderOptions{}))
+
+	others := []*api.OrderOptions{
+		{Profile: "shortlived"},
+		{NotBefore: notAfter.Add(-24 * time.Hour)},
+		{NotAfter: notAfter},
+		{ReplacesCertID: "aYhba4dGQEHhs3uEe6CuLN4ByNQ.AIdlQyE"},
+	}
+
+	for _, opts := range others {
+		assert.NotEqual(t, base, orderCacheKey(domains, opts))
+	}
+}
+
+func TestCertifier_forgetOrder(t *testing.T) {
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	var created atomic.Int32
+
+	mux.HandleFunc("POST /newOrder", func(w http.ResponseWriter, _ *http.Request) {
+		created.Add(1)
+
+		w.Header().Set("Location", apiURL+"/order")
+		w.WriteHeader(http.StatusCreated)
+
+		err := tester.WriteJSONResponse(w, acme.Order{Status: acme.StatusPending})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", key)
+	require.NoError(t, err)
+
+	certifier := NewCertifier(core, &resolverMock{}, CertifierOptions{OrderReuseWindow: time.Hour})
+
+	order, err := certifier.newOrder([]string{"example.com"}, nil)
+	require.NoError(t, err)
+
+	require.Len(t, certifier.orders, 1)
+
+	certifier.forgetOrder(order.Location)
+
+	assert.Empty(t, certifier.orders)
+}
+
+func TestCertifier_Obtain_orderReuseWindow_afterFailure(t *testing.T) {
+	testCases := []struct {
+		desc                string
+		alwaysDeactivate    bool
+		expectedCreated     int32
+		expectedDeactivated int32
+	}{
+		{
+			desc:            "order resumed",
+			expectedCreated: 1,
+		},
+		{
+			desc:                "always deactivate authorizations",
+			alwaysDeactivate:    true,
+			expectedCreated:     2,
+			expectedDeactivated: 1,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			mux, apiURL := tester.SetupFakeAPI(t)
+
+			writeJSON := func(w http.ResponseWriter, v any) {
+				err := tester.WriteJSONResponse(w, v)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+				}
+			}
+
+			order := acme.Order{
+				Status:         acme.StatusPending,
+				Identifiers:    []acme.Identifier{{Type: "dns", Value: "example.com"}},
+				Authorizations: []string{apiURL + "/authz/1"},
+				Finalize:       apiURL + "/finalize",
+			}
+
+			var created, deactivated atomic.Int32
+
+			mux.HandleFunc("POST /newOrder", func(w http.ResponseWriter, _ *http.Request) {
+				created.Add(1)
+
+				w.Header().Set("Location", apiURL+"/order")
+				w.WriteHeader(http.StatusCreated)
+
+				writeJSON(w, order)
+			})
+
+			mux.HandleFunc("POST /order", func(w http.ResponseWriter, _ *http.Request) {
+				writeJSON(w, order)
+			})
+
+			mux.HandleFunc("POST /authz/1", func(w http.ResponseWriter, r *http.Request) {
+				raw, err := io.ReadAll(r.Body)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				jws, err := jose.ParseSigned(string(raw), []jose.SignatureAlgorithm{jose.RS256})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				var update acme.Authorization
+
+				// The POST-as-GET requests have an empty payload.
+				if payload := jws.UnsafePayloadWithoutVerification(); len(payload) > 0 {
+					err = json.Unmarshal(payload, &update)
+					if err != nil {
+						http.Error(w, err.Error(), http.StatusBadRequest)
+						return
+					}
+				}
+
+				if update.Status == acme.StatusDeactivated {
+					deactivated.Add(1)
+				}
+
+				writeJSON(w, acme.Authorization{
+					Status:     acme.StatusPending,
+					Identifier: acme.Identifier{Type: "dns", Value: "example.com"},
+				})
+			})
+
+			mux.HandleFunc("POST /finalize", func(w http.ResponseWriter, _ *http.Request) {
+				writeJSON(w, acme.Order{Status: acme.StatusValid, Certificate: apiURL + "/certificate"})
+			})
+
+			mux.HandleFunc("POST /certificate", func(w http.ResponseWriter, _ *http.Request) {
+				_, err := w.Write([]byte(certResponseMock))
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+				}
+			})
+
+			key, err := rsa.GenerateKey(rand.Reader, 1024)
+			require.NoError(t, err)
+
+			core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", key)
+			require.NoError(t, err)
+
+			resolver := &resolverMock{error: errors.New("present failed")}
+
+			certifier := NewCertifier(core, resolver, CertifierOptions{KeyType: certcrypto.EC256, OrderReuseWindow: time.Hour})
+
+			request := ObtainRequest{
+				Domains:                        []string{"example.com"},
+				AlwaysDeactivateAuthorizations: test.alwaysDeactivate,
+			}
+
+			_, err = certifier.Obtain(request)
+			require.EqualError(t, err, "present failed")
+
+			assert.Equal(t, test.expectedDeactivated, deactivated.Load())
+
+			resolver.error = nil
+
+			certRes, err := certifier.Obtain(request)
+			require.NoError(t, err)
+
+			assert.Equal(t, apiURL+"/order", certRes.Order.OrderURL)
+			assert.Equal(t, test.expectedCreated, created.Load())
+			assert.Empty(t, certifier.orders)
+		})
+	}
+}
+
+func TestCertifier_Obtain_reuseOrders_afterFailure(t *testing.T) {
+	testCases := []struct {
+		desc                string
+		order               acme.Order
+		expectedDeactivated int32
+	}{
+		{
+			desc:  "reusable order",
+			order: acme.Order{Status: acme.StatusPending},
+		},
+		{
+			desc:                "validity window",
+			order:               acme.Order{Status: acme.StatusPending, NotAfter: time.Now().Add(24 * time.Hour).Format(time.RFC3339)},
+			expectedDeactivated: 1,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			mux, apiURL := tester.SetupFakeAPI(t)
+
+			writeJSON := func(w http.ResponseWriter, v any) {
+				err := tester.WriteJSONResponse(w, v)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+				}
+			}
+
+			order := test.order
+			order.Identifiers = []acme.Identifier{{Type: "dns", Value: "example.com"}}
+			order.Authorizations = []string{apiURL + "/authz/1"}
+			order.Finalize = apiURL + "/finalize"
+
+			var deactivated atomic.Int32
+
+			mux.HandleFunc("POST /account", func(w http.ResponseWriter, _ *http.Request) {
+				writeJSON(w, acme.Account{Status: acme.StatusValid, Orders: apiURL + "/orders"})
+			})
+
+			mux.HandleFunc("POST /orders", func(w http.ResponseWriter, _ *http.Request) {
+				writeJSON(w, acme.OrdersList{Orders: []string{apiURL + "/order"}})
+			})
+
+			mux.HandleFunc("POST /order", func(w http.ResponseWriter, _ *http.Request) {
+				writeJSON(w, order)
+			})
+
+			mux.HandleFunc("POST /authz/1", func(w http.ResponseWriter, r *http.Request) {
+				raw, err := io.ReadAll(r.Body)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				jws, err := jose.ParseSigned(string(raw), []jose.SignatureAlgorithm{jose.RS256})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				// The POST-as-GET requests have an empty payload.
+				if len(jws.UnsafePayloadWithoutVerification()) > 0 {
+					deactivated.Add(1)
+				}
+
+				writeJSON(w, acme.Authorization{
+					Status:     acme.StatusPending,
+					Identifier: acme.Identifier{Type: "dns", Value: "example.com"},
+				})
+			})
+
+			key, err := rsa.GenerateKey(rand.Reader, 1024)
+			require.NoError(t, err)
+
+			core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", apiURL+"/account", key)
+			require.NoError(t, err)
+
+			certifier := NewCertifier(core, &resolverMock{error: errors.New("present failed")}, CertifierOptions{KeyType: certcrypto.EC256, ReuseOrders: true})
+
+			_, err = certifier.Obtain(ObtainRequest{Domains: []string{"example.com"}})
+			require.EqualError(t, err, "present failed")
+
+			assert.Equal(t, test.expectedDeactivated, deactivated.Load())
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package certificate
+
+import (
+	"context"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/acme/api"
+	"github.com/go-acme/lego/v4/platform/wait"
+)
+
+const (
+	// DefaultFinalizeTimeout is the default maximum time to wait for the certificate after the finalization of an order.
+	DefaultFinalizeTimeout = 30 * time.Second
+
+	// DefaultFinalizeMaxPollInterval is the default maximum interval between two polls of an order being processed.
+	DefaultFinalizeMaxPollInterval = 10 * time.Second
+)
+
+// waitForCertificate polls the order, after its finalization, until the certificate is issued.
+// The interval between the polls grows exponentially, from FinalizePollInterval up to FinalizeMaxPollInterval,
+// but a Retry-After header sent by the CA takes precedence (in the limit of the timeout).
+// An invalid order stops the polling, the other errors are retried until the timeout or the end of the context.
+func (c *Certifier) waitForCertificate(ctx context.Context, order, respOrder acme.ExtendedOrder, certRes *Resource, bundle bool, preferredChain string) error {
+	timeout, interval, maxInterval := c.finalizePolling()
+
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = interval
+	bo.MaxInterval = maxInterval
+	bo.MaxElapsedTime = 0
+	bo.Reset()
+
+	rab := &wait.RetryAfterBackOff{BackOff: bo, Deadline: time.Now().Add(timeout)}
+
+	// The response of the finalization is the first state of the order.
+	ord := respOrder
+	polled := false
+
+	var errOrder error
+
+	err := wait.ForBackOffContext(ctx, "certificate", timeout, rab, func() (bool, error) {
+		if polled {
+			var err error
+			ord, err = c.core.Orders.Get(order.Location)
+			if err != nil {
+				rab.SetRetryAfter(0)
+				return false, err
+			}
+		}
+
+		polled = true
+		retryAfter, _ := api.ParseRetryAfter(ord.RetryAfter)
+		rab.SetRetryAfter(retryAfter)
+
+		done, err := c.checkFinalizedOrder(ord, certRes, bundle, preferredChain)
+		if err != nil {
+			// Stops the polling.
+			errOrder = err
+			return true, nil
+		}
+
+		return done, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return errOrder
+}
+
+// checkFinalizedOrder checks the status of the order and gets the certificate when the order is valid.
+// An invalid order returns a ProblemError carrying the error of the order.
+func (c *Certifier) checkFinalizedOrder(order acme.ExtendedOrder, certRes *Resource, bundle bool, preferredChain string) (bool, error) {
+	switch order.Status {
+	case acme.StatusInvalid:
+		return false, newOrderProblemError(order)
+	case acme.StatusValid:
+		return c.checkResponse(order, certRes, bundle, preferredChain)
+	default:
+		// pending, ready, processing: the CA is still working on the order.
+		return false, nil
+	}
+}
+
+func (c *Certifier) finalizePolling() (timeout, interval, maxInterval time.Duration) {
+	timeout = c.options.Timeout
+	if timeout <= 0 {
+		timeout = DefaultFinalizeTimeout
+	}
+
+	interval = c.options.FinalizePollInterval
+	if interval <= 0 {
+		interval = timeout / 60
+	}
+
+	maxInterval = c.options.FinalizeMaxPollInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultFinalizeMaxPollInterval
+	}
+
+	return timeout, interval, max(interval, maxInterval)
+}
@@ -0,0 +1,160 @@
+package certificate
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/acme/api"
+	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupFinalizeTest(t *testing.T, processingPolls int32, retryAfter string, final acme.Order) (*Certifier, acme.ExtendedOrder, *atomic.Int32) {
+	t.Helper()
+
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	polls := &atomic.Int32{}
+
+	if final.Certificate != "" {
+		final.Certificate = apiURL + final.Certificate
+	}
+
+	mux.HandleFunc("/finalize", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", retryAfter)
+
+		err := tester.WriteJSONResponse(w, acme.Order{Status: acme.StatusProcessing})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	mux.HandleFunc("/order", func(w http.ResponseWriter, _ *http.Request) {
+		if polls.Add(1) <= processingPolls {
+			w.Header().Set("Retry-After", retryAfter)
+
+			err := tester.WriteJSONResponse(w, acme.Order{Status: acme.StatusProcessing})
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		err := tester.WriteJSONResponse(w, final)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	mux.HandleFunc("/certificate", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte(certResponseMock))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", key)
+	require.NoError(t, err)
+
+	certifier := NewCertifier(core, &resolverMock{}, CertifierOptions{
+		Timeout:                 2 * time.Second,
+		FinalizePollInterval:    10 * time.Millisecond,
+		FinalizeMaxPollInterval: 50 * time.Millisecond,
+	})
+
+	order := acme.ExtendedOrder{
+		Order:    acme.Order{Finalize: apiURL + "/finalize"},
+		Location: apiURL + "/order",
+	}
+
+	return certifier, order, polls
+}
+
+func TestCertifier_getForCSR_processing(t *testing.T) {
+	certifier, order, polls := setupFinalizeTest(t, 5, "", acme.Order{Status: acme.StatusValid, Certificate: "/certificate"})
+
+	certRes, err := certifier.getForCSR(context.Background(), []string{"example.com"}, order, true, []byte("csr"), nil, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(6), polls.Load())
+	assert.Equal(t, certResponseMock, string(certRes.Certificate))
+}
+
+func TestCertifier_getForCSR_orderInfo(t *testing.T) {
+	certifier, order, _ := setupFinalizeTest(t, 0, "", acme.Order{Status: acme.StatusValid, Certificate: "/certificate"})
+
+	order.Authorizations = []string{"https://example.com/authz/1", "https://example.com/authz/2"}
+
+	certRes, err := certifier.getForCSR(context.Background(), []string{"example.com"}, order, true, []byte("csr"), nil, "")
+	require.NoError(t, err)
+
+	expected := &OrderInfo{
+		OrderURL:          order.Location,
+		AuthorizationURLs: []string{"https://example.com/authz/1", "https://example.com/authz/2"},
+		FinalizeURL:       order.Finalize,
+	}
+
+	assert.Equal(t, expected, certRes.Order)
+}
+
+func TestCertifier_getForCSR_retryAfter(t *testing.T) {
+	certifier, order, polls := setupFinalizeTest(t, 1, "1", acme.Order{Status: acme.StatusValid, Certificate: "/certificate"})
+	certifier.options.Timeout = 5 * time.Second
+
+	start := time.Now()
+
+	_, err := certifier.getForCSR(context.Background(), []string{"example.com"}, order, true, []byte("csr"), nil, "")
+	require.NoError(t, err)
+
+	// The Retry-After of the finalization response and of the first poll are respected.
+	assert.GreaterOrEqual(t, time.Since(start), 2*time.Second-100*time.Millisecond)
+	assert.Equal(t, int32(2), polls.Load())
+}
+
+func TestCertifier_getForCSR_invalid(t *testing.T) {
+	final := acme.Order{
+		Status: acme.StatusInvalid,
+		Error:  &acme.ProblemDetails{Type: "urn:ietf:params:acme:error:badCSR", Detail: "bad CSR"},
+	}
+
+	certifier, order, polls := setupFinalizeTest(t, 2, "", final)
+
+	_, err := certifier.getForCSR(context.Background(), []string{"example.com"}, order, true, []byte("csr"), nil, "")
+	require.ErrorContains(t, err, "bad CSR")
+
+	var problemErr *ProblemError
+	require.ErrorAs(t, err, &problemErr)
+
+	assert.Equal(t, "urn:ietf:params:acme:error:badCSR", problemErr.Order.Type)
+
+	assert.Equal(t, int32(3), polls.Load())
+}
+
+func TestCertifier_getForCSR_timeout(t *testing.T) {
+	certifier, order, _ := setupFinalizeTest(t, 1000, "", acme.Order{})
+	certifier.options.Timeout = 200 * time.Millisecond
+
+	_, err := certifier.getForCSR(context.Background(), []string{"example.com"}, order, true, []byte("csr"), nil, "")
+	require.EqualError(t, err, "certificate: time limit exceeded")
+}
+
+func TestCertifier_getForCSR_canceled(t *testing.T) {
+	certifier, order, _ := setupFinalizeTest(t, 1000, "", acme.Order{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := certifier.getForCSR(ctx, []string{"example.com"}, order, true, []byte("csr"), nil, "")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
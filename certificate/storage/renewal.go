@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"crypto/x509"
+	"time"
+
+	"github.com/go-acme/lego/v4/certificate"
+)
+
+// RenewalInfoGetter gets the renewal information (ARI) of a certificate.
+// It is implemented by certificate.Certifier.
+type RenewalInfoGetter interface {
+	GetRenewalInfo(req certificate.RenewalInfoRequest) (*certificate.RenewalInfoResponse, error)
+}
+
+// RenewalOptions the options to select the certificates due for renewal.
+type RenewalOptions struct {
+	// Days the number of days left on a certificate to renew it (the "days" option of the CLI).
+	// When negative, the certificates are always due for renewal.
+	Days int
+
+	// RenewalInfo (optional) the client used to get the renewal information (ARI) of the certificates.
+	// When the renewal information is unavailable, the expiration date of the certificate is used.
+	RenewalInfo RenewalInfoGetter
+
+	// WillingToSleep the duration the caller is willing to wait for the suggested renewal time of ARI
+	// (the "ari-wait-to-renew-duration" option of the CLI).
+	WillingToSleep time.Duration
+
+	// Now (optional) the current time, time.Now by default.
+	Now func() time.Time
+}
+
+// Renewal a certificate due for renewal.
+type Renewal struct {
+	*Entry
+
+	// RenewAt the renewal time suggested by ARI,
+	// nil when the renewal is based on the expiration date of the certificate.
+	RenewAt *time.Time
+
+	// ARIError the error returned while getting the renewal information, if any.
+	ARIError error
+}
+
+// DueForRenewal returns the certificates of the storage that are due for renewal.
+//
+// When a RenewalInfoGetter is provided, the renewal information (ARI) is used first,
+// then the number of days before the expiration of the certificates.
+//
+// The unreadable certificates are skipped:
+// the certificates due for renewal are returned along with the errors of the skipped files.
+func (l *Layout) DueForRenewal(opts RenewalOptions) ([]Renewal, error) {
+	now := time.Now
+	if opts.Now != nil {
+		now = opts.Now
+	}
+
+	it, err := l.Certificates()
+	if err != nil {
+		return nil, err
+	}
+
+	var renewals []Renewal
+
+	for it.Next() {
+		entry := it.Entry()
+
+		renewal := Renewal{Entry: entry}
+
+		if opts.RenewalInfo != nil {
+			renewal.RenewAt, renewal.ARIError = getARIRenewalTime(opts.RenewalInfo, entry.Leaf(), now().UTC(), opts.WillingToSleep)
+		}
+
+		if renewal.RenewAt == nil && !NeedRenewal(entry.Leaf(), now(), opts.Days) {
+			continue
+		}
+
+		renewals = append(renewals, renewal)
+	}
+
+	return renewals, it.Err()
+}
+
+// NeedRenewal reports whether a certificate must be renewed,
+// based on the number of days left before its expiration.
+// When days is negative, the certificate is always due for renewal.
+func NeedRenewal(cert *x509.Certificate, now time.Time, days int) bool {
+	if days < 0 {
+		return true
+	}
+
+	return DaysLeft(cert, now) <= days
+}
+
+// DaysLeft returns the number of whole days before the expiration of a certificate.
+func DaysLeft(cert *x509.Certificate, now time.Time) int {
+	return int(cert.NotAfter.Sub(now).Hours() / 24.0)
+}
+
+func getARIRenewalTime(client RenewalInfoGetter, cert *x509.Certificate, now time.Time, willingToSleep time.Duration) (*time.Time, error) {
+	renewalInfo, err := client.GetRenewalInfo(certificate.RenewalInfoRequest{Cert: cert})
+	if err != nil {
+		return nil, err
+	}
+
+	return renewalInfo.ShouldRenewAt(now, willingToSleep), nil
+}
@@ -0,0 +1,225 @@
+// Package storage reads the certificates saved by the lego CLI, and selects the certificates due for renewal.
+//
+// It also defines the stores of the account keys (KeyStore) and of the certificates (CertStore),
+// implemented on the file system (FileKeyStore, Layout) and with HashiCorp Vault (VaultStore).
+//
+// Layout of the storage (the "path" option of the CLI):
+//
+//	./.lego/
+//	├── certificates/
+//	│   ├── example.com.crt          the certificate (and the issuer chain when bundled)
+//	│   ├── example.com.issuer.crt   the issuer certificate
+//	│   ├── example.com.key          the private key
+//	│   ├── example.com.json         the metadata of the certificate (certificate.Resource)
+//	│   ├── example.com.pem          (optional) the certificate and the private key
+//	│   └── example.com.pfx          (optional) the PKCS#12 archive
+//	└── archives/
+//	    └── archived certificates
+package storage
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/go-acme/lego/v4/certificate"
+	"golang.org/x/net/idna"
+)
+
+// Folders of the storage.
+const (
+	CertificatesFolderName = "certificates"
+	ArchivesFolderName     = "archives"
+)
+
+// Extensions of the files of the storage.
+const (
+	IssuerExt   = ".issuer.crt"
+	CertExt     = ".crt"
+	KeyExt      = ".key"
+	PEMExt      = ".pem"
+	PFXExt      = ".pfx"
+	ResourceExt = ".json"
+)
+
+// Layout the layout of a certificates' storage.
+type Layout struct {
+	basePath string
+}
+
+// NewLayout creates a Layout.
+// basePath is the "path" option of the CLI (the parent of the certificates folder).
+func NewLayout(basePath string) *Layout {
+	return &Layout{basePath: basePath}
+}
+
+// CertificatesPath returns the path of the certificates folder.
+func (l *Layout) CertificatesPath() string {
+	return filepath.Join(l.basePath, CertificatesFolderName)
+}
+
+// ArchivesPath returns the path of the archives folder.
+func (l *Layout) ArchivesPath() string {
+	return filepath.Join(l.basePath, ArchivesFolderName)
+}
+
+// FileName returns the path of the file of a domain with the given extension.
+func (l *Layout) FileName(domain, extension string) (string, error) {
+	name, err := SanitizedDomain(domain)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(l.CertificatesPath(), name+extension), nil
+}
+
+// Certificates returns an iterator over the certificates of the storage, sorted by file name.
+// The issuer certificates are skipped.
+func (l *Layout) Certificates() (*Iterator, error) {
+	matches, err := filepath.Glob(filepath.Join(l.CertificatesPath(), "*"+CertExt))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, match := range matches {
+		if strings.HasSuffix(match, IssuerExt) {
+			continue
+		}
+
+		files = append(files, match)
+	}
+
+	sort.Strings(files)
+
+	return &Iterator{files: files}, nil
+}
+
+// Entry a certificate of the storage.
+type Entry struct {
+	// Name the base name of the files of the certificate (the sanitized main domain).
+	Name string
+	// Path the path of the certificate file.
+	Path string
+	// Certificates the certificates of the file, the leaf certificate first.
+	Certificates []*x509.Certificate
+}
+
+// Leaf returns the leaf certificate.
+func (e *Entry) Leaf() *x509.Certificate {
+	return e.Certificates[0]
+}
+
+// Domain returns the main domain of the certificate.
+func (e *Entry) Domain() (string, error) {
+	return certcrypto.GetCertificateMainDomain(e.Leaf())
+}
+
+// FileName returns the path of the file of the certificate with the given extension.
+func (e *Entry) FileName(extension string) string {
+	return filepath.Join(filepath.Dir(e.Path), e.Name+extension)
+}
+
+// ReadResource reads the metadata of the certificate.
+func (e *Entry) ReadResource() (*certificate.Resource, error) {
+	raw, err := os.ReadFile(e.FileName(ResourceExt))
+	if err != nil {
+		return nil, err
+	}
+
+	resource := &certificate.Resource{}
+	err = json.Unmarshal(raw, resource)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", e.FileName(ResourceExt), err)
+	}
+
+	return resource, nil
+}
+
+// Iterator iterates over the certificates of a storage.
+//
+//	it, err := layout.Certificates()
+//	// ...
+//	for it.Next() {
+//		entry := it.Entry()
+//		// ...
+//	}
+//
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type Iterator struct {
+	files []string
+
+	current *Entry
+	errs    []error
+}
+
+// Next reads the next certificate.
+// The unreadable or corrupt files are skipped, their errors are reported by Err.
+// It returns false when there are no more certificates.
+func (it *Iterator) Next() bool {
+	for len(it.files) > 0 {
+		file := it.files[0]
+		it.files = it.files[1:]
+
+		entry, err := readEntry(file)
+		if err != nil {
+			it.errs = append(it.errs, err)
+			continue
+		}
+
+		it.current = entry
+
+		return true
+	}
+
+	it.current = nil
+
+	return false
+}
+
+// Entry returns the current certificate.
+func (it *Iterator) Entry() *Entry {
+	return it.current
+}
+
+// Err returns the errors of the files skipped during the iteration, if any.
+func (it *Iterator) Err() error {
+	return errors.Join(it.errs...)
+}
+
+func readEntry(file string) (*Entry, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	// The file may be a bundle or a single certificate.
+	certs, err := certcrypto.ParsePEMBundle(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	if certs[0].IsCA {
+		return nil, fmt.Errorf("%s: the certificate bundle starts with a CA certificate", file)
+	}
+
+	return &Entry{
+		Name:         strings.TrimSuffix(filepath.Base(file), CertExt),
+		Path:         file,
+		Certificates: certs,
+	}, nil
+}
+
+// SanitizedDomain returns the name used for the files of a domain:
+// the forbidden characters (like the wildcards) are replaced, and the domain is converted to ASCII.
+func SanitizedDomain(domain string) (string, error) {
+	return idna.ToASCII(strings.NewReplacer(":", "-", "*", "_").Replace(domain))
+}
@@ -0,0 +1,339 @@
+package storage
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/certificate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+// setupStorage creates a storage with:
+//   - example.com: expires in 60 days (bundled with its issuer).
+//   - example.org: expires in 20 days.
+//   - example.net: expired.
+//   - _.example.com: expires in 85 days (wildcard).
+func setupStorage(t *testing.T) *Layout {
+	t.Helper()
+
+	layout := NewLayout(t.TempDir())
+
+	err := os.MkdirAll(layout.CertificatesPath(), 0o700)
+	require.NoError(t, err)
+
+	issuer := generateCert(t, "Test CA", now.AddDate(1, 0, 0), true)
+
+	writeCert(t, layout, "example.com", CertExt, generateCert(t, "example.com", now.AddDate(0, 0, 60), false), issuer)
+	writeCert(t, layout, "example.com", IssuerExt, issuer)
+	writeCert(t, layout, "example.org", CertExt, generateCert(t, "example.org", now.AddDate(0, 0, 20), false))
+	writeCert(t, layout, "example.net", CertExt, generateCert(t, "example.net", now.AddDate(0, 0, -1), false))
+	writeCert(t, layout, "*.example.com", CertExt, generateCert(t, "*.example.com", now.AddDate(0, 0, 85), false))
+
+	err = os.WriteFile(filepath.Join(layout.CertificatesPath(), "example.org.json"), []byte(`{"domain":"example.org","certUrl":"https://acme.example/cert/1"}`), 0o600)
+	require.NoError(t, err)
+
+	return layout
+}
+
+func TestLayout_FileName(t *testing.T) {
+	layout := NewLayout("/tmp/.lego")
+
+	fileName, err := layout.FileName("*.example.com", KeyExt)
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.FromSlash("/tmp/.lego/certificates/_.example.com.key"), fileName)
+}
+
+func TestLayout_Certificates(t *testing.T) {
+	layout := setupStorage(t)
+
+	it, err := layout.Certificates()
+	require.NoError(t, err)
+
+	var names []string
+	var domains []string
+
+	for it.Next() {
+		entry := it.Entry()
+
+		names = append(names, entry.Name)
+
+		domain, err := entry.Domain()
+		require.NoError(t, err)
+
+		domains = append(domains, domain)
+	}
+
+	require.NoError(t, it.Err())
+
+	assert.Equal(t, []string{"_.example.com", "example.com", "example.net", "example.org"}, names)
+	assert.Equal(t, []string{"*.example.com", "example.com", "example.net", "example.org"}, domains)
+	assert.Nil(t, it.Entry())
+}
+
+func TestLayout_Certificates_bundle(t *testing.T) {
+	layout := setupStorage(t)
+
+	it, err := layout.Certificates()
+	require.NoError(t, err)
+
+	require.True(t, it.Next())
+	require.True(t, it.Next())
+
+	entry := it.Entry()
+
+	assert.Equal(t, "example.com", entry.Name)
+	require.Len(t, entry.Certificates, 2)
+	assert.Equal(t, "example.com", entry.Leaf().Subject.CommonName)
+	assert.Equal(t, "Test CA", entry.Certificates[1].Subject.CommonName)
+}
+
+func TestLayout_Certificates_invalid(t *testing.T) {
+	layout := setupStorage(t)
+
+	err := os.WriteFile(filepath.Join(layout.CertificatesPath(), "example.info.crt"), []byte("invalid"), 0o600)
+	require.NoError(t, err)
+
+	it, err := layout.Certificates()
+	require.NoError(t, err)
+
+	var names []string
+	for it.Next() {
+		names = append(names, it.Entry().Name)
+	}
+
+	assert.Equal(t, []string{"_.example.com", "example.com", "example.net", "example.org"}, names)
+	require.ErrorContains(t, it.Err(), "example.info.crt")
+	assert.False(t, it.Next())
+}
+
+func TestLayout_Certificates_empty(t *testing.T) {
+	layout := NewLayout(t.TempDir())
+
+	it, err := layout.Certificates()
+	require.NoError(t, err)
+
+	assert.False(t, it.Next())
+	require.NoError(t, it.Err())
+}
+
+func TestEntry_ReadResource(t *testing.T) {
+	layout := setupStorage(t)
+
+	it, err := layout.Certificates()
+	require.NoError(t, err)
+
+	for it.Next() {
+		entry := it.Entry()
+
+		if entry.Name != "example.org" {
+			_, err := entry.ReadResource()
+			require.ErrorIs(t, err, os.ErrNotExist)
+
+			continue
+		}
+
+		resource, err := entry.ReadResource()
+		require.NoError(t, err)
+
+		expected := &certificate.Resource{
+			Domain:  "example.org",
+			CertURL: "https://acme.example/cert/1",
+		}
+
+		assert.Equal(t, expected, resource)
+	}
+
+	require.NoError(t, it.Err())
+}
+
+func TestLayout_DueForRenewal(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		days     int
+		expected []string
+	}{
+		{
+			desc:     "30 days",
+			days:     30,
+			expected: []string{"example.net", "example.org"},
+		},
+		{
+			desc:     "60 days",
+			days:     60,
+			expected: []string{"example.com", "example.net", "example.org"},
+		},
+		{
+			desc:     "no days",
+			days:     0,
+			expected: []string{"example.net"},
+		},
+		{
+			desc:     "always",
+			days:     -1,
+			expected: []string{"_.example.com", "example.com", "example.net", "example.org"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			layout := setupStorage(t)
+
+			renewals, err := layout.DueForRenewal(RenewalOptions{
+				Days: test.days,
+				Now:  func() time.Time { return now },
+			})
+			require.NoError(t, err)
+
+			var names []string
+			for _, renewal := range renewals {
+				assert.Nil(t, renewal.RenewAt)
+				names = append(names, renewal.Name)
+			}
+
+			assert.Equal(t, test.expected, names)
+		})
+	}
+}
+
+func TestLayout_DueForRenewal_invalid(t *testing.T) {
+	layout := setupStorage(t)
+
+	err := os.WriteFile(filepath.Join(layout.CertificatesPath(), "example.info.crt"), []byte("invalid"), 0o600)
+	require.NoError(t, err)
+
+	renewals, err := layout.DueForRenewal(RenewalOptions{
+		Days: 30,
+		Now:  func() time.Time { return now },
+	})
+	require.ErrorContains(t, err, "example.info.crt")
+
+	var names []string
+	for _, renewal := range renewals {
+		names = append(names, renewal.Name)
+	}
+
+	assert.Equal(t, []string{"example.net", "example.org"}, names)
+}
+
+func TestLayout_DueForRenewal_renewalInfo(t *testing.T) {
+	layout := setupStorage(t)
+
+	client := &renewalInfoMock{
+		windows: map[string]acme.Window{
+			// ARI suggests a renewal of a certificate not due by its expiration date.
+			"_.example.com": {Start: now.Add(-time.Hour), End: now},
+			// ARI suggests a renewal in the future.
+			"example.org": {Start: now.AddDate(0, 0, 10), End: now.AddDate(0, 0, 11)},
+		},
+	}
+
+	renewals, err := layout.DueForRenewal(RenewalOptions{
+		Days:        30,
+		RenewalInfo: client,
+		Now:         func() time.Time { return now },
+	})
+	require.NoError(t, err)
+
+	require.Len(t, renewals, 3)
+
+	assert.Equal(t, "_.example.com", renewals[0].Name)
+	require.NotNil(t, renewals[0].RenewAt)
+	assert.Equal(t, now, *renewals[0].RenewAt)
+	require.NoError(t, renewals[0].ARIError)
+
+	// Fallback on the expiration date when ARI is unavailable.
+	assert.Equal(t, "example.net", renewals[1].Name)
+	assert.Nil(t, renewals[1].RenewAt)
+	require.Error(t, renewals[1].ARIError)
+
+	// Fallback on the expiration date when ARI defers the renewal.
+	assert.Equal(t, "example.org", renewals[2].Name)
+	assert.Nil(t, renewals[2].RenewAt)
+	require.NoError(t, renewals[2].ARIError)
+}
+
+func TestNeedRenewal(t *testing.T) {
+	cert := &x509.Certificate{NotAfter: now.Add(30*24*time.Hour + time.Hour)}
+
+	assert.True(t, NeedRenewal(cert, now, -1))
+	assert.True(t, NeedRenewal(cert, now, 30))
+	assert.False(t, NeedRenewal(cert, now, 29))
+	assert.Equal(t, 30, DaysLeft(cert, now))
+}
+
+type renewalInfoMock struct {
+	windows map[string]acme.Window
+}
+
+func (m *renewalInfoMock) GetRenewalInfo(req certificate.RenewalInfoRequest) (*certificate.RenewalInfoResponse, error) {
+	name, err := SanitizedDomain(req.Cert.Subject.CommonName)
+	if err != nil {
+		return nil, err
+	}
+
+	window, ok := m.windows[name]
+	if !ok {
+		return nil, errors.New("no renewal info")
+	}
+
+	return &certificate.RenewalInfoResponse{
+		RenewalInfoResponse: acme.RenewalInfoResponse{SuggestedWindow: window},
+	}, nil
+}
+
+func generateCert(t *testing.T, domain string, notAfter time.Time, isCA bool) []byte {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: domain},
+		NotBefore:             notAfter.AddDate(0, 0, -90),
+		NotAfter:              notAfter,
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+
+	if !isCA {
+		template.DNSNames = []string{domain}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func writeCert(t *testing.T, layout *Layout, domain, extension string, certs ...[]byte) {
+	t.Helper()
+
+	fileName, err := layout.FileName(domain, extension)
+	require.NoError(t, err)
+
+	var content []byte
+	for _, cert := range certs {
+		content = append(content, cert...)
+	}
+
+	err = os.WriteFile(fileName, content, 0o600)
+	require.NoError(t, err)
+}
@@ -0,0 +1,177 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/go-acme/lego/v4/certificate"
+)
+
+const filePerm os.FileMode = 0o600
+
+// ErrNotFound is returned (wrapped) by the stores when a key or a certificate doesn't exist.
+var ErrNotFound = errors.New("not found")
+
+// KeyStore stores the PEM-encoded private keys of the ACME accounts.
+type KeyStore interface {
+	// LoadKey returns the PEM-encoded private key of the account,
+	// or an error wrapping ErrNotFound when the key doesn't exist.
+	LoadKey(name string) ([]byte, error)
+
+	// SaveKey stores the PEM-encoded private key of the account.
+	SaveKey(name string, key []byte) error
+}
+
+// CertStore stores the issued certificates.
+type CertStore interface {
+	// LoadCertificate returns the certificate of the domain,
+	// or an error wrapping ErrNotFound when the certificate doesn't exist.
+	LoadCertificate(domain string) (*certificate.Resource, error)
+
+	// SaveCertificate stores the certificate, the issuer certificate, the private key, and the metadata of the certificate.
+	SaveCertificate(certRes *certificate.Resource) error
+}
+
+var (
+	_ KeyStore  = (*FileKeyStore)(nil)
+	_ CertStore = (*Layout)(nil)
+)
+
+// FileKeyStore stores the private keys inside a directory, one file (<name>.key) per account.
+type FileKeyStore struct {
+	dir string
+}
+
+// NewFileKeyStore creates a FileKeyStore.
+// For the CLI, dir is the "keys" folder of the account.
+func NewFileKeyStore(dir string) *FileKeyStore {
+	return &FileKeyStore{dir: dir}
+}
+
+// LoadKey reads the private key from the file <dir>/<name>.key.
+func (s *FileKeyStore) LoadKey(name string) ([]byte, error) {
+	key, err := os.ReadFile(s.fileName(name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("key %s: %w", name, ErrNotFound)
+	}
+
+	return key, err
+}
+
+// SaveKey writes the private key to the file <dir>/<name>.key.
+func (s *FileKeyStore) SaveKey(name string, key []byte) error {
+	err := os.MkdirAll(s.dir, 0o700)
+	if err != nil {
+		return fmt.Errorf("could not create the directory %s: %w", s.dir, err)
+	}
+
+	return os.WriteFile(s.fileName(name), key, filePerm)
+}
+
+func (s *FileKeyStore) fileName(name string) string {
+	return filepath.Join(s.dir, name+KeyExt)
+}
+
+// LoadCertificate reads the certificate of the domain from the certificates folder.
+func (l *Layout) LoadCertificate(domain string) (*certificate.Resource, error) {
+	resourceFile, err := l.FileName(domain, ResourceExt)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := os.ReadFile(resourceFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("certificate %s: %w", domain, ErrNotFound)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	certRes := &certificate.Resource{}
+
+	err = json.Unmarshal(raw, certRes)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", resourceFile, err)
+	}
+
+	files := []struct {
+		extension string
+		dst       *[]byte
+		optional  bool
+	}{
+		{extension: CertExt, dst: &certRes.Certificate},
+		{extension: IssuerExt, dst: &certRes.IssuerCertificate, optional: true},
+		{extension: KeyExt, dst: &certRes.PrivateKey, optional: true},
+	}
+
+	for _, f := range files {
+		filename, err := l.FileName(domain, f.extension)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := os.ReadFile(filename)
+		if err != nil {
+			if f.optional && errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		*f.dst = content
+	}
+
+	return certRes, nil
+}
+
+// SaveCertificate writes the certificate files of the domain inside the certificates folder.
+// The issuer certificate and the private key are only written when they are known.
+func (l *Layout) SaveCertificate(certRes *certificate.Resource) error {
+	if certRes == nil {
+		return errors.New("nil certificate resource")
+	}
+
+	err := os.MkdirAll(l.CertificatesPath(), 0o700)
+	if err != nil {
+		return fmt.Errorf("could not create the directory %s: %w", l.CertificatesPath(), err)
+	}
+
+	jsonBytes, err := json.MarshalIndent(certRes, "", "\t")
+	if err != nil {
+		return fmt.Errorf("marshal the metadata of %s: %w", certRes.Domain, err)
+	}
+
+	files := []struct {
+		extension string
+		content   []byte
+	}{
+		{extension: CertExt, content: certRes.Certificate},
+		{extension: IssuerExt, content: certRes.IssuerCertificate},
+		{extension: KeyExt, content: certRes.PrivateKey},
+		{extension: ResourceExt, content: jsonBytes},
+	}
+
+	for _, f := range files {
+		if f.content == nil {
+			continue
+		}
+
+		filename, err := l.FileName(certRes.Domain, f.extension)
+		if err != nil {
+			return err
+		}
+
+		err = os.WriteFile(filename, f.content, filePerm)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
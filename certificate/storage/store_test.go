@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-acme/lego/v4/certificate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileKeyStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "keys")
+
+	store := NewFileKeyStore(dir)
+
+	_, err := store.LoadKey("user@example.com")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	err = store.SaveKey("user@example.com", []byte("key"))
+	require.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(dir, "user@example.com.key"))
+
+	key, err := store.LoadKey("user@example.com")
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte("key"), key)
+}
+
+func TestLayout_SaveCertificate(t *testing.T) {
+	layout := NewLayout(t.TempDir())
+
+	certRes := &certificate.Resource{
+		Domain:            "*.example.com",
+		CertURL:           "https://ca.example.com/cert/1",
+		Certificate:       []byte("certificate"),
+		IssuerCertificate: []byte("issuer"),
+		PrivateKey:        []byte("key"),
+	}
+
+	err := layout.SaveCertificate(certRes)
+	require.NoError(t, err)
+
+	for _, ext := range []string{CertExt, IssuerExt, KeyExt, ResourceExt} {
+		assert.FileExists(t, filepath.Join(layout.CertificatesPath(), "_.example.com"+ext))
+	}
+
+	loaded, err := layout.LoadCertificate("*.example.com")
+	require.NoError(t, err)
+
+	assert.Equal(t, certRes, loaded)
+}
+
+func TestLayout_SaveCertificate_withoutPrivateKey(t *testing.T) {
+	layout := NewLayout(t.TempDir())
+
+	certRes := &certificate.Resource{
+		Domain:      "example.com",
+		Certificate: []byte("certificate"),
+	}
+
+	err := layout.SaveCertificate(certRes)
+	require.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(layout.CertificatesPath(), "example.com"+KeyExt))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	loaded, err := layout.LoadCertificate("example.com")
+	require.NoError(t, err)
+
+	assert.Equal(t, certRes, loaded)
+}
+
+func TestLayout_LoadCertificate_notFound(t *testing.T) {
+	layout := NewLayout(t.TempDir())
+
+	_, err := layout.LoadCertificate("example.com")
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,430 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/certificate"
+)
+
+// Default values of the VaultConfig.
+const (
+	DefaultVaultMount        = "secret"
+	DefaultVaultPath         = "lego"
+	DefaultVaultAppRoleMount = "approle"
+)
+
+// Fields of the secrets of the VaultStore.
+const (
+	vaultFieldPrivateKey        = "private_key"
+	vaultFieldCertificate       = "certificate"
+	vaultFieldIssuerCertificate = "issuer_certificate"
+	vaultFieldResource          = "resource"
+)
+
+// VaultConfig the configuration of a VaultStore.
+type VaultConfig struct {
+	// Address the address of the Vault server (e.g. https://vault.example.com:8200).
+	Address string
+	// Namespace (optional) the Vault namespace (Vault Enterprise).
+	Namespace string
+
+	// Token the Vault token.
+	// When the token is empty, the AppRole authentication (RoleID and SecretID) is used.
+	Token string
+	// RoleID the role ID of the AppRole authentication.
+	RoleID string
+	// SecretID the secret ID of the AppRole authentication.
+	SecretID string
+	// AppRoleMount (optional) the mount path of the AppRole auth method ("approle" by default).
+	AppRoleMount string
+
+	// Mount (optional) the mount path of the KV version 2 secrets engine ("secret" by default).
+	Mount string
+	// Path (optional) the base path of the secrets inside the secrets engine ("lego" by default).
+	Path string
+
+	// HTTPClient (optional) the HTTP client used to call Vault.
+	HTTPClient *http.Client
+}
+
+// VaultStore stores the private keys of the accounts and the certificates inside the KV version 2 secrets engine of HashiCorp Vault.
+//
+// Layout of the secrets:
+//
+//	<mount>/
+//	└── <path>/
+//	    ├── keys/
+//	    │   └── <name>                  private_key
+//	    └── certificates/
+//	        └── <sanitized domain>      certificate, issuer_certificate, private_key, resource (metadata)
+type VaultStore struct {
+	config     VaultConfig
+	baseURL    *url.URL
+	httpClient *http.Client
+
+	tokenMu sync.Mutex
+	token   string
+}
+
+var (
+	_ KeyStore  = (*VaultStore)(nil)
+	_ CertStore = (*VaultStore)(nil)
+)
+
+// NewVaultStore creates a VaultStore.
+func NewVaultStore(config VaultConfig) (*VaultStore, error) {
+	if config.Address == "" {
+		return nil, errors.New("vault: the address is missing")
+	}
+
+	if config.Token == "" && (config.RoleID == "" || config.SecretID == "") {
+		return nil, errors.New("vault: a token or the AppRole credentials (role ID and secret ID) are required")
+	}
+
+	baseURL, err := url.Parse(config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("vault: invalid address: %w", err)
+	}
+
+	if config.Mount == "" {
+		config.Mount = DefaultVaultMount
+	}
+
+	if config.Path == "" {
+		config.Path = DefaultVaultPath
+	}
+
+	if config.AppRoleMount == "" {
+		config.AppRoleMount = DefaultVaultAppRoleMount
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	return &VaultStore{
+		config:     config,
+		baseURL:    baseURL,
+		httpClient: httpClient,
+		token:      config.Token,
+	}, nil
+}
+
+// LoadKey reads the private key of the account from the secret <path>/keys/<name>.
+func (s *VaultStore) LoadKey(name string) ([]byte, error) {
+	data, err := s.read("keys", name)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := data[vaultFieldPrivateKey]
+	if !ok {
+		return nil, fmt.Errorf("vault: key %s: the field %s is missing", name, vaultFieldPrivateKey)
+	}
+
+	return []byte(key), nil
+}
+
+// SaveKey writes the private key of the account to the secret <path>/keys/<name>.
+func (s *VaultStore) SaveKey(name string, key []byte) error {
+	return s.write("keys", name, map[string]string{vaultFieldPrivateKey: string(key)})
+}
+
+// LoadCertificate reads the certificate of the domain from the secret <path>/certificates/<sanitized domain>.
+func (s *VaultStore) LoadCertificate(domain string) (*certificate.Resource, error) {
+	name, err := SanitizedDomain(domain)
+	if err != nil {
+		return nil, fmt.Errorf("vault: %w", err)
+	}
+
+	data, err := s.read("certificates", name)
+	if err != nil {
+		return nil, err
+	}
+
+	certRes := &certificate.Resource{}
+
+	err = json.Unmarshal([]byte(data[vaultFieldResource]), certRes)
+	if err != nil {
+		return nil, fmt.Errorf("vault: certificate %s: unmarshal the metadata: %w", domain, err)
+	}
+
+	certRes.Certificate = toBytes(data[vaultFieldCertificate])
+	certRes.IssuerCertificate = toBytes(data[vaultFieldIssuerCertificate])
+	certRes.PrivateKey = toBytes(data[vaultFieldPrivateKey])
+
+	return certRes, nil
+}
+
+// SaveCertificate writes the certificate to the secret <path>/certificates/<sanitized domain>.
+// A new version of the secret is created on each call.
+func (s *VaultStore) SaveCertificate(certRes *certificate.Resource) error {
+	if certRes == nil {
+		return errors.New("vault: nil certificate resource")
+	}
+
+	name, err := SanitizedDomain(certRes.Domain)
+	if err != nil {
+		return fmt.Errorf("vault: %w", err)
+	}
+
+	resource, err := json.Marshal(certRes)
+	if err != nil {
+		return fmt.Errorf("vault: certificate %s: marshal the metadata: %w", certRes.Domain, err)
+	}
+
+	data := map[string]string{
+		vaultFieldCertificate:       string(certRes.Certificate),
+		vaultFieldIssuerCertificate: string(certRes.IssuerCertificate),
+		vaultFieldPrivateKey:        string(certRes.PrivateKey),
+		vaultFieldResource:          string(resource),
+	}
+
+	return s.write("certificates", name, data)
+}
+
+func (s *VaultStore) read(kind, name string) (map[string]string, error) {
+	result := &vaultSecretResponse{}
+
+	err := s.send(http.MethodGet, s.secretEndpoint(kind, name), nil, result)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("vault: %s/%s: %w", kind, name, ErrNotFound)
+		}
+
+		return nil, err
+	}
+
+	// A deleted version of a secret has no data.
+	if result.Data.Data == nil {
+		return nil, fmt.Errorf("vault: %s/%s: %w", kind, name, ErrNotFound)
+	}
+
+	return result.Data.Data, nil
+}
+
+func (s *VaultStore) write(kind, name string, data map[string]string) error {
+	return s.send(http.MethodPost, s.secretEndpoint(kind, name), vaultSecretRequest{Data: data}, nil)
+}
+
+// send sends an authenticated request.
+// With the AppRole authentication, a request denied because the token has expired (or has been revoked) is sent again after a new login.
+func (s *VaultStore) send(method string, endpoint *url.URL, payload, result any) error {
+	req, err := s.newRequest(method, endpoint, payload)
+	if err != nil {
+		return err
+	}
+
+	err = s.do(req, result)
+
+	var statusErr *vaultStatusError
+	if s.config.Token != "" || !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusForbidden {
+		return err
+	}
+
+	s.resetToken(req.Header.Get("X-Vault-Token"))
+
+	req, err = s.newRequest(method, endpoint, payload)
+	if err != nil {
+		return err
+	}
+
+	return s.do(req, result)
+}
+
+func (s *VaultStore) secretEndpoint(kind, name string) *url.URL {
+	return s.baseURL.JoinPath("v1", s.config.Mount, "data", s.config.Path, kind, name)
+}
+
+func (s *VaultStore) newRequest(method string, endpoint *url.URL, payload any) (*http.Request, error) {
+	token, err := s.getToken()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := newVaultRequest(method, endpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Vault-Token", token)
+
+	if s.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", s.config.Namespace)
+	}
+
+	return req, nil
+}
+
+// getToken returns the Vault token, or logs in with the AppRole credentials on the first call
+// (and after the reset of an expired token).
+func (s *VaultStore) getToken() (string, error) {
+	s.tokenMu.Lock()
+	defer s.tokenMu.Unlock()
+
+	if s.token != "" {
+		return s.token, nil
+	}
+
+	endpoint := s.baseURL.JoinPath("v1", "auth", s.config.AppRoleMount, "login")
+
+	payload := vaultAppRoleLoginRequest{RoleID: s.config.RoleID, SecretID: s.config.SecretID}
+
+	req, err := newVaultRequest(http.MethodPost, endpoint, payload)
+	if err != nil {
+		return "", err
+	}
+
+	if s.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", s.config.Namespace)
+	}
+
+	result := &vaultLoginResponse{}
+
+	err = s.do(req, result)
+	if err != nil {
+		return "", err
+	}
+
+	if result.Auth.ClientToken == "" {
+		return "", errors.New("vault: AppRole login: the client token is missing")
+	}
+
+	s.token = result.Auth.ClientToken
+
+	return s.token, nil
+}
+
+// resetToken forgets the AppRole token, if it is still the current one, to force a new login.
+func (s *VaultStore) resetToken(token string) {
+	s.tokenMu.Lock()
+	defer s.tokenMu.Unlock()
+
+	if s.token == token {
+		s.token = ""
+	}
+}
+
+func (s *VaultStore) do(req *http.Request, result any) error {
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("vault: %s %s: %w", req.Method, req.URL.Path, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("vault: %s %s: read the response: %w", req.Method, req.URL.Path, err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound && req.Method == http.MethodGet {
+		return ErrNotFound
+	}
+
+	if resp.StatusCode/100 != 2 {
+		statusErr := &vaultStatusError{Method: req.Method, Path: req.URL.Path, StatusCode: resp.StatusCode, Body: string(raw)}
+
+		errResp := &vaultErrorResponse{}
+		if json.Unmarshal(raw, errResp) == nil {
+			statusErr.Errors = errResp.Errors
+		}
+
+		return statusErr
+	}
+
+	if result == nil || len(raw) == 0 {
+		return nil
+	}
+
+	err = json.Unmarshal(raw, result)
+	if err != nil {
+		return fmt.Errorf("vault: %s %s: unmarshal the response: %w", req.Method, req.URL.Path, err)
+	}
+
+	return nil
+}
+
+func newVaultRequest(method string, endpoint *url.URL, payload any) (*http.Request, error) {
+	buf := new(bytes.Buffer)
+
+	if payload != nil {
+		err := json.NewEncoder(buf).Encode(payload)
+		if err != nil {
+			return nil, fmt.Errorf("vault: failed to create request JSON body: %w", err)
+		}
+	}
+
+	req, err := http.NewRequest(method, endpoint.String(), buf)
+	if err != nil {
+		return nil, fmt.Errorf("vault: unable to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+func toBytes(value string) []byte {
+	if value == "" {
+		return nil
+	}
+
+	return []byte(value)
+}
+
+type vaultSecretRequest struct {
+	Data map[string]string `json:"data"`
+}
+
+type vaultSecretResponse struct {
+	Data struct {
+		Data map[string]string `json:"data"`
+	} `json:"data"`
+}
+
+type vaultAppRoleLoginRequest struct {
+	RoleID   string `json:"role_id"`
+	SecretID string `json:"secret_id"`
+}
+
+type vaultLoginResponse struct {
+	Auth struct {
+		ClientToken string `json:"client_token"`
+	} `json:"auth"`
+}
+
+type vaultErrorResponse struct {
+	Errors []string `json:"errors"`
+}
+
+// vaultStatusError an error response of Vault.
+type vaultStatusError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Errors     []string
+	Body       string
+}
+
+func (e *vaultStatusError) Error() string {
+	if len(e.Errors) > 0 {
+		return fmt.Sprintf("vault: %s %s: %d: %s", e.Method, e.Path, e.StatusCode, strings.Join(e.Errors, ", "))
+	}
+
+	return fmt.Sprintf("vault: %s %s: unexpected status code %d: %s", e.Method, e.Path, e.StatusCode, e.Body)
+}
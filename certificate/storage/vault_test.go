@@ -0,0 +1,254 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-acme/lego/v4/certificate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVault a minimal KV version 2 secrets engine and AppRole auth method.
+type fakeVault struct {
+	mu      sync.Mutex
+	secrets map[string]map[string]string
+	logins  int
+	tokens  map[string]bool
+}
+
+// revokeTokens revokes the tokens issued by the AppRole logins (i.e. expired leases).
+func (f *fakeVault) revokeTokens() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	clear(f.tokens)
+}
+
+func setupFakeVault(t *testing.T, config VaultConfig) (*VaultStore, *fakeVault) {
+	t.Helper()
+
+	fake := &fakeVault{secrets: map[string]map[string]string{}, tokens: map[string]bool{}}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("POST /v1/auth/approle/login", func(rw http.ResponseWriter, req *http.Request) {
+		var creds vaultAppRoleLoginRequest
+		err := json.NewDecoder(req.Body).Decode(&creds)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if creds.RoleID != "role" || creds.SecretID != "secret" {
+			rw.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprint(rw, `{"errors":["invalid role or secret ID"]}`)
+			return
+		}
+
+		fake.mu.Lock()
+		fake.logins++
+		token := fmt.Sprintf("approle-token-%d", fake.logins)
+		fake.tokens[token] = true
+		fake.mu.Unlock()
+
+		_, _ = fmt.Fprintf(rw, `{"auth":{"client_token":%q,"lease_duration":3600}}`, token)
+	})
+
+	mux.HandleFunc("/v1/secret/data/", func(rw http.ResponseWriter, req *http.Request) {
+		token := req.Header.Get("X-Vault-Token")
+
+		fake.mu.Lock()
+		valid := token == "token" || fake.tokens[token]
+		fake.mu.Unlock()
+
+		if !valid {
+			rw.WriteHeader(http.StatusForbidden)
+			_, _ = fmt.Fprint(rw, `{"errors":["permission denied"]}`)
+			return
+		}
+
+		if req.Header.Get("X-Vault-Namespace") != config.Namespace {
+			http.Error(rw, "unexpected namespace", http.StatusBadRequest)
+			return
+		}
+
+		key := strings.TrimPrefix(req.URL.Path, "/v1/secret/data/")
+
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+
+		switch req.Method {
+		case http.MethodGet:
+			data, ok := fake.secrets[key]
+			if !ok {
+				rw.WriteHeader(http.StatusNotFound)
+				_, _ = fmt.Fprint(rw, `{"errors":[]}`)
+				return
+			}
+
+			_ = json.NewEncoder(rw).Encode(map[string]any{
+				"data": map[string]any{"data": data, "metadata": map[string]any{"version": 1}},
+			})
+
+		case http.MethodPost:
+			var secret vaultSecretRequest
+			err := json.NewDecoder(req.Body).Decode(&secret)
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			fake.secrets[key] = secret.Data
+
+			_, _ = fmt.Fprint(rw, `{"data":{"version":1}}`)
+
+		default:
+			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
+		}
+	})
+
+	config.Address = server.URL
+	config.HTTPClient = server.Client()
+
+	store, err := NewVaultStore(config)
+	require.NoError(t, err)
+
+	return store, fake
+}
+
+func TestNewVaultStore_error(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		config   VaultConfig
+		expected string
+	}{
+		{
+			desc:     "missing address",
+			config:   VaultConfig{Token: "token"},
+			expected: "vault: the address is missing",
+		},
+		{
+			desc:     "missing credentials",
+			config:   VaultConfig{Address: "https://vault.example.com"},
+			expected: "vault: a token or the AppRole credentials (role ID and secret ID) are required",
+		},
+		{
+			desc:     "missing secret ID",
+			config:   VaultConfig{Address: "https://vault.example.com", RoleID: "role"},
+			expected: "vault: a token or the AppRole credentials (role ID and secret ID) are required",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := NewVaultStore(test.config)
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func TestVaultStore_key(t *testing.T) {
+	store, fake := setupFakeVault(t, VaultConfig{Token: "token", Namespace: "team"})
+
+	_, err := store.LoadKey("acme/user@example.com")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	err = store.SaveKey("acme/user@example.com", []byte("key"))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"private_key": "key"}, fake.secrets["lego/keys/acme/user@example.com"])
+
+	key, err := store.LoadKey("acme/user@example.com")
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte("key"), key)
+	assert.Zero(t, fake.logins)
+}
+
+func TestVaultStore_certificate(t *testing.T) {
+	store, fake := setupFakeVault(t, VaultConfig{Token: "token", Path: "acme/production"})
+
+	_, err := store.LoadCertificate("*.example.com")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	certRes := &certificate.Resource{
+		Domain:            "*.example.com",
+		CertURL:           "https://ca.example.com/cert/1",
+		CertStableURL:     "https://ca.example.com/cert/1",
+		Certificate:       []byte("certificate"),
+		IssuerCertificate: []byte("issuer"),
+		PrivateKey:        []byte("key"),
+	}
+
+	err = store.SaveCertificate(certRes)
+	require.NoError(t, err)
+
+	secret := fake.secrets["acme/production/certificates/_.example.com"]
+	require.NotNil(t, secret)
+
+	assert.Equal(t, "certificate", secret["certificate"])
+	assert.Equal(t, "issuer", secret["issuer_certificate"])
+	assert.Equal(t, "key", secret["private_key"])
+	assert.JSONEq(t, `{"domain":"*.example.com","certUrl":"https://ca.example.com/cert/1","certStableUrl":"https://ca.example.com/cert/1"}`, secret["resource"])
+
+	loaded, err := store.LoadCertificate("*.example.com")
+	require.NoError(t, err)
+
+	assert.Equal(t, certRes, loaded)
+}
+
+func TestVaultStore_appRole(t *testing.T) {
+	store, fake := setupFakeVault(t, VaultConfig{RoleID: "role", SecretID: "secret"})
+
+	err := store.SaveKey("user@example.com", []byte("key"))
+	require.NoError(t, err)
+
+	key, err := store.LoadKey("user@example.com")
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte("key"), key)
+
+	// The token is reused by the following requests.
+	assert.Equal(t, 1, fake.logins)
+}
+
+func TestVaultStore_appRole_expiredToken(t *testing.T) {
+	store, fake := setupFakeVault(t, VaultConfig{RoleID: "role", SecretID: "secret"})
+
+	err := store.SaveKey("user@example.com", []byte("key"))
+	require.NoError(t, err)
+
+	fake.revokeTokens()
+
+	key, err := store.LoadKey("user@example.com")
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte("key"), key)
+	assert.Equal(t, 2, fake.logins)
+}
+
+func TestVaultStore_appRole_error(t *testing.T) {
+	store, _ := setupFakeVault(t, VaultConfig{RoleID: "role", SecretID: "invalid"})
+
+	_, err := store.LoadKey("user@example.com")
+	require.EqualError(t, err, "vault: POST /v1/auth/approle/login: 400: invalid role or secret ID")
+}
+
+func TestVaultStore_permissionDenied(t *testing.T) {
+	store, _ := setupFakeVault(t, VaultConfig{Token: "invalid"})
+
+	err := store.SaveKey("user@example.com", []byte("key"))
+	require.EqualError(t, err, "vault: POST /v1/secret/data/lego/keys/user@example.com: 403: permission denied")
+
+	_, err = store.LoadKey("user@example.com")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrNotFound)
+}
@@ -1,6 +1,7 @@
 package dns01
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
@@ -50,6 +51,13 @@ type Challenge struct {
 	provider   challenge.Provider
 	preCheck   preCheck
 	dnsTimeout time.Duration
+
+	// domainTTLs the TTL of the TXT records per domain (see SetDomainTTLs).
+	domainTTLs map[string]int
+
+	propagationCheckDisabled bool
+	// propagationBackOff the backoff of the propagation checks (see SetPropagationBackOff).
+	propagationBackOff *PropagationBackOff
 }
 
 func NewChallenge(core *api.Core, validate ValidateFunc, provider challenge.Provider, opts ...ChallengeOption) *Challenge {
@@ -64,7 +72,7 @@ func NewChallenge(core *api.Core, validate ValidateFunc, provider challenge.Prov
 	for _, opt := range opts {
 		err := opt(chlg)
 		if err != nil {
-			log.Infof("challenge option error: %v", err)
+			log.Info("acme: challenge option error", "error", err)
 		}
 	}
 
@@ -74,8 +82,13 @@ func NewChallenge(core *api.Core, validate ValidateFunc, provider challenge.Prov
 // PreSolve just submits the txt record to the dns provider.
 // It does not validate record propagation, or do anything at all with the acme server.
 func (c *Challenge) PreSolve(authz acme.Authorization) error {
+	return c.PreSolveContext(context.Background(), authz)
+}
+
+// PreSolveContext is like PreSolve, but the context is passed to the provider.
+func (c *Challenge) PreSolveContext(ctx context.Context, authz acme.Authorization) error {
 	domain := challenge.GetTargetedDomain(authz)
-	log.Infof("[%s] acme: Preparing to solve DNS-01", domain)
+	log.Info("acme: Preparing to solve DNS-01", "domain", domain)
 
 	chlng, err := challenge.FindChallenge(challenge.DNS01, authz)
 	if err != nil {
@@ -92,7 +105,9 @@ func (c *Challenge) PreSolve(authz acme.Authorization) error {
 		return err
 	}
 
-	err = c.provider.Present(authz.Identifier.Value, chlng.Token, keyAuth)
+	ctx = c.withDomainTTL(ctx, domain, authz.Identifier.Value)
+
+	err = challenge.Present(ctx, c.provider, authz.Identifier.Value, chlng.Token, keyAuth)
 	if err != nil {
 		return fmt.Errorf("[%s] acme: error presenting token: %w", domain, err)
 	}
@@ -101,8 +116,13 @@ func (c *Challenge) PreSolve(authz acme.Authorization) error {
 }
 
 func (c *Challenge) Solve(authz acme.Authorization) error {
+	return c.SolveContext(context.Background(), authz)
+}
+
+// SolveContext is like Solve, but the wait for the propagation of the record is stopped when the context is done.
+func (c *Challenge) SolveContext(ctx context.Context, authz acme.Authorization) error {
 	domain := challenge.GetTargetedDomain(authz)
-	log.Infof("[%s] acme: Trying to solve DNS-01", domain)
+	log.Info("acme: Trying to solve DNS-01", "domain", domain)
 
 	chlng, err := challenge.FindChallenge(challenge.DNS01, authz)
 	if err != nil {
@@ -115,8 +135,23 @@ func (c *Challenge) Solve(authz acme.Authorization) error {
 		return err
 	}
 
-	info := GetChallengeInfo(authz.Identifier.Value, keyAuth)
+	if c.propagationCheckDisabled {
+		log.Info("acme: The DNS propagation check is disabled, the CA validates the record directly.", "domain", domain)
+	} else {
+		info := GetChallengeInfo(authz.Identifier.Value, keyAuth)
 
+		err = c.waitForPropagation(ctx, domain, info)
+		if err != nil {
+			return err
+		}
+	}
+
+	chlng.KeyAuthorization = keyAuth
+	return c.validate(c.core, domain, chlng)
+}
+
+// waitForPropagation waits for the TXT record to be propagated, according to the provider timeout.
+func (c *Challenge) waitForPropagation(ctx context.Context, domain string, info ChallengeInfo) error {
 	var timeout, interval time.Duration
 	switch provider := c.provider.(type) {
 	case challenge.ProviderTimeout:
@@ -125,28 +160,38 @@ func (c *Challenge) Solve(authz acme.Authorization) error {
 		timeout, interval = DefaultPropagationTimeout, DefaultPollingInterval
 	}
 
-	log.Infof("[%s] acme: Checking DNS record propagation. [nameservers=%s]", domain, strings.Join(recursiveNameservers, ","))
+	log.Info("acme: Checking DNS record propagation.", "domain", domain, "nameservers", strings.Join(recursiveNameservers, ","))
 
-	time.Sleep(interval)
+	select {
+	case <-time.After(interval):
+	case <-ctx.Done():
+		return fmt.Errorf("propagation: %w", ctx.Err())
+	}
 
-	err = wait.For("propagation", timeout, interval, func() (bool, error) {
+	check := func() (bool, error) {
 		stop, errP := c.preCheck.call(domain, info.EffectiveFQDN, info.Value)
 		if !stop || errP != nil {
-			log.Infof("[%s] acme: Waiting for DNS record propagation.", domain)
+			log.Info("acme: Waiting for DNS record propagation.", "domain", domain)
 		}
 		return stop, errP
-	})
-	if err != nil {
-		return err
 	}
 
-	chlng.KeyAuthorization = keyAuth
-	return c.validate(c.core, domain, chlng)
+	if c.propagationBackOff != nil {
+		return wait.ForBackOffContext(ctx, "propagation", timeout, c.propagationBackOff.newBackOff(interval, timeout), check)
+	}
+
+	return wait.ForContext(ctx, "propagation", timeout, interval, check)
 }
 
 // CleanUp cleans the challenge.
 func (c *Challenge) CleanUp(authz acme.Authorization) error {
-	log.Infof("[%s] acme: Cleaning DNS-01 challenge", challenge.GetTargetedDomain(authz))
+	return c.CleanUpContext(context.Background(), authz)
+}
+
+// CleanUpContext is like CleanUp, but the context is passed to the provider.
+func (c *Challenge) CleanUpContext(ctx context.Context, authz acme.Authorization) error {
+	domain := challenge.GetTargetedDomain(authz)
+	log.Info("acme: Cleaning DNS-01 challenge", "domain", domain)
 
 	chlng, err := challenge.FindChallenge(challenge.DNS01, authz)
 	if err != nil {
@@ -158,7 +203,9 @@ func (c *Challenge) CleanUp(authz acme.Authorization) error {
 		return err
 	}
 
-	return c.provider.CleanUp(authz.Identifier.Value, chlng.Token, keyAuth)
+	ctx = c.withDomainTTL(ctx, domain, authz.Identifier.Value)
+
+	return challenge.CleanUp(ctx, c.provider, authz.Identifier.Value, chlng.Token, keyAuth)
 }
 
 func (c *Challenge) Sequential() (bool, time.Duration) {
@@ -194,12 +241,20 @@ type ChallengeInfo struct {
 
 // GetChallengeInfo returns information used to create a DNS record which will fulfill the `dns-01` challenge.
 func GetChallengeInfo(domain, keyAuth string) ChallengeInfo {
+	ok, _ := strconv.ParseBool(os.Getenv("LEGO_DISABLE_CNAME_SUPPORT"))
+
+	if record, found := getRawRecord(keyAuth); found {
+		return ChallengeInfo{
+			Value:         record.value,
+			FQDN:          record.fqdn,
+			EffectiveFQDN: resolveFQDN(record.fqdn, !ok),
+		}
+	}
+
 	keyAuthShaBytes := sha256.Sum256([]byte(keyAuth))
 	// base64URL encoding without padding
 	value := base64.RawURLEncoding.EncodeToString(keyAuthShaBytes[:sha256.Size])
 
-	ok, _ := strconv.ParseBool(os.Getenv("LEGO_DISABLE_CNAME_SUPPORT"))
-
 	return ChallengeInfo{
 		Value:         value,
 		FQDN:          getChallengeFQDN(domain, false),
@@ -208,8 +263,11 @@ func GetChallengeInfo(domain, keyAuth string) ChallengeInfo {
 }
 
 func getChallengeFQDN(domain string, followCNAME bool) string {
-	fqdn := fmt.Sprintf("_acme-challenge.%s.", domain)
+	return resolveFQDN(fmt.Sprintf("_acme-challenge.%s.", domain), followCNAME)
+}
 
+// resolveFQDN follows the CNAMEs of the FQDN, if followCNAME is true.
+func resolveFQDN(fqdn string, followCNAME bool) string {
 	if !followCNAME {
 		return fqdn
 	}
@@ -230,7 +288,7 @@ func getChallengeFQDN(domain string, followCNAME bool) string {
 			break
 		}
 
-		log.Infof("Found CNAME entry for %q: %q", fqdn, cname)
+		log.Info("acme: Found CNAME entry", "fqdn", fqdn, "cname", cname)
 
 		fqdn = cname
 	}
@@ -0,0 +1,67 @@
+package dns01
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/log"
+)
+
+// DryRun validates a DNS provider without contacting the ACME server.
+// It presents a TXT record with a random value for the domain,
+// waits for the record propagation, then cleans the record.
+// A cleanup error is returned, joined with the propagation error if any.
+func DryRun(provider challenge.Provider, domain string, opts ...ChallengeOption) (err error) {
+	if provider == nil {
+		return fmt.Errorf("[%s] dry-run: no DNS Provider configured", domain)
+	}
+
+	chlg := NewChallenge(nil, nil, provider, opts...)
+
+	token, err := randomToken()
+	if err != nil {
+		return fmt.Errorf("[%s] dry-run: %w", domain, err)
+	}
+
+	keyAuth := token + ".lego-dry-run"
+
+	log.Info("dry-run: Presenting a TXT record", "domain", domain)
+
+	err = provider.Present(domain, token, keyAuth)
+	if err != nil {
+		return fmt.Errorf("[%s] dry-run: error presenting token: %w", domain, err)
+	}
+
+	defer func() {
+		log.Info("dry-run: Cleaning the TXT record", "domain", domain)
+
+		errC := provider.CleanUp(domain, token, keyAuth)
+		if errC != nil {
+			err = errors.Join(err, fmt.Errorf("[%s] dry-run: error cleaning up: %w", domain, errC))
+		}
+	}()
+
+	err = chlg.waitForPropagation(context.Background(), domain, GetChallengeInfo(domain, keyAuth))
+	if err != nil {
+		return fmt.Errorf("[%s] dry-run: %w", domain, err)
+	}
+
+	log.Info("dry-run: The TXT record has been propagated", "domain", domain)
+
+	return nil
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 16)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
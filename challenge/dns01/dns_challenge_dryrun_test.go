@@ -0,0 +1,77 @@
+package dns01
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRun(t *testing.T) {
+	mockDefault := &providerTimeoutMock{timeout: 500 * time.Millisecond, interval: 10 * time.Millisecond}
+
+	testCases := []struct {
+		desc        string
+		provider    *providerTimeoutMock
+		preCheck    WrapPreCheckFunc
+		expectError string
+	}{
+		{
+			desc:     "success",
+			provider: mockDefault,
+			preCheck: func(_, _, _ string, _ PreCheckFunc) (bool, error) {
+				return true, nil
+			},
+		},
+		{
+			desc: "present error",
+			provider: &providerTimeoutMock{
+				present: errors.New("oops"), timeout: 500 * time.Millisecond, interval: 10 * time.Millisecond,
+			},
+			expectError: "[example.com] dry-run: error presenting token: oops",
+		},
+		{
+			desc:     "propagation error",
+			provider: mockDefault,
+			preCheck: func(_, _, _ string, _ PreCheckFunc) (bool, error) {
+				return false, errors.New("not propagated")
+			},
+			expectError: "[example.com] dry-run: propagation: time limit exceeded: last error: not propagated",
+		},
+		{
+			desc: "cleanup error",
+			provider: &providerTimeoutMock{
+				cleanUp: errors.New("oops"), timeout: 500 * time.Millisecond, interval: 10 * time.Millisecond,
+			},
+			preCheck: func(_, _, _ string, _ PreCheckFunc) (bool, error) {
+				return true, nil
+			},
+			expectError: "[example.com] dry-run: error cleaning up: oops",
+		},
+		{
+			desc: "propagation and cleanup errors",
+			provider: &providerTimeoutMock{
+				cleanUp: errors.New("oops"), timeout: 500 * time.Millisecond, interval: 10 * time.Millisecond,
+			},
+			preCheck: func(_, _, _ string, _ PreCheckFunc) (bool, error) {
+				return false, errors.New("not propagated")
+			},
+			expectError: "[example.com] dry-run: propagation: time limit exceeded: last error: not propagated\n" +
+				"[example.com] dry-run: error cleaning up: oops",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := DryRun(test.provider, "example.com", CondOption(test.preCheck != nil, WrapPreCheck(test.preCheck)))
+			if test.expectError == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectError)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package dns01
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"errors"
@@ -12,6 +13,7 @@ import (
 	"github.com/go-acme/lego/v4/acme/api"
 	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -281,3 +283,96 @@ func TestChallenge_CleanUp(t *testing.T) {
 		})
 	}
 }
+
+func TestChallenge_Solve_propagationCheckDisabled(t *testing.T) {
+	_, apiURL := tester.SetupFakeAPI(t)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, err)
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+	require.NoError(t, err)
+
+	var preChecks, validations int
+
+	validate := func(_ *api.Core, _ string, _ acme.Challenge) error {
+		validations++
+		return nil
+	}
+
+	preCheck := func(_, _, _ string, _ PreCheckFunc) (bool, error) {
+		preChecks++
+		return false, errors.New("OOPS")
+	}
+
+	provider := &providerTimeoutMock{
+		timeout:  2 * time.Second,
+		interval: 500 * time.Millisecond,
+	}
+
+	chlg := NewChallenge(core, validate, provider, WrapPreCheck(preCheck), DisablePropagationCheck())
+
+	authz := acme.Authorization{
+		Identifier: acme.Identifier{
+			Value: "example.com",
+		},
+		Challenges: []acme.Challenge{
+			{Type: challenge.DNS01.String()},
+		},
+	}
+
+	err = chlg.Solve(authz)
+	require.NoError(t, err)
+
+	// The propagation check is bypassed, but the CA validation still runs.
+	assert.Equal(t, 0, preChecks)
+	assert.Equal(t, 1, validations)
+}
+
+func TestChallenge_SolveContext_canceled(t *testing.T) {
+	_, apiURL := tester.SetupFakeAPI(t)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, err)
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+	require.NoError(t, err)
+
+	var validations int
+
+	validate := func(_ *api.Core, _ string, _ acme.Challenge) error {
+		validations++
+		return nil
+	}
+
+	preCheck := func(_, _, _ string, _ PreCheckFunc) (bool, error) {
+		return false, nil
+	}
+
+	provider := &providerTimeoutMock{
+		timeout:  time.Minute,
+		interval: 300 * time.Millisecond,
+	}
+
+	chlg := NewChallenge(core, validate, provider, WrapPreCheck(preCheck))
+
+	authz := acme.Authorization{
+		Identifier: acme.Identifier{
+			Value: "example.com",
+		},
+		Challenges: []acme.Challenge{
+			{Type: challenge.DNS01.String()},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+
+	err = chlg.SolveContext(ctx, authz)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Equal(t, 0, validations)
+}
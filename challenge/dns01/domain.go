@@ -22,3 +22,27 @@ func ExtractSubDomain(domain, zone string) (string, error) {
 
 	return strings.TrimSuffix(canonDomain, "."+canonZone), nil
 }
+
+// FindMostSpecificZone returns the most specific zone (the longest one) containing the FQDN,
+// or an empty string if none of the zones contains the FQDN.
+// The names are compared case-insensitively, with or without the trailing dot,
+// and the zone is returned as it appears in the list.
+func FindMostSpecificZone(fqdn string, zones []string) string {
+	name := strings.ToLower(UnFqdn(fqdn))
+
+	var zone, zoneName string
+
+	for _, z := range zones {
+		candidate := strings.ToLower(UnFqdn(z))
+
+		if name != candidate && !strings.HasSuffix(name, "."+candidate) {
+			continue
+		}
+
+		if len(candidate) > len(zoneName) {
+			zone, zoneName = z, candidate
+		}
+	}
+
+	return zone
+}
@@ -100,3 +100,65 @@ func TestExtractSubDomain_errors(t *testing.T) {
 		})
 	}
 }
+
+func TestFindMostSpecificZone(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		fqdn     string
+		zones    []string
+		expected string
+	}{
+		{
+			desc:     "parent zone",
+			fqdn:     "_acme-challenge.example.com.",
+			zones:    []string{"example.org", "example.com"},
+			expected: "example.com",
+		},
+		{
+			desc:     "most specific zone",
+			fqdn:     "_acme-challenge.sub.example.com.",
+			zones:    []string{"example.com", "sub.example.com", "com"},
+			expected: "sub.example.com",
+		},
+		{
+			desc:     "same name",
+			fqdn:     "example.com.",
+			zones:    []string{"com", "example.com"},
+			expected: "example.com",
+		},
+		{
+			desc:     "FQDN zones",
+			fqdn:     "_acme-challenge.example.com.",
+			zones:    []string{"com.", "example.com."},
+			expected: "example.com.",
+		},
+		{
+			desc:     "case-insensitive",
+			fqdn:     "_acme-challenge.Example.com",
+			zones:    []string{"EXAMPLE.COM"},
+			expected: "EXAMPLE.COM",
+		},
+		{
+			desc:  "label suffix",
+			fqdn:  "_acme-challenge.myexample.com.",
+			zones: []string{"example.com"},
+		},
+		{
+			desc:  "subdomain of the FQDN",
+			fqdn:  "example.com.",
+			zones: []string{"sub.example.com"},
+		},
+		{
+			desc: "no zones",
+			fqdn: "_acme-challenge.example.com.",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, FindMostSpecificZone(test.fqdn, test.zones))
+		})
+	}
+}
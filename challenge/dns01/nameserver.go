@@ -16,8 +16,12 @@ import (
 
 const defaultResolvConf = "/etc/resolv.conf"
 
+// defaultNegativeCacheTTL is used to cache a negative result when the response doesn't contain a SOA record (RFC 2308).
+const defaultNegativeCacheTTL = 60 * time.Second
+
 var (
 	fqdnSoaCache   = map[string]*soaCacheEntry{}
+	zoneApexCache  = map[string]*zoneApexCacheEntry{}
 	muFqdnSoaCache sync.Mutex
 )
 
@@ -29,6 +33,10 @@ var defaultNameservers = []string{
 // recursiveNameservers are used to pre-check DNS propagation.
 var recursiveNameservers = getNameservers(defaultResolvConf, defaultNameservers)
 
+// zoneNameservers are used to find the zone apexes (SOA records).
+// When empty, recursiveNameservers are used.
+var zoneNameservers []string
+
 // soaCacheEntry holds a cached SOA record (only selected fields).
 type soaCacheEntry struct {
 	zone      string    // zone apex (a domain name)
@@ -49,13 +57,73 @@ func (cache *soaCacheEntry) isExpired() bool {
 	return time.Now().After(cache.expires)
 }
 
-// ClearFqdnCache clears the cache of fqdn to zone mappings. Primarily used in testing.
+// zoneApexCacheEntry holds the result of a SOA lookup for a domain:
+// soa is nil when the domain is not a zone apex (negative result).
+type zoneApexCacheEntry struct {
+	soa     *soaCacheEntry
+	expires time.Time
+}
+
+func newNegativeZoneApexCacheEntry(msg *dns.Msg) *zoneApexCacheEntry {
+	ttl := defaultNegativeCacheTTL
+
+	// The negative caching TTL is the minimum of the TTL of the SOA record and of its MINIMUM field (RFC 2308 section 5).
+	for _, rr := range msg.Ns {
+		if soa, ok := rr.(*dns.SOA); ok {
+			ttl = time.Duration(min(soa.Hdr.Ttl, soa.Minttl)) * time.Second
+			break
+		}
+	}
+
+	return &zoneApexCacheEntry{expires: time.Now().Add(ttl)}
+}
+
+// isExpired checks whether a cache entry should be considered expired.
+func (cache *zoneApexCacheEntry) isExpired() bool {
+	return time.Now().After(cache.expires)
+}
+
+// ClearFqdnCache clears the cache of fqdn to zone mappings, and the cache of the zone apexes.
+// The cache is shared by all the resolutions of the process:
+// the entries expire according to the SOA records (refresh interval, or negative caching TTL).
+// To only invalidate the entries of a domain, see InvalidateFqdnCache.
 func ClearFqdnCache() {
 	muFqdnSoaCache.Lock()
 	fqdnSoaCache = map[string]*soaCacheEntry{}
+	zoneApexCache = map[string]*zoneApexCacheEntry{}
 	muFqdnSoaCache.Unlock()
 }
 
+// InvalidateFqdnCache removes the cached zones of the fqdn and of its subdomains (e.g. "_acme-challenge.<fqdn>"),
+// and the cached SOA lookups of the fqdn, of its subdomains, and of its parent domains, whatever the resolvers.
+// The other entries are kept, so it can be called at the start of each resolution without affecting the other resolutions of the process:
+// the lookups of the resolution query the resolvers again, and share their results.
+func InvalidateFqdnCache(fqdn string) {
+	fqdn = dns.Fqdn(strings.TrimPrefix(fqdn, "*."))
+
+	muFqdnSoaCache.Lock()
+	defer muFqdnSoaCache.Unlock()
+
+	for key := range fqdnSoaCache {
+		if dns.IsSubDomain(fqdn, cacheKeyName(key)) {
+			delete(fqdnSoaCache, key)
+		}
+	}
+
+	for key := range zoneApexCache {
+		name := cacheKeyName(key)
+		if dns.IsSubDomain(fqdn, name) || dns.IsSubDomain(name, fqdn) {
+			delete(zoneApexCache, key)
+		}
+	}
+}
+
+// cacheKeyName returns the domain of a cache key (see soaCacheKey).
+func cacheKeyName(key string) string {
+	_, name, _ := strings.Cut(key, "|")
+	return name
+}
+
 func AddDNSTimeout(timeout time.Duration) ChallengeOption {
 	return func(_ *Challenge) error {
 		dnsTimeout = timeout
@@ -63,6 +131,9 @@ func AddDNSTimeout(timeout time.Duration) ChallengeOption {
 	}
 }
 
+// AddRecursiveNameservers defines the resolvers used to check the propagation, and to find the zones
+// (unless AddZoneNameservers is used).
+// The port 53 is used when the port of a resolver is not defined.
 func AddRecursiveNameservers(nameservers []string) ChallengeOption {
 	return func(_ *Challenge) error {
 		recursiveNameservers = ParseNameservers(nameservers)
@@ -70,6 +141,27 @@ func AddRecursiveNameservers(nameservers []string) ChallengeOption {
 	}
 }
 
+// AddZoneNameservers defines the resolvers used to find the zone apexes and the primary nameservers (SOA records),
+// independently of the resolvers used to check the propagation (see AddRecursiveNameservers).
+// It is useful with split DNS: e.g. the zones are only known by the internal resolvers,
+// but the propagation of the TXT records must be checked with public resolvers.
+// The port 53 is used when the port of a resolver is not defined.
+func AddZoneNameservers(nameservers []string) ChallengeOption {
+	return func(_ *Challenge) error {
+		zoneNameservers = ParseNameservers(nameservers)
+		return nil
+	}
+}
+
+// getZoneNameservers returns the resolvers used to find the zone apexes.
+func getZoneNameservers() []string {
+	if len(zoneNameservers) > 0 {
+		return zoneNameservers
+	}
+
+	return recursiveNameservers
+}
+
 // getNameservers attempts to get systems nameservers before falling back to the defaults.
 func getNameservers(path string, defaults []string) []string {
 	config, err := dns.ClientConfigFromFile(path)
@@ -97,7 +189,8 @@ func ParseNameservers(servers []string) []string {
 func lookupNameservers(fqdn string) ([]string, error) {
 	var authoritativeNss []string
 
-	zone, err := FindZoneByFqdn(fqdn)
+	// The authoritative nameservers are used to check the propagation: the zone is found with the same resolvers.
+	zone, err := FindZoneByFqdnCustom(fqdn, recursiveNameservers)
 	if err != nil {
 		return nil, fmt.Errorf("could not find zone: %w", err)
 	}
@@ -122,8 +215,9 @@ func lookupNameservers(fqdn string) ([]string, error) {
 
 // FindPrimaryNsByFqdn determines the primary nameserver of the zone apex for the given fqdn
 // by recursing up the domain labels until the nameserver returns a SOA record in the answer section.
+// The resolvers defined by AddZoneNameservers are used, if any.
 func FindPrimaryNsByFqdn(fqdn string) (string, error) {
-	return FindPrimaryNsByFqdnCustom(fqdn, recursiveNameservers)
+	return FindPrimaryNsByFqdnCustom(fqdn, getZoneNameservers())
 }
 
 // FindPrimaryNsByFqdnCustom determines the primary nameserver of the zone apex for the given fqdn
@@ -138,8 +232,9 @@ func FindPrimaryNsByFqdnCustom(fqdn string, nameservers []string) (string, error
 
 // FindZoneByFqdn determines the zone apex for the given fqdn
 // by recursing up the domain labels until the nameserver returns a SOA record in the answer section.
+// The resolvers defined by AddZoneNameservers are used, if any.
 func FindZoneByFqdn(fqdn string) (string, error) {
-	return FindZoneByFqdnCustom(fqdn, recursiveNameservers)
+	return FindZoneByFqdnCustom(fqdn, getZoneNameservers())
 }
 
 // FindZoneByFqdnCustom determines the zone apex for the given fqdn
@@ -153,11 +248,16 @@ func FindZoneByFqdnCustom(fqdn string, nameservers []string) (string, error) {
 }
 
 func lookupSoaByFqdn(fqdn string, nameservers []string) (*soaCacheEntry, error) {
+	// A wildcard label cannot be a zone apex: "*.example.com." and "example.com." belong to the same zone.
+	fqdn = strings.TrimPrefix(fqdn, "*.")
+
 	muFqdnSoaCache.Lock()
 	defer muFqdnSoaCache.Unlock()
 
+	key := soaCacheKey(fqdn, nameservers)
+
 	// Do we have it cached and is it still fresh?
-	if ent := fqdnSoaCache[fqdn]; ent != nil && !ent.isExpired() {
+	if ent := fqdnSoaCache[key]; ent != nil && !ent.isExpired() {
 		return ent, nil
 	}
 
@@ -166,10 +266,16 @@ func lookupSoaByFqdn(fqdn string, nameservers []string) (*soaCacheEntry, error)
 		return nil, err
 	}
 
-	fqdnSoaCache[fqdn] = ent
+	fqdnSoaCache[key] = ent
 	return ent, nil
 }
 
+// soaCacheKey builds the key of a SOA lookup:
+// the answers depend on the resolvers (i.e. split DNS, see AddZoneNameservers).
+func soaCacheKey(name string, nameservers []string) string {
+	return strings.Join(nameservers, ",") + "|" + name
+}
+
 func fetchSoaByFqdn(fqdn string, nameservers []string) (*soaCacheEntry, error) {
 	var err error
 	var r *dns.Msg
@@ -177,6 +283,16 @@ func fetchSoaByFqdn(fqdn string, nameservers []string) (*soaCacheEntry, error) {
 	labelIndexes := dns.Split(fqdn)
 	for _, index := range labelIndexes {
 		domain := fqdn[index:]
+		key := soaCacheKey(domain, nameservers)
+
+		// Reuse the result of a previous lookup of the same domain (e.g. the parent domains of the SANs in the same zone).
+		if cached := zoneApexCache[key]; cached != nil && !cached.isExpired() {
+			if cached.soa != nil {
+				return cached.soa, nil
+			}
+
+			continue
+		}
 
 		r, err = dnsQuery(domain, dns.TypeSOA, nameservers, true)
 		if err != nil {
@@ -191,22 +307,28 @@ func fetchSoaByFqdn(fqdn string, nameservers []string) (*soaCacheEntry, error) {
 		case dns.RcodeSuccess:
 			// Check if we got a SOA RR in the answer section
 			if len(r.Answer) == 0 {
+				zoneApexCache[key] = newNegativeZoneApexCacheEntry(r)
 				continue
 			}
 
 			// CNAME records cannot/should not exist at the root of a zone.
 			// So we skip a domain when a CNAME is found.
 			if dnsMsgContainsCNAME(r) {
+				zoneApexCache[key] = newNegativeZoneApexCacheEntry(r)
 				continue
 			}
 
 			for _, ans := range r.Answer {
 				if soa, ok := ans.(*dns.SOA); ok {
-					return newSoaCacheEntry(soa), nil
+					ent := newSoaCacheEntry(soa)
+					zoneApexCache[key] = &zoneApexCacheEntry{soa: ent, expires: ent.expires}
+
+					return ent, nil
 				}
 			}
 		case dns.RcodeNameError:
 			// NXDOMAIN
+			zoneApexCache[key] = newNegativeZoneApexCacheEntry(r)
 		default:
 			// Any response code other than NOERROR and NXDOMAIN is treated as error
 			return nil, &DNSError{Message: fmt.Sprintf("unexpected response for '%s'", domain), MsgOut: r}
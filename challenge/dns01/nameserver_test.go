@@ -2,8 +2,13 @@ package dns01
 
 import (
 	"errors"
+	"fmt"
+	"net"
 	"sort"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
@@ -169,6 +174,309 @@ func TestFindPrimaryNsByFqdnCustom(t *testing.T) {
 	}
 }
 
+func TestFindZoneByFqdnCustom_cache(t *testing.T) {
+	ClearFqdnCache()
+	t.Cleanup(ClearFqdnCache)
+
+	var counter atomic.Int32
+	nameserver := setupSOAServer(t, "example.com.", 300, &counter)
+
+	for i := range 50 {
+		zone, err := FindZoneByFqdnCustom(fmt.Sprintf("_acme-challenge.www%d.example.com.", i), []string{nameserver})
+		require.NoError(t, err)
+
+		assert.Equal(t, "example.com.", zone)
+	}
+
+	// 2 SOA queries by SAN (_acme-challenge.wwwN.example.com., wwwN.example.com.), and only 1 query for the zone apex.
+	assert.EqualValues(t, 101, counter.Load())
+
+	// The wildcard domain and the domain share the same zone.
+	counter.Store(0)
+
+	zone, err := FindZoneByFqdnCustom("*.www1.example.com.", []string{nameserver})
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com.", zone)
+	assert.EqualValues(t, 0, counter.Load())
+}
+
+func TestFindZoneByFqdnCustom_cacheNegativeResults(t *testing.T) {
+	ClearFqdnCache()
+	t.Cleanup(ClearFqdnCache)
+
+	var counter atomic.Int32
+	nameserver := setupSOAServer(t, "example.com.", 300, &counter)
+
+	zone, err := FindZoneByFqdnCustom("_acme-challenge.a.example.com.", []string{nameserver})
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com.", zone)
+	assert.EqualValues(t, 3, counter.Load())
+
+	// "a.example.com." is known to not be a zone apex.
+	counter.Store(0)
+
+	zone, err = FindZoneByFqdnCustom("a.example.com.", []string{nameserver})
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com.", zone)
+	assert.EqualValues(t, 0, counter.Load())
+}
+
+func TestFindZoneByFqdnCustom_cacheNegativeResultsExpired(t *testing.T) {
+	ClearFqdnCache()
+	t.Cleanup(ClearFqdnCache)
+
+	var counter atomic.Int32
+	nameserver := setupSOAServer(t, "example.com.", 0, &counter)
+
+	_, err := FindZoneByFqdnCustom("_acme-challenge.a.example.com.", []string{nameserver})
+	require.NoError(t, err)
+
+	time.Sleep(10 * time.Millisecond)
+
+	// The negative result has expired, but the zone apex is still cached.
+	counter.Store(0)
+
+	zone, err := FindZoneByFqdnCustom("a.example.com.", []string{nameserver})
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com.", zone)
+	assert.EqualValues(t, 1, counter.Load())
+}
+
+func TestClearFqdnCache(t *testing.T) {
+	ClearFqdnCache()
+	t.Cleanup(ClearFqdnCache)
+
+	var counter atomic.Int32
+	nameserver := setupSOAServer(t, "example.com.", 300, &counter)
+
+	_, err := FindZoneByFqdnCustom("_acme-challenge.a.example.com.", []string{nameserver})
+	require.NoError(t, err)
+
+	ClearFqdnCache()
+
+	_, err = FindZoneByFqdnCustom("_acme-challenge.a.example.com.", []string{nameserver})
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 6, counter.Load())
+}
+
+func TestInvalidateFqdnCache(t *testing.T) {
+	ClearFqdnCache()
+	t.Cleanup(ClearFqdnCache)
+
+	var counter atomic.Int32
+	nameserver := setupSOAServer(t, "example.com.", 300, &counter)
+
+	_, err := FindZoneByFqdnCustom("_acme-challenge.a.example.com.", []string{nameserver})
+	require.NoError(t, err)
+
+	_, err = FindZoneByFqdnCustom("_acme-challenge.b.example.com.", []string{nameserver})
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 5, counter.Load())
+
+	InvalidateFqdnCache("*.A.example.com")
+
+	// The entries of "a.example.com." and of its parent domains are invalidated.
+	counter.Store(0)
+
+	zone, err := FindZoneByFqdnCustom("_acme-challenge.a.example.com.", []string{nameserver})
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com.", zone)
+	assert.EqualValues(t, 3, counter.Load())
+
+	// The entries of the other domains are kept.
+	counter.Store(0)
+
+	zone, err = FindZoneByFqdnCustom("_acme-challenge.b.example.com.", []string{nameserver})
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com.", zone)
+	assert.EqualValues(t, 0, counter.Load())
+}
+
+func TestAddZoneNameservers(t *testing.T) {
+	ClearFqdnCache()
+	t.Cleanup(ClearFqdnCache)
+
+	originalRecursive := recursiveNameservers
+	t.Cleanup(func() {
+		recursiveNameservers = originalRecursive
+		zoneNameservers = nil
+	})
+
+	var counter atomic.Int32
+	nameserver := setupSOAServer(t, "internal.example.", 300, &counter)
+
+	// The propagation check resolvers cannot resolve the zone.
+	recursiveNameservers = []string{"127.0.0.1:1"}
+
+	err := AddZoneNameservers([]string{nameserver})(nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{nameserver}, zoneNameservers)
+	assert.Equal(t, []string{"127.0.0.1:1"}, recursiveNameservers)
+
+	zone, err := FindZoneByFqdn("_acme-challenge.www.internal.example.")
+	require.NoError(t, err)
+
+	assert.Equal(t, "internal.example.", zone)
+
+	primaryNs, err := FindPrimaryNsByFqdn("_acme-challenge.www.internal.example.")
+	require.NoError(t, err)
+
+	assert.Equal(t, "ns1.internal.example.", primaryNs)
+
+	assert.EqualValues(t, 3, counter.Load())
+}
+
+func TestAddZoneNameservers_default(t *testing.T) {
+	ClearFqdnCache()
+	t.Cleanup(ClearFqdnCache)
+
+	originalRecursive := recursiveNameservers
+	t.Cleanup(func() { recursiveNameservers = originalRecursive })
+
+	var counter atomic.Int32
+	nameserver := setupSOAServer(t, "example.com.", 300, &counter)
+
+	// Without zone resolvers, the recursive resolvers are used.
+	recursiveNameservers = []string{nameserver}
+
+	zone, err := FindZoneByFqdn("_acme-challenge.example.com.")
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com.", zone)
+	assert.EqualValues(t, 2, counter.Load())
+}
+
+func TestFindZoneByFqdnCustom_cacheByNameservers(t *testing.T) {
+	ClearFqdnCache()
+	t.Cleanup(ClearFqdnCache)
+
+	// Split DNS: the internal resolver knows a delegated zone unknown by the public resolver.
+	var internalCounter, publicCounter atomic.Int32
+	internal := setupSOAServer(t, "www.example.com.", 300, &internalCounter)
+	public := setupSOAServer(t, "example.com.", 300, &publicCounter)
+
+	zone, err := FindZoneByFqdnCustom("_acme-challenge.www.example.com.", []string{internal})
+	require.NoError(t, err)
+
+	assert.Equal(t, "www.example.com.", zone)
+
+	zone, err = FindZoneByFqdnCustom("_acme-challenge.www.example.com.", []string{public})
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com.", zone)
+	assert.EqualValues(t, 3, publicCounter.Load())
+}
+
+func Test_lookupNameservers_recursiveNameservers(t *testing.T) {
+	ClearFqdnCache()
+	t.Cleanup(ClearFqdnCache)
+
+	originalRecursive := recursiveNameservers
+	t.Cleanup(func() {
+		recursiveNameservers = originalRecursive
+		zoneNameservers = nil
+	})
+
+	var counter atomic.Int32
+	nameserver := setupSOAServer(t, "example.com.", 300, &counter)
+
+	recursiveNameservers = []string{nameserver}
+
+	// The zone resolvers are not used to find the authoritative nameservers of the propagation check.
+	err := AddZoneNameservers([]string{"127.0.0.1:1"})(nil)
+	require.NoError(t, err)
+
+	// The zone is found, but the test server doesn't answer with NS records.
+	_, err = lookupNameservers("_acme-challenge.example.com.")
+	require.EqualError(t, err, "[zone=example.com.] could not determine authoritative nameservers")
+
+	assert.EqualValues(t, 2, counter.Load())
+}
+
+func BenchmarkFindZoneByFqdnCustom(b *testing.B) {
+	b.Cleanup(ClearFqdnCache)
+
+	var counter atomic.Int32
+	nameserver := setupSOAServer(b, "example.com.", 300, &counter)
+
+	b.ResetTimer()
+
+	for range b.N {
+		ClearFqdnCache()
+
+		for i := range 50 {
+			_, err := FindZoneByFqdnCustom(fmt.Sprintf("_acme-challenge.www%d.example.com.", i), []string{nameserver})
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+
+	b.ReportMetric(float64(counter.Load())/float64(b.N), "queries/op")
+}
+
+// setupSOAServer starts a local DNS server for the zone:
+// it answers the SOA of the zone apex, and NXDOMAIN (with the SOA in the authority section) for the other names.
+// The SOA queries are counted.
+func setupSOAServer(tb testing.TB, zone string, negativeTTL uint32, counter *atomic.Int32) string {
+	tb.Helper()
+
+	soa := &dns.SOA{
+		Hdr:     dns.RR_Header{Name: zone, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 300},
+		Ns:      "ns1." + zone,
+		Mbox:    "admin." + zone,
+		Serial:  1,
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  86400,
+		Minttl:  negativeTTL,
+	}
+
+	mux := dns.NewServeMux()
+	mux.HandleFunc(".", func(w dns.ResponseWriter, req *dns.Msg) {
+		m := new(dns.Msg)
+		m.SetReply(req)
+
+		if req.Question[0].Qtype == dns.TypeSOA {
+			counter.Add(1)
+		}
+
+		if req.Question[0].Name == zone {
+			m.Answer = append(m.Answer, soa)
+		} else {
+			m.Rcode = dns.RcodeNameError
+			m.Ns = append(m.Ns, soa)
+		}
+
+		_ = w.WriteMsg(m)
+	})
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(tb, err)
+
+	waitLock := sync.Mutex{}
+	waitLock.Lock()
+
+	server := &dns.Server{PacketConn: pc, Handler: mux, NotifyStartedFunc: waitLock.Unlock}
+
+	go func() { _ = server.ActivateAndServe() }()
+
+	waitLock.Lock()
+
+	tb.Cleanup(func() { _ = server.Shutdown() })
+
+	return pc.LocalAddr().String()
+}
+
 func TestResolveConfServers(t *testing.T) {
 	testCases := []struct {
 		fixture  string
@@ -3,8 +3,11 @@ package dns01
 import (
 	"fmt"
 	"net"
+	"net/http"
 	"strings"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/miekg/dns"
 )
 
@@ -30,11 +33,81 @@ func DisableCompletePropagationRequirement() ChallengeOption {
 	}
 }
 
+// DisablePropagationCheck disables the propagation check of the TXT record:
+// the CA is notified that the challenge is ready right after the creation of the record,
+// and becomes the only source of truth (useful when the vantage point of lego differs from the one of the CA, e.g. split-horizon DNS).
+// The validation of the challenge by the CA is still polled.
+func DisablePropagationCheck() ChallengeOption {
+	return SetPropagationCheckDisabled(true)
+}
+
+// SetPropagationCheckDisabled disables (true) or enables (false) the propagation check of the TXT record.
+// See DisablePropagationCheck.
+func SetPropagationCheckDisabled(disabled bool) ChallengeOption {
+	return func(chlg *Challenge) error {
+		chlg.propagationCheckDisabled = disabled
+		return nil
+	}
+}
+
+// PropagationBackOff defines the growth and the randomization of the interval between two checks of the propagation.
+// It avoids the synchronized checks of many concurrent challenges (e.g. a certificate with many domains).
+type PropagationBackOff struct {
+	// Multiplier is the factor applied to the interval after each check (e.g. 2 doubles the interval).
+	// If 1 or less, the interval is not increased.
+	Multiplier float64
+	// MaxInterval is the maximum interval between two checks, before the jitter.
+	// If 0, the interval is only limited by the propagation timeout.
+	MaxInterval time.Duration
+	// Jitter is the randomization factor of the interval, between 0 and 1:
+	// the interval is randomly chosen between interval*(1-Jitter) and interval*(1+Jitter).
+	Jitter float64
+}
+
+// newBackOff creates the backoff of the propagation checks, starting from the polling interval of the provider.
+func (p PropagationBackOff) newBackOff(interval, timeout time.Duration) backoff.BackOff {
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = interval
+	bo.Multiplier = max(p.Multiplier, 1)
+	bo.RandomizationFactor = p.Jitter
+	bo.MaxInterval = p.MaxInterval
+	bo.MaxElapsedTime = 0
+
+	if bo.MaxInterval <= 0 {
+		bo.MaxInterval = max(timeout, interval)
+	}
+
+	bo.Reset()
+
+	return bo
+}
+
+// SetPropagationBackOff enables an exponential backoff and a jitter for the interval between two checks of the propagation.
+// By default, the interval is fixed (the polling interval of the provider).
+func SetPropagationBackOff(propagationBackOff PropagationBackOff) ChallengeOption {
+	return func(chlg *Challenge) error {
+		if propagationBackOff.Jitter < 0 || propagationBackOff.Jitter > 1 {
+			return fmt.Errorf("invalid propagation jitter: %v (must be between 0 and 1)", propagationBackOff.Jitter)
+		}
+
+		if propagationBackOff.MaxInterval < 0 {
+			return fmt.Errorf("invalid propagation max interval: %s", propagationBackOff.MaxInterval)
+		}
+
+		chlg.propagationBackOff = &propagationBackOff
+
+		return nil
+	}
+}
+
 type preCheck struct {
 	// checks DNS propagation before notifying ACME that the DNS challenge is ready.
 	checkFunc WrapPreCheckFunc
 	// require the TXT record to be propagated to all authoritative name servers
 	requireCompletePropagation bool
+	// DNS-over-HTTPS endpoints used instead of the nameservers to check the propagation.
+	dohEndpoints []string
+	dohClient    *http.Client
 }
 
 func newPreCheck() preCheck {
@@ -53,6 +126,10 @@ func (p preCheck) call(domain, fqdn, value string) (bool, error) {
 
 // checkDNSPropagation checks if the expected TXT record has been propagated to all authoritative nameservers.
 func (p preCheck) checkDNSPropagation(fqdn, value string) (bool, error) {
+	if len(p.dohEndpoints) > 0 {
+		return p.checkDoHPropagation(fqdn, value)
+	}
+
 	// Initial attempt to resolve at the recursive NS
 	r, err := dnsQuery(fqdn, dns.TypeTXT, recursiveNameservers, true)
 	if err != nil {
@@ -0,0 +1,196 @@
+package dns01
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// DefaultDoHEndpoints are the DNS-over-HTTPS (JSON API) endpoints used when none are provided.
+var DefaultDoHEndpoints = []string{
+	"https://cloudflare-dns.com/dns-query",
+	"https://dns.google/resolve",
+}
+
+// UseDoHPropagationCheck uses DNS-over-HTTPS resolvers (JSON API) to check the propagation of the TXT record,
+// instead of querying the recursive and authoritative nameservers over UDP/TCP.
+//
+// By default, the TXT record must be observed by all the endpoints,
+// see DisableCompletePropagationRequirement to only require one of them.
+// If no endpoint is provided, DefaultDoHEndpoints are used.
+func UseDoHPropagationCheck(endpoints ...string) ChallengeOption {
+	return func(chlg *Challenge) error {
+		if len(endpoints) == 0 {
+			endpoints = DefaultDoHEndpoints
+		}
+
+		for _, endpoint := range endpoints {
+			u, err := url.Parse(endpoint)
+			if err != nil {
+				return fmt.Errorf("invalid DoH endpoint %q: %w", endpoint, err)
+			}
+
+			if u.Scheme != "https" {
+				return fmt.Errorf("invalid DoH endpoint %q: the scheme must be https", endpoint)
+			}
+		}
+
+		chlg.preCheck.dohEndpoints = endpoints
+
+		return nil
+	}
+}
+
+// dohResponse represents a response of a DNS-over-HTTPS JSON API.
+// https://developers.google.com/speed/public-dns/docs/doh/json
+// https://developers.cloudflare.com/1.1.1.1/encryption/dns-over-https/make-api-requests/dns-json/
+type dohResponse struct {
+	Status int         `json:"Status"`
+	Answer []dohAnswer `json:"Answer"`
+}
+
+type dohAnswer struct {
+	Name string `json:"name"`
+	Type uint16 `json:"type"`
+	TTL  int    `json:"TTL"`
+	Data string `json:"data"`
+}
+
+// checkDoHPropagation checks if the expected TXT record is returned by the DoH endpoints.
+func (p preCheck) checkDoHPropagation(fqdn, value string) (bool, error) {
+	var errs []error
+
+	for _, endpoint := range p.dohEndpoints {
+		err := p.checkDoHEndpoint(endpoint, fqdn, value)
+		if err == nil {
+			if !p.requireCompletePropagation {
+				return true, nil
+			}
+
+			continue
+		}
+
+		if p.requireCompletePropagation {
+			return false, err
+		}
+
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return false, errors.Join(errs...)
+	}
+
+	return true, nil
+}
+
+func (p preCheck) checkDoHEndpoint(endpoint, fqdn, value string) error {
+	records, err := p.dohQueryTXT(endpoint, fqdn)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if record == value {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("DoH %s did not return the expected TXT record [fqdn: %s, value: %s]: %s", endpoint, fqdn, value, strings.Join(records, " ,"))
+}
+
+func (p preCheck) dohQueryTXT(endpoint, fqdn string) ([]string, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("DoH %s: %w", endpoint, err)
+	}
+
+	query := endpointURL.Query()
+	query.Set("name", fqdn)
+	query.Set("type", "TXT")
+	endpointURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, endpointURL.String(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("DoH %s: %w", endpoint, err)
+	}
+
+	req.Header.Set("Accept", "application/dns-json")
+
+	client := p.dohClient
+	if client == nil {
+		client = &http.Client{Timeout: dnsTimeout}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("DoH %s: %w", endpoint, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("DoH %s: %w", endpoint, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("DoH %s: unexpected status code: [status code: %d] body: %s", endpoint, resp.StatusCode, string(raw))
+	}
+
+	var result dohResponse
+	err = json.Unmarshal(raw, &result)
+	if err != nil {
+		return nil, fmt.Errorf("DoH %s: unable to unmarshal response: %w", endpoint, err)
+	}
+
+	if result.Status != dns.RcodeSuccess {
+		return nil, fmt.Errorf("DoH %s returned %s for %s", endpoint, dns.RcodeToString[result.Status], fqdn)
+	}
+
+	var records []string
+
+	// The answer also contains the CNAME records followed by the resolver.
+	for _, answer := range result.Answer {
+		if answer.Type == dns.TypeTXT {
+			records = append(records, parseDoHTXTData(answer.Data))
+		}
+	}
+
+	return records, nil
+}
+
+// parseDoHTXTData parses the data of a TXT record.
+// Depending on the resolver, the character-strings are quoted (`"a" "b"`) or not.
+func parseDoHTXTData(data string) string {
+	data = strings.TrimSpace(data)
+
+	if !strings.HasPrefix(data, `"`) {
+		return data
+	}
+
+	var sb strings.Builder
+
+	var quoted, escaped bool
+	for _, c := range data {
+		switch {
+		case escaped:
+			sb.WriteRune(c)
+			escaped = false
+		case c == '\\' && quoted:
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+			sb.WriteRune(c)
+		}
+	}
+
+	return sb.String()
+}
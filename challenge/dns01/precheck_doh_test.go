@@ -0,0 +1,117 @@
+package dns01
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupDoHServer(t *testing.T, response string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("name") != "_acme-challenge.example.com." || req.URL.Query().Get("type") != "TXT" {
+			http.Error(rw, "invalid query: "+req.URL.RawQuery, http.StatusBadRequest)
+			return
+		}
+
+		if req.Header.Get("Accept") != "application/dns-json" {
+			http.Error(rw, "invalid Accept header", http.StatusBadRequest)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "application/dns-json")
+		_, _ = rw.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestPreCheck_checkDoHPropagation(t *testing.T) {
+	found := setupDoHServer(t, `{"Status":0,"Answer":[{"name":"_acme-challenge.example.com.","type":5,"TTL":60,"data":"alias.example.net."},{"name":"alias.example.net.","type":16,"TTL":60,"data":"\"foo\" \"bar\""}]}`)
+	notFound := setupDoHServer(t, `{"Status":0,"Answer":[{"name":"_acme-challenge.example.com.","type":16,"TTL":60,"data":"other"}]}`)
+	nxDomain := setupDoHServer(t, `{"Status":3}`)
+
+	testCases := []struct {
+		desc                       string
+		endpoints                  []string
+		requireCompletePropagation bool
+		expected                   bool
+		expectedError              string
+	}{
+		{
+			desc:                       "all endpoints",
+			endpoints:                  []string{found.URL, found.URL},
+			requireCompletePropagation: true,
+			expected:                   true,
+		},
+		{
+			desc:                       "complete propagation: one endpoint without the record",
+			endpoints:                  []string{found.URL, notFound.URL},
+			requireCompletePropagation: true,
+			expectedError:              "DoH " + notFound.URL + " did not return the expected TXT record [fqdn: _acme-challenge.example.com., value: foobar]: other",
+		},
+		{
+			desc:      "any endpoint",
+			endpoints: []string{notFound.URL, found.URL},
+			expected:  true,
+		},
+		{
+			desc:          "any endpoint: no endpoint with the record",
+			endpoints:     []string{notFound.URL, nxDomain.URL},
+			expectedError: "DoH " + notFound.URL + " did not return the expected TXT record [fqdn: _acme-challenge.example.com., value: foobar]: other\nDoH " + nxDomain.URL + " returned NXDOMAIN for _acme-challenge.example.com.",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			check := newPreCheck()
+			check.requireCompletePropagation = test.requireCompletePropagation
+			check.dohEndpoints = test.endpoints
+			check.dohClient = found.Client()
+
+			ok, err := check.checkDNSPropagation("_acme-challenge.example.com.", "foobar")
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expected, ok)
+		})
+	}
+}
+
+func TestUseDoHPropagationCheck(t *testing.T) {
+	chlg := NewChallenge(nil, nil, nil)
+
+	err := UseDoHPropagationCheck()(chlg)
+	require.NoError(t, err)
+
+	assert.Equal(t, DefaultDoHEndpoints, chlg.preCheck.dohEndpoints)
+
+	err = UseDoHPropagationCheck("http://dns.example.com/dns-query")(chlg)
+	require.EqualError(t, err, `invalid DoH endpoint "http://dns.example.com/dns-query": the scheme must be https`)
+}
+
+func Test_parseDoHTXTData(t *testing.T) {
+	testCases := []struct {
+		data     string
+		expected string
+	}{
+		{data: `foo`, expected: "foo"},
+		{data: `"foo"`, expected: "foo"},
+		{data: `"foo" "bar"`, expected: "foobar"},
+		{data: `"fo\"o"`, expected: `fo"o`},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.data, func(t *testing.T) {
+			assert.Equal(t, test.expected, parseDoHTXTData(test.data))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package dns01
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -112,3 +113,115 @@ func TestCheckAuthoritativeNssErr(t *testing.T) {
 		})
 	}
 }
+
+func TestPropagationBackOff_newBackOff(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		backOff  PropagationBackOff
+		expected []time.Duration
+	}{
+		{
+			desc:     "fixed interval",
+			backOff:  PropagationBackOff{},
+			expected: []time.Duration{2 * time.Second, 2 * time.Second, 2 * time.Second, 2 * time.Second, 2 * time.Second},
+		},
+		{
+			desc:     "exponential",
+			backOff:  PropagationBackOff{Multiplier: 2},
+			expected: []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 32 * time.Second},
+		},
+		{
+			desc:     "exponential with max interval",
+			backOff:  PropagationBackOff{Multiplier: 2, MaxInterval: 10 * time.Second},
+			expected: []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second},
+		},
+		{
+			desc:     "exponential limited by the timeout",
+			backOff:  PropagationBackOff{Multiplier: 3},
+			expected: []time.Duration{2 * time.Second, 6 * time.Second, 18 * time.Second, 54 * time.Second, 60 * time.Second},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			bo := test.backOff.newBackOff(2*time.Second, time.Minute)
+
+			var delays []time.Duration
+			for range test.expected {
+				delays = append(delays, bo.NextBackOff())
+			}
+
+			assert.Equal(t, test.expected, delays)
+		})
+	}
+}
+
+func TestPropagationBackOff_newBackOff_jitter(t *testing.T) {
+	backOff := PropagationBackOff{Multiplier: 2, MaxInterval: 20 * time.Second, Jitter: 0.5}
+
+	bo := backOff.newBackOff(2*time.Second, time.Minute)
+
+	bases := []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 20 * time.Second, 20 * time.Second}
+
+	var delays []time.Duration
+
+	for _, base := range bases {
+		delay := bo.NextBackOff()
+
+		assert.GreaterOrEqual(t, delay, base/2)
+		assert.LessOrEqual(t, delay, base+base/2)
+
+		delays = append(delays, delay)
+	}
+
+	assert.NotEqual(t, bases, delays, "the delays must be randomized")
+}
+
+func TestSetPropagationBackOff(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		backOff  PropagationBackOff
+		expected string
+	}{
+		{
+			desc:    "valid",
+			backOff: PropagationBackOff{Multiplier: 1.5, MaxInterval: time.Minute, Jitter: 0.2},
+		},
+		{
+			desc:     "negative jitter",
+			backOff:  PropagationBackOff{Jitter: -0.1},
+			expected: "invalid propagation jitter: -0.1 (must be between 0 and 1)",
+		},
+		{
+			desc:     "jitter greater than 1",
+			backOff:  PropagationBackOff{Jitter: 1.5},
+			expected: "invalid propagation jitter: 1.5 (must be between 0 and 1)",
+		},
+		{
+			desc:     "negative max interval",
+			backOff:  PropagationBackOff{MaxInterval: -time.Second},
+			expected: "invalid propagation max interval: -1s",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			chlg := &Challenge{}
+
+			err := SetPropagationBackOff(test.backOff)(chlg)
+			if test.expected != "" {
+				require.EqualError(t, err, test.expected)
+				assert.Nil(t, chlg.propagationBackOff)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, &test.backOff, chlg.propagationBackOff)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package dns01
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/go-acme/lego/v4/challenge"
+)
+
+const rawKeyAuthPrefix = "lego-raw:"
+
+// KeyAuthProvider is implemented by the providers that can send the key authorization itself to their backend,
+// instead of the record computed with GetChallengeInfo (e.g. the RAW mode of the exec and httpreq providers).
+// PresentRaw and CleanUpRaw reject the providers for which UsesKeyAuth returns true.
+type KeyAuthProvider interface {
+	UsesKeyAuth() bool
+}
+
+type rawRecord struct {
+	fqdn  string
+	value string
+
+	// refs the number of the calls (PresentRaw or CleanUpRaw) in progress for the record.
+	refs int
+}
+
+var (
+	rawRecords   = map[string]rawRecord{}
+	muRawRecords sync.Mutex
+)
+
+// PresentRaw creates a TXT record with an arbitrary FQDN and value, using a configured provider,
+// without an ACME order (e.g. when the ACME order is handled by an external system).
+//
+// The key identifies the record between PresentRaw and CleanUpRaw:
+// it is passed to the provider as the token, so the providers that keep the IDs of the records by token still work.
+// The same key, FQDN, and value must be used to clean up the record.
+//
+// The provider must use GetChallengeInfo to compute the FQDN and the value of the record:
+// the providers sending the key authorization to their backend (see KeyAuthProvider) are rejected.
+func PresentRaw(provider challenge.Provider, key, fqdn, value string) error {
+	err := checkRawProvider(provider)
+	if err != nil {
+		return err
+	}
+
+	domain, keyAuth := registerRawRecord(key, fqdn, value)
+	defer unregisterRawRecord(keyAuth)
+
+	return challenge.Present(context.Background(), provider, domain, key, keyAuth)
+}
+
+// CleanUpRaw removes a TXT record created by PresentRaw.
+func CleanUpRaw(provider challenge.Provider, key, fqdn, value string) error {
+	err := checkRawProvider(provider)
+	if err != nil {
+		return err
+	}
+
+	domain, keyAuth := registerRawRecord(key, fqdn, value)
+	defer unregisterRawRecord(keyAuth)
+
+	return challenge.CleanUp(context.Background(), provider, domain, key, keyAuth)
+}
+
+func checkRawProvider(provider challenge.Provider) error {
+	if p, ok := provider.(KeyAuthProvider); ok && p.UsesKeyAuth() {
+		return errors.New("the provider uses the key authorization instead of the record value, it cannot create arbitrary records")
+	}
+
+	return nil
+}
+
+// registerRawRecord registers the record, and returns the domain and the key authorization to pass to the provider.
+func registerRawRecord(key, fqdn, value string) (string, string) {
+	fqdn = ToFqdn(fqdn)
+
+	keyAuth := rawKeyAuthPrefix + key + ":" + fqdn + ":" + value
+
+	muRawRecords.Lock()
+	record := rawRecords[keyAuth]
+	rawRecords[keyAuth] = rawRecord{fqdn: fqdn, value: value, refs: record.refs + 1}
+	muRawRecords.Unlock()
+
+	// The domain is only used by the providers to log and to compute the challenge information.
+	domain := UnFqdn(strings.TrimPrefix(fqdn, "_acme-challenge."))
+
+	return domain, keyAuth
+}
+
+func unregisterRawRecord(keyAuth string) {
+	muRawRecords.Lock()
+	defer muRawRecords.Unlock()
+
+	record := rawRecords[keyAuth]
+	if record.refs <= 1 {
+		delete(rawRecords, keyAuth)
+		return
+	}
+
+	record.refs--
+	rawRecords[keyAuth] = record
+}
+
+func getRawRecord(keyAuth string) (rawRecord, bool) {
+	if !strings.HasPrefix(keyAuth, rawKeyAuthPrefix) {
+		return rawRecord{}, false
+	}
+
+	muRawRecords.Lock()
+	defer muRawRecords.Unlock()
+
+	record, ok := rawRecords[keyAuth]
+
+	return record, ok
+}
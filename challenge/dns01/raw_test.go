@@ -0,0 +1,131 @@
+package dns01
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordProviderMock a provider that keeps the records by token, like most of the DNS providers.
+type recordProviderMock struct {
+	records map[string]ChallengeInfo
+	domains []string
+}
+
+func (p *recordProviderMock) Present(domain, token, keyAuth string) error {
+	p.domains = append(p.domains, domain)
+	p.records[token] = GetChallengeInfo(domain, keyAuth)
+
+	return nil
+}
+
+func (p *recordProviderMock) CleanUp(domain, token, keyAuth string) error {
+	info := GetChallengeInfo(domain, keyAuth)
+
+	record, ok := p.records[token]
+	if !ok {
+		return errors.New("unknown record")
+	}
+
+	if record != info {
+		return errors.New("record mismatch")
+	}
+
+	delete(p.records, token)
+
+	return nil
+}
+
+// keyAuthProviderMock a provider sending the key authorization to its backend.
+type keyAuthProviderMock struct {
+	recordProviderMock
+
+	raw bool
+}
+
+func (p *keyAuthProviderMock) UsesKeyAuth() bool {
+	return p.raw
+}
+
+func TestPresentRaw(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	provider := &recordProviderMock{records: map[string]ChallengeInfo{}}
+
+	err := PresentRaw(provider, "key1", "_acme-challenge.example.com", "value1")
+	require.NoError(t, err)
+
+	err = PresentRaw(provider, "key2", "custom.example.org.", "value2")
+	require.NoError(t, err)
+
+	expected := map[string]ChallengeInfo{
+		"key1": {
+			FQDN:          "_acme-challenge.example.com.",
+			EffectiveFQDN: "_acme-challenge.example.com.",
+			Value:         "value1",
+		},
+		"key2": {
+			FQDN:          "custom.example.org.",
+			EffectiveFQDN: "custom.example.org.",
+			Value:         "value2",
+		},
+	}
+
+	assert.Equal(t, expected, provider.records)
+	assert.Equal(t, []string{"example.com", "custom.example.org"}, provider.domains)
+
+	assert.Empty(t, rawRecords)
+
+	err = CleanUpRaw(provider, "key1", "_acme-challenge.example.com.", "value1")
+	require.NoError(t, err)
+
+	err = CleanUpRaw(provider, "key2", "custom.example.org", "value2")
+	require.NoError(t, err)
+
+	assert.Empty(t, provider.records)
+	assert.Empty(t, rawRecords)
+}
+
+func TestCleanUpRaw_unknownKey(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	provider := &recordProviderMock{records: map[string]ChallengeInfo{}}
+
+	err := PresentRaw(provider, "key1", "_acme-challenge.example.com", "value1")
+	require.NoError(t, err)
+
+	err = CleanUpRaw(provider, "key2", "_acme-challenge.example.com", "value1")
+	require.EqualError(t, err, "unknown record")
+}
+
+func TestPresentRaw_keyAuthProvider(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	provider := &keyAuthProviderMock{recordProviderMock: recordProviderMock{records: map[string]ChallengeInfo{}}, raw: true}
+
+	err := PresentRaw(provider, "key1", "_acme-challenge.example.com", "value1")
+	require.EqualError(t, err, "the provider uses the key authorization instead of the record value, it cannot create arbitrary records")
+
+	err = CleanUpRaw(provider, "key1", "_acme-challenge.example.com", "value1")
+	require.EqualError(t, err, "the provider uses the key authorization instead of the record value, it cannot create arbitrary records")
+
+	assert.Empty(t, provider.records)
+
+	provider.raw = false
+
+	err = PresentRaw(provider, "key1", "_acme-challenge.example.com", "value1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "value1", provider.records["key1"].Value)
+}
+
+func TestGetChallengeInfo_notRaw(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	info := GetChallengeInfo("example.com", rawKeyAuthPrefix+"unknown")
+
+	assert.Equal(t, "_acme-challenge.example.com.", info.FQDN)
+	assert.NotEqual(t, "unknown", info.Value)
+}
@@ -0,0 +1,61 @@
+package dns01
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type ttlKey struct{}
+
+// SetDomainTTLs defines the TTL of the TXT records per domain (e.g. "example.com", "*.example.org"),
+// when a certificate covers domains with different TTL requirements.
+// The TTL is passed to the providers through the context (see WithTTL),
+// so it is only applied by the providers that implement challenge.ProviderContext and support it.
+// The TTL of the other domains is the TTL of the provider configuration.
+func SetDomainTTLs(ttls map[string]int) ChallengeOption {
+	return func(chlg *Challenge) error {
+		domainTTLs := make(map[string]int, len(ttls))
+
+		for domain, ttl := range ttls {
+			if ttl <= 0 {
+				return fmt.Errorf("invalid TTL for the domain %s: %d", domain, ttl)
+			}
+
+			domainTTLs[normalizeDomain(domain)] = ttl
+		}
+
+		chlg.domainTTLs = domainTTLs
+
+		return nil
+	}
+}
+
+// WithTTL returns a copy of the context carrying the TTL of the TXT record.
+func WithTTL(ctx context.Context, ttl int) context.Context {
+	return context.WithValue(ctx, ttlKey{}, ttl)
+}
+
+// TTLFromContext returns the TTL of the TXT record carried by the context, if any.
+// The providers that support the TTL per record use it instead of the TTL of their configuration.
+func TTLFromContext(ctx context.Context) (int, bool) {
+	ttl, ok := ctx.Value(ttlKey{}).(int)
+
+	return ttl, ok
+}
+
+// withDomainTTL adds the TTL of the domain to the context, if the domain has a specific TTL.
+// The targeted domain (e.g. "*.example.com") takes precedence over the domain of the identifier.
+func (c *Challenge) withDomainTTL(ctx context.Context, domains ...string) context.Context {
+	for _, domain := range domains {
+		if ttl, ok := c.domainTTLs[normalizeDomain(domain)]; ok {
+			return WithTTL(ctx, ttl)
+		}
+	}
+
+	return ctx
+}
+
+func normalizeDomain(domain string) string {
+	return strings.ToLower(UnFqdn(domain))
+}
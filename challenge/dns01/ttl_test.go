@@ -0,0 +1,106 @@
+package dns01
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"testing"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/acme/api"
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type providerContextMock struct {
+	presentTTLs map[string]int
+	cleanUpTTLs map[string]int
+}
+
+func (p *providerContextMock) Present(domain, token, keyAuth string) error {
+	return p.PresentContext(context.Background(), domain, token, keyAuth)
+}
+
+func (p *providerContextMock) CleanUp(domain, token, keyAuth string) error {
+	return p.CleanUpContext(context.Background(), domain, token, keyAuth)
+}
+
+func (p *providerContextMock) PresentContext(ctx context.Context, domain, _, _ string) error {
+	p.presentTTLs[domain], _ = TTLFromContext(ctx)
+	return nil
+}
+
+func (p *providerContextMock) CleanUpContext(ctx context.Context, domain, _, _ string) error {
+	p.cleanUpTTLs[domain], _ = TTLFromContext(ctx)
+	return nil
+}
+
+func TestChallenge_domainTTLs(t *testing.T) {
+	_, apiURL := tester.SetupFakeAPI(t)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+	require.NoError(t, err)
+
+	provider := &providerContextMock{
+		presentTTLs: map[string]int{},
+		cleanUpTTLs: map[string]int{},
+	}
+
+	ttls := map[string]int{
+		"A.example.com.":  300,
+		"*.example.org":   600,
+		"b.example.com":   900,
+		"unused.test.com": 1500,
+	}
+
+	chlg := NewChallenge(core, nil, provider, SetDomainTTLs(ttls))
+
+	authzs := []acme.Authorization{
+		{Identifier: acme.Identifier{Value: "a.example.com"}},
+		{Identifier: acme.Identifier{Value: "example.org"}, Wildcard: true},
+		{Identifier: acme.Identifier{Value: "example.org"}},
+		{Identifier: acme.Identifier{Value: "b.example.com"}},
+		{Identifier: acme.Identifier{Value: "d.example.com"}},
+	}
+
+	for _, authz := range authzs {
+		authz.Challenges = []acme.Challenge{{Type: challenge.DNS01.String(), Token: "token"}}
+
+		err = chlg.PreSolve(authz)
+		require.NoError(t, err)
+
+		if authz.Wildcard {
+			// The wildcard and the non-wildcard authorizations of a domain use the same domain,
+			// so the TTLs are checked before the next authorization.
+			assert.Equal(t, 600, provider.presentTTLs["example.org"])
+		}
+
+		err = chlg.CleanUp(authz)
+		require.NoError(t, err)
+	}
+
+	expected := map[string]int{
+		"a.example.com": 300,
+		"example.org":   0,
+		"b.example.com": 900,
+		"d.example.com": 0,
+	}
+
+	assert.Equal(t, expected, provider.presentTTLs)
+	assert.Equal(t, expected, provider.cleanUpTTLs)
+}
+
+func TestSetDomainTTLs_invalid(t *testing.T) {
+	chlg := &Challenge{}
+
+	err := SetDomainTTLs(map[string]int{"example.com": 0})(chlg)
+	require.EqualError(t, err, "invalid TTL for the domain example.com: 0")
+
+	assert.Nil(t, chlg.domainTTLs)
+}
@@ -1,6 +1,7 @@
 package http01
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-acme/lego/v4/acme"
@@ -35,6 +36,11 @@ func (c *Challenge) SetProvider(provider challenge.Provider) {
 }
 
 func (c *Challenge) Solve(authz acme.Authorization) error {
+	return c.SolveContext(context.Background(), authz)
+}
+
+// SolveContext is like Solve, but the context is passed to the provider.
+func (c *Challenge) SolveContext(ctx context.Context, authz acme.Authorization) error {
 	domain := challenge.GetTargetedDomain(authz)
 	log.Infof("[%s] acme: Trying to solve HTTP-01", domain)
 
@@ -49,12 +55,12 @@ func (c *Challenge) Solve(authz acme.Authorization) error {
 		return err
 	}
 
-	err = c.provider.Present(authz.Identifier.Value, chlng.Token, keyAuth)
+	err = challenge.Present(ctx, c.provider, authz.Identifier.Value, chlng.Token, keyAuth)
 	if err != nil {
 		return fmt.Errorf("[%s] acme: error presenting token: %w", domain, err)
 	}
 	defer func() {
-		err := c.provider.CleanUp(authz.Identifier.Value, chlng.Token, keyAuth)
+		err := challenge.CleanUp(context.WithoutCancel(ctx), c.provider, authz.Identifier.Value, chlng.Token, keyAuth)
 		if err != nil {
 			log.Warnf("[%s] acme: cleaning up failed: %v", domain, err)
 		}
@@ -1,6 +1,9 @@
 package challenge
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // Provider enables implementing a custom challenge
 // provider. Present presents the solution to a challenge available to
@@ -26,3 +29,38 @@ type ProviderTimeout interface {
 	Provider
 	Timeout() (timeout, interval time.Duration)
 }
+
+// ProviderContext allows for implementing a Provider
+// where the calls to Present and CleanUp are bound to a context.
+// If a Provider implements ProviderContext,
+// PresentContext and CleanUpContext will be used instead of Present and CleanUp,
+// allowing cancellation and deadlines to flow into the provider calls.
+type ProviderContext interface {
+	Provider
+	PresentContext(ctx context.Context, domain, token, keyAuth string) error
+	CleanUpContext(ctx context.Context, domain, token, keyAuth string) error
+}
+
+// Present presents the solution to a challenge.
+// It uses PresentContext if the provider implements ProviderContext, otherwise Present.
+func Present(ctx context.Context, provider Provider, domain, token, keyAuth string) error {
+	if p, ok := provider.(ProviderContext); ok {
+		return p.PresentContext(ctx, domain, token, keyAuth)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return provider.Present(domain, token, keyAuth)
+}
+
+// CleanUp cleans the solution of a challenge.
+// It uses CleanUpContext if the provider implements ProviderContext, otherwise CleanUp.
+func CleanUp(ctx context.Context, provider Provider, domain, token, keyAuth string) error {
+	if p, ok := provider.(ProviderContext); ok {
+		return p.CleanUpContext(ctx, domain, token, keyAuth)
+	}
+
+	return provider.CleanUp(domain, token, keyAuth)
+}
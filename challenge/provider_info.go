@@ -0,0 +1,49 @@
+package challenge
+
+// ProviderInfo describes a provider and its configuration.
+// It allows tools (configuration UIs, validators, etc.) to introspect a provider without parsing its documentation.
+type ProviderInfo struct {
+	// Name is the display name of the provider.
+	Name string `json:"name"`
+	// Code is the name used to select the provider (ex: the `--dns` CLI flag).
+	Code string `json:"code"`
+	// Since is the first lego version including the provider.
+	Since string `json:"since,omitempty"`
+	// URL is the website of the provider.
+	URL string `json:"url,omitempty"`
+
+	// EnvVars are the environment variables read by the provider.
+	EnvVars []EnvVarInfo `json:"envVars,omitempty"`
+
+	Links *ProviderLinks `json:"links,omitempty"`
+}
+
+// EnvVarInfo describes an environment variable read by a provider.
+type EnvVarInfo struct {
+	Name string `json:"name"`
+	// Description describes the variable, and its default value when documented.
+	Description string `json:"description"`
+
+	// Credential is true when the variable is part of the credentials of the provider.
+	// Some providers accept several sets of credentials (see the descriptions of the variables).
+	Credential bool `json:"credential,omitempty"`
+}
+
+// ProviderLinks contains the links related to a provider.
+type ProviderLinks struct {
+	API      string `json:"api,omitempty"`
+	GoClient string `json:"goClient,omitempty"`
+}
+
+// CredentialEnvVars returns the names of the environment variables of the credentials of the provider.
+func (p ProviderInfo) CredentialEnvVars() []string {
+	var names []string
+
+	for _, envVar := range p.EnvVars {
+		if envVar.Credential {
+			names = append(names, envVar.Name)
+		}
+	}
+
+	return names
+}
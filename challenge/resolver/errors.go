@@ -23,3 +23,31 @@ func (e obtainError) Error() string {
 	}
 	return buffer.String()
 }
+
+func (e obtainError) add(domain string, err error) {
+	if err != nil {
+		e[domain] = err
+	}
+}
+
+// CleanUpError is returned when the cleanup of one or more challenges failed,
+// and the cleanup errors are fatal (see SolverManager.SetCleanUpErrorsFatal).
+type CleanUpError struct {
+	// Errors contains the cleanup errors by domain.
+	Errors map[string]error
+}
+
+func (e *CleanUpError) Error() string {
+	buffer := bytes.NewBufferString("error: the cleanup of one or more domains failed:\n")
+
+	var domains []string
+	for domain := range e.Errors {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	for _, domain := range domains {
+		_, _ = fmt.Fprintf(buffer, "[%s] %s\n", domain, e.Errors[domain])
+	}
+	return buffer.String()
+}
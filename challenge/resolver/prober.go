@@ -1,11 +1,15 @@
 package resolver
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/go-acme/lego/v4/acme"
 	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/log"
 )
 
@@ -25,6 +29,21 @@ type cleanup interface {
 	CleanUp(authorization acme.Authorization) error
 }
 
+// Interface for solvers that can pass a context to the challenge provider.
+type solverContext interface {
+	SolveContext(ctx context.Context, authorization acme.Authorization) error
+}
+
+// Interface for pre-solvers that can pass a context to the challenge provider.
+type preSolverContext interface {
+	PreSolveContext(ctx context.Context, authorization acme.Authorization) error
+}
+
+// Interface for cleanup that can pass a context to the challenge provider.
+type cleanupContext interface {
+	CleanUpContext(ctx context.Context, authorization acme.Authorization) error
+}
+
 type sequential interface {
 	Sequential() (bool, time.Duration)
 }
@@ -33,6 +52,9 @@ type sequential interface {
 type selectedAuthSolver struct {
 	authz  acme.Authorization
 	solver solver
+
+	// skipCleanUp keeps the resources created to solve the challenge (see SolverManager.SetDNSDisableCleanUp).
+	skipCleanUp bool
 }
 
 type Prober struct {
@@ -48,7 +70,14 @@ func NewProber(solverManager *SolverManager) *Prober {
 // Solve Looks through the challenge combinations to find a solvable match.
 // Then solves the challenges in series and returns.
 func (p *Prober) Solve(authorizations []acme.Authorization) error {
+	return p.SolveContext(context.Background(), authorizations)
+}
+
+// SolveContext is like Solve, but the context is passed to the challenge providers.
+// The cleanup of the challenges is not canceled when the context is canceled.
+func (p *Prober) SolveContext(ctx context.Context, authorizations []acme.Authorization) error {
 	failures := make(obtainError)
+	cleanUpFailures := make(obtainError)
 
 	var authSolvers []*selectedAuthSolver
 	var authSolversSequential []*selectedAuthSolver
@@ -59,13 +88,27 @@ func (p *Prober) Solve(authorizations []acme.Authorization) error {
 		domain := challenge.GetTargetedDomain(authz)
 		if authz.Status == acme.StatusValid {
 			// Boulder might recycle recent validated authz (see issue #267)
-			log.Infof("[%s] acme: authorization already valid; skipping challenge", domain)
+			log.Info("acme: authorization already valid; skipping challenge", "domain", domain)
 			continue
 		}
 
 		if solvr := p.solverManager.chooseSolver(authz); solvr != nil {
 			authSolver := &selectedAuthSolver{authz: authz, solver: solvr}
 
+			if _, ok := solvr.(*dns01.Challenge); ok {
+				// The zones found by a previous resolution may be outdated:
+				// the lookups of this resolution are cached again, and shared by its authorizations.
+				dns01.InvalidateFqdnCache(domain)
+
+				authSolver.skipCleanUp = p.solverManager.dnsDisableCleanUp
+			}
+
+			if p.solverManager.serialAuthz {
+				// The challenges are presented, validated, and cleaned up one authorization at a time.
+				authSolversSequential = append(authSolversSequential, authSolver)
+				continue
+			}
+
 			switch s := solvr.(type) {
 			case sequential:
 				if ok, _ := s.Sequential(); ok {
@@ -81,93 +124,239 @@ func (p *Prober) Solve(authorizations []acme.Authorization) error {
 		}
 	}
 
-	parallelSolve(authSolvers, failures)
+	parallelSolve(ctx, authSolvers, failures, cleanUpFailures, p.solverManager.maxConcurrentAuthz, p.solverManager.presentGraceDelay)
 
-	sequentialSolve(authSolversSequential, failures)
+	sequentialSolve(ctx, authSolversSequential, failures, cleanUpFailures, p.solverManager.presentGraceDelay)
+
+	var cleanUpErr error
+	if len(cleanUpFailures) > 0 {
+		cleanUpErr = &CleanUpError{Errors: cleanUpFailures}
+
+		if !p.solverManager.cleanUpErrorsFatal {
+			log.Warn("acme: the challenge records or resources may not have been removed", "error", cleanUpErr)
+			cleanUpErr = nil
+		}
+	}
 
 	// Be careful not to return an empty failures map,
 	// for even an empty obtainError is a non-nil error value
 	if len(failures) > 0 {
+		if cleanUpErr != nil {
+			return errors.Join(failures, cleanUpErr)
+		}
+
 		return failures
 	}
-	return nil
+
+	return cleanUpErr
 }
 
-func sequentialSolve(authSolvers []*selectedAuthSolver, failures obtainError) {
+func sequentialSolve(ctx context.Context, authSolvers []*selectedAuthSolver, failures, cleanUpFailures obtainError, graceDelay time.Duration) {
 	for i, authSolver := range authSolvers {
 		// Submit the challenge
 		domain := challenge.GetTargetedDomain(authSolver.authz)
 
-		if solvr, ok := authSolver.solver.(preSolver); ok {
-			err := solvr.PreSolve(authSolver.authz)
-			if err != nil {
-				failures[domain] = err
-				cleanUp(authSolver.solver, authSolver.authz)
-				continue
-			}
+		err := preSolve(ctx, authSolver.solver, authSolver.authz)
+		if err != nil {
+			failures[domain] = err
+			cleanUpFailures.add(domain, cleanUp(ctx, authSolver))
+			continue
+		}
+
+		if isPreSolver(authSolver.solver) {
+			waitGraceDelay(ctx, graceDelay)
 		}
 
 		// Solve challenge
-		err := authSolver.solver.Solve(authSolver.authz)
+		err = solve(ctx, authSolver.solver, authSolver.authz)
 		if err != nil {
 			failures[domain] = err
-			cleanUp(authSolver.solver, authSolver.authz)
+			cleanUpFailures.add(domain, cleanUp(ctx, authSolver))
 			continue
 		}
 
 		// Clean challenge
-		cleanUp(authSolver.solver, authSolver.authz)
+		cleanUpFailures.add(domain, cleanUp(ctx, authSolver))
 
 		if len(authSolvers)-1 > i {
-			solvr := authSolver.solver.(sequential)
-			_, interval := solvr.Sequential()
-			log.Infof("sequence: wait for %s", interval)
-			time.Sleep(interval)
+			// In serial mode (see SolverManager.SetSerialAuthz), the solver is not necessarily sequential.
+			if ok, interval := sequentialInterval(authSolver.solver); ok {
+				log.Info("sequence: wait", "interval", interval)
+				time.Sleep(interval)
+			}
 		}
 	}
 }
 
-func parallelSolve(authSolvers []*selectedAuthSolver, failures obtainError) {
+func sequentialInterval(solvr solver) (bool, time.Duration) {
+	s, ok := solvr.(sequential)
+	if !ok {
+		return false, 0
+	}
+
+	return s.Sequential()
+}
+
+func parallelSolve(ctx context.Context, authSolvers []*selectedAuthSolver, failures, cleanUpFailures obtainError, maxConcurrent int, graceDelay time.Duration) {
+	var mu sync.Mutex
+
+	setFailure := func(authz acme.Authorization, err error) {
+		mu.Lock()
+		failures[challenge.GetTargetedDomain(authz)] = err
+		mu.Unlock()
+	}
+
 	// For all valid preSolvers, first submit the challenges, so they have max time to propagate
-	for _, authSolver := range authSolvers {
-		authz := authSolver.authz
-		if solvr, ok := authSolver.solver.(preSolver); ok {
-			err := solvr.PreSolve(authz)
-			if err != nil {
-				failures[challenge.GetTargetedDomain(authz)] = err
-			}
+	forEachAuthSolver(authSolvers, maxConcurrent, func(authSolver *selectedAuthSolver) {
+		err := preSolve(ctx, authSolver.solver, authSolver.authz)
+		if err != nil {
+			setFailure(authSolver.authz, err)
 		}
-	}
+	})
 
 	defer func() {
 		// Clean all created TXT records
-		for _, authSolver := range authSolvers {
-			cleanUp(authSolver.solver, authSolver.authz)
-		}
+		forEachAuthSolver(authSolvers, maxConcurrent, func(authSolver *selectedAuthSolver) {
+			err := cleanUp(ctx, authSolver)
+
+			mu.Lock()
+			cleanUpFailures.add(challenge.GetTargetedDomain(authSolver.authz), err)
+			mu.Unlock()
+		})
 	}()
 
-	// Finally solve all challenges for real
+	var toSolve []*selectedAuthSolver
+	var presented bool
 	for _, authSolver := range authSolvers {
-		authz := authSolver.authz
-		domain := challenge.GetTargetedDomain(authz)
-		if failures[domain] != nil {
-			// already failed in previous loop
-			continue
+		// already failed in previous loop
+		if failures[challenge.GetTargetedDomain(authSolver.authz)] == nil {
+			toSolve = append(toSolve, authSolver)
+			presented = presented || isPreSolver(authSolver.solver)
 		}
+	}
+
+	// The grace delay is applied once, after all the challenges are presented.
+	if presented {
+		waitGraceDelay(ctx, graceDelay)
+	}
 
-		err := authSolver.solver.Solve(authz)
+	// Finally solve all challenges for real
+	forEachAuthSolver(toSolve, maxConcurrent, func(authSolver *selectedAuthSolver) {
+		err := solve(ctx, authSolver.solver, authSolver.authz)
 		if err != nil {
-			failures[domain] = err
+			setFailure(authSolver.authz, err)
+		}
+	})
+}
+
+// forEachAuthSolver calls fn for each authSolver, with at most maxConcurrent concurrent calls.
+// When maxConcurrent is 0, the calls are made one after the other.
+// Only the pre-solvers (DNS-01) are called concurrently:
+// the built-in HTTP-01 and TLS-ALPN-01 servers listen on a single address, so their challenges are always solved one after the other.
+func forEachAuthSolver(authSolvers []*selectedAuthSolver, maxConcurrent int, fn func(authSolver *selectedAuthSolver)) {
+	if maxConcurrent <= 0 {
+		for _, authSolver := range authSolvers {
+			fn(authSolver)
 		}
+
+		return
 	}
+
+	sem := make(chan struct{}, maxConcurrent)
+
+	var wg sync.WaitGroup
+
+	for _, authSolver := range authSolvers {
+		if !isPreSolver(authSolver.solver) {
+			fn(authSolver)
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(authSolver *selectedAuthSolver) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			fn(authSolver)
+		}(authSolver)
+	}
+
+	wg.Wait()
 }
 
-func cleanUp(solvr solver, authz acme.Authorization) {
-	if solvr, ok := solvr.(cleanup); ok {
-		domain := challenge.GetTargetedDomain(authz)
-		err := solvr.CleanUp(authz)
-		if err != nil {
-			log.Warnf("[%s] acme: cleaning up failed: %v ", domain, err)
+func preSolve(ctx context.Context, solvr solver, authz acme.Authorization) error {
+	switch s := solvr.(type) {
+	case preSolverContext:
+		return s.PreSolveContext(ctx, authz)
+	case preSolver:
+		if err := ctx.Err(); err != nil {
+			return err
 		}
+		return s.PreSolve(authz)
+	default:
+		return nil
+	}
+}
+
+func isPreSolver(solvr solver) bool {
+	switch solvr.(type) {
+	case preSolverContext, preSolver:
+		return true
+	default:
+		return false
+	}
+}
+
+// waitGraceDelay waits for the grace delay (see SolverManager.SetPresentGraceDelay), or until the context is done.
+func waitGraceDelay(ctx context.Context, delay time.Duration) {
+	if delay <= 0 {
+		return
+	}
+
+	log.Info("acme: waiting after the presentation of the challenges", "delay", delay)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
+
+func solve(ctx context.Context, solvr solver, authz acme.Authorization) error {
+	if s, ok := solvr.(solverContext); ok {
+		return s.SolveContext(ctx, authz)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return solvr.Solve(authz)
+}
+
+func cleanUp(ctx context.Context, authSolver *selectedAuthSolver) error {
+	if authSolver.skipCleanUp {
+		log.Warn("acme: the cleanup is disabled: the challenge record is kept and must be removed manually",
+			"domain", challenge.GetTargetedDomain(authSolver.authz))
+
+		return nil
+	}
+
+	// The cleanup must be done even if the context is canceled.
+	ctx = context.WithoutCancel(ctx)
+
+	switch s := authSolver.solver.(type) {
+	case cleanupContext:
+		return s.CleanUpContext(ctx, authSolver.authz)
+	case cleanup:
+		return s.CleanUp(authSolver.authz)
+	default:
+		return nil
 	}
 }
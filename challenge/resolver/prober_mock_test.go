@@ -1,6 +1,9 @@
 package resolver
 
 import (
+	"errors"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-acme/lego/v4/acme"
@@ -42,3 +45,125 @@ func createStubAuthorizationHTTP01(domain, status string) acme.Authorization {
 		},
 	}
 }
+
+// countingSolverMock records the maximum number of concurrent calls.
+type countingSolverMock struct {
+	current atomic.Int32
+	max     atomic.Int32
+}
+
+func (s *countingSolverMock) PreSolve(_ acme.Authorization) error {
+	s.track()
+	return nil
+}
+
+func (s *countingSolverMock) Solve(_ acme.Authorization) error {
+	s.track()
+	return nil
+}
+
+func (s *countingSolverMock) track() {
+	current := s.current.Add(1)
+	defer s.current.Add(-1)
+
+	for {
+		maximum := s.max.Load()
+		if current <= maximum || s.max.CompareAndSwap(maximum, current) {
+			break
+		}
+	}
+
+	time.Sleep(10 * time.Millisecond)
+}
+
+// countingServerSolverMock is like countingSolverMock, but without PreSolve (like the HTTP-01 and TLS-ALPN-01 solvers).
+type countingServerSolverMock struct {
+	counter countingSolverMock
+}
+
+func (s *countingServerSolverMock) Solve(_ acme.Authorization) error {
+	s.counter.track()
+	return nil
+}
+
+// recorderSolverMock records the calls, and counts the challenges presented and not cleaned up.
+type recorderSolverMock struct {
+	mu    sync.Mutex
+	calls []string
+
+	presented    int
+	maxPresented int
+}
+
+func (s *recorderSolverMock) PreSolve(authorization acme.Authorization) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = append(s.calls, "present "+authorization.Identifier.Value)
+
+	s.presented++
+	s.maxPresented = max(s.maxPresented, s.presented)
+
+	return nil
+}
+
+func (s *recorderSolverMock) Solve(authorization acme.Authorization) error {
+	s.record("validate " + authorization.Identifier.Value)
+	return nil
+}
+
+func (s *recorderSolverMock) CleanUp(authorization acme.Authorization) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = append(s.calls, "cleanup "+authorization.Identifier.Value)
+
+	s.presented--
+
+	return nil
+}
+
+func (s *recorderSolverMock) record(call string) {
+	s.mu.Lock()
+	s.calls = append(s.calls, call)
+	s.mu.Unlock()
+}
+
+type sequentialSolverMock struct {
+	preSolverMock
+}
+
+func (s *sequentialSolverMock) Sequential() (bool, time.Duration) {
+	return true, 0
+}
+
+// dnsProviderMock is a DNS provider failing to present the record, and counting the cleanups.
+type dnsProviderMock struct {
+	cleanUps atomic.Int32
+}
+
+func (p *dnsProviderMock) Present(_, _, _ string) error {
+	return errors.New("present failed")
+}
+
+func (p *dnsProviderMock) CleanUp(_, _, _ string) error {
+	p.cleanUps.Add(1)
+	return nil
+}
+
+func createStubAuthorizationDNS01(domain string) acme.Authorization {
+	return acme.Authorization{
+		Status:  acme.StatusPending,
+		Expires: time.Now(),
+		Identifier: acme.Identifier{
+			Type:  "dns",
+			Value: domain,
+		},
+		Challenges: []acme.Challenge{
+			{
+				Type:  challenge.DNS01.String(),
+				Token: "token",
+			},
+		},
+	}
+}
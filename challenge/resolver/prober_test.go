@@ -1,11 +1,20 @@
 package resolver
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/acme/api"
 	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -98,20 +107,384 @@ func TestProber_Solve(t *testing.T) {
 		},
 	}
 
+	for _, test := range testCases {
+		for _, maxConcurrent := range []int{0, 2} {
+			t.Run(fmt.Sprintf("%s (max concurrent: %d)", test.desc, maxConcurrent), func(t *testing.T) {
+				t.Parallel()
+
+				prober := &Prober{
+					solverManager: &SolverManager{solvers: test.solvers, maxConcurrentAuthz: maxConcurrent},
+				}
+
+				err := prober.Solve(test.authz)
+				if test.expectedError != "" {
+					require.EqualError(t, err, test.expectedError)
+				} else {
+					require.NoError(t, err)
+				}
+			})
+		}
+	}
+}
+
+func TestProber_Solve_maxConcurrentAuthz(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		maxConcurrent int
+		expected      int32
+	}{
+		{
+			desc:     "default",
+			expected: 1,
+		},
+		{
+			desc:          "limited",
+			maxConcurrent: 3,
+			expected:      3,
+		},
+	}
+
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
 			t.Parallel()
 
-			prober := &Prober{
-				solverManager: &SolverManager{solvers: test.solvers},
+			mock := &countingSolverMock{}
+
+			solverManager := &SolverManager{solvers: map[challenge.Type]solver{challenge.HTTP01: mock}}
+
+			err := solverManager.SetMaxConcurrentAuthz(test.maxConcurrent)
+			require.NoError(t, err)
+
+			var authz []acme.Authorization
+			for i := range 10 {
+				authz = append(authz, createStubAuthorizationHTTP01(fmt.Sprintf("%d.example.com", i), acme.StatusProcessing))
 			}
 
-			err := prober.Solve(test.authz)
-			if test.expectedError != "" {
-				require.EqualError(t, err, test.expectedError)
+			prober := &Prober{solverManager: solverManager}
+
+			err = prober.Solve(authz)
+			require.NoError(t, err)
+
+			assert.LessOrEqual(t, mock.max.Load(), test.expected)
+
+			if test.maxConcurrent > 0 {
+				assert.Greater(t, mock.max.Load(), int32(1))
 			} else {
+				assert.Equal(t, int32(1), mock.max.Load())
+			}
+		})
+	}
+}
+
+func TestProber_Solve_maxConcurrentAuthz_serverSolver(t *testing.T) {
+	mock := &countingServerSolverMock{}
+
+	solverManager := &SolverManager{solvers: map[challenge.Type]solver{challenge.HTTP01: mock}}
+
+	err := solverManager.SetMaxConcurrentAuthz(3)
+	require.NoError(t, err)
+
+	var authz []acme.Authorization
+	for i := range 5 {
+		authz = append(authz, createStubAuthorizationHTTP01(fmt.Sprintf("%d.example.com", i), acme.StatusProcessing))
+	}
+
+	prober := &Prober{solverManager: solverManager}
+
+	err = prober.Solve(authz)
+	require.NoError(t, err)
+
+	// The challenges solved by a server (HTTP-01, TLS-ALPN-01) are never solved concurrently.
+	assert.Equal(t, int32(1), mock.counter.max.Load())
+}
+
+func TestProber_Solve_serialAuthz(t *testing.T) {
+	testCases := []struct {
+		desc                 string
+		serial               bool
+		expected             []string
+		expectedMaxPresented int
+	}{
+		{
+			desc: "default",
+			expected: []string{
+				"present a.example.com", "present b.example.com", "present c.example.com",
+				"validate a.example.com", "validate b.example.com", "validate c.example.com",
+				"cleanup a.example.com", "cleanup b.example.com", "cleanup c.example.com",
+			},
+			expectedMaxPresented: 3,
+		},
+		{
+			desc:   "serial",
+			serial: true,
+			expected: []string{
+				"present a.example.com", "validate a.example.com", "cleanup a.example.com",
+				"present b.example.com", "validate b.example.com", "cleanup b.example.com",
+				"present c.example.com", "validate c.example.com", "cleanup c.example.com",
+			},
+			expectedMaxPresented: 1,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			mock := &recorderSolverMock{}
+
+			solverManager := &SolverManager{solvers: map[challenge.Type]solver{challenge.HTTP01: mock}}
+			solverManager.SetSerialAuthz(test.serial)
+
+			prober := &Prober{solverManager: solverManager}
+
+			err := prober.Solve([]acme.Authorization{
+				createStubAuthorizationHTTP01("a.example.com", acme.StatusProcessing),
+				createStubAuthorizationHTTP01("b.example.com", acme.StatusProcessing),
+				createStubAuthorizationHTTP01("c.example.com", acme.StatusProcessing),
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, mock.calls)
+			assert.Equal(t, test.expectedMaxPresented, mock.maxPresented)
+		})
+	}
+}
+
+func TestProber_Solve_serialAuthz_maxConcurrentAuthz(t *testing.T) {
+	mock := &countingSolverMock{}
+
+	solverManager := &SolverManager{solvers: map[challenge.Type]solver{challenge.HTTP01: mock}}
+	solverManager.SetSerialAuthz(true)
+
+	err := solverManager.SetMaxConcurrentAuthz(3)
+	require.NoError(t, err)
+
+	var authz []acme.Authorization
+	for i := range 10 {
+		authz = append(authz, createStubAuthorizationHTTP01(fmt.Sprintf("%d.example.com", i), acme.StatusProcessing))
+	}
+
+	prober := &Prober{solverManager: solverManager}
+
+	err = prober.Solve(authz)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), mock.max.Load())
+}
+
+func TestProber_Solve_presentGraceDelay(t *testing.T) {
+	const delay = 100 * time.Millisecond
+
+	mock := &recorderSolverMock{}
+
+	solverManager := &SolverManager{solvers: map[challenge.Type]solver{challenge.HTTP01: mock}}
+	solverManager.SetPresentGraceDelay(delay)
+
+	prober := &Prober{solverManager: solverManager}
+
+	start := time.Now()
+
+	err := prober.Solve([]acme.Authorization{
+		createStubAuthorizationHTTP01("a.example.com", acme.StatusProcessing),
+		createStubAuthorizationHTTP01("b.example.com", acme.StatusProcessing),
+		createStubAuthorizationHTTP01("c.example.com", acme.StatusProcessing),
+	})
+	require.NoError(t, err)
+
+	elapsed := time.Since(start)
+
+	// The delay is applied once for the 3 authorizations.
+	assert.GreaterOrEqual(t, elapsed, delay)
+	assert.Less(t, elapsed, 3*delay)
+
+	expected := []string{
+		"present a.example.com", "present b.example.com", "present c.example.com",
+		"validate a.example.com", "validate b.example.com", "validate c.example.com",
+		"cleanup a.example.com", "cleanup b.example.com", "cleanup c.example.com",
+	}
+
+	assert.Equal(t, expected, mock.calls)
+}
+
+func TestSolverManager_SetMaxConcurrentAuthz_invalid(t *testing.T) {
+	err := (&SolverManager{}).SetMaxConcurrentAuthz(-1)
+	require.EqualError(t, err, "invalid maximum number of concurrent authorizations: -1")
+}
+
+func TestProber_SolveContext_canceled(t *testing.T) {
+	prober := &Prober{
+		solverManager: &SolverManager{solvers: map[challenge.Type]solver{
+			challenge.HTTP01: &preSolverMock{
+				preSolve: map[string]error{},
+				solve:    map[string]error{},
+				cleanUp:  map[string]error{},
+			},
+		}},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := prober.SolveContext(ctx, []acme.Authorization{
+		createStubAuthorizationHTTP01("acme.wtf", acme.StatusProcessing),
+	})
+	require.EqualError(t, err, `error: one or more domains had a problem:
+[acme.wtf] context canceled
+`)
+}
+
+func TestProber_Solve_cleanUpErrors(t *testing.T) {
+	newMock := func() preSolverMock {
+		return preSolverMock{
+			preSolve: map[string]error{},
+			solve: map[string]error{
+				"lego.wtf": errors.New("solve error lego.wtf"),
+			},
+			cleanUp: map[string]error{
+				"acme.wtf": errors.New("clean error acme.wtf"),
+				"lego.wtf": errors.New("clean error lego.wtf"),
+			},
+		}
+	}
+
+	testCases := []struct {
+		desc          string
+		solver        solver
+		fatal         bool
+		authz         []acme.Authorization
+		expectedError string
+	}{
+		{
+			desc:   "not fatal",
+			solver: &preSolverMock{cleanUp: map[string]error{"acme.wtf": errors.New("clean error acme.wtf")}},
+			authz: []acme.Authorization{
+				createStubAuthorizationHTTP01("acme.wtf", acme.StatusProcessing),
+				createStubAuthorizationHTTP01("mydomain.wtf", acme.StatusProcessing),
+			},
+		},
+		{
+			desc:   "not fatal with other errors",
+			solver: &preSolverMock{solve: map[string]error{"lego.wtf": errors.New("solve error lego.wtf")}, cleanUp: map[string]error{"acme.wtf": errors.New("clean error acme.wtf")}},
+			authz: []acme.Authorization{
+				createStubAuthorizationHTTP01("acme.wtf", acme.StatusProcessing),
+				createStubAuthorizationHTTP01("lego.wtf", acme.StatusProcessing),
+			},
+			expectedError: `error: one or more domains had a problem:
+[lego.wtf] solve error lego.wtf
+`,
+		},
+		{
+			desc:   "fatal",
+			solver: &preSolverMock{cleanUp: map[string]error{"acme.wtf": errors.New("clean error acme.wtf")}},
+			fatal:  true,
+			authz: []acme.Authorization{
+				createStubAuthorizationHTTP01("acme.wtf", acme.StatusProcessing),
+				createStubAuthorizationHTTP01("mydomain.wtf", acme.StatusProcessing),
+			},
+			expectedError: `error: the cleanup of one or more domains failed:
+[acme.wtf] clean error acme.wtf
+`,
+		},
+		{
+			desc: "fatal with other errors",
+			solver: func() solver {
+				mock := newMock()
+				return &mock
+			}(),
+			fatal: true,
+			authz: []acme.Authorization{
+				createStubAuthorizationHTTP01("acme.wtf", acme.StatusProcessing),
+				createStubAuthorizationHTTP01("lego.wtf", acme.StatusProcessing),
+			},
+			expectedError: `error: one or more domains had a problem:
+[lego.wtf] solve error lego.wtf
+
+error: the cleanup of one or more domains failed:
+[acme.wtf] clean error acme.wtf
+[lego.wtf] clean error lego.wtf
+`,
+		},
+		{
+			desc:   "fatal sequential",
+			solver: &sequentialSolverMock{preSolverMock: newMock()},
+			fatal:  true,
+			authz: []acme.Authorization{
+				createStubAuthorizationHTTP01("acme.wtf", acme.StatusProcessing),
+				createStubAuthorizationHTTP01("lego.wtf", acme.StatusProcessing),
+			},
+			expectedError: `error: one or more domains had a problem:
+[lego.wtf] solve error lego.wtf
+
+error: the cleanup of one or more domains failed:
+[acme.wtf] clean error acme.wtf
+[lego.wtf] clean error lego.wtf
+`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			solverManager := &SolverManager{solvers: map[challenge.Type]solver{challenge.HTTP01: test.solver}}
+			solverManager.SetCleanUpErrorsFatal(test.fatal)
+
+			prober := &Prober{solverManager: solverManager}
+
+			err := prober.Solve(test.authz)
+			if test.expectedError == "" {
 				require.NoError(t, err)
+				return
 			}
+
+			require.EqualError(t, err, test.expectedError)
+
+			var cleanUpErr *CleanUpError
+			assert.Equal(t, test.fatal, errors.As(err, &cleanUpErr))
+		})
+	}
+}
+
+func TestProber_Solve_dnsDisableCleanUp(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		disable  bool
+		expected int32
+	}{
+		{
+			desc:     "cleanup enabled",
+			expected: 1,
+		},
+		{
+			desc:    "cleanup disabled",
+			disable: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			_, apiURL := tester.SetupFakeAPI(t)
+
+			privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+			require.NoError(t, err)
+
+			core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+			require.NoError(t, err)
+
+			provider := &dnsProviderMock{}
+
+			manager := NewSolversManager(core)
+			manager.SetDNSDisableCleanUp(test.disable)
+
+			err = manager.SetDNS01Provider(provider)
+			require.NoError(t, err)
+
+			prober := NewProber(manager)
+
+			err = prober.Solve([]acme.Authorization{createStubAuthorizationDNS01("example.com")})
+			require.ErrorContains(t, err, "present failed")
+
+			assert.Equal(t, test.expected, provider.cleanUps.Load())
 		})
 	}
 }
@@ -3,8 +3,8 @@ package resolver
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
-	"strconv"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -15,6 +15,7 @@ import (
 	"github.com/go-acme/lego/v4/challenge/http01"
 	"github.com/go-acme/lego/v4/challenge/tlsalpn01"
 	"github.com/go-acme/lego/v4/log"
+	"github.com/go-acme/lego/v4/platform/wait"
 )
 
 type byType []acme.Challenge
@@ -26,6 +27,16 @@ func (a byType) Less(i, j int) bool { return a[i].Type > a[j].Type }
 type SolverManager struct {
 	core    *api.Core
 	solvers map[challenge.Type]solver
+
+	preferredChallenges []challenge.Type
+
+	maxConcurrentAuthz int
+	serialAuthz        bool
+	cleanUpErrorsFatal bool
+	dnsDisableCleanUp  bool
+	presentGraceDelay  time.Duration
+
+	dnsPropagationCheckDisabled bool
 }
 
 func NewSolversManager(core *api.Core) *SolverManager {
@@ -49,10 +60,80 @@ func (c *SolverManager) SetTLSALPN01Provider(p challenge.Provider) error {
 
 // SetDNS01Provider specifies a custom provider p that can solve the given DNS-01 challenge.
 func (c *SolverManager) SetDNS01Provider(p challenge.Provider, opts ...dns01.ChallengeOption) error {
+	opts = append(opts, dns01.CondOption(c.dnsPropagationCheckDisabled, dns01.DisablePropagationCheck()))
+
 	c.solvers[challenge.DNS01] = dns01.NewChallenge(c.core, validate, p, opts...)
 	return nil
 }
 
+// SetChallengePreference defines the order of preference of the challenge types,
+// used when an authorization offers several challenges with a configured solver.
+// The types not listed are tried after the listed ones, in the default order (tls-alpn-01, http-01, dns-01).
+func (c *SolverManager) SetChallengePreference(types ...challenge.Type) {
+	c.preferredChallenges = types
+}
+
+// SetMaxConcurrentAuthz limits the number of authorizations solved concurrently.
+// When n is 0 (default), the authorizations are solved one after the other.
+// Only the DNS-01 challenges are solved concurrently:
+// the HTTP-01 and TLS-ALPN-01 challenges are always solved one after the other, because their servers listen on a single address.
+// The authorizations solved by a sequential provider (a DNS provider with a Sequential method) are not affected.
+func (c *SolverManager) SetMaxConcurrentAuthz(n int) error {
+	if n < 0 {
+		return fmt.Errorf("invalid maximum number of concurrent authorizations: %d", n)
+	}
+
+	c.maxConcurrentAuthz = n
+
+	return nil
+}
+
+// SetSerialAuthz enables the resolution of the authorizations one at a time:
+// the challenge of an authorization is presented, validated by the CA, and cleaned up before moving to the next authorization.
+// By default, all the challenges are presented before asking the CA to validate them.
+// When enabled, the maximum number of concurrent authorizations (see SetMaxConcurrentAuthz) is ignored.
+func (c *SolverManager) SetSerialAuthz(serial bool) {
+	c.serialAuthz = serial
+}
+
+// SetPresentGraceDelay defines a delay to wait once all the challenges are presented,
+// before the propagation checks and the validation by the CA,
+// for backends reporting success before their servers answer with the new records.
+// The delay is applied once for all the authorizations, not for each record.
+// In serial mode (see SetSerialAuthz), it is applied after the presentation of each authorization.
+func (c *SolverManager) SetPresentGraceDelay(d time.Duration) {
+	c.presentGraceDelay = d
+}
+
+// SetCleanUpErrorsFatal defines if the cleanup errors make the resolution fail.
+// By default, the cleanup errors are aggregated and logged as a warning,
+// when fatal is true, a *CleanUpError is returned.
+// The HTTP-01 and TLS-ALPN-01 challenges are cleaned up by their own solver, so their cleanup errors are only logged.
+func (c *SolverManager) SetCleanUpErrorsFatal(fatal bool) {
+	c.cleanUpErrorsFatal = fatal
+}
+
+// SetDNSDisableCleanUp disables the cleanup of the DNS-01 challenges:
+// the TXT records are kept after the resolution, whatever its result, to allow inspecting them.
+// The records must be removed manually.
+func (c *SolverManager) SetDNSDisableCleanUp(disable bool) {
+	c.dnsDisableCleanUp = disable
+}
+
+// SetDNSPropagationCheckDisabled disables the propagation check of the DNS-01 challenges (enabled by default):
+// the CA is notified right after the creation of the TXT record, and the validation by the CA is still polled.
+// See dns01.DisablePropagationCheck.
+// It applies to the DNS-01 provider already defined, and to the ones defined afterward with SetDNS01Provider:
+// passing false restores the propagation check of the DNS-01 provider already defined.
+func (c *SolverManager) SetDNSPropagationCheckDisabled(disabled bool) {
+	c.dnsPropagationCheckDisabled = disabled
+
+	if chlg, ok := c.solvers[challenge.DNS01].(*dns01.Challenge); ok {
+		// The option never fails.
+		_ = dns01.SetPropagationCheckDisabled(disabled)(chlg)
+	}
+}
+
 // Remove removes a challenge type from the available solvers.
 func (c *SolverManager) Remove(chlgType challenge.Type) {
 	delete(c.solvers, chlgType)
@@ -63,19 +144,49 @@ func (c *SolverManager) chooseSolver(authz acme.Authorization) solver {
 	// Allow to have a deterministic challenge order
 	sort.Sort(byType(authz.Challenges))
 
+	if len(c.preferredChallenges) > 0 {
+		sort.SliceStable(authz.Challenges, func(i, j int) bool {
+			return c.challengeRank(authz.Challenges[i]) < c.challengeRank(authz.Challenges[j])
+		})
+	}
+
 	domain := challenge.GetTargetedDomain(authz)
 	for _, chlg := range authz.Challenges {
+		// The DNS-01 challenge cannot be used to validate an IP address (RFC 8738 section 7).
+		if authz.Identifier.Type == "ip" && challenge.Type(chlg.Type) == challenge.DNS01 {
+			log.Info("acme: skip the solver for an IP address", "domain", domain, "challenge", chlg.Type)
+			continue
+		}
+
 		if solvr, ok := c.solvers[challenge.Type(chlg.Type)]; ok {
-			log.Infof("[%s] acme: use %s solver", domain, chlg.Type)
+			log.Info("acme: use solver", "domain", domain, "challenge", chlg.Type)
 			return solvr
 		}
-		log.Infof("[%s] acme: Could not find solver for: %s", domain, chlg.Type)
+		log.Info("acme: Could not find solver", "domain", domain, "challenge", chlg.Type)
 	}
 
 	return nil
 }
 
+// challengeRank returns the position of the challenge type in the order of preference.
+func (c *SolverManager) challengeRank(chlg acme.Challenge) int {
+	index := slices.Index(c.preferredChallenges, challenge.Type(chlg.Type))
+	if index < 0 {
+		return len(c.preferredChallenges)
+	}
+
+	return index
+}
+
+// maxPollRetryAfter caps the wait between two polls of an authorization requested by the CA with a Retry-After header.
+const maxPollRetryAfter = time.Minute
+
 func validate(core *api.Core, domain string, chlg acme.Challenge) error {
+	return validateWithTimer(core, domain, chlg, nil)
+}
+
+// validateWithTimer is like validate, but the waits between the polls use the timer (the default timer when nil).
+func validateWithTimer(core *api.Core, domain string, chlg acme.Challenge, timer backoff.Timer) error {
 	chlng, err := core.Challenges.New(chlg.URL)
 	if err != nil {
 		return fmt.Errorf("failed to initiate challenge: %w", err)
@@ -87,47 +198,51 @@ func validate(core *api.Core, domain string, chlg acme.Challenge) error {
 	}
 
 	if valid {
-		log.Infof("[%s] The server validated our request", domain)
+		log.Info("acme: The server validated our request", "domain", domain)
 		return nil
 	}
 
-	ra, err := strconv.Atoi(chlng.RetryAfter)
-	if err != nil {
-		// The ACME server MUST return a Retry-After.
-		// If it doesn't, we'll just poll hard.
-		// Boulder does not implement the ability to retry challenges or the Retry-After header.
-		// https://github.com/letsencrypt/boulder/blob/master/docs/acme-divergences.md#section-82
-		ra = 5
+	// The ACME server MUST return a Retry-After.
+	// If it doesn't, we'll just poll hard.
+	// Boulder does not implement the ability to retry challenges or the Retry-After header.
+	// https://github.com/letsencrypt/boulder/blob/master/docs/acme-divergences.md#section-82
+	initialInterval := 5 * time.Second
+	if ra, ok := api.ParseRetryAfter(chlng.RetryAfter); ok && ra > 0 {
+		initialInterval = min(ra, maxPollRetryAfter)
 	}
-	initialInterval := time.Duration(ra) * time.Second
 
 	bo := backoff.NewExponentialBackOff()
 	bo.InitialInterval = initialInterval
 	bo.MaxInterval = 10 * initialInterval
 	bo.MaxElapsedTime = 100 * initialInterval
 
+	rab := &wait.RetryAfterBackOff{BackOff: bo, MaxRetryAfter: maxPollRetryAfter}
+
 	// After the path is sent, the ACME server will access our server.
 	// Repeatedly check the server for an updated status on our request.
 	operation := func() error {
-		authz, err := core.Authorizations.Get(chlng.AuthorizationURL)
+		authz, err := core.Authorizations.Poll(chlng.AuthorizationURL)
 		if err != nil {
 			return backoff.Permanent(err)
 		}
 
-		valid, err := checkAuthorizationStatus(authz)
+		retryAfter, _ := api.ParseRetryAfter(authz.RetryAfter)
+		rab.SetRetryAfter(retryAfter)
+
+		valid, err := checkAuthorizationStatus(authz.Authorization)
 		if err != nil {
 			return backoff.Permanent(err)
 		}
 
 		if valid {
-			log.Infof("[%s] The server validated our request", domain)
+			log.Info("acme: The server validated our request", "domain", domain)
 			return nil
 		}
 
 		return errors.New("the server didn't respond to our request")
 	}
 
-	return backoff.Retry(operation, bo)
+	return backoff.RetryNotifyWithTimer(operation, rab, nil, timer)
 }
 
 func checkChallengeStatus(chlng acme.ExtendedChallenge) (bool, error) {
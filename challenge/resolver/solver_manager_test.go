@@ -3,14 +3,18 @@ package resolver
 import (
 	"crypto/rand"
 	"crypto/rsa"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/go-acme/lego/v4/acme"
 	"github.com/go-acme/lego/v4/acme/api"
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/tester"
 	"github.com/go-jose/go-jose/v4"
 	"github.com/stretchr/testify/assert"
@@ -31,6 +35,128 @@ func TestByType(t *testing.T) {
 	assert.Equal(t, expected, challenges)
 }
 
+func TestSolverManager_chooseSolver(t *testing.T) {
+	dnsSolver := &preSolverMock{}
+	httpSolver := &preSolverMock{}
+
+	manager := &SolverManager{solvers: map[challenge.Type]solver{
+		challenge.DNS01:  dnsSolver,
+		challenge.HTTP01: httpSolver,
+	}}
+
+	testCases := []struct {
+		desc       string
+		identifier acme.Identifier
+		challenges []acme.Challenge
+		expected   solver
+	}{
+		{
+			desc:       "DNS name",
+			identifier: acme.Identifier{Type: "dns", Value: "example.com"},
+			challenges: []acme.Challenge{{Type: "dns-01"}},
+			expected:   dnsSolver,
+		},
+		{
+			desc:       "IP address",
+			identifier: acme.Identifier{Type: "ip", Value: "192.0.2.1"},
+			challenges: []acme.Challenge{{Type: "dns-01"}, {Type: "http-01"}},
+			expected:   httpSolver,
+		},
+		{
+			desc:       "IP address without usable challenge",
+			identifier: acme.Identifier{Type: "ip", Value: "192.0.2.1"},
+			challenges: []acme.Challenge{{Type: "dns-01"}},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			authz := acme.Authorization{Identifier: test.identifier, Challenges: test.challenges}
+
+			solvr := manager.chooseSolver(authz)
+
+			if test.expected == nil {
+				assert.Nil(t, solvr)
+			} else {
+				assert.Same(t, test.expected, solvr)
+			}
+		})
+	}
+}
+
+func TestSolverManager_chooseSolver_preference(t *testing.T) {
+	dnsSolver := &preSolverMock{}
+	httpSolver := &preSolverMock{}
+	tlsSolver := &preSolverMock{}
+
+	testCases := []struct {
+		desc       string
+		preference []challenge.Type
+		expected   solver
+	}{
+		{
+			desc:     "default",
+			expected: tlsSolver,
+		},
+		{
+			desc:       "DNS-01 first",
+			preference: []challenge.Type{challenge.DNS01},
+			expected:   dnsSolver,
+		},
+		{
+			desc:       "HTTP-01 then DNS-01",
+			preference: []challenge.Type{challenge.HTTP01, challenge.DNS01},
+			expected:   httpSolver,
+		},
+		{
+			desc:       "unknown challenge type first",
+			preference: []challenge.Type{"foo-01", challenge.DNS01},
+			expected:   dnsSolver,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			manager := &SolverManager{solvers: map[challenge.Type]solver{
+				challenge.DNS01:     dnsSolver,
+				challenge.HTTP01:    httpSolver,
+				challenge.TLSALPN01: tlsSolver,
+			}}
+
+			manager.SetChallengePreference(test.preference...)
+
+			authz := acme.Authorization{
+				Identifier: acme.Identifier{Type: "dns", Value: "example.com"},
+				Challenges: []acme.Challenge{{Type: "http-01"}, {Type: "dns-01"}, {Type: "tls-alpn-01"}},
+			}
+
+			solvr := manager.chooseSolver(authz)
+
+			assert.Same(t, test.expected, solvr)
+		})
+	}
+}
+
+func TestSolverManager_chooseSolver_preferenceNotConfigured(t *testing.T) {
+	httpSolver := &preSolverMock{}
+
+	manager := &SolverManager{solvers: map[challenge.Type]solver{
+		challenge.HTTP01: httpSolver,
+	}}
+
+	// The preferred challenge type has no solver.
+	manager.SetChallengePreference(challenge.DNS01)
+
+	authz := acme.Authorization{
+		Identifier: acme.Identifier{Type: "dns", Value: "example.com"},
+		Challenges: []acme.Challenge{{Type: "dns-01"}, {Type: "http-01"}},
+	}
+
+	solvr := manager.chooseSolver(authz)
+
+	assert.Same(t, httpSolver, solvr)
+}
+
 func TestValidate(t *testing.T) {
 	mux, apiURL := tester.SetupFakeAPI(t)
 
@@ -148,6 +274,86 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidate_retryAfter(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		retryAfter string
+		expected   []time.Duration
+	}{
+		{
+			desc:       "longer than the backoff",
+			retryAfter: "3",
+			expected:   []time.Duration{3 * time.Second, 3 * time.Second},
+		},
+		{
+			desc:       "capped",
+			retryAfter: "3600",
+			expected:   []time.Duration{maxPollRetryAfter, maxPollRetryAfter},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			mux, apiURL := tester.SetupFakeAPI(t)
+
+			privateKey, _ := rsa.GenerateKey(rand.Reader, 512)
+
+			mux.HandleFunc("POST /chlg", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Link", "<"+apiURL+`/my-authz>; rel="up"`)
+				w.Header().Set("Retry-After", "1")
+
+				err := tester.WriteJSONResponse(w, &acme.Challenge{Type: "http-01", Status: acme.StatusPending, URL: apiURL + "/chlg", Token: "token"})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+				}
+			})
+
+			statuses := []string{acme.StatusPending, acme.StatusPending, acme.StatusValid}
+
+			mux.HandleFunc("POST /my-authz", func(w http.ResponseWriter, r *http.Request) {
+				st := statuses[0]
+				statuses = statuses[1:]
+
+				w.Header().Set("Retry-After", test.retryAfter)
+
+				err := tester.WriteJSONResponse(w, acme.Authorization{Status: st, Challenges: []acme.Challenge{}})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+				}
+			})
+
+			core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+			require.NoError(t, err)
+
+			timer := &fakeTimer{}
+
+			err = validateWithTimer(core, "example.com", acme.Challenge{Type: "http-01", Token: "token", URL: apiURL + "/chlg"}, timer)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, timer.waits)
+		})
+	}
+}
+
+// fakeTimer is a backoff.Timer recording the waits, and firing immediately.
+type fakeTimer struct {
+	waits []time.Duration
+	c     chan time.Time
+}
+
+func (f *fakeTimer) Start(duration time.Duration) {
+	f.waits = append(f.waits, duration)
+
+	f.c = make(chan time.Time, 1)
+	f.c <- time.Now()
+}
+
+func (f *fakeTimer) Stop() {}
+
+func (f *fakeTimer) C() <-chan time.Time {
+	return f.c
+}
+
 // validateNoBody reads the http.Request POST body, parses the JWS and validates it to read the body.
 // If there is an error doing this,
 // or if the JWS body is not the empty JSON payload "{}" or a POST-as-GET payload "" an error is returned.
@@ -177,3 +383,116 @@ func validateNoBody(privateKey *rsa.PrivateKey, r *http.Request) error {
 	}
 	return nil
 }
+
+func TestSolverManager_SetDNSPropagationCheckDisabled(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		before bool
+	}{
+		{
+			desc:   "before the provider",
+			before: true,
+		},
+		{
+			desc: "after the provider",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			mux, apiURL := tester.SetupFakeAPI(t)
+
+			mux.HandleFunc("POST /chlg", func(w http.ResponseWriter, _ *http.Request) {
+				err := tester.WriteJSONResponse(w, &acme.Challenge{Type: "dns-01", Status: acme.StatusValid, URL: apiURL + "/chlg", Token: "token"})
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+				}
+			})
+
+			privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+			require.NoError(t, err)
+
+			core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+			require.NoError(t, err)
+
+			var preChecks int
+
+			preCheck := func(_, _, _ string, _ dns01.PreCheckFunc) (bool, error) {
+				preChecks++
+				return false, errors.New("OOPS")
+			}
+
+			manager := NewSolversManager(core)
+
+			if test.before {
+				manager.SetDNSPropagationCheckDisabled(true)
+			}
+
+			err = manager.SetDNS01Provider(&dnsProviderTimeoutMock{}, dns01.WrapPreCheck(preCheck))
+			require.NoError(t, err)
+
+			if !test.before {
+				manager.SetDNSPropagationCheckDisabled(true)
+			}
+
+			authz := createStubAuthorizationDNS01("example.com")
+			authz.Challenges[0].URL = apiURL + "/chlg"
+
+			err = manager.solvers[challenge.DNS01].Solve(authz)
+			require.NoError(t, err)
+
+			assert.Equal(t, 0, preChecks)
+		})
+	}
+}
+
+func TestSolverManager_SetDNSPropagationCheckDisabled_restored(t *testing.T) {
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	mux.HandleFunc("POST /chlg", func(w http.ResponseWriter, _ *http.Request) {
+		err := tester.WriteJSONResponse(w, &acme.Challenge{Type: "dns-01", Status: acme.StatusValid, URL: apiURL + "/chlg", Token: "token"})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, err)
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+	require.NoError(t, err)
+
+	var preChecks int
+
+	preCheck := func(_, _, _ string, _ dns01.PreCheckFunc) (bool, error) {
+		preChecks++
+		return true, nil
+	}
+
+	manager := NewSolversManager(core)
+
+	err = manager.SetDNS01Provider(&dnsProviderTimeoutMock{}, dns01.WrapPreCheck(preCheck))
+	require.NoError(t, err)
+
+	manager.SetDNSPropagationCheckDisabled(true)
+	manager.SetDNSPropagationCheckDisabled(false)
+
+	authz := createStubAuthorizationDNS01("example.com")
+	authz.Challenges[0].URL = apiURL + "/chlg"
+
+	err = manager.solvers[challenge.DNS01].Solve(authz)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, preChecks)
+}
+
+// dnsProviderTimeoutMock is a DNS provider with a short propagation timeout.
+type dnsProviderTimeoutMock struct{}
+
+func (p *dnsProviderTimeoutMock) Present(_, _, _ string) error { return nil }
+
+func (p *dnsProviderTimeoutMock) CleanUp(_, _, _ string) error { return nil }
+
+func (p *dnsProviderTimeoutMock) Timeout() (timeout, interval time.Duration) {
+	return 100 * time.Millisecond, 10 * time.Millisecond
+}
@@ -0,0 +1,111 @@
+package tlsalpn01
+
+import (
+	"crypto/tls"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/miekg/dns"
+)
+
+// CertificateProvider implements ChallengeProvider for `TLS-ALPN-01` challenge without binding a socket:
+// the challenge certificates are served by an existing TLS listener through GetCertificate.
+//
+//	provider := tlsalpn01.NewCertificateProvider()
+//	listener, err := tls.Listen("tcp", ":443", provider.TLSConfig(myTLSConfig))
+type CertificateProvider struct {
+	mu    sync.RWMutex
+	certs map[string]*tls.Certificate
+}
+
+// NewCertificateProvider creates a new CertificateProvider.
+func NewCertificateProvider() *CertificateProvider {
+	return &CertificateProvider{certs: make(map[string]*tls.Certificate)}
+}
+
+// Present generates the challenge certificate of the domain, and makes it available to GetCertificate.
+func (p *CertificateProvider) Present(domain, token, keyAuth string) error {
+	cert, err := ChallengeCert(domain, keyAuth)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.certs[serverName(domain)] = cert
+	p.mu.Unlock()
+
+	return nil
+}
+
+// CleanUp removes the challenge certificate of the domain.
+func (p *CertificateProvider) CleanUp(domain, token, keyAuth string) error {
+	p.mu.Lock()
+	delete(p.certs, serverName(domain))
+	p.mu.Unlock()
+
+	return nil
+}
+
+// GetCertificate returns the challenge certificate matching the ClientHello, it can be used as tls.Config.GetCertificate.
+//
+// A ClientHello matches if it offers the `acme-tls/1` protocol (ALPN),
+// and if its server name (SNI) is a domain with a pending challenge.
+// For an IP address, the server name is the reverse DNS name of the IP (RFC 8738 section 6).
+//
+// When the ClientHello doesn't match, no certificate and no error are returned,
+// so the tls.Config falls back to its own certificates.
+func (p *CertificateProvider) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if !slices.Contains(hello.SupportedProtos, ACMETLS1Protocol) {
+		return nil, nil
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.certs[strings.ToLower(strings.TrimSuffix(hello.ServerName, "."))], nil
+}
+
+// TLSConfig returns a copy of the base configuration (can be nil) able to serve the challenge certificates.
+// The `acme-tls/1` protocol is added to the NextProtos,
+// and the challenge certificates take precedence over the certificates of the base configuration.
+func (p *CertificateProvider) TLSConfig(base *tls.Config) *tls.Config {
+	var tlsConf *tls.Config
+	if base == nil {
+		tlsConf = new(tls.Config)
+	} else {
+		tlsConf = base.Clone()
+	}
+
+	if !slices.Contains(tlsConf.NextProtos, ACMETLS1Protocol) {
+		tlsConf.NextProtos = append(tlsConf.NextProtos, ACMETLS1Protocol)
+	}
+
+	next := tlsConf.GetCertificate
+
+	tlsConf.GetCertificate = func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+		cert, err := p.GetCertificate(hello)
+		if cert != nil || err != nil || next == nil {
+			return cert, err
+		}
+
+		return next(hello)
+	}
+
+	return tlsConf
+}
+
+// serverName returns the server name (SNI) used by the CA to validate the domain.
+func serverName(domain string) string {
+	if net.ParseIP(domain) == nil {
+		return strings.ToLower(domain)
+	}
+
+	rd, err := dns.ReverseAddr(domain)
+	if err != nil {
+		return domain
+	}
+
+	return strings.TrimSuffix(rd, ".")
+}
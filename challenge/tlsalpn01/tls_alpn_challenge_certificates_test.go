@@ -0,0 +1,197 @@
+package tlsalpn01
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/asn1"
+	"net/http"
+	"testing"
+
+	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/acme/api"
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func assertChallengeCert(t *testing.T, cert *x509.Certificate, keyAuth string) {
+	t.Helper()
+
+	zBytes := sha256.Sum256([]byte(keyAuth))
+	value, err := asn1.Marshal(zBytes[:sha256.Size])
+	require.NoError(t, err)
+
+	for _, ext := range cert.Extensions {
+		if idPeAcmeIdentifierV1.Equal(ext.Id) {
+			assert.True(t, ext.Critical, "Expected the challenge certificate id-pe-acmeIdentifier extension to be marked as critical")
+			assert.Equal(t, value, ext.Value, "Expected the challenge certificate id-pe-acmeIdentifier extension to contain the SHA-256 digest of the keyAuth")
+
+			return
+		}
+	}
+
+	t.Error("Expected the challenge certificate to contain an extension with the id-pe-acmeIdentifier id")
+}
+
+func TestCertificateProvider_GetCertificate(t *testing.T) {
+	provider := NewCertificateProvider()
+
+	err := provider.Present("example.com", "token", "keyAuthA")
+	require.NoError(t, err)
+
+	err = provider.Present("127.0.0.1", "token", "keyAuthB")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc       string
+		hello      *tls.ClientHelloInfo
+		expected   string
+		expectedIP bool
+	}{
+		{
+			desc:     "domain",
+			hello:    &tls.ClientHelloInfo{ServerName: "example.com", SupportedProtos: []string{ACMETLS1Protocol}},
+			expected: "keyAuthA",
+		},
+		{
+			desc:     "domain case insensitive",
+			hello:    &tls.ClientHelloInfo{ServerName: "EXAMPLE.com", SupportedProtos: []string{ACMETLS1Protocol}},
+			expected: "keyAuthA",
+		},
+		{
+			desc:       "IP address",
+			hello:      &tls.ClientHelloInfo{ServerName: "1.0.0.127.in-addr.arpa", SupportedProtos: []string{ACMETLS1Protocol}},
+			expected:   "keyAuthB",
+			expectedIP: true,
+		},
+		{
+			desc:  "without acme-tls/1",
+			hello: &tls.ClientHelloInfo{ServerName: "example.com", SupportedProtos: []string{"h2", "http/1.1"}},
+		},
+		{
+			desc:  "unknown server name",
+			hello: &tls.ClientHelloInfo{ServerName: "example.org", SupportedProtos: []string{ACMETLS1Protocol}},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			cert, err := provider.GetCertificate(test.hello)
+			require.NoError(t, err)
+
+			if test.expected == "" {
+				assert.Nil(t, cert)
+				return
+			}
+
+			require.NotNil(t, cert)
+
+			leaf, err := x509.ParseCertificate(cert.Certificate[0])
+			require.NoError(t, err)
+
+			if test.expectedIP {
+				assert.Len(t, leaf.IPAddresses, 1)
+			} else {
+				assert.Equal(t, []string{"example.com"}, leaf.DNSNames)
+			}
+
+			assertChallengeCert(t, leaf, test.expected)
+		})
+	}
+
+	err = provider.CleanUp("example.com", "token", "keyAuthA")
+	require.NoError(t, err)
+
+	cert, err := provider.GetCertificate(&tls.ClientHelloInfo{ServerName: "example.com", SupportedProtos: []string{ACMETLS1Protocol}})
+	require.NoError(t, err)
+	assert.Nil(t, cert)
+}
+
+func TestCertificateProvider_TLSConfig(t *testing.T) {
+	_, apiURL := tester.SetupFakeAPI(t)
+
+	domain := "localhost"
+
+	provider := NewCertificateProvider()
+
+	fallback, err := ChallengeCert("fallback.example.com", "fallback")
+	require.NoError(t, err)
+
+	// The existing TLS listener.
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", provider.TLSConfig(&tls.Config{
+		Certificates: []tls.Certificate{*fallback},
+		NextProtos:   []string{"http/1.1"},
+	}))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, errA := listener.Accept()
+			if errA != nil {
+				return
+			}
+
+			_ = conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+
+	mockValidate := func(_ *api.Core, _ string, chlng acme.Challenge) error {
+		conn, errD := tls.Dial("tcp", listener.Addr().String(), &tls.Config{
+			ServerName:         domain,
+			NextProtos:         []string{ACMETLS1Protocol},
+			InsecureSkipVerify: true,
+		})
+		require.NoError(t, errD)
+
+		defer func() { _ = conn.Close() }()
+
+		connState := conn.ConnectionState()
+		assert.Equal(t, ACMETLS1Protocol, connState.NegotiatedProtocol)
+		require.Len(t, connState.PeerCertificates, 1)
+		assert.Equal(t, []string{domain}, connState.PeerCertificates[0].DNSNames)
+
+		assertChallengeCert(t, connState.PeerCertificates[0], chlng.KeyAuthorization)
+
+		return nil
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, err, "Could not generate test key")
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", privateKey)
+	require.NoError(t, err)
+
+	solver := NewChallenge(core, mockValidate, provider)
+
+	authz := acme.Authorization{
+		Identifier: acme.Identifier{
+			Type:  "dns",
+			Value: domain,
+		},
+		Challenges: []acme.Challenge{
+			{Type: challenge.TLSALPN01.String(), Token: "tlsalpn1"},
+		},
+	}
+
+	err = solver.Solve(authz)
+	require.NoError(t, err)
+
+	// The other connections use the certificates of the base configuration.
+	conn, err := tls.Dial("tcp", listener.Addr().String(), &tls.Config{
+		ServerName:         domain,
+		NextProtos:         []string{"http/1.1"},
+		InsecureSkipVerify: true,
+	})
+	require.NoError(t, err)
+
+	defer func() { _ = conn.Close() }()
+
+	assert.Equal(t, []string{"fallback.example.com"}, conn.ConnectionState().PeerCertificates[0].DNSNames)
+}
@@ -8,10 +8,12 @@ import (
 	"errors"
 	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
 	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/go-acme/lego/v4/certificate/storage"
 	"github.com/go-acme/lego/v4/lego"
 	"github.com/go-acme/lego/v4/log"
 	"github.com/go-acme/lego/v4/registration"
@@ -64,6 +66,14 @@ type AccountsStorage struct {
 	keysPath        string
 	accountFilePath string
 	ctx             *cli.Context
+
+	// keyStore stores the private key of the account (the keys folder, or Vault).
+	keyStore    storage.KeyStore
+	keyName     string
+	keyLocation string
+
+	// localKeyStore the keys folder, used to migrate the existing keys to Vault (nil without Vault).
+	localKeyStore *storage.FileKeyStore
 }
 
 // NewAccountsStorage Creates a new AccountsStorage.
@@ -80,15 +90,29 @@ func NewAccountsStorage(ctx *cli.Context) *AccountsStorage {
 	serverPath := strings.NewReplacer(":", "_", "/", string(os.PathSeparator)).Replace(serverURL.Host)
 	accountsPath := filepath.Join(rootPath, serverPath)
 	rootUserPath := filepath.Join(accountsPath, email)
+	keysPath := filepath.Join(rootUserPath, baseKeysFolderName)
 
-	return &AccountsStorage{
+	accountsStorage := &AccountsStorage{
 		userID:          email,
 		rootPath:        rootPath,
 		rootUserPath:    rootUserPath,
-		keysPath:        filepath.Join(rootUserPath, baseKeysFolderName),
+		keysPath:        keysPath,
 		accountFilePath: filepath.Join(rootUserPath, accountFileName),
 		ctx:             ctx,
+		keyStore:        storage.NewFileKeyStore(keysPath),
+		keyName:         email,
+		keyLocation:     filepath.Join(keysPath, email+storage.KeyExt),
+	}
+
+	if vaultStore := getVaultStore(ctx); vaultStore != nil {
+		// The keys of the accounts of the different CA servers are stored in the same secrets engine.
+		accountsStorage.localKeyStore = storage.NewFileKeyStore(keysPath)
+		accountsStorage.keyStore = vaultStore
+		accountsStorage.keyName = path.Join(strings.ReplaceAll(serverURL.Host, ":", "_"), email)
+		accountsStorage.keyLocation = "Vault (" + path.Join(ctx.String("vault.path"), "keys", accountsStorage.keyName) + ")"
 	}
+
+	return accountsStorage
 }
 
 func (s *AccountsStorage) ExistsAccountFilePath() bool {
@@ -153,63 +177,68 @@ func (s *AccountsStorage) LoadAccount(privateKey crypto.PrivateKey) *Account {
 }
 
 func (s *AccountsStorage) GetPrivateKey(keyType certcrypto.KeyType) crypto.PrivateKey {
-	accKeyPath := filepath.Join(s.keysPath, s.userID+".key")
+	keyBytes, err := s.keyStore.LoadKey(s.keyName)
+	if errors.Is(err, storage.ErrNotFound) && s.localKeyStore != nil {
+		keyBytes, err = s.migrateLocalKey()
 
-	if _, err := os.Stat(accKeyPath); os.IsNotExist(err) {
+		if errors.Is(err, storage.ErrNotFound) && s.ExistsAccountFilePath() {
+			// The account is registered with a key that is neither in Vault nor in the keys folder: a new key would not match the account.
+			log.Fatalf("No key found for the existing account %s (%s) in %s or %s.", s.userID, s.accountFilePath, s.keyLocation, s.keysPath)
+		}
+	}
+
+	if errors.Is(err, storage.ErrNotFound) {
 		log.Printf("No key found for account %s. Generating a %s key.", s.userID, keyType)
-		s.createKeysFolder()
 
-		privateKey, err := generatePrivateKey(accKeyPath, keyType)
+		privateKey, err := certcrypto.GeneratePrivateKey(keyType)
 		if err != nil {
 			log.Fatalf("Could not generate RSA private account key for account %s: %v", s.userID, err)
 		}
 
-		log.Printf("Saved key to %s", accKeyPath)
+		err = s.keyStore.SaveKey(s.keyName, certcrypto.PEMEncode(privateKey))
+		if err != nil {
+			log.Fatalf("Could not save the private key of the account %s: %v", s.userID, err)
+		}
+
+		log.Printf("Saved key to %s", s.keyLocation)
 		return privateKey
 	}
 
-	privateKey, err := loadPrivateKey(accKeyPath)
 	if err != nil {
-		log.Fatalf("Could not load RSA private key from file %s: %v", accKeyPath, err)
+		log.Fatalf("Could not load the private key of the account %s from %s: %v", s.userID, s.keyLocation, err)
 	}
 
-	return privateKey
-}
-
-func (s *AccountsStorage) createKeysFolder() {
-	if err := createNonExistingFolder(s.keysPath); err != nil {
-		log.Fatalf("Could not check/create directory for account %s: %v", s.userID, err)
+	privateKey, err := parsePrivateKey(keyBytes)
+	if err != nil {
+		log.Fatalf("Could not load RSA private key from %s: %v", s.keyLocation, err)
 	}
+
+	return privateKey
 }
 
-func generatePrivateKey(file string, keyType certcrypto.KeyType) (crypto.PrivateKey, error) {
-	privateKey, err := certcrypto.GeneratePrivateKey(keyType)
+// migrateLocalKey copies the private key of the keys folder (if any) to the key store (Vault).
+func (s *AccountsStorage) migrateLocalKey() ([]byte, error) {
+	// The local keys are named after the user ID (<email>.key).
+	keyBytes, err := s.localKeyStore.LoadKey(s.userID)
 	if err != nil {
 		return nil, err
 	}
 
-	certOut, err := os.Create(file)
+	err = s.keyStore.SaveKey(s.keyName, keyBytes)
 	if err != nil {
-		return nil, err
+		log.Fatalf("Could not migrate the private key of the account %s to %s: %v", s.userID, s.keyLocation, err)
 	}
-	defer certOut.Close()
 
-	pemKey := certcrypto.PEMBlock(privateKey)
-	err = pem.Encode(certOut, pemKey)
-	if err != nil {
-		return nil, err
-	}
+	log.Printf("Migrated the key of the account %s from %s to %s", s.userID, s.keysPath, s.keyLocation)
 
-	return privateKey, nil
+	return keyBytes, nil
 }
 
-func loadPrivateKey(file string) (crypto.PrivateKey, error) {
-	keyBytes, err := os.ReadFile(file)
-	if err != nil {
-		return nil, err
-	}
-
+func parsePrivateKey(keyBytes []byte) (crypto.PrivateKey, error) {
 	keyBlock, _ := pem.Decode(keyBytes)
+	if keyBlock == nil {
+		return nil, errors.New("no PEM block found")
+	}
 
 	switch keyBlock.Type {
 	case "RSA PRIVATE KEY":
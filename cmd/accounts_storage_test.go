@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/go-acme/lego/v4/certificate/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryKeyStore an in-memory storage.KeyStore (i.e. a Vault store).
+type memoryKeyStore map[string][]byte
+
+func (m memoryKeyStore) LoadKey(name string) ([]byte, error) {
+	key, ok := m[name]
+	if !ok {
+		return nil, fmt.Errorf("key %s: %w", name, storage.ErrNotFound)
+	}
+
+	return key, nil
+}
+
+func (m memoryKeyStore) SaveKey(name string, key []byte) error {
+	m[name] = key
+	return nil
+}
+
+func TestAccountsStorage_GetPrivateKey_migrateLocalKey(t *testing.T) {
+	rootUserPath := t.TempDir()
+	keysPath := filepath.Join(rootUserPath, baseKeysFolderName)
+
+	localKeyStore := storage.NewFileKeyStore(keysPath)
+
+	privateKey, err := certcrypto.GeneratePrivateKey(certcrypto.EC256)
+	require.NoError(t, err)
+
+	err = localKeyStore.SaveKey("user@example.com", certcrypto.PEMEncode(privateKey))
+	require.NoError(t, err)
+
+	vaultStore := memoryKeyStore{}
+
+	accountsStorage := &AccountsStorage{
+		userID:          "user@example.com",
+		rootUserPath:    rootUserPath,
+		keysPath:        keysPath,
+		accountFilePath: filepath.Join(rootUserPath, accountFileName),
+		keyStore:        vaultStore,
+		keyName:         "example.com/user@example.com",
+		keyLocation:     "Vault",
+		localKeyStore:   localKeyStore,
+	}
+
+	key := accountsStorage.GetPrivateKey(certcrypto.EC256)
+
+	assert.Equal(t, privateKey, key)
+	assert.Equal(t, certcrypto.PEMEncode(privateKey), vaultStore["example.com/user@example.com"])
+}
+
+func TestAccountsStorage_GetPrivateKey_vaultKey(t *testing.T) {
+	rootUserPath := t.TempDir()
+
+	privateKey, err := certcrypto.GeneratePrivateKey(certcrypto.EC256)
+	require.NoError(t, err)
+
+	vaultStore := memoryKeyStore{"example.com/user@example.com": certcrypto.PEMEncode(privateKey)}
+
+	accountsStorage := &AccountsStorage{
+		userID:          "user@example.com",
+		rootUserPath:    rootUserPath,
+		keysPath:        filepath.Join(rootUserPath, baseKeysFolderName),
+		accountFilePath: filepath.Join(rootUserPath, accountFileName),
+		keyStore:        vaultStore,
+		keyName:         "example.com/user@example.com",
+		keyLocation:     "Vault",
+		localKeyStore:   storage.NewFileKeyStore(filepath.Join(rootUserPath, baseKeysFolderName)),
+	}
+
+	key := accountsStorage.GetPrivateKey(certcrypto.EC256)
+
+	assert.Equal(t, privateKey, key)
+	assert.NoFileExists(t, filepath.Join(rootUserPath, baseKeysFolderName, "user@example.com"+storage.KeyExt))
+}
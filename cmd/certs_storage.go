@@ -16,24 +16,24 @@ import (
 
 	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/go-acme/lego/v4/certificate"
+	"github.com/go-acme/lego/v4/certificate/storage"
 	"github.com/go-acme/lego/v4/log"
 	"github.com/urfave/cli/v2"
-	"golang.org/x/net/idna"
 	"software.sslmate.com/src/go-pkcs12"
 )
 
 const (
-	baseCertificatesFolderName = "certificates"
-	baseArchivesFolderName     = "archives"
+	baseCertificatesFolderName = storage.CertificatesFolderName
+	baseArchivesFolderName     = storage.ArchivesFolderName
 )
 
 const (
-	issuerExt   = ".issuer.crt"
-	certExt     = ".crt"
-	keyExt      = ".key"
-	pemExt      = ".pem"
-	pfxExt      = ".pfx"
-	resourceExt = ".json"
+	issuerExt   = storage.IssuerExt
+	certExt     = storage.CertExt
+	keyExt      = storage.KeyExt
+	pemExt      = storage.PEMExt
+	pfxExt      = storage.PFXExt
+	resourceExt = storage.ResourceExt
 )
 
 // CertificatesStorage a certificates' storage.
@@ -57,6 +57,9 @@ type CertificatesStorage struct {
 	pfxPassword string
 	pfxFormat   string
 	filename    string // Deprecated
+
+	// certStore (optional) stores a copy of the certificates (Vault).
+	certStore storage.CertStore
 }
 
 // NewCertificatesStorage create a new certificates storage.
@@ -69,7 +72,7 @@ func NewCertificatesStorage(ctx *cli.Context) *CertificatesStorage {
 		log.Fatalf("Invalid PFX format: %s", pfxFormat)
 	}
 
-	return &CertificatesStorage{
+	certsStorage := &CertificatesStorage{
 		rootPath:    filepath.Join(ctx.String("path"), baseCertificatesFolderName),
 		archivePath: filepath.Join(ctx.String("path"), baseArchivesFolderName),
 		pem:         ctx.Bool("pem"),
@@ -78,6 +81,12 @@ func NewCertificatesStorage(ctx *cli.Context) *CertificatesStorage {
 		pfxFormat:   pfxFormat,
 		filename:    ctx.String("filename"),
 	}
+
+	if vaultStore := getVaultStore(ctx); vaultStore != nil {
+		certsStorage.certStore = vaultStore
+	}
+
+	return certsStorage
 }
 
 func (s *CertificatesStorage) CreateRootFolder() {
@@ -135,6 +144,14 @@ func (s *CertificatesStorage) SaveResource(certRes *certificate.Resource) {
 	if err != nil {
 		log.Fatalf("Unable to save CertResource for domain %s\n\t%v", domain, err)
 	}
+
+	// The local files are still written: they are used to renew the certificates.
+	if s.certStore != nil {
+		err = s.certStore.SaveCertificate(certRes)
+		if err != nil {
+			log.Fatalf("Unable to store the certificate for domain %s\n\t%v", domain, err)
+		}
+	}
 }
 
 func (s *CertificatesStorage) ReadResource(domain string) certificate.Resource {
@@ -333,7 +350,7 @@ func getPFXEncoder(pfxFormat string) (*pkcs12.Encoder, error) {
 
 // sanitizedDomain Make sure no funny chars are in the cert names (like wildcards ;)).
 func sanitizedDomain(domain string) string {
-	safe, err := idna.ToASCII(strings.NewReplacer(":", "-", "*", "_").Replace(domain))
+	safe, err := storage.SanitizedDomain(domain)
 	if err != nil {
 		log.Fatal(err)
 	}
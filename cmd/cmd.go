@@ -10,5 +10,6 @@ func CreateCommands() []*cli.Command {
 		createRenew(),
 		createDNSHelp(),
 		createList(),
+		createProviders(),
 	}
 }
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/providers/dns"
+	"github.com/urfave/cli/v2"
+)
+
+// manualDNSProviderInfo describes the manual DNS provider, which has no documentation to generate its description.
+var manualDNSProviderInfo = challenge.ProviderInfo{
+	Name: "Manual",
+	Code: "manual",
+	URL:  "https://go-acme.github.io/lego/dns/manual",
+}
+
+func createProviders() *cli.Command {
+	return &cli.Command{
+		Name:   "providers",
+		Usage:  "Display the supported DNS providers and their environment variables.",
+		Action: providers,
+		Flags: []cli.Flag{
+			&cli.StringSliceFlag{
+				Name:    "code",
+				Aliases: []string{"c"},
+				Usage:   "Display only the DNS providers with these codes.",
+			},
+			&cli.BoolFlag{
+				Name:  "json",
+				Usage: "Display the DNS providers as JSON.",
+			},
+		},
+	}
+}
+
+func providers(ctx *cli.Context) error {
+	infos, err := filterDNSProviders(allDNSProviders(), ctx.StringSlice("code"))
+	if err != nil {
+		return err
+	}
+
+	if ctx.Bool("json") {
+		encoder := json.NewEncoder(ctx.App.Writer)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(infos)
+	}
+
+	w := tabwriter.NewWriter(ctx.App.Writer, 0, 0, 2, ' ', 0)
+	ew := &errWriter{w: w}
+
+	for i, info := range infos {
+		if i > 0 {
+			ew.writeln()
+		}
+
+		ew.writef("%s (%s)\n", info.Code, info.Name)
+
+		writeDNSProviderEnvVars(ew, "Credentials", info.EnvVars, true)
+		writeDNSProviderEnvVars(ew, "Additional Configuration", info.EnvVars, false)
+	}
+
+	if ew.err != nil {
+		return ew.err
+	}
+
+	return w.Flush()
+}
+
+func writeDNSProviderEnvVars(ew *errWriter, title string, envVars []challenge.EnvVarInfo, credential bool) {
+	var selected []challenge.EnvVarInfo
+	for _, envVar := range envVars {
+		if envVar.Credential == credential {
+			selected = append(selected, envVar)
+		}
+	}
+
+	if len(selected) == 0 {
+		return
+	}
+
+	ew.writef("  %s:\n", title)
+
+	for _, envVar := range selected {
+		ew.writef("    - %s:\t%s\n", envVar.Name, envVar.Description)
+	}
+}
+
+// allDNSProviders returns the descriptions of the DNS providers, generated from their documentation.
+func allDNSProviders() []challenge.ProviderInfo {
+	infos := []challenge.ProviderInfo{manualDNSProviderInfo}
+
+	for _, name := range dns.ProvidersWithInfo() {
+		info, _ := dns.ProviderInfoByName(name)
+
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+func filterDNSProviders(infos []challenge.ProviderInfo, codes []string) ([]challenge.ProviderInfo, error) {
+	slices.SortFunc(infos, func(a, b challenge.ProviderInfo) int {
+		return strings.Compare(a.Code, b.Code)
+	})
+
+	if len(codes) == 0 {
+		return infos, nil
+	}
+
+	var result []challenge.ProviderInfo
+
+	for _, code := range codes {
+		idx := slices.IndexFunc(infos, func(info challenge.ProviderInfo) bool {
+			return info.Code == strings.ToLower(code)
+		})
+
+		if idx < 0 {
+			return nil, fmt.Errorf("%q is not yet supported", code)
+		}
+
+		result = append(result, infos[idx])
+	}
+
+	return result, nil
+}
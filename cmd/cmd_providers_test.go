@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_filterDNSProviders(t *testing.T) {
+	infos := []challenge.ProviderInfo{
+		{Name: "Technitium", Code: "technitium"},
+		{Name: "Manual", Code: "manual"},
+		{Name: "Hostinger", Code: "hostinger"},
+	}
+
+	testCases := []struct {
+		desc     string
+		codes    []string
+		expected []string
+	}{
+		{
+			desc:     "all",
+			expected: []string{"hostinger", "manual", "technitium"},
+		},
+		{
+			desc:     "selected codes",
+			codes:    []string{"Technitium", "hostinger"},
+			expected: []string{"technitium", "hostinger"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			result, err := filterDNSProviders(infos, test.codes)
+			require.NoError(t, err)
+
+			var codes []string
+			for _, info := range result {
+				codes = append(codes, info.Code)
+			}
+
+			assert.Equal(t, test.expected, codes)
+		})
+	}
+}
+
+func Test_filterDNSProviders_unknown(t *testing.T) {
+	_, err := filterDNSProviders([]challenge.ProviderInfo{{Name: "Manual", Code: "manual"}}, []string{"unknown"})
+	require.EqualError(t, err, `"unknown" is not yet supported`)
+}
+
+func Test_allDNSProviders(t *testing.T) {
+	infos := allDNSProviders()
+
+	codes := map[string]struct{}{}
+	for _, info := range infos {
+		assert.NotEmpty(t, info.Name, info.Code)
+
+		codes[info.Code] = struct{}{}
+	}
+
+	assert.Len(t, codes, len(infos))
+	assert.Contains(t, codes, "manual")
+	assert.Contains(t, codes, "cloudflare")
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/go-acme/lego/v4/acme/api"
 	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/go-acme/lego/v4/certificate"
+	"github.com/go-acme/lego/v4/certificate/storage"
 	"github.com/go-acme/lego/v4/lego"
 	"github.com/go-acme/lego/v4/log"
 	"github.com/mattn/go-isatty"
@@ -86,6 +87,10 @@ func createRenew() *cli.Command {
 				Usage: "If the CA offers multiple certificate chains, prefer the chain with an issuer matching this Subject Common Name." +
 					" If no match, the default offered chain will be used.",
 			},
+			&cli.StringFlag{
+				Name:  "profile",
+				Usage: "If the CA offers multiple certificate profiles (draft-aaron-acme-profiles), choose this one.",
+			},
 			&cli.StringFlag{
 				Name:  "always-deactivate-authorizations",
 				Usage: "Force the authorizations to be relinquished even if the certificate request was successful.",
@@ -196,6 +201,7 @@ func renewForDomains(ctx *cli.Context, client *lego.Client, certsStorage *Certif
 		NotAfter:                       getTime(ctx, "not-after"),
 		Bundle:                         bundle,
 		PreferredChain:                 ctx.String("preferred-chain"),
+		Profile:                        ctx.String("profile"),
 		AlwaysDeactivateAuthorizations: ctx.Bool("always-deactivate-authorizations"),
 	}
 
@@ -215,6 +221,11 @@ func renewForDomains(ctx *cli.Context, client *lego.Client, certsStorage *Certif
 
 	addPathToMetadata(meta, domain, certRes, certsStorage)
 
+	err = launchPostIssueHook(ctx, meta)
+	if err != nil {
+		return err
+	}
+
 	return launchHook(ctx.String("renew-hook"), meta)
 }
 
@@ -266,6 +277,7 @@ func renewForCSR(ctx *cli.Context, client *lego.Client, certsStorage *Certificat
 		NotAfter:                       getTime(ctx, "not-after"),
 		Bundle:                         bundle,
 		PreferredChain:                 ctx.String("preferred-chain"),
+		Profile:                        ctx.String("profile"),
 		AlwaysDeactivateAuthorizations: ctx.Bool("always-deactivate-authorizations"),
 	}
 
@@ -285,6 +297,11 @@ func renewForCSR(ctx *cli.Context, client *lego.Client, certsStorage *Certificat
 
 	addPathToMetadata(meta, domain, certRes, certsStorage)
 
+	err = launchPostIssueHook(ctx, meta)
+	if err != nil {
+		return err
+	}
+
 	return launchHook(ctx.String("renew-hook"), meta)
 }
 
@@ -293,13 +310,12 @@ func needRenewal(x509Cert *x509.Certificate, domain string, days int) bool {
 		log.Fatalf("[%s] Certificate bundle starts with a CA certificate", domain)
 	}
 
-	if days >= 0 {
-		notAfter := int(time.Until(x509Cert.NotAfter).Hours() / 24.0)
-		if notAfter > days {
-			log.Printf("[%s] The certificate expires in %d days, the number of days defined to perform the renewal is %d: no renewal.",
-				domain, notAfter, days)
-			return false
-		}
+	now := time.Now()
+
+	if !storage.NeedRenewal(x509Cert, now, days) {
+		log.Printf("[%s] The certificate expires in %d days, the number of days defined to perform the renewal is %d: no renewal.",
+			domain, storage.DaysLeft(x509Cert, now), days)
+		return false
 	}
 
 	return true
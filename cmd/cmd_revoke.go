@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"github.com/go-acme/lego/v4/acme"
+	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/go-acme/lego/v4/lego"
 	"github.com/go-acme/lego/v4/log"
 	"github.com/urfave/cli/v2"
 )
@@ -27,6 +29,10 @@ func createRevoke() *cli.Command {
 					" 9 (privilegeWithdrawn), or 10 (aACompromise).",
 				Value: acme.CRLReasonUnspecified,
 			},
+			&cli.BoolFlag{
+				Name:  "cert-key",
+				Usage: "Sign the revocation request with the private key of the certificate instead of the account key (e.g. when the key is compromised).",
+			},
 		},
 	}
 }
@@ -51,7 +57,11 @@ func revoke(ctx *cli.Context) error {
 
 		reason := ctx.Uint("reason")
 
-		err = client.Certificate.RevokeWithReason(certBytes, &reason)
+		if ctx.Bool("cert-key") {
+			err = revokeWithCertificateKey(client, certsStorage, domain, certBytes, reason)
+		} else {
+			err = client.Certificate.RevokeWithReason(certBytes, &reason)
+		}
 		if err != nil {
 			log.Fatalf("Error while revoking the certificate for domain %s\n\t%v", domain, err)
 		}
@@ -74,3 +84,17 @@ func revoke(ctx *cli.Context) error {
 
 	return nil
 }
+
+func revokeWithCertificateKey(client *lego.Client, certsStorage *CertificatesStorage, domain string, certBytes []byte, reason uint) error {
+	keyBytes, err := certsStorage.ReadFile(domain, keyExt)
+	if err != nil {
+		return err
+	}
+
+	privateKey, err := certcrypto.ParsePEMPrivateKey(keyBytes)
+	if err != nil {
+		return err
+	}
+
+	return client.Certificate.RevokeWithCertificateKey(certBytes, privateKey, &reason)
+}
@@ -7,9 +7,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/go-acme/lego/v4/certificate"
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/lego"
 	"github.com/go-acme/lego/v4/log"
+	"github.com/go-acme/lego/v4/providers/dns"
 	"github.com/go-acme/lego/v4/registration"
 	"github.com/urfave/cli/v2"
 )
@@ -56,6 +59,15 @@ func createRun() *cli.Command {
 				Usage: "If the CA offers multiple certificate chains, prefer the chain with an issuer matching this Subject Common Name." +
 					" If no match, the default offered chain will be used.",
 			},
+			&cli.StringFlag{
+				Name: "private-key",
+				Usage: "Path to a PEM-encoded private key to use for the certificate instead of generating a new one." +
+					" RSA, ECDSA, and Ed25519 keys are supported; when --key-type is set, the type of the key must match it. Only works with --domains.",
+			},
+			&cli.StringFlag{
+				Name:  "profile",
+				Usage: "If the CA offers multiple certificate profiles (draft-aaron-acme-profiles), choose this one.",
+			},
 			&cli.StringFlag{
 				Name:  "always-deactivate-authorizations",
 				Usage: "Force the authorizations to be relinquished even if the certificate request was successful.",
@@ -64,6 +76,11 @@ func createRun() *cli.Command {
 				Name:  "run-hook",
 				Usage: "Define a hook. The hook is executed when the certificates are effectively created.",
 			},
+			&cli.BoolFlag{
+				Name: "dry-run",
+				Usage: "Validate the DNS provider configuration (create, check the propagation, and remove a TXT record for each domain)" +
+					" without contacting the ACME server. Requires --dns and --domains.",
+			},
 		},
 	}
 }
@@ -80,6 +97,10 @@ backups of this folder is ideal.
 `
 
 func run(ctx *cli.Context) error {
+	if ctx.Bool("dry-run") {
+		return dryRun(ctx)
+	}
+
 	accountsStorage := NewAccountsStorage(ctx)
 
 	account, client := setup(ctx, accountsStorage)
@@ -120,9 +141,43 @@ func run(ctx *cli.Context) error {
 		renewEnvCertPFXPath:  certsStorage.GetFileName(cert.Domain, ".pfx"),
 	}
 
+	err = launchPostIssueHook(ctx, meta)
+	if err != nil {
+		return err
+	}
+
 	return launchHook(ctx.String("run-hook"), meta)
 }
 
+func dryRun(ctx *cli.Context) error {
+	if !ctx.IsSet("dns") {
+		log.Fatal("The dry-run mode requires a DNS provider: use --dns.")
+	}
+
+	domains := ctx.StringSlice("domains")
+	if len(domains) == 0 {
+		log.Fatal("The dry-run mode requires --domains/-d.")
+	}
+
+	provider, err := dns.NewDNSChallengeProviderByName(ctx.String("dns"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	opts := getDNSChallengeOptions(ctx)
+
+	for _, domain := range domains {
+		err = dns01.DryRun(provider, strings.TrimPrefix(domain, "*."), opts...)
+		if err != nil {
+			log.Fatalf("Dry-run failed:\n\t%v", err)
+		}
+	}
+
+	log.Println("Dry-run succeeded: the DNS provider is correctly configured.")
+
+	return nil
+}
+
 func handleTOS(ctx *cli.Context, client *lego.Client) bool {
 	// Check for a global accept override
 	if ctx.Bool("accept-tos") {
@@ -186,9 +241,25 @@ func obtainCertificate(ctx *cli.Context, client *lego.Client) (*certificate.Reso
 			Bundle:                         bundle,
 			MustStaple:                     ctx.Bool("must-staple"),
 			PreferredChain:                 ctx.String("preferred-chain"),
+			Profile:                        ctx.String("profile"),
 			AlwaysDeactivateAuthorizations: ctx.Bool("always-deactivate-authorizations"),
 		}
 
+		if ctx.IsSet("private-key") {
+			// The key type is only checked when explicitly set: the key types cannot describe an Ed25519 key.
+			var keyType certcrypto.KeyType
+			if ctx.IsSet("key-type") {
+				keyType = getKeyType(ctx)
+			}
+
+			privateKey, err := certcrypto.ReadPrivateKeyFile(ctx.String("private-key"), keyType)
+			if err != nil {
+				return nil, fmt.Errorf("private key: %w", err)
+			}
+
+			request.PrivateKey = privateKey
+		}
+
 		notBefore := ctx.Timestamp("not-before")
 		if notBefore != nil {
 			request.NotBefore = *notBefore
@@ -215,6 +286,7 @@ func obtainCertificate(ctx *cli.Context, client *lego.Client) (*certificate.Reso
 		NotAfter:                       getTime(ctx, "not-after"),
 		Bundle:                         bundle,
 		PreferredChain:                 ctx.String("preferred-chain"),
+		Profile:                        ctx.String("profile"),
 		AlwaysDeactivateAuthorizations: ctx.Bool("always-deactivate-authorizations"),
 	}
 
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/certificate"
+	"github.com/go-acme/lego/v4/certificate/storage"
 	"github.com/go-acme/lego/v4/lego"
 	"github.com/urfave/cli/v2"
 	"software.sslmate.com/src/go-pkcs12"
@@ -113,6 +114,30 @@ func CreateFlags(defaultPath string) []cli.Flag {
 			Name:  "dns.disable-cp",
 			Usage: "By setting this flag to true, disables the need to await propagation of the TXT record to all authoritative name servers.",
 		},
+		&cli.BoolFlag{
+			Name: "dns.disable-propagation-check",
+			Usage: "Do not check the propagation of the TXT record: the CA is notified right after the creation of the record." +
+				" Useful when the DNS view of lego differs from the one of the CA (split-horizon).",
+		},
+		&cli.Float64Flag{
+			Name: "dns.propagation-backoff",
+			Usage: "Multiply the interval between two checks of the propagation by this factor after each check (exponential backoff)." +
+				" By default, the interval is fixed.",
+		},
+		&cli.DurationFlag{
+			Name:  "dns.propagation-max-interval",
+			Usage: "Set the maximum interval between two checks of the propagation when '--dns.propagation-backoff' is used.",
+		},
+		&cli.Float64Flag{
+			Name: "dns.propagation-jitter",
+			Usage: "Randomize the interval between two checks of the propagation by this factor (between 0 and 1)," +
+				" to avoid synchronized checks of many domains.",
+		},
+		&cli.BoolFlag{
+			Name:    "dns.disable-cleanup",
+			EnvVars: []string{"LEGO_DNS_DISABLE_CLEANUP"},
+			Usage:   "Keep the TXT records after the resolution of the challenges (for debugging). The records must be removed manually.",
+		},
 		&cli.StringSliceFlag{
 			Name: "dns.resolvers",
 			Usage: "Set the resolvers to use for performing (recursive) CNAME resolving and apex domain determination." +
@@ -120,6 +145,17 @@ func CreateFlags(defaultPath string) []cli.Flag {
 				" Supported: host:port." +
 				" The default is to use the system resolvers, or Google's DNS resolvers if the system's cannot be determined.",
 		},
+		&cli.StringSliceFlag{
+			Name: "dns.zone-resolvers",
+			Usage: "Set the resolvers to use for the apex domain determination (SOA), instead of the resolvers defined by '--dns.resolvers'." +
+				" Supported: host:port.",
+		},
+		&cli.StringSliceFlag{
+			Name: "dns.doh",
+			Usage: "Use DNS-over-HTTPS resolvers (JSON API) to check the propagation of the TXT record, instead of the authoritative DNS servers." +
+				" Supported: https URL." +
+				" Use 'default' for the Cloudflare and Google DoH resolvers.",
+		},
 		&cli.IntFlag{
 			Name:  "http-timeout",
 			Usage: "Set the HTTP timeout value to a specific value in seconds.",
@@ -150,20 +186,89 @@ func CreateFlags(defaultPath string) []cli.Flag {
 			Value:   "RC2",
 			EnvVars: []string{"LEGO_PFX_FORMAT"},
 		},
+		&cli.StringFlag{
+			Name:    "vault.address",
+			EnvVars: []string{"LEGO_VAULT_ADDR"},
+			Usage: "The address of a HashiCorp Vault server used to store the account keys (instead of the local files) and a copy of the certificates (KV version 2 secrets engine)." +
+				" An existing local account key is migrated to Vault.",
+		},
+		&cli.StringFlag{
+			Name:    "vault.token",
+			EnvVars: []string{"LEGO_VAULT_TOKEN"},
+			Usage:   "The Vault token. When empty, the AppRole authentication is used (--vault.role-id and --vault.secret-id).",
+		},
+		&cli.StringFlag{
+			Name:    "vault.role-id",
+			EnvVars: []string{"LEGO_VAULT_ROLE_ID"},
+			Usage:   "The role ID of the Vault AppRole authentication.",
+		},
+		&cli.StringFlag{
+			Name:    "vault.secret-id",
+			EnvVars: []string{"LEGO_VAULT_SECRET_ID"},
+			Usage:   "The secret ID of the Vault AppRole authentication.",
+		},
+		&cli.StringFlag{
+			Name:    "vault.namespace",
+			EnvVars: []string{"LEGO_VAULT_NAMESPACE"},
+			Usage:   "The Vault namespace (Vault Enterprise).",
+		},
+		&cli.StringFlag{
+			Name:    "vault.mount",
+			EnvVars: []string{"LEGO_VAULT_MOUNT"},
+			Usage:   "The mount path of the Vault KV version 2 secrets engine.",
+			Value:   storage.DefaultVaultMount,
+		},
+		&cli.StringFlag{
+			Name:    "vault.path",
+			EnvVars: []string{"LEGO_VAULT_PATH"},
+			Usage:   "The base path of the secrets inside the Vault secrets engine.",
+			Value:   storage.DefaultVaultPath,
+		},
 		&cli.IntFlag{
 			Name:  "cert.timeout",
 			Usage: "Set the certificate timeout value to a specific value in seconds. Only used when obtaining certificates.",
 			Value: 30,
 		},
+		&cli.IntFlag{
+			Name: "max-concurrent-authz",
+			Usage: "Set the maximum number of DNS-01 authorizations solved concurrently." +
+				" By default, the authorizations are solved one after the other.",
+		},
 		&cli.IntFlag{
 			Name:  "overall-request-limit",
 			Usage: "ACME overall requests limit.",
 			Value: certificate.DefaultOverallRequestLimit,
 		},
+		&cli.BoolFlag{
+			Name:  "acme-trace",
+			Usage: "Log the ACME requests (method, URL, and decoded JWS with the signature redacted).",
+		},
+		&cli.BoolFlag{
+			Name:  "acme-trace.dry-run",
+			Usage: "Log the first JWS-signed ACME request without sending it (implies --acme-trace).",
+		},
 		&cli.StringFlag{
 			Name:  "user-agent",
 			Usage: "Add to the user-agent sent to the CA to identify an application embedding lego-cli",
 		},
+		&cli.StringFlag{
+			Name:    "user-agent-suffix",
+			EnvVars: []string{"LEGO_USER_AGENT_SUFFIX"},
+			Usage:   "Append an identifier to the user-agent of all the requests (CA and DNS providers), e.g. for the allow-lists of an API gateway.",
+		},
+		&cli.StringFlag{
+			Name:  "hook-post-issue",
+			Usage: "Define a hook executed after each successful issuance of a certificate (run and renew).",
+		},
+		&cli.StringFlag{
+			Name:  "hook-pre-cleanup",
+			Usage: "Define a hook executed before the cleanup of each challenge.",
+		},
+		&cli.IntFlag{
+			Name:  "hook-timeout",
+			Usage: "Set the timeout of the hooks defined by --hook-post-issue and --hook-pre-cleanup, in seconds.",
+			Value: int(defaultHookTimeout.Seconds()),
+		},
 	}
 }
 
@@ -1,27 +1,48 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/go-acme/lego/v4/log"
+	"github.com/urfave/cli/v2"
+)
+
+// Lifecycle events of the hooks.
+const (
+	hookEventPostIssue  = "post-issue"
+	hookEventPreCleanUp = "pre-cleanup"
 )
 
+const (
+	hookEnvEvent            = "LEGO_HOOK_EVENT"
+	hookEnvChallengeType    = "LEGO_CHALLENGE_TYPE"
+	hookEnvChallengeDomain  = "LEGO_CHALLENGE_DOMAIN"
+	hookEnvChallengeToken   = "LEGO_CHALLENGE_TOKEN"
+	hookEnvChallengeKeyAuth = "LEGO_CHALLENGE_KEY_AUTH"
+	hookEnvChallengePath    = "LEGO_CHALLENGE_PATH"
+	hookEnvRecordFQDN       = "LEGO_CHALLENGE_RECORD_FQDN"
+	hookEnvRecordValue      = "LEGO_CHALLENGE_RECORD_VALUE"
+)
+
+const defaultHookTimeout = 120 * time.Second
+
 func launchHook(hook string, meta map[string]string) error {
 	if hook == "" {
 		return nil
 	}
 
-	ctxCmd, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	ctxCmd, cancel := context.WithTimeout(context.Background(), defaultHookTimeout)
 	defer cancel()
 
-	parts := strings.Fields(hook)
-
-	cmdCtx := exec.CommandContext(ctxCmd, parts[0], parts[1:]...)
-	cmdCtx.Env = append(os.Environ(), metaToEnv(meta)...)
+	cmdCtx := newHookCommand(ctxCmd, hook, meta)
 
 	output, err := cmdCtx.CombinedOutput()
 
@@ -36,6 +57,54 @@ func launchHook(hook string, meta map[string]string) error {
 	return err
 }
 
+// executeHook runs the hook of a lifecycle event.
+// The output of the command is streamed, line by line, to the logger.
+func executeHook(ctx context.Context, event, hook string, timeout time.Duration, meta map[string]string) error {
+	if hook == "" {
+		return nil
+	}
+
+	ctxCmd, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmdCtx := newHookCommand(ctxCmd, hook, meta)
+	cmdCtx.Env = append(cmdCtx.Env, hookEnvEvent+"="+event)
+
+	output := &hookLogWriter{event: event}
+	cmdCtx.Stdout = output
+	cmdCtx.Stderr = output
+
+	err := cmdCtx.Run()
+
+	output.Flush()
+
+	if errors.Is(ctxCmd.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%s hook timed out", event)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s hook: %w", event, err)
+	}
+
+	return nil
+}
+
+// launchPostIssueHook runs the post-issue hook, after the certificate has been saved.
+func launchPostIssueHook(ctx *cli.Context, meta map[string]string) error {
+	timeout := time.Duration(ctx.Int("hook-timeout")) * time.Second
+
+	return executeHook(context.Background(), hookEventPostIssue, ctx.String("hook-post-issue"), timeout, meta)
+}
+
+func newHookCommand(ctx context.Context, hook string, meta map[string]string) *exec.Cmd {
+	parts := strings.Fields(hook)
+
+	cmdCtx := exec.CommandContext(ctx, parts[0], parts[1:]...)
+	cmdCtx.Env = append(os.Environ(), metaToEnv(meta)...)
+
+	return cmdCtx
+}
+
 func metaToEnv(meta map[string]string) []string {
 	var envs []string
 
@@ -45,3 +114,48 @@ func metaToEnv(meta map[string]string) []string {
 
 	return envs
 }
+
+// hookLogWriter writes each line of the output of a hook to the logger.
+type hookLogWriter struct {
+	event string
+
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (w *hookLogWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf.Write(p)
+
+	for {
+		line, err := w.buf.ReadString('\n')
+		if err != nil {
+			// Keeps the incomplete line until the next write.
+			w.buf.Reset()
+			w.buf.WriteString(line)
+
+			break
+		}
+
+		w.log(line)
+	}
+
+	return len(p), nil
+}
+
+// Flush writes the remaining incomplete line to the logger.
+func (w *hookLogWriter) Flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.buf.Len() > 0 {
+		w.log(w.buf.String())
+		w.buf.Reset()
+	}
+}
+
+func (w *hookLogWriter) log(line string) {
+	log.Infof("[hook %s] %s", w.event, strings.TrimRight(line, "\r\n"))
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/challenge/http01"
+	"github.com/go-acme/lego/v4/log"
+	"github.com/urfave/cli/v2"
+)
+
+type sequential interface {
+	Sequential() time.Duration
+}
+
+// hookProvider wraps a challenge provider to run the pre-cleanup hook before the cleanup of each challenge.
+type hookProvider struct {
+	provider challenge.Provider
+	chlgType challenge.Type
+	hook     string
+	timeout  time.Duration
+}
+
+// newHookProvider returns the provider unchanged when no pre-cleanup hook is defined.
+func newHookProvider(ctx *cli.Context, chlgType challenge.Type, provider challenge.Provider) challenge.Provider {
+	hook := ctx.String("hook-pre-cleanup")
+	if hook == "" {
+		return provider
+	}
+
+	p := &hookProvider{
+		provider: provider,
+		chlgType: chlgType,
+		hook:     hook,
+		timeout:  time.Duration(ctx.Int("hook-timeout")) * time.Second,
+	}
+
+	// The sequential providers must stay sequential.
+	if s, ok := provider.(sequential); ok {
+		return &sequentialHookProvider{hookProvider: p, sequential: s}
+	}
+
+	return p
+}
+
+func (p *hookProvider) Present(domain, token, keyAuth string) error {
+	return p.PresentContext(context.Background(), domain, token, keyAuth)
+}
+
+func (p *hookProvider) PresentContext(ctx context.Context, domain, token, keyAuth string) error {
+	return challenge.Present(ctx, p.provider, domain, token, keyAuth)
+}
+
+func (p *hookProvider) CleanUp(domain, token, keyAuth string) error {
+	return p.CleanUpContext(context.Background(), domain, token, keyAuth)
+}
+
+func (p *hookProvider) CleanUpContext(ctx context.Context, domain, token, keyAuth string) error {
+	// The failure of the hook must not prevent the cleanup.
+	err := executeHook(ctx, hookEventPreCleanUp, p.hook, p.timeout, p.meta(domain, token, keyAuth))
+	if err != nil {
+		log.Warnf("[%s] %v", domain, err)
+	}
+
+	return challenge.CleanUp(ctx, p.provider, domain, token, keyAuth)
+}
+
+// Timeout returns the timeout of the wrapped provider, or the default DNS-01 propagation timeout.
+func (p *hookProvider) Timeout() (timeout, interval time.Duration) {
+	if provider, ok := p.provider.(challenge.ProviderTimeout); ok {
+		return provider.Timeout()
+	}
+
+	return dns01.DefaultPropagationTimeout, dns01.DefaultPollingInterval
+}
+
+func (p *hookProvider) meta(domain, token, keyAuth string) map[string]string {
+	meta := map[string]string{
+		hookEnvChallengeType:    string(p.chlgType),
+		hookEnvChallengeDomain:  domain,
+		hookEnvChallengeToken:   token,
+		hookEnvChallengeKeyAuth: keyAuth,
+	}
+
+	switch p.chlgType {
+	case challenge.DNS01:
+		info := dns01.GetChallengeInfo(domain, keyAuth)
+
+		meta[hookEnvRecordFQDN] = info.EffectiveFQDN
+		meta[hookEnvRecordValue] = info.Value
+
+	case challenge.HTTP01:
+		meta[hookEnvChallengePath] = http01.ChallengePath(token)
+	}
+
+	return meta
+}
+
+type sequentialHookProvider struct {
+	*hookProvider
+
+	sequential sequential
+}
+
+func (p *sequentialHookProvider) Sequential() time.Duration {
+	return p.sequential.Sequential()
+}
@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+// setupHookScript creates a script that writes its environment into a file.
+func setupHookScript(t *testing.T) (hook, output string) {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("the hook script requires a POSIX shell")
+	}
+
+	dir := t.TempDir()
+
+	output = filepath.Join(dir, "env.txt")
+
+	hook = filepath.Join(dir, "hook.sh")
+
+	err := os.WriteFile(hook, []byte("#!/bin/sh\nenv > \""+output+"\"\necho done\n"), 0o700)
+	require.NoError(t, err)
+
+	return hook, output
+}
+
+func readHookEnv(t *testing.T, filename string) map[string]string {
+	t.Helper()
+
+	data, err := os.ReadFile(filename)
+	require.NoError(t, err)
+
+	env := map[string]string{}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		k, v, ok := strings.Cut(line, "=")
+		if ok {
+			env[k] = v
+		}
+	}
+
+	return env
+}
+
+func Test_executeHook(t *testing.T) {
+	hook, output := setupHookScript(t)
+
+	meta := map[string]string{
+		renewEnvCertDomain: "example.com",
+		renewEnvCertPath:   "/tmp/certificates/example.com.crt",
+	}
+
+	err := executeHook(context.Background(), hookEventPostIssue, hook, time.Minute, meta)
+	require.NoError(t, err)
+
+	env := readHookEnv(t, output)
+
+	assert.Equal(t, hookEventPostIssue, env[hookEnvEvent])
+	assert.Equal(t, "example.com", env[renewEnvCertDomain])
+	assert.Equal(t, "/tmp/certificates/example.com.crt", env[renewEnvCertPath])
+}
+
+func Test_executeHook_empty(t *testing.T) {
+	err := executeHook(context.Background(), hookEventPostIssue, "", time.Minute, nil)
+	require.NoError(t, err)
+}
+
+func Test_executeHook_timeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the hook requires the sleep command")
+	}
+
+	err := executeHook(context.Background(), hookEventPostIssue, "sleep 5", 100*time.Millisecond, nil)
+	require.EqualError(t, err, "post-issue hook timed out")
+}
+
+func Test_hookLogWriter(t *testing.T) {
+	w := &hookLogWriter{event: hookEventPostIssue}
+
+	_, err := w.Write([]byte("foo\nba"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "ba", w.buf.String())
+
+	_, err = w.Write([]byte("r\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, w.buf.Len())
+
+	_, err = w.Write([]byte("baz"))
+	require.NoError(t, err)
+
+	w.Flush()
+
+	assert.Equal(t, 0, w.buf.Len())
+}
+
+func Test_hookProvider_CleanUp(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	hook, output := setupHookScript(t)
+
+	testCases := []struct {
+		desc     string
+		chlgType challenge.Type
+		expected map[string]string
+	}{
+		{
+			desc:     "DNS-01",
+			chlgType: challenge.DNS01,
+			expected: map[string]string{
+				hookEnvEvent:            hookEventPreCleanUp,
+				hookEnvChallengeType:    "dns-01",
+				hookEnvChallengeDomain:  "example.com",
+				hookEnvChallengeToken:   "token",
+				hookEnvChallengeKeyAuth: "keyAuth",
+				hookEnvRecordFQDN:       "_acme-challenge.example.com.",
+				hookEnvRecordValue:      dns01.GetChallengeInfo("example.com", "keyAuth").Value,
+			},
+		},
+		{
+			desc:     "HTTP-01",
+			chlgType: challenge.HTTP01,
+			expected: map[string]string{
+				hookEnvEvent:            hookEventPreCleanUp,
+				hookEnvChallengeType:    "http-01",
+				hookEnvChallengeDomain:  "example.com",
+				hookEnvChallengeToken:   "token",
+				hookEnvChallengeKeyAuth: "keyAuth",
+				hookEnvChallengePath:    "/.well-known/acme-challenge/token",
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			mock := &hookProviderMock{output: output}
+
+			p := &hookProvider{provider: mock, chlgType: test.chlgType, hook: hook, timeout: time.Minute}
+
+			err := p.Present("example.com", "token", "keyAuth")
+			require.NoError(t, err)
+
+			err = p.CleanUp("example.com", "token", "keyAuth")
+			require.NoError(t, err)
+
+			// The hook is executed before the cleanup.
+			assert.True(t, mock.hookCalled)
+
+			env := readHookEnv(t, output)
+
+			for k, v := range test.expected {
+				assert.Equal(t, v, env[k], k)
+			}
+
+			require.NoError(t, os.Remove(output))
+		})
+	}
+}
+
+func Test_hookProvider_CleanUp_hookError(t *testing.T) {
+	mock := &hookProviderMock{}
+
+	p := &hookProvider{provider: mock, chlgType: challenge.HTTP01, hook: "lego-hook-does-not-exist", timeout: time.Minute}
+
+	err := p.CleanUp("example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	assert.True(t, mock.cleanedUp)
+}
+
+func Test_newHookProvider(t *testing.T) {
+	newContext := func(hook string) *cli.Context {
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+		set.String("hook-pre-cleanup", hook, "")
+		set.Int("hook-timeout", 120, "")
+
+		return cli.NewContext(nil, set, nil)
+	}
+
+	mock := &hookProviderMock{}
+
+	provider := newHookProvider(newContext(""), challenge.DNS01, mock)
+	assert.Same(t, mock, provider)
+
+	provider = newHookProvider(newContext("hook"), challenge.DNS01, mock)
+	require.IsType(t, &hookProvider{}, provider)
+
+	assert.Equal(t, 2*time.Minute, provider.(*hookProvider).timeout)
+
+	// The sequential providers stay sequential.
+	provider = newHookProvider(newContext("hook"), challenge.DNS01, &sequentialProviderMock{})
+
+	s, ok := provider.(sequential)
+	require.True(t, ok)
+
+	assert.Equal(t, 42*time.Second, s.Sequential())
+}
+
+type hookProviderMock struct {
+	output string
+
+	hookCalled bool
+	cleanedUp  bool
+}
+
+func (m *hookProviderMock) Present(_, _, _ string) error {
+	return nil
+}
+
+func (m *hookProviderMock) CleanUp(_, _, _ string) error {
+	if m.output != "" {
+		_, err := os.Stat(m.output)
+		m.hookCalled = err == nil
+	}
+
+	m.cleanedUp = true
+
+	return nil
+}
+
+type sequentialProviderMock struct {
+	hookProviderMock
+}
+
+func (m *sequentialProviderMock) Sequential() time.Duration {
+	return 42 * time.Second
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/go-acme/lego/v4/certificate/storage"
 	"github.com/go-acme/lego/v4/lego"
 	"github.com/go-acme/lego/v4/log"
 	"github.com/go-acme/lego/v4/registration"
@@ -43,11 +44,17 @@ func newClient(ctx *cli.Context, acc registration.User, keyType certcrypto.KeyTy
 		OverallRequestLimit: ctx.Int("overall-request-limit"),
 	}
 	config.UserAgent = getUserAgent(ctx)
+	config.UserAgentSuffix = ctx.String("user-agent-suffix")
+	config.MaxConcurrentAuthz = ctx.Int("max-concurrent-authz")
 
 	if ctx.IsSet("http-timeout") {
 		config.HTTPClient.Timeout = time.Duration(ctx.Int("http-timeout")) * time.Second
 	}
 
+	if ctx.Bool("acme-trace") || ctx.Bool("acme-trace.dry-run") {
+		config.HTTPClient.Transport = lego.NewTraceTransport(config.HTTPClient.Transport, ctx.Bool("acme-trace.dry-run"))
+	}
+
 	client, err := lego.NewClient(config)
 	if err != nil {
 		log.Fatalf("Could not create client: %v", err)
@@ -94,6 +101,42 @@ func getUserAgent(ctx *cli.Context) string {
 	return strings.TrimSpace(fmt.Sprintf("%s lego-cli/%s", ctx.String("user-agent"), ctx.App.Version))
 }
 
+// vaultStoreMetadataKey the key of the Vault store in the metadata of the application.
+const vaultStoreMetadataKey = "vaultStore"
+
+// getVaultStore returns the Vault store, or nil if no Vault server is defined.
+// The store is created once, and shared by the accounts and the certificates storages (one login by run).
+func getVaultStore(ctx *cli.Context) *storage.VaultStore {
+	if ctx.String("vault.address") == "" {
+		return nil
+	}
+
+	if store, ok := ctx.App.Metadata[vaultStoreMetadataKey].(*storage.VaultStore); ok {
+		return store
+	}
+
+	store, err := storage.NewVaultStore(storage.VaultConfig{
+		Address:   ctx.String("vault.address"),
+		Namespace: ctx.String("vault.namespace"),
+		Token:     ctx.String("vault.token"),
+		RoleID:    ctx.String("vault.role-id"),
+		SecretID:  ctx.String("vault.secret-id"),
+		Mount:     ctx.String("vault.mount"),
+		Path:      ctx.String("vault.path"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if ctx.App.Metadata == nil {
+		ctx.App.Metadata = map[string]any{}
+	}
+
+	ctx.App.Metadata[vaultStoreMetadataKey] = store
+
+	return store
+}
+
 func createNonExistingFolder(path string) error {
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		return os.MkdirAll(path, 0o700)
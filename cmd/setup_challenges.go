@@ -24,14 +24,14 @@ func setupChallenges(ctx *cli.Context, client *lego.Client) {
 	}
 
 	if ctx.Bool("http") {
-		err := client.Challenge.SetHTTP01Provider(setupHTTPProvider(ctx))
+		err := client.Challenge.SetHTTP01Provider(newHookProvider(ctx, challenge.HTTP01, setupHTTPProvider(ctx)))
 		if err != nil {
 			log.Fatal(err)
 		}
 	}
 
 	if ctx.Bool("tls") {
-		err := client.Challenge.SetTLSALPN01Provider(setupTLSProvider(ctx))
+		err := client.Challenge.SetTLSALPN01Provider(newHookProvider(ctx, challenge.TLSALPN01, setupTLSProvider(ctx)))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -114,21 +114,64 @@ func setupTLSProvider(ctx *cli.Context) challenge.Provider {
 }
 
 func setupDNS(ctx *cli.Context, client *lego.Client) {
+	dns.SetUserAgentSuffix(ctx.String("user-agent-suffix"))
+
 	provider, err := dns.NewDNSChallengeProviderByName(ctx.String("dns"))
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	if ctx.Bool("dns.disable-propagation-check") {
+		log.Warnf("The DNS propagation check is disabled: the CA is the only source of truth.")
+		client.Challenge.SetDNSPropagationCheckDisabled(true)
+	}
+
+	err = client.Challenge.SetDNS01Provider(newHookProvider(ctx, challenge.DNS01, provider), getDNSChallengeOptions(ctx)...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if ctx.Bool("dns.disable-cleanup") {
+		log.Warnf("The cleanup of the DNS-01 challenges is disabled: the TXT records will be kept.")
+		client.Challenge.SetDNSDisableCleanUp(true)
+	}
+}
+
+func getDNSChallengeOptions(ctx *cli.Context) []dns01.ChallengeOption {
 	servers := ctx.StringSlice("dns.resolvers")
-	err = client.Challenge.SetDNS01Provider(provider,
+	zoneServers := ctx.StringSlice("dns.zone-resolvers")
+
+	return []dns01.ChallengeOption{
 		dns01.CondOption(len(servers) > 0,
-			dns01.AddRecursiveNameservers(dns01.ParseNameservers(ctx.StringSlice("dns.resolvers")))),
+			dns01.AddRecursiveNameservers(servers)),
+		dns01.CondOption(len(zoneServers) > 0,
+			dns01.AddZoneNameservers(zoneServers)),
 		dns01.CondOption(ctx.Bool("dns.disable-cp"),
 			dns01.DisableCompletePropagationRequirement()),
 		dns01.CondOption(ctx.IsSet("dns-timeout"),
 			dns01.AddDNSTimeout(time.Duration(ctx.Int("dns-timeout"))*time.Second)),
-	)
-	if err != nil {
-		log.Fatal(err)
+		dns01.CondOption(ctx.IsSet("dns.doh"),
+			dns01.UseDoHPropagationCheck(getDoHEndpoints(ctx)...)),
+		dns01.CondOption(ctx.IsSet("dns.propagation-backoff") || ctx.IsSet("dns.propagation-jitter"),
+			dns01.SetPropagationBackOff(dns01.PropagationBackOff{
+				Multiplier:  ctx.Float64("dns.propagation-backoff"),
+				MaxInterval: ctx.Duration("dns.propagation-max-interval"),
+				Jitter:      ctx.Float64("dns.propagation-jitter"),
+			})),
+	}
+}
+
+func getDoHEndpoints(ctx *cli.Context) []string {
+	var endpoints []string
+
+	for _, endpoint := range ctx.StringSlice("dns.doh") {
+		if endpoint == "default" {
+			endpoints = append(endpoints, dns01.DefaultDoHEndpoints...)
+			continue
+		}
+
+		endpoints = append(endpoints, endpoint)
 	}
+
+	return endpoints
 }
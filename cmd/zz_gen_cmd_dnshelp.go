@@ -15,6 +15,7 @@ func allDNSCodes() string {
 	providers := []string{
 		"manual",
 		"acme-dns",
+		"active24",
 		"alidns",
 		"allinkl",
 		"arvancloud",
@@ -22,8 +23,10 @@ func allDNSCodes() string {
 		"autodns",
 		"azure",
 		"azuredns",
+		"beget",
 		"bindman",
 		"bluecat",
+		"bookmyname",
 		"brandit",
 		"bunny",
 		"checkdomain",
@@ -35,11 +38,13 @@ func allDNSCodes() string {
 		"cloudxns",
 		"conoha",
 		"constellix",
+		"corenetworks",
 		"cpanel",
 		"derak",
 		"desec",
 		"designate",
 		"digitalocean",
+		"dinahosting",
 		"dnshomede",
 		"dnsimple",
 		"dnsmadeeasy",
@@ -65,7 +70,9 @@ func allDNSCodes() string {
 		"godaddy",
 		"googledomains",
 		"hetzner",
+		"hetznerrobot",
 		"hostingde",
+		"hostinger",
 		"hosttech",
 		"httpnet",
 		"httpreq",
@@ -90,6 +97,7 @@ func allDNSCodes() string {
 		"luadns",
 		"mailinabox",
 		"metaname",
+		"mittwald",
 		"mydnsjp",
 		"mythicbeasts",
 		"namecheap",
@@ -111,6 +119,7 @@ func allDNSCodes() string {
 		"porkbun",
 		"rackspace",
 		"rcodezero",
+		"regfish",
 		"regru",
 		"rfc2136",
 		"rimuhosting",
@@ -125,7 +134,9 @@ func allDNSCodes() string {
 		"simply",
 		"sonic",
 		"stackpath",
+		"technitium",
 		"tencentcloud",
+		"timeweb",
 		"transip",
 		"ultradns",
 		"variomedia",
@@ -134,11 +145,13 @@ func allDNSCodes() string {
 		"versio",
 		"vinyldns",
 		"vkcloud",
+		"volcengine",
 		"vscale",
 		"vultr",
 		"webnames",
 		"websupport",
 		"wedos",
+		"westcn",
 		"yandex",
 		"yandex360",
 		"yandexcloud",
@@ -169,6 +182,26 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/acme-dns`)
 
+	case "active24":
+		// generated from: providers/dns/active24/active24.toml
+		ew.writeln(`Configuration for Active24.`)
+		ew.writeln(`Code:	'active24'`)
+		ew.writeln(`Since:	'v4.18.0'`)
+		ew.writeln()
+
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "ACTIVE24_API_KEY":	API key (bearer token)`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "ACTIVE24_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "ACTIVE24_POLLING_INTERVAL":	Time between DNS propagation check`)
+		ew.writeln(`	- "ACTIVE24_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+		ew.writeln(`	- "ACTIVE24_TTL":	The TTL of the TXT record used for the DNS challenge`)
+
+		ew.writeln()
+		ew.writeln(`More information: https://go-acme.github.io/lego/dns/active24`)
+
 	case "alidns":
 		// generated from: providers/dns/alidns/alidns.toml
 		ew.writeln(`Configuration for Alibaba Cloud DNS.`)
@@ -187,6 +220,8 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln(`	- "ALICLOUD_HTTP_TIMEOUT":	API request timeout`)
 		ew.writeln(`	- "ALICLOUD_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "ALICLOUD_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+		ew.writeln(`	- "ALICLOUD_ROLE_ARN":	The ARN of the RAM role to assume with the access key (STS)`)
+		ew.writeln(`	- "ALICLOUD_ROLE_SESSION_NAME":	The session name used to assume the RAM role (Default: lego)`)
 		ew.writeln(`	- "ALICLOUD_TTL":	The TTL of the TXT record used for the DNS challenge`)
 
 		ew.writeln()
@@ -315,6 +350,7 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln(`	- "AZURE_CLIENT_CERTIFICATE_PATH":	Client certificate path`)
 		ew.writeln(`	- "AZURE_CLIENT_ID":	Client ID`)
 		ew.writeln(`	- "AZURE_CLIENT_SECRET":	Client secret`)
+		ew.writeln(`	- "AZURE_FEDERATED_TOKEN_FILE":	Path to the federated token file (workload identity)`)
 		ew.writeln(`	- "AZURE_TENANT_ID":	Tenant ID`)
 		ew.writeln()
 
@@ -334,6 +370,26 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/azuredns`)
 
+	case "beget":
+		// generated from: providers/dns/beget/beget.toml
+		ew.writeln(`Configuration for Beget.com.`)
+		ew.writeln(`Code:	'beget'`)
+		ew.writeln(`Since:	'v4.18.0'`)
+		ew.writeln()
+
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "BEGET_PASSWORD":	API password`)
+		ew.writeln(`	- "BEGET_USERNAME":	API username`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "BEGET_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "BEGET_POLLING_INTERVAL":	Time between DNS propagation check`)
+		ew.writeln(`	- "BEGET_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+
+		ew.writeln()
+		ew.writeln(`More information: https://go-acme.github.io/lego/dns/beget`)
+
 	case "bindman":
 		// generated from: providers/dns/bindman/bindman.toml
 		ew.writeln(`Configuration for Bindman.`)
@@ -377,6 +433,27 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/bluecat`)
 
+	case "bookmyname":
+		// generated from: providers/dns/bookmyname/bookmyname.toml
+		ew.writeln(`Configuration for Bookmyname.`)
+		ew.writeln(`Code:	'bookmyname'`)
+		ew.writeln(`Since:	'v4.18.0'`)
+		ew.writeln()
+
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "BOOKMYNAME_PASSWORD":	Password of the dyndns access`)
+		ew.writeln(`	- "BOOKMYNAME_USERNAME":	Username of the dyndns access`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "BOOKMYNAME_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "BOOKMYNAME_POLLING_INTERVAL":	Time between DNS propagation check`)
+		ew.writeln(`	- "BOOKMYNAME_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+		ew.writeln(`	- "BOOKMYNAME_TTL":	The TTL of the TXT record used for the DNS challenge`)
+
+		ew.writeln()
+		ew.writeln(`More information: https://go-acme.github.io/lego/dns/bookmyname`)
+
 	case "brandit":
 		// generated from: providers/dns/brandit/brandit.toml
 		ew.writeln(`Configuration for Brandit.`)
@@ -501,6 +578,8 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln(`	- "CLOUDFLARE_HTTP_TIMEOUT":	API request timeout`)
 		ew.writeln(`	- "CLOUDFLARE_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "CLOUDFLARE_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+		ew.writeln(`	- "CLOUDFLARE_RECORD_COMMENT":	Comment added to the TXT records, to identify the records created by lego (Default: managed by lego)`)
+		ew.writeln(`	- "CLOUDFLARE_RECORD_TAG":	Tag ('name:value') added to the TXT records, used to find the records during the cleanup (requires a plan supporting record tags)`)
 		ew.writeln(`	- "CLOUDFLARE_TTL":	The TTL of the TXT record used for the DNS challenge`)
 
 		ew.writeln()
@@ -520,9 +599,11 @@ func displayDNSHelp(w io.Writer, name string) error {
 
 		ew.writeln(`Additional Configuration:`)
 		ew.writeln(`	- "CLOUDNS_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "CLOUDNS_MIN_REQUEST_INTERVAL":	Minimum time between two API calls, in seconds (default: no limit)`)
 		ew.writeln(`	- "CLOUDNS_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "CLOUDNS_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
-		ew.writeln(`	- "CLOUDNS_SUB_AUTH_ID":	The API sub user ID`)
+		ew.writeln(`	- "CLOUDNS_SUB_AUTH_ID":	The API sub user ID (exclusive with CLOUDNS_AUTH_ID and CLOUDNS_SUB_AUTH_USER)`)
+		ew.writeln(`	- "CLOUDNS_SUB_AUTH_USER":	The API sub user name (exclusive with CLOUDNS_AUTH_ID and CLOUDNS_SUB_AUTH_ID)`)
 		ew.writeln(`	- "CLOUDNS_TTL":	The TTL of the TXT record used for the DNS challenge`)
 
 		ew.writeln()
@@ -616,6 +697,27 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/constellix`)
 
+	case "corenetworks":
+		// generated from: providers/dns/corenetworks/corenetworks.toml
+		ew.writeln(`Configuration for Core-Networks.`)
+		ew.writeln(`Code:	'corenetworks'`)
+		ew.writeln(`Since:	'v4.18.0'`)
+		ew.writeln()
+
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "CORENETWORKS_LOGIN":	The username of the API account`)
+		ew.writeln(`	- "CORENETWORKS_PASSWORD":	The password`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "CORENETWORKS_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "CORENETWORKS_POLLING_INTERVAL":	Time between DNS propagation check`)
+		ew.writeln(`	- "CORENETWORKS_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+		ew.writeln(`	- "CORENETWORKS_TTL":	The TTL of the TXT record used for the DNS challenge`)
+
+		ew.writeln()
+		ew.writeln(`More information: https://go-acme.github.io/lego/dns/corenetworks`)
+
 	case "cpanel":
 		// generated from: providers/dns/cpanel/cpanel.toml
 		ew.writeln(`Configuration for CPanel/WHM.`)
@@ -731,6 +833,26 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/digitalocean`)
 
+	case "dinahosting":
+		// generated from: providers/dns/dinahosting/dinahosting.toml
+		ew.writeln(`Configuration for Dinahosting.`)
+		ew.writeln(`Code:	'dinahosting'`)
+		ew.writeln(`Since:	'v4.18.0'`)
+		ew.writeln()
+
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "DINAHOSTING_PASSWORD":	Password of the account`)
+		ew.writeln(`	- "DINAHOSTING_USERNAME":	Username of the account`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "DINAHOSTING_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "DINAHOSTING_POLLING_INTERVAL":	Time between DNS propagation check`)
+		ew.writeln(`	- "DINAHOSTING_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+
+		ew.writeln()
+		ew.writeln(`More information: https://go-acme.github.io/lego/dns/dinahosting`)
+
 	case "dnshomede":
 		// generated from: providers/dns/dnshomede/dnshomede.toml
 		ew.writeln(`Configuration for dnsHome.de.`)
@@ -860,6 +982,7 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 
 		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "DREAMHOST_DELAY":	Time to wait after each modification of the records, to let the DreamHost backend apply it (default: 0)`)
 		ew.writeln(`	- "DREAMHOST_HTTP_TIMEOUT":	API request timeout`)
 		ew.writeln(`	- "DREAMHOST_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "DREAMHOST_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
@@ -1157,7 +1280,7 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln(`	- "GCORE_HTTP_TIMEOUT":	API request timeout`)
 		ew.writeln(`	- "GCORE_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "GCORE_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
-		ew.writeln(`	- "GCORE_TTL":	The TTL of the TXT record used for the DNS challenge`)
+		ew.writeln(`	- "GCORE_TTL":	The TTL of the TXT record used for the DNS challenge (minimum: 120)`)
 
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/gcore`)
@@ -1235,6 +1358,7 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 
 		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "HETZNER_BATCH_WINDOW":	Enable the zone file import mode: the records created (or deleted) during the window (in seconds) are grouped in a single import, requires '--max-concurrent-authz' (Default: 0, disabled)`)
 		ew.writeln(`	- "HETZNER_HTTP_TIMEOUT":	API request timeout`)
 		ew.writeln(`	- "HETZNER_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "HETZNER_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
@@ -1243,6 +1367,27 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/hetzner`)
 
+	case "hetznerrobot":
+		// generated from: providers/dns/hetznerrobot/hetznerrobot.toml
+		ew.writeln(`Configuration for Hetzner Robot.`)
+		ew.writeln(`Code:	'hetznerrobot'`)
+		ew.writeln(`Since:	'v4.18.0'`)
+		ew.writeln()
+
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "HETZNER_ROBOT_PASSWORD":	Webservice password`)
+		ew.writeln(`	- "HETZNER_ROBOT_USERNAME":	Webservice username`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "HETZNER_ROBOT_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "HETZNER_ROBOT_POLLING_INTERVAL":	Time between DNS propagation check`)
+		ew.writeln(`	- "HETZNER_ROBOT_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+		ew.writeln(`	- "HETZNER_ROBOT_TTL":	The TTL of the TXT record used for the DNS challenge`)
+
+		ew.writeln()
+		ew.writeln(`More information: https://go-acme.github.io/lego/dns/hetznerrobot`)
+
 	case "hostingde":
 		// generated from: providers/dns/hostingde/hostingde.toml
 		ew.writeln(`Configuration for Hosting.de.`)
@@ -1264,6 +1409,26 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/hostingde`)
 
+	case "hostinger":
+		// generated from: providers/dns/hostinger/hostinger.toml
+		ew.writeln(`Configuration for Hostinger.`)
+		ew.writeln(`Code:	'hostinger'`)
+		ew.writeln(`Since:	'v4.18.0'`)
+		ew.writeln()
+
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "HOSTINGER_API_TOKEN":	API token`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "HOSTINGER_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "HOSTINGER_POLLING_INTERVAL":	Time between DNS propagation check`)
+		ew.writeln(`	- "HOSTINGER_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+		ew.writeln(`	- "HOSTINGER_TTL":	The TTL of the TXT record set used for the DNS challenge (minimum: 60)`)
+
+		ew.writeln()
+		ew.writeln(`More information: https://go-acme.github.io/lego/dns/hostinger`)
+
 	case "hosttech":
 		// generated from: providers/dns/hosttech/hosttech.toml
 		ew.writeln(`Configuration for Hosttech.`)
@@ -1272,8 +1437,7 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 
 		ew.writeln(`Credentials:`)
-		ew.writeln(`	- "HOSTTECH_API_KEY":	API login`)
-		ew.writeln(`	- "HOSTTECH_PASSWORD":	API password`)
+		ew.writeln(`	- "HOSTTECH_API_KEY":	API token`)
 		ew.writeln()
 
 		ew.writeln(`Additional Configuration:`)
@@ -1437,6 +1601,7 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 
 		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "INFOBLOX_CA_CERTIFICATE":	The path to a PEM file (can contain a full chain) or a directory of PEM files, containing the CA certificates of the grid manager`)
 		ew.writeln(`	- "INFOBLOX_DNS_VIEW":	The view for the TXT records, default: External`)
 		ew.writeln(`	- "INFOBLOX_HTTP_TIMEOUT":	HTTP request timeout`)
 		ew.writeln(`	- "INFOBLOX_POLLING_INTERVAL":	Time between DNS propagation check`)
@@ -1461,6 +1626,7 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 
 		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "INFOMANIAK_DOMAIN_ID":	The ID of the domain, skips the domain discovery (for tokens without the permission to list the domains)`)
 		ew.writeln(`	- "INFOMANIAK_ENDPOINT":	https://api.infomaniak.com`)
 		ew.writeln(`	- "INFOMANIAK_HTTP_TIMEOUT":	API request timeout`)
 		ew.writeln(`	- "INFOMANIAK_POLLING_INTERVAL":	Time between DNS propagation check`)
@@ -1525,6 +1691,7 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 
 		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "IONOS_CUSTOMER_ID":	The ID of the sub-customer owning the zones (reseller context)`)
 		ew.writeln(`	- "IONOS_HTTP_TIMEOUT":	API request timeout`)
 		ew.writeln(`	- "IONOS_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "IONOS_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
@@ -1696,6 +1863,7 @@ func displayDNSHelp(w io.Writer, name string) error {
 
 		ew.writeln(`Additional Configuration:`)
 		ew.writeln(`	- "LOOPIA_API_URL":	API endpoint. Ex: https://api.loopia.se/RPCSERV or https://api.loopia.rs/RPCSERV`)
+		ew.writeln(`	- "LOOPIA_CUSTOMER_NUMBER":	Customer number of the sub-account (reseller accounts only)`)
 		ew.writeln(`	- "LOOPIA_HTTP_TIMEOUT":	API request timeout`)
 		ew.writeln(`	- "LOOPIA_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "LOOPIA_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
@@ -1758,6 +1926,7 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 
 		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "METANAME_HTTP_TIMEOUT":	API request timeout`)
 		ew.writeln(`	- "METANAME_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "METANAME_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
 		ew.writeln(`	- "METANAME_TTL":	The TTL of the TXT record used for the DNS challenge`)
@@ -1765,6 +1934,26 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/metaname`)
 
+	case "mittwald":
+		// generated from: providers/dns/mittwald/mittwald.toml
+		ew.writeln(`Configuration for Mittwald.`)
+		ew.writeln(`Code:	'mittwald'`)
+		ew.writeln(`Since:	'v4.18.0'`)
+		ew.writeln()
+
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "MITTWALD_TOKEN":	API token`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "MITTWALD_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "MITTWALD_POLLING_INTERVAL":	Time between DNS propagation check`)
+		ew.writeln(`	- "MITTWALD_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+		ew.writeln(`	- "MITTWALD_TTL":	The TTL of the TXT record used for the DNS challenge`)
+
+		ew.writeln()
+		ew.writeln(`More information: https://go-acme.github.io/lego/dns/mittwald`)
+
 	case "mydnsjp":
 		// generated from: providers/dns/mydnsjp/mydnsjp.toml
 		ew.writeln(`Configuration for MyDNS.jp.`)
@@ -1794,17 +1983,19 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 
 		ew.writeln(`Credentials:`)
-		ew.writeln(`	- "MYTHICBEASTS_PASSWORD":	Password`)
-		ew.writeln(`	- "MYTHICBEASTS_USERNAME":	User name`)
+		ew.writeln(`	- "MYTHICBEASTS_API_KEY":	API key ID`)
+		ew.writeln(`	- "MYTHICBEASTS_API_SECRET":	API key secret`)
 		ew.writeln()
 
 		ew.writeln(`Additional Configuration:`)
 		ew.writeln(`	- "MYTHICBEASTS_API_ENDPOINT":	The endpoint for the API (must implement v2)`)
 		ew.writeln(`	- "MYTHICBEASTS_AUTH_API_ENDPOINT":	The endpoint for Mythic Beasts' Authentication`)
 		ew.writeln(`	- "MYTHICBEASTS_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "MYTHICBEASTS_PASSWORD":	Password (deprecated, use MYTHICBEASTS_API_SECRET)`)
 		ew.writeln(`	- "MYTHICBEASTS_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "MYTHICBEASTS_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
 		ew.writeln(`	- "MYTHICBEASTS_TTL":	The TTL of the TXT record used for the DNS challenge`)
+		ew.writeln(`	- "MYTHICBEASTS_USERNAME":	User name (deprecated, use MYTHICBEASTS_API_KEY)`)
 
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/mythicbeasts`)
@@ -2016,7 +2207,7 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln(`	- "NODION_HTTP_TIMEOUT":	API request timeout`)
 		ew.writeln(`	- "NODION_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "NODION_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
-		ew.writeln(`	- "NODION_TTL":	The TTL of the TXT record used for the DNS challenge`)
+		ew.writeln(`	- "NODION_TTL":	The TTL of the TXT record used for the DNS challenge (minimum: 60)`)
 
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/nodion`)
@@ -2059,6 +2250,7 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 
 		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "OCI_AUTH":	Authentication mode: 'api_key' (default), 'instance_principal', or 'resource_principal'. With the principals, only OCI_COMPARTMENT_OCID is required`)
 		ew.writeln(`	- "OCI_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "OCI_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
 		ew.writeln(`	- "OCI_TTL":	The TTL of the TXT record used for the DNS challenge`)
@@ -2153,7 +2345,10 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 
 		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "PLESK_API_KEY":	Secret key, used instead of the username and the password`)
+		ew.writeln(`	- "PLESK_CA_CERTIFICATE":	Path to a PEM file containing the CA certificates used to verify the server certificate`)
 		ew.writeln(`	- "PLESK_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "PLESK_INSECURE_SKIP_VERIFY":	Whether or not to skip the verification of the server certificate`)
 		ew.writeln(`	- "PLESK_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "PLESK_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
 		ew.writeln(`	- "PLESK_TTL":	The TTL of the TXT record used for the DNS challenge`)
@@ -2223,6 +2418,26 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/rcodezero`)
 
+	case "regfish":
+		// generated from: providers/dns/regfish/regfish.toml
+		ew.writeln(`Configuration for Regfish.`)
+		ew.writeln(`Code:	'regfish'`)
+		ew.writeln(`Since:	'v4.18.0'`)
+		ew.writeln()
+
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "REGFISH_API_KEY":	API key`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "REGFISH_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "REGFISH_POLLING_INTERVAL":	Time between DNS propagation check`)
+		ew.writeln(`	- "REGFISH_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+		ew.writeln(`	- "REGFISH_TTL":	The TTL of the TXT record used for the DNS challenge (minimum: 60)`)
+
+		ew.writeln()
+		ew.writeln(`More information: https://go-acme.github.io/lego/dns/regfish`)
+
 	case "regru":
 		// generated from: providers/dns/regru/regru.toml
 		ew.writeln(`Configuration for reg.ru.`)
@@ -2257,11 +2472,13 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln(`	- "RFC2136_NAMESERVER":	Network address in the form "host" or "host:port"`)
 		ew.writeln(`	- "RFC2136_TSIG_ALGORITHM":	TSIG algorithm. See [miekg/dns#tsig.go](https://github.com/miekg/dns/blob/master/tsig.go) for supported values. To disable TSIG authentication, leave the 'RFC2136_TSIG*' variables unset.`)
 		ew.writeln(`	- "RFC2136_TSIG_KEY":	Name of the secret key as defined in DNS server configuration. To disable TSIG authentication, leave the 'RFC2136_TSIG*' variables unset.`)
+		ew.writeln(`	- "RFC2136_TSIG_KEYS":	TSIG keys by zone apex (JSON), they take precedence over 'RFC2136_TSIG_KEY'. Ex: '{"example.com": {"key": "name", "secret": "payload", "algorithm": "hmac-sha256."}}'`)
 		ew.writeln(`	- "RFC2136_TSIG_SECRET":	Secret key payload. To disable TSIG authentication, leave the' RFC2136_TSIG*' variables unset.`)
 		ew.writeln()
 
 		ew.writeln(`Additional Configuration:`)
 		ew.writeln(`	- "RFC2136_DNS_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "RFC2136_NOTIFY_TARGETS":	Secondaries to notify (DNS NOTIFY) after each update, comma-separated network addresses in the form "host" or "host:port" (hidden primary)`)
 		ew.writeln(`	- "RFC2136_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "RFC2136_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
 		ew.writeln(`	- "RFC2136_SEQUENCE_INTERVAL":	Time between sequential requests`)
@@ -2356,6 +2573,7 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln(`	- "SAKURACLOUD_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "SAKURACLOUD_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
 		ew.writeln(`	- "SAKURACLOUD_TTL":	The TTL of the TXT record used for the DNS challenge`)
+		ew.writeln(`	- "SAKURACLOUD_ZONE":	The zone containing the records (by default, the most specific zone of the account containing the domain)`)
 
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/sakuracloud`)
@@ -2532,6 +2750,29 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/stackpath`)
 
+	case "technitium":
+		// generated from: providers/dns/technitium/technitium.toml
+		ew.writeln(`Configuration for Technitium.`)
+		ew.writeln(`Code:	'technitium'`)
+		ew.writeln(`Since:	'v4.18.0'`)
+		ew.writeln()
+
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "TECHNITIUM_API_TOKEN":	API token`)
+		ew.writeln(`	- "TECHNITIUM_SERVER_URL":	Server URL (ex: https://localhost:5380)`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "TECHNITIUM_CA_CERTIFICATE":	Path to a PEM file containing the CA certificates used to verify the server certificate`)
+		ew.writeln(`	- "TECHNITIUM_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "TECHNITIUM_INSECURE_SKIP_VERIFY":	Whether or not to skip the verification of the server certificate`)
+		ew.writeln(`	- "TECHNITIUM_POLLING_INTERVAL":	Time between DNS propagation check`)
+		ew.writeln(`	- "TECHNITIUM_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+		ew.writeln(`	- "TECHNITIUM_TTL":	The TTL of the TXT record used for the DNS challenge`)
+
+		ew.writeln()
+		ew.writeln(`More information: https://go-acme.github.io/lego/dns/technitium`)
+
 	case "tencentcloud":
 		// generated from: providers/dns/tencentcloud/tencentcloud.toml
 		ew.writeln(`Configuration for Tencent Cloud DNS.`)
@@ -2555,6 +2796,25 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/tencentcloud`)
 
+	case "timeweb":
+		// generated from: providers/dns/timeweb/timeweb.toml
+		ew.writeln(`Configuration for Timeweb Cloud.`)
+		ew.writeln(`Code:	'timeweb'`)
+		ew.writeln(`Since:	'v4.18.0'`)
+		ew.writeln()
+
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "TIMEWEB_ACCESS_TOKEN":	Access token`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "TIMEWEB_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "TIMEWEB_POLLING_INTERVAL":	Time between DNS propagation check`)
+		ew.writeln(`	- "TIMEWEB_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+
+		ew.writeln()
+		ew.writeln(`More information: https://go-acme.github.io/lego/dns/timeweb`)
+
 	case "transip":
 		// generated from: providers/dns/transip/transip.toml
 		ew.writeln(`Configuration for TransIP.`)
@@ -2608,10 +2868,10 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 
 		ew.writeln(`Additional Configuration:`)
-		ew.writeln(`	- "DODE_SEQUENCE_INTERVAL":	Time between sequential requests`)
 		ew.writeln(`	- "VARIOMEDIA_HTTP_TIMEOUT":	API request timeout`)
-		ew.writeln(`	- "VARIOMEDIA_POLLING_INTERVAL":	Time between DNS propagation check`)
-		ew.writeln(`	- "VARIOMEDIA_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+		ew.writeln(`	- "VARIOMEDIA_POLLING_INTERVAL":	Time between DNS propagation check, and between the checks of the queue jobs (default: 10 seconds)`)
+		ew.writeln(`	- "VARIOMEDIA_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation, and for the application of the queue jobs (default: 5 minutes)`)
+		ew.writeln(`	- "VARIOMEDIA_SEQUENCE_INTERVAL":	Time between sequential requests`)
 		ew.writeln(`	- "VARIOMEDIA_TTL":	The TTL of the TXT record used for the DNS challenge`)
 
 		ew.writeln()
@@ -2727,6 +2987,28 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/vkcloud`)
 
+	case "volcengine":
+		// generated from: providers/dns/volcengine/volcengine.toml
+		ew.writeln(`Configuration for Volcano Engine/火山引擎.`)
+		ew.writeln(`Code:	'volcengine'`)
+		ew.writeln(`Since:	'v4.18.0'`)
+		ew.writeln()
+
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "VOLC_ACCESSKEY":	Access Key ID (AK)`)
+		ew.writeln(`	- "VOLC_SECRETKEY":	Secret Access Key (SK)`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "VOLC_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "VOLC_POLLING_INTERVAL":	Time between DNS propagation check`)
+		ew.writeln(`	- "VOLC_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+		ew.writeln(`	- "VOLC_REGION":	Region (Default: cn-north-1)`)
+		ew.writeln(`	- "VOLC_TTL":	The TTL of the TXT record used for the DNS challenge`)
+
+		ew.writeln()
+		ew.writeln(`More information: https://go-acme.github.io/lego/dns/volcengine`)
+
 	case "vscale":
 		// generated from: providers/dns/vscale/vscale.toml
 		ew.writeln(`Configuration for Vscale.`)
@@ -2783,7 +3065,6 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln(`	- "WEBNAMES_HTTP_TIMEOUT":	API request timeout`)
 		ew.writeln(`	- "WEBNAMES_POLLING_INTERVAL":	Time between DNS propagation check`)
 		ew.writeln(`	- "WEBNAMES_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
-		ew.writeln(`	- "WEBNAMES_TTL":	The TTL of the TXT record used for the DNS challenge`)
 
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/webnames`)
@@ -2831,6 +3112,27 @@ func displayDNSHelp(w io.Writer, name string) error {
 		ew.writeln()
 		ew.writeln(`More information: https://go-acme.github.io/lego/dns/wedos`)
 
+	case "westcn":
+		// generated from: providers/dns/westcn/westcn.toml
+		ew.writeln(`Configuration for West.cn/西部数码.`)
+		ew.writeln(`Code:	'westcn'`)
+		ew.writeln(`Since:	'v4.18.0'`)
+		ew.writeln()
+
+		ew.writeln(`Credentials:`)
+		ew.writeln(`	- "WESTCN_API_PASSWORD":	API password`)
+		ew.writeln(`	- "WESTCN_USERNAME":	Username`)
+		ew.writeln()
+
+		ew.writeln(`Additional Configuration:`)
+		ew.writeln(`	- "WESTCN_HTTP_TIMEOUT":	API request timeout`)
+		ew.writeln(`	- "WESTCN_POLLING_INTERVAL":	Time between DNS propagation check`)
+		ew.writeln(`	- "WESTCN_PROPAGATION_TIMEOUT":	Maximum waiting time for DNS propagation`)
+		ew.writeln(`	- "WESTCN_TTL":	The TTL of the TXT record used for the DNS challenge`)
+
+		ew.writeln()
+		ew.writeln(`More information: https://go-acme.github.io/lego/dns/westcn`)
+
 	case "yandex":
 		// generated from: providers/dns/yandex/yandex.toml
 		ew.writeln(`Configuration for Yandex PDD.`)
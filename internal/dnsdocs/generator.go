@@ -20,13 +20,17 @@ import (
 )
 
 const (
-	root        = "../../"
-	dnsPackage  = root + "providers/dns"
-	mdTemplate  = root + "internal/dnsdocs/dns.md.tmpl"
-	cliTemplate = root + "internal/dnsdocs/dns.go.tmpl"
-	cliOutput   = root + "cmd/zz_gen_cmd_dnshelp.go"
-	docOutput   = root + "docs/content/dns"
-	readmePath  = root + "README.md"
+	root         = "../../"
+	dnsPackage   = root + "providers/dns"
+	mdTemplate   = root + "internal/dnsdocs/dns.md.tmpl"
+	cliTemplate  = root + "internal/dnsdocs/dns.go.tmpl"
+	cliOutput    = root + "cmd/zz_gen_cmd_dnshelp.go"
+	infoTemplate = root + "internal/dnsdocs/dns_info.go.tmpl"
+	infoOutput   = root + "providers/dns/zz_gen_dns_providers_info.go"
+	pkgTemplate  = root + "internal/dnsdocs/info.go.tmpl"
+	pkgOutput    = "zz_gen_info.go"
+	docOutput    = root + "docs/content/dns"
+	readmePath   = root + "README.md"
 )
 
 const (
@@ -45,6 +49,7 @@ type Model struct {
 	Links         *Links         // Links
 	Additional    string         // Extra documentation
 	GeneratedFrom string         // Source file
+	Package       string         // Go package of the DNS provider
 }
 
 type Configuration struct {
@@ -70,7 +75,21 @@ func main() {
 	}
 
 	// generate CLI help
-	err = generateCLIHelp(models)
+	err = generateGoSource(models, cliTemplate, cliOutput)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// generate the descriptions of the providers
+	for _, m := range models.Providers {
+		err = generateGoSource(m, pkgTemplate, filepath.Join(root, filepath.Dir(m.GeneratedFrom), pkgOutput))
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// generate the registry of the descriptions
+	err = generateGoSource(models, infoTemplate, infoOutput)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -103,6 +122,8 @@ func walker(prs *Providers) func(string, os.FileInfo, error) error {
 				return err
 			}
 
+			m.Package = filepath.Base(filepath.Dir(path))
+
 			prs.Providers = append(prs.Providers, m)
 
 			// generate documentation
@@ -124,22 +145,22 @@ func generateDocumentation(m Model) error {
 	return template.Must(template.ParseFiles(mdTemplate)).Execute(file, m)
 }
 
-func generateCLIHelp(models *Providers) error {
-	filename := filepath.Clean(cliOutput)
+func generateGoSource(data any, tmpl, output string) error {
+	filename := filepath.Clean(output)
 
 	file, err := os.Create(filename)
 	if err != nil {
 		return err
 	}
 
-	tlt := template.New(filepath.Base(cliTemplate)).Funcs(map[string]interface{}{
+	tlt := template.New(filepath.Base(tmpl)).Funcs(map[string]interface{}{
 		"safe": func(src string) string {
 			return strings.ReplaceAll(src, "`", "'")
 		},
 	})
 
 	b := &bytes.Buffer{}
-	err = template.Must(tlt.ParseFiles(cliTemplate)).Execute(b, models)
+	err = template.Must(tlt.ParseFiles(tmpl)).Execute(b, data)
 	if err != nil {
 		return err
 	}
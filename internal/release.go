@@ -17,7 +17,10 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
-const sourceFile = "./acme/api/internal/sender/useragent.go"
+const (
+	sourceFile    = "./acme/api/internal/sender/useragent.go"
+	dnsSourceFile = "./providers/dns/internal/useragent/zz_gen_version.go"
+)
 
 const uaTemplate = `package sender
 
@@ -36,6 +39,23 @@ const (
 
 `
 
+const dnsUATemplate = `package useragent
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+const (
+	// ourUserAgent is the User-Agent of the DNS providers.
+	ourUserAgent = "goacme-lego/{{ .version }}"
+
+	// ourUserAgentComment is part of the UA comment linked to the version status of the DNS providers.
+	// values: detach|release
+	// NOTE: Update this with each tagged release.
+	ourUserAgentComment = "{{ .comment }}"
+)
+
+`
+
 func main() {
 	app := cli.NewApp()
 	app.Name = "lego-releaser"
@@ -92,9 +112,9 @@ func release(ctx *cli.Context) error {
 		return err
 	}
 
-	// Write file
+	// Write files
 	comment := "release" // detach|release
-	return writeUserAgentFile(sourceFile, newVersion, comment)
+	return writeUserAgentFiles(newVersion, comment)
 }
 
 func detach(_ *cli.Context) error {
@@ -104,10 +124,10 @@ func detach(_ *cli.Context) error {
 		return err
 	}
 
-	// Write file
+	// Write files
 	version := strings.TrimPrefix(data["ourUserAgent"], "xenolf-acme/")
 	comment := "detach"
-	return writeUserAgentFile(sourceFile, version, comment)
+	return writeUserAgentFiles(version, comment)
 }
 
 type visitor struct {
@@ -166,8 +186,17 @@ func readUserAgentFile(filename string) (map[string]string, error) {
 	return v.data, nil
 }
 
-func writeUserAgentFile(filename, version, comment string) error {
-	tmpl, err := template.New("ua").Parse(uaTemplate)
+func writeUserAgentFiles(version, comment string) error {
+	err := writeUserAgentFile(sourceFile, uaTemplate, version, comment)
+	if err != nil {
+		return err
+	}
+
+	return writeUserAgentFile(dnsSourceFile, dnsUATemplate, version, comment)
+}
+
+func writeUserAgentFile(filename, text, version, comment string) error {
+	tmpl, err := template.New("ua").Parse(text)
 	if err != nil {
 		return err
 	}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/url"
 
+	"github.com/go-acme/lego/v4/acme"
 	"github.com/go-acme/lego/v4/acme/api"
 	"github.com/go-acme/lego/v4/certificate"
 	"github.com/go-acme/lego/v4/challenge/resolver"
@@ -16,6 +17,8 @@ type Client struct {
 	Challenge    *resolver.SolverManager
 	Registration *registration.Registrar
 	core         *api.Core
+
+	certifierOptions certificate.CertifierOptions
 }
 
 // NewClient creates a new ACME client on behalf of the user.
@@ -45,29 +48,136 @@ func NewClient(config *Config) (*Client, error) {
 		kid = reg.URI
 	}
 
-	core, err := api.New(config.HTTPClient, config.UserAgent, config.CADirURL, kid, privateKey)
+	core, err := api.New(config.HTTPClient, config.UserAgent, config.CADirURL, kid, privateKey,
+		api.WithUserAgentSuffix(config.UserAgentSuffix))
 	if err != nil {
 		return nil, err
 	}
 
+	if config.MaxBadNonceRetries != 0 {
+		err = core.SetMaxBadNonceRetries(max(config.MaxBadNonceRetries, 0))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if config.MaxRateLimitRetries != 0 || config.MaxRateLimitWait != 0 {
+		retries := config.MaxRateLimitRetries
+		switch {
+		case retries == 0:
+			retries = api.DefaultMaxRateLimitRetries
+		case retries < 0:
+			retries = 0
+		}
+
+		wait := config.MaxRateLimitWait
+		if wait == 0 {
+			wait = api.DefaultMaxRateLimitWait
+		}
+
+		err = core.SetRateLimitRetries(retries, wait)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if config.NonceURL != "" {
+		err = core.SetNonceURL(config.NonceURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if config.NoncePoolSize != 0 {
+		err = core.SetNoncePoolSize(config.NoncePoolSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	solversManager := resolver.NewSolversManager(core)
 
+	if config.MaxConcurrentAuthz != 0 {
+		err = solversManager.SetMaxConcurrentAuthz(config.MaxConcurrentAuthz)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	prober := resolver.NewProber(solversManager)
-	certifier := certificate.NewCertifier(core, prober, certificate.CertifierOptions{KeyType: config.Certificate.KeyType, Timeout: config.Certificate.Timeout, OverallRequestLimit: config.Certificate.OverallRequestLimit})
+	options := certificate.CertifierOptions{
+		KeyType:                 config.Certificate.KeyType,
+		Timeout:                 config.Certificate.Timeout,
+		FinalizePollInterval:    config.Certificate.FinalizePollInterval,
+		FinalizeMaxPollInterval: config.Certificate.FinalizeMaxPollInterval,
+		OverallRequestLimit:     config.Certificate.OverallRequestLimit,
+		ArtifactStore:           config.Certificate.ArtifactStore,
+		ReuseOrders:             config.Certificate.ReuseOrders,
+		OrderReuseWindow:        config.Certificate.OrderReuseWindow,
+	}
+
+	certifier := certificate.NewCertifier(core, prober, options)
 
 	return &Client{
-		Certificate:  certifier,
-		Challenge:    solversManager,
-		Registration: registration.NewRegistrar(core, config.User),
-		core:         core,
+		Certificate:      certifier,
+		Challenge:        solversManager,
+		Registration:     registration.NewRegistrar(core, config.User),
+		core:             core,
+		certifierOptions: options,
 	}, nil
 }
 
+// WithUser creates a lightweight client acting on behalf of another user (account) of the same CA.
+// The directory, the HTTP client, the nonces, the retry settings, and the certificate options are shared with the original client,
+// so no request is sent to the CA: the account must belong to the CA of the directory of the original client.
+// The requests of the new client are signed with the private key of the user,
+// and the account is identified by the URI of its registration (if any).
+// The challenge solvers depend on the account key, so they are not shared: they must be defined on the new client.
+func (c *Client) WithUser(user registration.User) (*Client, error) {
+	if user == nil {
+		return nil, errors.New("a user must be provided")
+	}
+
+	var kid string
+	if reg := user.GetRegistration(); reg != nil {
+		kid = reg.URI
+	}
+
+	core, err := c.core.WithAccount(kid, user.GetPrivateKey())
+	if err != nil {
+		return nil, err
+	}
+
+	solversManager := resolver.NewSolversManager(core)
+
+	prober := resolver.NewProber(solversManager)
+
+	return &Client{
+		Certificate:      certificate.NewCertifier(core, prober, c.certifierOptions),
+		Challenge:        solversManager,
+		Registration:     registration.NewRegistrar(core, user),
+		core:             core,
+		certifierOptions: c.certifierOptions,
+	}, nil
+}
+
+// GetDirectoryMeta returns the metadata of the Directory (terms of service, website, CAA identities, EAB requirement, profiles, etc.).
+// - https://www.rfc-editor.org/rfc/rfc8555.html#section-7.1.1
+func (c *Client) GetDirectoryMeta() acme.Meta {
+	return c.core.GetDirectory().Meta
+}
+
 // GetToSURL returns the current ToS URL from the Directory.
 func (c *Client) GetToSURL() string {
 	return c.core.GetDirectory().Meta.TermsOfService
 }
 
+// GetProfiles returns the certificate profiles advertised by the Directory (name to description).
+// - https://datatracker.ietf.org/doc/draft-aaron-acme-profiles/
+func (c *Client) GetProfiles() map[string]string {
+	return c.core.GetDirectory().Meta.Profiles
+}
+
 // GetExternalAccountRequired returns the External Account Binding requirement of the Directory.
 func (c *Client) GetExternalAccountRequired() bool {
 	return c.core.GetDirectory().Meta.ExternalAccountRequired
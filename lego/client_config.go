@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/certcrypto"
+	"github.com/go-acme/lego/v4/certificate"
 	"github.com/go-acme/lego/v4/registration"
 )
 
@@ -46,6 +47,32 @@ type Config struct {
 	UserAgent   string
 	HTTPClient  *http.Client
 	Certificate CertificateConfig
+
+	// UserAgentSuffix is an identifier appended to the User-Agent of the requests sent to the CA.
+	// It overrides the value of the environment variable LEGO_USER_AGENT_SUFFIX.
+	UserAgentSuffix string
+
+	// MaxBadNonceRetries is the number of retries of a request rejected by the CA because of an invalid nonce.
+	// If 0, api.DefaultMaxBadNonceRetries is used, a negative value disables the retries.
+	MaxBadNonceRetries int
+
+	// MaxRateLimitRetries is the number of retries of a request rate limited by the CA (429 status code with a Retry-After header).
+	// If 0, api.DefaultMaxRateLimitRetries is used, a negative value disables the retries.
+	MaxRateLimitRetries int
+	// MaxRateLimitWait is the maximum total time spent waiting for the rate limits of a request.
+	// If 0, api.DefaultMaxRateLimitWait is used.
+	MaxRateLimitWait time.Duration
+
+	// NonceURL overrides the URL of the newNonce endpoint provided by the directory.
+	NonceURL string
+	// NoncePoolSize is the number of nonces pre-fetched from the newNonce endpoint.
+	// If 0, the nonces are not pre-fetched.
+	NoncePoolSize int
+
+	// MaxConcurrentAuthz is the maximum number of DNS-01 authorizations solved concurrently
+	// (see resolver.SolverManager.SetMaxConcurrentAuthz).
+	// If 0, the authorizations are solved one after the other.
+	MaxConcurrentAuthz int
 }
 
 func NewConfig(user registration.User) *Config {
@@ -61,9 +88,25 @@ func NewConfig(user registration.User) *Config {
 }
 
 type CertificateConfig struct {
-	KeyType             certcrypto.KeyType
-	Timeout             time.Duration
-	OverallRequestLimit int
+	KeyType certcrypto.KeyType
+	// Timeout is the maximum time to wait for the certificate after the finalization of an order.
+	Timeout time.Duration
+	// FinalizePollInterval is the initial interval between two polls of an order being processed by the CA,
+	// the interval grows exponentially up to FinalizeMaxPollInterval.
+	// If 0, Timeout/60 is used.
+	FinalizePollInterval time.Duration
+	// FinalizeMaxPollInterval is the maximum interval between two polls of an order.
+	// If 0, certificate.DefaultFinalizeMaxPollInterval is used.
+	FinalizeMaxPollInterval time.Duration
+	OverallRequestLimit     int
+	// ArtifactStore, if not nil, persists the CSR, the order, and the chain of each issuance.
+	ArtifactStore certificate.ArtifactStore
+	// ReuseOrders allows to reuse a pending or ready order of the account with the same identifiers,
+	// instead of creating a new order (requires a CA exposing the orders of the account).
+	ReuseOrders bool
+	// OrderReuseWindow, if not 0, allows to resume an order created by the client during this window,
+	// for a new request with the same identifiers, if the order is still pending or ready.
+	OrderReuseWindow time.Duration
 }
 
 // createDefaultHTTPClient Creates an HTTP client with a reasonable timeout value
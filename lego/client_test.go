@@ -4,8 +4,12 @@ import (
 	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/go-acme/lego/v4/acme"
 	"github.com/go-acme/lego/v4/platform/tester"
 	"github.com/go-acme/lego/v4/registration"
 	"github.com/stretchr/testify/assert"
@@ -43,3 +47,101 @@ type mockUser struct {
 func (u mockUser) GetEmail() string                        { return u.email }
 func (u mockUser) GetRegistration() *registration.Resource { return u.regres }
 func (u mockUser) GetPrivateKey() crypto.PrivateKey        { return u.privatekey }
+
+func TestClient_WithUser(t *testing.T) {
+	_, apiURL := tester.SetupFakeAPI(t)
+
+	keyBits := 512 // small value keeps test fast
+	key, err := rsa.GenerateKey(rand.Reader, keyBits)
+	require.NoError(t, err, "Could not generate test key")
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, keyBits)
+	require.NoError(t, err, "Could not generate test key")
+
+	config := NewConfig(mockUser{email: "test@test.com", regres: &registration.Resource{URI: apiURL + "/account/1"}, privatekey: key})
+	config.CADirURL = apiURL + "/dir"
+	config.Certificate.ReuseOrders = true
+
+	client, err := NewClient(config)
+	require.NoError(t, err, "Could not create client")
+
+	other, err := client.WithUser(mockUser{email: "other@test.com", regres: &registration.Resource{URI: apiURL + "/account/2"}, privatekey: otherKey})
+	require.NoError(t, err)
+
+	assert.Equal(t, client.core.GetDirectory(), other.core.GetDirectory())
+	assert.Equal(t, client.certifierOptions, other.certifierOptions)
+	assert.NotSame(t, client.Challenge, other.Challenge)
+
+	keyAuth, err := client.core.GetKeyAuthorization("token")
+	require.NoError(t, err)
+
+	otherKeyAuth, err := other.core.GetKeyAuthorization("token")
+	require.NoError(t, err)
+
+	assert.NotEqual(t, keyAuth, otherKeyAuth)
+}
+
+func TestClient_WithUser_noUser(t *testing.T) {
+	_, apiURL := tester.SetupFakeAPI(t)
+
+	key, err := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, err, "Could not generate test key")
+
+	config := NewConfig(mockUser{email: "test@test.com", regres: new(registration.Resource), privatekey: key})
+	config.CADirURL = apiURL + "/dir"
+
+	client, err := NewClient(config)
+	require.NoError(t, err, "Could not create client")
+
+	_, err = client.WithUser(nil)
+	require.EqualError(t, err, "a user must be provided")
+}
+
+func TestClient_GetDirectoryMeta(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("GET /dir", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, `{
+  "newNonce": "%[1]s/nonce",
+  "newAccount": "%[1]s/account",
+  "newOrder": "%[1]s/newOrder",
+  "revokeCert": "%[1]s/revokeCert",
+  "keyChange": "%[1]s/keyChange",
+  "meta": {
+    "termsOfService": "https://example.com/acme/terms/2017-5-30",
+    "website": "https://www.example.com/",
+    "caaIdentities": ["example.com", "example.net"],
+    "externalAccountRequired": true,
+    "profiles": {
+      "classic": "The same profile you're accustomed to",
+      "tlsserver": "https://example.com/docs/tls-server-profile"
+    }
+  }
+}`, server.URL)
+	})
+
+	key, err := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, err, "Could not generate test key")
+
+	config := NewConfig(mockUser{email: "test@test.com", regres: new(registration.Resource), privatekey: key})
+	config.CADirURL = server.URL + "/dir"
+
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	expected := acme.Meta{
+		TermsOfService:          "https://example.com/acme/terms/2017-5-30",
+		Website:                 "https://www.example.com/",
+		CaaIdentities:           []string{"example.com", "example.net"},
+		ExternalAccountRequired: true,
+		Profiles: map[string]string{
+			"classic":   "The same profile you're accustomed to",
+			"tlsserver": "https://example.com/docs/tls-server-profile",
+		},
+	}
+
+	assert.Equal(t, expected, client.GetDirectoryMeta())
+	assert.True(t, client.GetExternalAccountRequired())
+}
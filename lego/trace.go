@@ -0,0 +1,141 @@
+package lego
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-acme/lego/v4/log"
+)
+
+const redacted = "REDACTED"
+
+// ErrDryRun is returned by the TraceTransport, in dry-run mode, instead of sending a JWS-signed request.
+var ErrDryRun = errors.New("dry-run: the request has not been sent")
+
+// TraceTransport is an http.RoundTripper that logs the ACME requests:
+// the method, the URL, and, for JWS-signed requests, the decoded protected header and payload.
+// The signatures are redacted.
+//
+// In dry-run mode, the unauthenticated requests (directory, nonce) are sent,
+// but the JWS-signed requests are only logged and the round trip fails with ErrDryRun.
+// As the next requests depend on the responses of the CA,
+// only the first JWS-signed request of a flow (e.g. the account registration) is traced.
+//
+//	config.HTTPClient.Transport = lego.NewTraceTransport(config.HTTPClient.Transport, true)
+type TraceTransport struct {
+	next   http.RoundTripper
+	dryRun bool
+}
+
+// NewTraceTransport creates a TraceTransport.
+// If next is nil, http.DefaultTransport is used.
+func NewTraceTransport(next http.RoundTripper, dryRun bool) *TraceTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &TraceTransport{next: next, dryRun: dryRun}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *TraceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		log.Infof("acme: trace: %s %s", req.Method, req.URL)
+
+		return t.next.RoundTrip(req)
+	}
+
+	raw, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("trace: read request body: %w", err)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(raw))
+
+	log.Infof("acme: trace: %s %s\n%s", req.Method, req.URL, formatTraceBody(raw))
+
+	if t.dryRun && req.Method == http.MethodPost {
+		return nil, ErrDryRun
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+func formatTraceBody(raw []byte) string {
+	var content any
+	err := json.Unmarshal(raw, &content)
+	if err != nil {
+		return string(raw)
+	}
+
+	body, err := json.MarshalIndent(redactJWS(content), "", "  ")
+	if err != nil {
+		return string(raw)
+	}
+
+	return string(body)
+}
+
+// redactJWS decodes the flattened JWS (RFC 7515 section 7.2.2) found inside the value,
+// and redacts their signatures.
+// The nested JWS (External Account Binding, key change) are also decoded.
+func redactJWS(value any) any {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return value
+	}
+
+	if !isFlattenedJWS(obj) {
+		for k, v := range obj {
+			obj[k] = redactJWS(v)
+		}
+
+		return obj
+	}
+
+	result := map[string]any{
+		"protected": decodeJWSPart(obj["protected"]),
+		"payload":   redactJWS(decodeJWSPart(obj["payload"])),
+		"signature": redacted,
+	}
+
+	if _, ok := obj["header"]; ok {
+		result["header"] = obj["header"]
+	}
+
+	return result
+}
+
+func isFlattenedJWS(obj map[string]any) bool {
+	_, okProtected := obj["protected"].(string)
+	_, okPayload := obj["payload"].(string)
+	_, okSignature := obj["signature"].(string)
+
+	return okProtected && okPayload && okSignature
+}
+
+func decodeJWSPart(value any) any {
+	encoded, ok := value.(string)
+	if !ok || encoded == "" {
+		return value
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return encoded
+	}
+
+	var content any
+	err = json.Unmarshal(raw, &content)
+	if err != nil {
+		return string(raw)
+	}
+
+	return content
+}
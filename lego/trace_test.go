@@ -0,0 +1,87 @@
+package lego
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	stdlog "log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-acme/lego/v4/log"
+	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/registration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceTransport_dryRun(t *testing.T) {
+	mux, apiURL := tester.SetupFakeAPI(t)
+
+	mux.HandleFunc("/account", func(rw http.ResponseWriter, _ *http.Request) {
+		t.Error("the JWS-signed request must not be sent")
+	})
+
+	buf := &bytes.Buffer{}
+
+	logger := log.Logger
+	log.Logger = stdlog.New(buf, "", 0)
+	t.Cleanup(func() { log.Logger = logger })
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	user := mockUser{
+		email:      "test@test.com",
+		regres:     new(registration.Resource),
+		privatekey: key,
+	}
+
+	config := NewConfig(user)
+	config.CADirURL = apiURL + "/dir"
+	config.HTTPClient.Transport = NewTraceTransport(config.HTTPClient.Transport, true)
+
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	_, err = client.Registration.Register(registration.RegisterOptions{TermsOfServiceAgreed: true})
+	require.ErrorIs(t, err, ErrDryRun)
+
+	output := buf.String()
+
+	assert.Contains(t, output, "[INFO] acme: trace: GET "+apiURL+"/dir\n")
+	assert.Contains(t, output, "[INFO] acme: trace: HEAD "+apiURL+"/nonce\n")
+	assert.Contains(t, output, "[INFO] acme: trace: POST "+apiURL+"/account\n")
+	assert.Contains(t, output, `"nonce": "12345"`)
+	assert.Contains(t, output, `"url": "`+apiURL+`/account"`)
+	assert.Contains(t, output, `"termsOfServiceAgreed": true`)
+	assert.Contains(t, output, `"signature": "REDACTED"`)
+
+	// only one attempt.
+	assert.Equal(t, 1, strings.Count(output, "POST "))
+}
+
+func Test_formatTraceBody(t *testing.T) {
+	// newAccount request with an External Account Binding.
+	raw := `{"protected":"eyJhbGciOiJFUzI1NiIsIm5vbmNlIjoiMTIzIn0","payload":"eyJleHRlcm5hbEFjY291bnRCaW5kaW5nIjp7InByb3RlY3RlZCI6ImV5SmhiR2NpT2lKSVV6STFOaUo5IiwicGF5bG9hZCI6ImUzMCIsInNpZ25hdHVyZSI6ImFiYyJ9fQ","signature":"c2lnbmF0dXJl"}`
+
+	expected := `{
+  "payload": {
+    "externalAccountBinding": {
+      "payload": {},
+      "protected": {
+        "alg": "HS256"
+      },
+      "signature": "REDACTED"
+    }
+  },
+  "protected": {
+    "alg": "ES256",
+    "nonce": "123"
+  },
+  "signature": "REDACTED"
+}`
+
+	assert.Equal(t, expected, formatTraceBody([]byte(raw)))
+}
@@ -0,0 +1,119 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+const (
+	prefixInfo = "[INFO] "
+	prefixWarn = "[WARN] "
+)
+
+// SlogLogger adapts a *slog.Logger to the StdLogger interface.
+// Messages produced by Infof/Warnf are emitted with the matching slog level,
+// and messages produced by Info/Warn keep their key/value pairs as structured attributes.
+type SlogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger creates a StdLogger backed by a *slog.Logger.
+// If logger is nil, slog.Default() is used.
+//
+//	log.Logger = log.NewSlogLogger(slog.New(slog.NewJSONHandler(os.Stderr, nil)))
+func NewSlogLogger(logger *slog.Logger) *SlogLogger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &SlogLogger{logger: logger}
+}
+
+// Slog returns the underlying *slog.Logger.
+func (l *SlogLogger) Slog() *slog.Logger {
+	return l.logger
+}
+
+func (l *SlogLogger) Fatal(args ...interface{}) {
+	l.emit(slog.LevelError, fmt.Sprint(args...))
+	os.Exit(1)
+}
+
+func (l *SlogLogger) Fatalln(args ...interface{}) {
+	l.emit(slog.LevelError, fmt.Sprintln(args...))
+	os.Exit(1)
+}
+
+func (l *SlogLogger) Fatalf(format string, args ...interface{}) {
+	l.emit(slog.LevelError, fmt.Sprintf(format, args...))
+	os.Exit(1)
+}
+
+func (l *SlogLogger) Print(args ...interface{}) {
+	l.emit(slog.LevelInfo, fmt.Sprint(args...))
+}
+
+func (l *SlogLogger) Println(args ...interface{}) {
+	l.emit(slog.LevelInfo, fmt.Sprintln(args...))
+}
+
+func (l *SlogLogger) Printf(format string, args ...interface{}) {
+	l.emit(slog.LevelInfo, fmt.Sprintf(format, args...))
+}
+
+func (l *SlogLogger) emit(level slog.Level, msg string, args ...any) {
+	msg = strings.TrimSuffix(msg, "\n")
+
+	switch {
+	case strings.HasPrefix(msg, prefixWarn):
+		level = slog.LevelWarn
+		msg = strings.TrimPrefix(msg, prefixWarn)
+	case strings.HasPrefix(msg, prefixInfo):
+		msg = strings.TrimPrefix(msg, prefixInfo)
+	}
+
+	l.logger.Log(context.Background(), level, msg, args...)
+}
+
+// Info writes a structured log entry.
+// The args are key/value pairs, as for slog.Logger.Info.
+// If Logger is not a *SlogLogger, the pairs are appended to the message as `key=value`.
+func Info(msg string, args ...any) {
+	structured(slog.LevelInfo, prefixInfo, msg, args...)
+}
+
+// Warn writes a structured log entry.
+// The args are key/value pairs, as for slog.Logger.Warn.
+// If Logger is not a *SlogLogger, the pairs are appended to the message as `key=value`.
+func Warn(msg string, args ...any) {
+	structured(slog.LevelWarn, prefixWarn, msg, args...)
+}
+
+func structured(level slog.Level, prefix, msg string, args ...any) {
+	if l, ok := Logger.(*SlogLogger); ok {
+		l.logger.Log(context.Background(), level, msg, args...)
+		return
+	}
+
+	Print(prefix + msg + formatAttrs(args))
+}
+
+func formatAttrs(args []any) string {
+	if len(args) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	record := slog.Record{}
+	record.Add(args...)
+	record.Attrs(func(attr slog.Attr) bool {
+		_, _ = fmt.Fprintf(&sb, " %s=%v", attr.Key, attr.Value)
+		return true
+	})
+
+	return sb.String()
+}
@@ -0,0 +1,58 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlogLogger(t *testing.T) {
+	backupLogger := Logger
+	t.Cleanup(func() {
+		Logger = backupLogger
+	})
+
+	buf := &bytes.Buffer{}
+	Logger = NewSlogLogger(slog.New(slog.NewJSONHandler(buf, nil)))
+
+	Info("new record", "provider", "cloudflare", "domain", "example.com", "recordID", "123")
+
+	var entry map[string]any
+	err := json.Unmarshal(buf.Bytes(), &entry)
+	require.NoError(t, err)
+
+	assert.Equal(t, "INFO", entry["level"])
+	assert.Equal(t, "new record", entry["msg"])
+	assert.Equal(t, "cloudflare", entry["provider"])
+	assert.Equal(t, "example.com", entry["domain"])
+	assert.Equal(t, "123", entry["recordID"])
+
+	buf.Reset()
+	Warnf("something %s", "happened")
+
+	entry = map[string]any{}
+	err = json.Unmarshal(buf.Bytes(), &entry)
+	require.NoError(t, err)
+
+	assert.Equal(t, "WARN", entry["level"])
+	assert.Equal(t, "something happened", entry["msg"])
+}
+
+func TestInfo_stdLogger(t *testing.T) {
+	backupLogger := Logger
+	t.Cleanup(func() {
+		Logger = backupLogger
+	})
+
+	buf := &bytes.Buffer{}
+	Logger = log.New(buf, "", 0)
+
+	Info("new record", "provider", "cloudflare", "recordID", "123")
+
+	assert.Equal(t, "[INFO] new record provider=cloudflare recordID=123\n", buf.String())
+}
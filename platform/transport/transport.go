@@ -0,0 +1,79 @@
+// Package transport provides the HTTP transport shared by the DNS providers.
+package transport
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+var (
+	sharedMu sync.RWMutex
+	shared   http.RoundTripper = New()
+)
+
+// New creates an http.Transport, based on http.DefaultTransport, using ProxyFromEnvironment.
+func New() *http.Transport {
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	tr.Proxy = ProxyFromEnvironment
+
+	return tr
+}
+
+// Shared returns the transport shared by the HTTP clients of the DNS providers.
+func Shared() http.RoundTripper {
+	sharedMu.RLock()
+	defer sharedMu.RUnlock()
+
+	return shared
+}
+
+// SetShared replaces the transport shared by the HTTP clients of the DNS providers.
+// It must be called before the creation of the configurations of the providers.
+// If rt is nil, the default transport is restored.
+func SetShared(rt http.RoundTripper) {
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+
+	if rt == nil {
+		rt = New()
+	}
+
+	shared = rt
+}
+
+// ProxyFromEnvironment returns the URL of the proxy to use for a request.
+//
+// It follows the same rules as http.ProxyFromEnvironment (HTTPS_PROXY, HTTP_PROXY, and NO_PROXY),
+// but uses ALL_PROXY (or all_proxy) when neither HTTPS_PROXY nor HTTP_PROXY matches the scheme of the request.
+// The proxy URL can use the http, https, or socks5 schemes.
+//
+// Unlike http.ProxyFromEnvironment, the environment variables are read on each call.
+func ProxyFromEnvironment(req *http.Request) (*url.URL, error) {
+	config := httpproxy.FromEnvironment()
+
+	if all := getEnvAny("ALL_PROXY", "all_proxy"); all != "" {
+		if config.HTTPSProxy == "" {
+			config.HTTPSProxy = all
+		}
+
+		if config.HTTPProxy == "" {
+			config.HTTPProxy = all
+		}
+	}
+
+	return config.ProxyFunc()(req.URL)
+}
+
+func getEnvAny(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,101 @@
+package transport
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProxyFromEnvironment(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		env      map[string]string
+		target   string
+		expected string
+	}{
+		{
+			desc:   "no proxy",
+			target: "https://api.example.com",
+		},
+		{
+			desc:     "HTTPS_PROXY",
+			env:      map[string]string{"HTTPS_PROXY": "http://proxy.example.com:3128"},
+			target:   "https://api.example.com",
+			expected: "http://proxy.example.com:3128",
+		},
+		{
+			desc:   "HTTPS_PROXY with HTTP target",
+			env:    map[string]string{"HTTPS_PROXY": "http://proxy.example.com:3128"},
+			target: "http://api.example.com",
+		},
+		{
+			desc:     "ALL_PROXY",
+			env:      map[string]string{"ALL_PROXY": "socks5://proxy.example.com:1080"},
+			target:   "https://api.example.com",
+			expected: "socks5://proxy.example.com:1080",
+		},
+		{
+			desc:     "all_proxy",
+			env:      map[string]string{"all_proxy": "socks5://proxy.example.com:1080"},
+			target:   "http://api.example.com",
+			expected: "socks5://proxy.example.com:1080",
+		},
+		{
+			desc: "HTTPS_PROXY takes precedence over ALL_PROXY",
+			env: map[string]string{
+				"HTTPS_PROXY": "http://proxy.example.com:3128",
+				"ALL_PROXY":   "socks5://proxy.example.com:1080",
+			},
+			target:   "https://api.example.com",
+			expected: "http://proxy.example.com:3128",
+		},
+		{
+			desc: "NO_PROXY",
+			env: map[string]string{
+				"ALL_PROXY": "socks5://proxy.example.com:1080",
+				"NO_PROXY":  "example.com",
+			},
+			target: "https://api.example.com",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			for _, name := range []string{"HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy", "ALL_PROXY", "all_proxy", "NO_PROXY", "no_proxy", "REQUEST_METHOD"} {
+				t.Setenv(name, test.env[name])
+			}
+
+			req, err := http.NewRequest(http.MethodGet, test.target, http.NoBody)
+			require.NoError(t, err)
+
+			proxyURL, err := ProxyFromEnvironment(req)
+			require.NoError(t, err)
+
+			if test.expected == "" {
+				assert.Nil(t, proxyURL)
+				return
+			}
+
+			expected, err := url.Parse(test.expected)
+			require.NoError(t, err)
+
+			assert.Equal(t, expected, proxyURL)
+		})
+	}
+}
+
+func TestSetShared(t *testing.T) {
+	t.Cleanup(func() { SetShared(nil) })
+
+	custom := &http.Transport{}
+
+	SetShared(custom)
+	assert.Same(t, custom, Shared())
+
+	SetShared(nil)
+	assert.NotSame(t, custom, Shared())
+	assert.IsType(t, &http.Transport{}, Shared())
+}
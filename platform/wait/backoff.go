@@ -0,0 +1,47 @@
+package wait
+
+import (
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+// RetryAfterBackOff is a backoff.BackOff honoring the Retry-After header of the last response of the server,
+// when it asks for a longer wait than the wrapped backoff.
+type RetryAfterBackOff struct {
+	backoff.BackOff
+
+	// MaxRetryAfter, if not 0, caps the wait asked by the Retry-After header.
+	MaxRetryAfter time.Duration
+	// Deadline, if not zero, caps all the waits.
+	Deadline time.Time
+
+	retryAfter time.Duration
+}
+
+// SetRetryAfter records the wait asked by the last response (0 when the response has no Retry-After header).
+func (b *RetryAfterBackOff) SetRetryAfter(retryAfter time.Duration) {
+	b.retryAfter = retryAfter
+}
+
+// NextBackOff returns the next wait of the wrapped backoff, or the wait asked by the last response if it is longer.
+func (b *RetryAfterBackOff) NextBackOff() time.Duration {
+	next := b.BackOff.NextBackOff()
+	if next == backoff.Stop {
+		return next
+	}
+
+	if b.retryAfter > next {
+		next = b.retryAfter
+
+		if b.MaxRetryAfter > 0 {
+			next = min(next, b.MaxRetryAfter)
+		}
+	}
+
+	if !b.Deadline.IsZero() {
+		next = max(min(next, time.Until(b.Deadline)), 0)
+	}
+
+	return next
+}
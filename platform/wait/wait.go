@@ -1,25 +1,43 @@
 package wait
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/go-acme/lego/v4/log"
 )
 
 // For polls the given function 'f', once every 'interval', up to 'timeout'.
 func For(msg string, timeout, interval time.Duration, f func() (bool, error)) error {
+	return ForContext(context.Background(), msg, timeout, interval, f)
+}
+
+// ForContext is like For, but the polling is also stopped when the context is done.
+func ForContext(ctx context.Context, msg string, timeout, interval time.Duration, f func() (bool, error)) error {
 	log.Infof("Wait for %s [timeout: %s, interval: %s]", msg, timeout, interval)
 
+	return forBackOff(ctx, msg, timeout, backoff.NewConstantBackOff(interval), f)
+}
+
+// ForBackOffContext is like ForContext, but the interval between two polls is given by the backoff
+// (e.g. an exponential backoff with jitter).
+func ForBackOffContext(ctx context.Context, msg string, timeout time.Duration, bo backoff.BackOff, f func() (bool, error)) error {
+	log.Infof("Wait for %s [timeout: %s, backoff]", msg, timeout)
+
+	return forBackOff(ctx, msg, timeout, bo, f)
+}
+
+func forBackOff(ctx context.Context, msg string, timeout time.Duration, bo backoff.BackOff, f func() (bool, error)) error {
 	var lastErr error
 	timeUp := time.After(timeout)
 	for {
 		select {
 		case <-timeUp:
-			if lastErr == nil {
-				return fmt.Errorf("%s: time limit exceeded", msg)
-			}
-			return fmt.Errorf("%s: time limit exceeded: last error: %w", msg, lastErr)
+			return timeLimitExceeded(msg, lastErr)
+		case <-ctx.Done():
+			return fmt.Errorf("%s: %w", msg, ctx.Err())
 		default:
 		}
 
@@ -31,6 +49,23 @@ func For(msg string, timeout, interval time.Duration, f func() (bool, error)) er
 			lastErr = err
 		}
 
-		time.Sleep(interval)
+		delay := bo.NextBackOff()
+		if delay == backoff.Stop {
+			return timeLimitExceeded(msg, lastErr)
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return fmt.Errorf("%s: %w", msg, ctx.Err())
+		}
 	}
 }
+
+func timeLimitExceeded(msg string, lastErr error) error {
+	if lastErr == nil {
+		return fmt.Errorf("%s: time limit exceeded", msg)
+	}
+
+	return fmt.Errorf("%s: time limit exceeded: last error: %w", msg, lastErr)
+}
@@ -1,8 +1,12 @@
 package wait
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
+
+	"github.com/cenkalti/backoff/v4"
 )
 
 func TestForTimeout(t *testing.T) {
@@ -24,3 +28,135 @@ func TestForTimeout(t *testing.T) {
 		t.Logf("%v", err)
 	}
 }
+
+func TestForContext_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+
+	err := ForContext(ctx, "test", time.Minute, 10*time.Second, func() (bool, error) {
+		return false, nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected a context canceled error; got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("the wait was not aborted promptly: %s", elapsed)
+	}
+}
+
+type recordBackOff struct {
+	delays []time.Duration
+	calls  int
+}
+
+func (b *recordBackOff) NextBackOff() time.Duration {
+	if b.calls >= len(b.delays) {
+		return backoff.Stop
+	}
+
+	delay := b.delays[b.calls]
+	b.calls++
+
+	return delay
+}
+
+func (b *recordBackOff) Reset() {}
+
+func TestForBackOffContext(t *testing.T) {
+	bo := &recordBackOff{delays: []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond}}
+
+	var polls int
+
+	err := ForBackOffContext(context.Background(), "test", time.Minute, bo, func() (bool, error) {
+		polls++
+		return polls == 3, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if bo.calls != 2 {
+		t.Errorf("expected 2 backoff delays; got %d", bo.calls)
+	}
+}
+
+func TestForBackOffContext_stop(t *testing.T) {
+	bo := &recordBackOff{delays: []time.Duration{10 * time.Millisecond}}
+
+	err := ForBackOffContext(context.Background(), "test", time.Minute, bo, func() (bool, error) {
+		return false, errors.New("oops")
+	})
+	if err == nil || err.Error() != "test: time limit exceeded: last error: oops" {
+		t.Fatalf("expected a time limit error; got %v", err)
+	}
+}
+
+func TestRetryAfterBackOff(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		retryAfter time.Duration
+		max        time.Duration
+		deadline   time.Duration
+		expected   time.Duration
+	}{
+		{
+			desc:     "no Retry-After",
+			expected: 10 * time.Second,
+		},
+		{
+			desc:       "shorter Retry-After",
+			retryAfter: 5 * time.Second,
+			expected:   10 * time.Second,
+		},
+		{
+			desc:       "longer Retry-After",
+			retryAfter: 30 * time.Second,
+			expected:   30 * time.Second,
+		},
+		{
+			desc:       "capped Retry-After",
+			retryAfter: 5 * time.Minute,
+			max:        time.Minute,
+			expected:   time.Minute,
+		},
+		{
+			desc:       "deadline",
+			retryAfter: 5 * time.Minute,
+			deadline:   -time.Second,
+			expected:   0,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			bo := &RetryAfterBackOff{
+				BackOff:       backoff.NewConstantBackOff(10 * time.Second),
+				MaxRetryAfter: test.max,
+			}
+
+			if test.deadline != 0 {
+				bo.Deadline = time.Now().Add(test.deadline)
+			}
+
+			bo.SetRetryAfter(test.retryAfter)
+
+			if next := bo.NextBackOff(); next != test.expected {
+				t.Errorf("expected %s; got %s", test.expected, next)
+			}
+		})
+	}
+}
+
+func TestRetryAfterBackOff_stop(t *testing.T) {
+	bo := &RetryAfterBackOff{BackOff: &backoff.StopBackOff{}}
+	bo.SetRetryAfter(time.Minute)
+
+	if next := bo.NextBackOff(); next != backoff.Stop {
+		t.Errorf("expected the backoff to stop; got %s", next)
+	}
+}
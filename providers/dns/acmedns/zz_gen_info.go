@@ -0,0 +1,25 @@
+package acmedns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/acmedns/acmedns.toml
+		Name:  "Joohoi's ACME-DNS",
+		Code:  "acme-dns",
+		Since: "v1.1.0",
+		URL:   "https://github.com/joohoi/acme-dns",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "ACME_DNS_API_BASE", Description: "The ACME-DNS API address", Credential: true},
+			{Name: "ACME_DNS_STORAGE_PATH", Description: "The ACME-DNS JSON account data file. A per-domain account will be registered/persisted to this file and used for TXT updates.", Credential: true},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://github.com/joohoi/acme-dns#api",
+			GoClient: "https://github.com/cpu/goacmedns",
+		},
+	}
+}
@@ -0,0 +1,145 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTest(t *testing.T, method, pattern string, status int, file string) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc(pattern, func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != method {
+			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusBadRequest)
+			return
+		}
+
+		apiToken := req.Header.Get(authorizationHeader)
+		if apiToken != "Bearer secret" {
+			http.Error(rw, fmt.Sprintf("invalid credentials: %s", apiToken), http.StatusBadRequest)
+			return
+		}
+
+		if file == "" {
+			rw.WriteHeader(status)
+			return
+		}
+
+		open, err := os.Open(filepath.Join("fixtures", file))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		defer func() { _ = open.Close() }()
+
+		rw.WriteHeader(status)
+		_, err = io.Copy(rw, open)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	client := NewClient("secret")
+	client.HTTPClient = server.Client()
+	client.BaseURL, _ = url.Parse(server.URL)
+
+	return client
+}
+
+func TestClient_GetDomains(t *testing.T) {
+	client := setupTest(t, http.MethodGet, "/dns/domains/v1", http.StatusOK, "domains.json")
+
+	domains, err := client.GetDomains(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"example.com", "sub.example.com", "example.org"}, domains)
+}
+
+func TestClient_GetDomains_error(t *testing.T) {
+	client := setupTest(t, http.MethodGet, "/dns/domains/v1", http.StatusUnauthorized, "error.json")
+
+	_, err := client.GetDomains(context.Background())
+	require.Error(t, err)
+}
+
+func TestClient_CreateTXTRecord(t *testing.T) {
+	client := setupTest(t, http.MethodPost, "/dns/example.com/txt/v1", http.StatusNoContent, "")
+
+	record := TXTRecord{
+		Name: "_acme-challenge",
+		Text: "txtTXTtxt",
+		TTL:  300,
+	}
+
+	err := client.CreateTXTRecord(context.Background(), "example.com", record)
+	require.NoError(t, err)
+}
+
+func TestClient_CreateTXTRecord_error(t *testing.T) {
+	client := setupTest(t, http.MethodPost, "/dns/example.com/txt/v1", http.StatusBadRequest, "error.json")
+
+	err := client.CreateTXTRecord(context.Background(), "example.com", TXTRecord{Name: "_acme-challenge", Text: "txtTXTtxt"})
+	require.Error(t, err)
+}
+
+func TestClient_GetRecords(t *testing.T) {
+	client := setupTest(t, http.MethodGet, "/dns/example.com/records/v1", http.StatusOK, "records.json")
+
+	records, err := client.GetRecords(context.Background(), "example.com")
+	require.NoError(t, err)
+
+	expected := []Record{
+		{
+			HashID: "6b4b2a97e3f1e1e5c1c6e3b1f2d4a5c7",
+			Type:   "A",
+			Name:   "www",
+			TTL:    3600,
+		},
+		{
+			HashID: "0f0e3d2c1b0a99887766554433221100",
+			Type:   "TXT",
+			Name:   "_acme-challenge",
+			TTL:    300,
+			Text:   "ADw2sEd82DUgXcQ9hNBZThJs7zVJkR5v9JeSbAb9mZY",
+		},
+	}
+
+	assert.Equal(t, expected, records)
+}
+
+func TestClient_GetRecords_error(t *testing.T) {
+	client := setupTest(t, http.MethodGet, "/dns/example.com/records/v1", http.StatusUnauthorized, "error.json")
+
+	_, err := client.GetRecords(context.Background(), "example.com")
+	require.Error(t, err)
+}
+
+func TestClient_DeleteRecord(t *testing.T) {
+	client := setupTest(t, http.MethodDelete, "/dns/example.com/abc123/v1", http.StatusNoContent, "")
+
+	err := client.DeleteRecord(context.Background(), "example.com", "abc123")
+	require.NoError(t, err)
+}
+
+func TestClient_DeleteRecord_error(t *testing.T) {
+	client := setupTest(t, http.MethodDelete, "/dns/example.com/abc123/v1", http.StatusNotFound, "error.json")
+
+	err := client.DeleteRecord(context.Background(), "example.com", "abc123")
+	require.Error(t, err)
+}
@@ -0,0 +1,17 @@
+package internal
+
+// TXTRecord a TXT record to create.
+type TXTRecord struct {
+	Name string `json:"name"`
+	Text string `json:"text"`
+	TTL  int    `json:"ttl"`
+}
+
+// Record a record of a domain.
+type Record struct {
+	HashID string `json:"hashId,omitempty"`
+	Type   string `json:"type,omitempty"`
+	Name   string `json:"name,omitempty"`
+	TTL    int    `json:"ttl,omitempty"`
+	Text   string `json:"text,omitempty"`
+}
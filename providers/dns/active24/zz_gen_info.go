@@ -0,0 +1,28 @@
+package active24
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/active24/active24.toml
+		Name:  "Active24",
+		Code:  "active24",
+		Since: "v4.18.0",
+		URL:   "https://www.active24.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "ACTIVE24_API_KEY", Description: "API key (bearer token)", Credential: true},
+			{Name: "ACTIVE24_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "ACTIVE24_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "ACTIVE24_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "ACTIVE24_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.active24.com/",
+			GoClient: "",
+		},
+	}
+}
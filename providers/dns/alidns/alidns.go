@@ -18,15 +18,19 @@ import (
 
 const defaultRegionID = "cn-hangzhou"
 
+const defaultRoleSessionName = "lego"
+
 // Environment variables names.
 const (
 	envNamespace = "ALICLOUD_"
 
-	EnvRAMRole       = envNamespace + "RAM_ROLE"
-	EnvAccessKey     = envNamespace + "ACCESS_KEY"
-	EnvSecretKey     = envNamespace + "SECRET_KEY"
-	EnvSecurityToken = envNamespace + "SECURITY_TOKEN"
-	EnvRegionID      = envNamespace + "REGION_ID"
+	EnvRAMRole         = envNamespace + "RAM_ROLE"
+	EnvAccessKey       = envNamespace + "ACCESS_KEY"
+	EnvSecretKey       = envNamespace + "SECRET_KEY"
+	EnvSecurityToken   = envNamespace + "SECURITY_TOKEN"
+	EnvRoleARN         = envNamespace + "ROLE_ARN"
+	EnvRoleSessionName = envNamespace + "ROLE_SESSION_NAME"
+	EnvRegionID        = envNamespace + "REGION_ID"
 
 	EnvTTL                = envNamespace + "TTL"
 	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
@@ -40,6 +44,8 @@ type Config struct {
 	APIKey             string
 	SecretKey          string
 	SecurityToken      string
+	RoleARN            string
+	RoleSessionName    string
 	RegionID           string
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
@@ -50,6 +56,7 @@ type Config struct {
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
 	return &Config{
+		RoleSessionName:    env.GetOrDefaultString(EnvRoleSessionName, defaultRoleSessionName),
 		TTL:                env.GetOrDefaultInt(EnvTTL, 600),
 		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
 		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
@@ -67,6 +74,8 @@ type DNSProvider struct {
 // - If you're using the instance RAM role, the RAM role environment variable must be passed in: ALICLOUD_RAM_ROLE.
 // - Other than that, credentials must be passed in the environment variables:
 // ALICLOUD_ACCESS_KEY, ALICLOUD_SECRET_KEY, and optionally ALICLOUD_SECURITY_TOKEN.
+// - To assume a RAM role (STS) with these credentials, the role ARN must be passed in: ALICLOUD_ROLE_ARN,
+// and optionally ALICLOUD_ROLE_SESSION_NAME.
 func NewDNSProvider() (*DNSProvider, error) {
 	config := NewDefaultConfig()
 	config.RegionID = env.GetOrFile(EnvRegionID)
@@ -85,6 +94,7 @@ func NewDNSProvider() (*DNSProvider, error) {
 	config.APIKey = values[EnvAccessKey]
 	config.SecretKey = values[EnvSecretKey]
 	config.SecurityToken = env.GetOrFile(EnvSecurityToken)
+	config.RoleARN = env.GetOrFile(EnvRoleARN)
 
 	return NewDNSProviderConfig(config)
 }
@@ -99,16 +109,9 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		config.RegionID = defaultRegionID
 	}
 
-	var credential auth.Credential
-	switch {
-	case config.RAMRole != "":
-		credential = credentials.NewEcsRamRoleCredential(config.RAMRole)
-	case config.APIKey != "" && config.SecretKey != "" && config.SecurityToken != "":
-		credential = credentials.NewStsTokenCredential(config.APIKey, config.SecretKey, config.SecurityToken)
-	case config.APIKey != "" && config.SecretKey != "":
-		credential = credentials.NewAccessKeyCredential(config.APIKey, config.SecretKey)
-	default:
-		return nil, errors.New("alicloud: ram role or credentials missing")
+	credential, err := newCredential(config)
+	if err != nil {
+		return nil, fmt.Errorf("alicloud: %w", err)
 	}
 
 	conf := sdk.NewConfig().WithTimeout(config.HTTPTimeout)
@@ -121,6 +124,36 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 	return &DNSProvider{config: config, client: client}, nil
 }
 
+// newCredential selects the credential from the configuration:
+// the instance RAM role, the assumed RAM role (STS), the STS token, and then the access keys.
+func newCredential(config *Config) (auth.Credential, error) {
+	switch {
+	case config.RAMRole != "":
+		return credentials.NewEcsRamRoleCredential(config.RAMRole), nil
+
+	case config.RoleARN != "":
+		if config.APIKey == "" || config.SecretKey == "" {
+			return nil, errors.New("the role ARN requires the access key and the secret key")
+		}
+
+		sessionName := config.RoleSessionName
+		if sessionName == "" {
+			sessionName = defaultRoleSessionName
+		}
+
+		return credentials.NewRamRoleArnCredential(config.APIKey, config.SecretKey, config.RoleARN, sessionName, 0), nil
+
+	case config.APIKey != "" && config.SecretKey != "" && config.SecurityToken != "":
+		return credentials.NewStsTokenCredential(config.APIKey, config.SecretKey, config.SecurityToken), nil
+
+	case config.APIKey != "" && config.SecretKey != "":
+		return credentials.NewAccessKeyCredential(config.APIKey, config.SecretKey), nil
+
+	default:
+		return nil, errors.New("ram role or credentials missing")
+	}
+}
+
 // Timeout returns the timeout and interval to use when checking for DNS propagation.
 // Adjusting here to cope with spikes in propagation times.
 func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
@@ -4,7 +4,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -13,6 +16,9 @@ const envDomain = envNamespace + "DOMAIN"
 var envTest = tester.NewEnvTest(
 	EnvAccessKey,
 	EnvSecretKey,
+	EnvSecurityToken,
+	EnvRoleARN,
+	EnvRoleSessionName,
 	EnvRAMRole).
 	WithDomain(envDomain)
 
@@ -35,6 +41,14 @@ func TestNewDNSProvider(t *testing.T) {
 				EnvRAMRole: "LegoInstanceRole",
 			},
 		},
+		{
+			desc: "success (role ARN)",
+			envVars: map[string]string{
+				EnvAccessKey: "123",
+				EnvSecretKey: "456",
+				EnvRoleARN:   "acs:ram::123456789012:role/lego",
+			},
+		},
 		{
 			desc: "missing credentials",
 			envVars: map[string]string{
@@ -43,6 +57,13 @@ func TestNewDNSProvider(t *testing.T) {
 			},
 			expected: "alicloud: some credentials information are missing: ALICLOUD_ACCESS_KEY,ALICLOUD_SECRET_KEY",
 		},
+		{
+			desc: "role ARN without credentials",
+			envVars: map[string]string{
+				EnvRoleARN: "acs:ram::123456789012:role/lego",
+			},
+			expected: "alicloud: some credentials information are missing: ALICLOUD_ACCESS_KEY,ALICLOUD_SECRET_KEY",
+		},
 		{
 			desc: "missing access key",
 			envVars: map[string]string{
@@ -136,6 +157,75 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func Test_newCredential(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		config   *Config
+		expected auth.Credential
+	}{
+		{
+			desc:     "instance RAM role",
+			config:   &Config{RAMRole: "LegoInstanceRole", APIKey: "123", SecretKey: "456", RoleARN: "acs:ram::123456789012:role/lego"},
+			expected: credentials.NewEcsRamRoleCredential("LegoInstanceRole"),
+		},
+		{
+			desc:     "role ARN",
+			config:   &Config{APIKey: "123", SecretKey: "456", SecurityToken: "789", RoleARN: "acs:ram::123456789012:role/lego", RoleSessionName: "session"},
+			expected: credentials.NewRamRoleArnCredential("123", "456", "acs:ram::123456789012:role/lego", "session", 0),
+		},
+		{
+			desc:     "role ARN with the default session name",
+			config:   &Config{APIKey: "123", SecretKey: "456", RoleARN: "acs:ram::123456789012:role/lego"},
+			expected: credentials.NewRamRoleArnCredential("123", "456", "acs:ram::123456789012:role/lego", "lego", 0),
+		},
+		{
+			desc:     "STS token",
+			config:   &Config{APIKey: "123", SecretKey: "456", SecurityToken: "789"},
+			expected: credentials.NewStsTokenCredential("123", "456", "789"),
+		},
+		{
+			desc:     "access key",
+			config:   &Config{APIKey: "123", SecretKey: "456"},
+			expected: credentials.NewAccessKeyCredential("123", "456"),
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			credential, err := newCredential(test.config)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, credential)
+		})
+	}
+}
+
+func Test_newCredential_errors(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		config   *Config
+		expected string
+	}{
+		{
+			desc:     "missing credentials",
+			config:   &Config{},
+			expected: "ram role or credentials missing",
+		},
+		{
+			desc:     "role ARN without credentials",
+			config:   &Config{RoleARN: "acs:ram::123456789012:role/lego"},
+			expected: "the role ARN requires the access key and the secret key",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := newCredential(test.config)
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -0,0 +1,33 @@
+package alidns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/alidns/alidns.toml
+		Name:  "Alibaba Cloud DNS",
+		Code:  "alidns",
+		Since: "v1.1.0",
+		URL:   "https://www.alibabacloud.com/product/dns",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "ALICLOUD_ACCESS_KEY", Description: "Access key ID", Credential: true},
+			{Name: "ALICLOUD_RAM_ROLE", Description: "Your instance RAM role (https://www.alibabacloud.com/help/doc-detail/54579.htm)", Credential: true},
+			{Name: "ALICLOUD_SECRET_KEY", Description: "Access Key secret", Credential: true},
+			{Name: "ALICLOUD_SECURITY_TOKEN", Description: "STS Security Token (optional)", Credential: true},
+			{Name: "ALICLOUD_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "ALICLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "ALICLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "ALICLOUD_ROLE_ARN", Description: "The ARN of the RAM role to assume with the access key (STS)"},
+			{Name: "ALICLOUD_ROLE_SESSION_NAME", Description: "The session name used to assume the RAM role (Default: lego)"},
+			{Name: "ALICLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.alibabacloud.com/help/en/alibaba-cloud-dns/latest/api-alidns-2015-01-09-dir-parsing-records",
+			GoClient: "https://github.com/aliyun/alibaba-cloud-sdk-go",
+		},
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 	"github.com/go-viper/mapstructure/v2"
 )
 
@@ -126,6 +127,8 @@ func (c *Client) newRequest(ctx context.Context, action string, requestParams an
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	return req, nil
 }
 
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 // authEndpoint represents the Identity API endpoint to call.
@@ -67,6 +68,8 @@ func (c *Identifier) Authentication(ctx context.Context, sessionLifetime int, se
 		return "", fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return "", errutils.NewHTTPDoError(req, err)
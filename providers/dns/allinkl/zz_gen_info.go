@@ -0,0 +1,28 @@
+package allinkl
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/allinkl/allinkl.toml
+		Name:  "all-inkl",
+		Code:  "allinkl",
+		Since: "v4.5.0",
+		URL:   "https://all-inkl.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "ALL_INKL_LOGIN", Description: "KAS login", Credential: true},
+			{Name: "ALL_INKL_PASSWORD", Description: "KAS password", Credential: true},
+			{Name: "ALL_INKL_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "ALL_INKL_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "ALL_INKL_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://kasapi.kasserver.com/dokumentation/phpdoc/index.html",
+			GoClient: "",
+		},
+	}
+}
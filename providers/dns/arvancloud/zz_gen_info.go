@@ -0,0 +1,28 @@
+package arvancloud
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/arvancloud/arvancloud.toml
+		Name:  "ArvanCloud",
+		Code:  "arvancloud",
+		Since: "v3.8.0",
+		URL:   "https://arvancloud.ir",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "ARVANCLOUD_API_KEY", Description: "API key", Credential: true},
+			{Name: "ARVANCLOUD_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "ARVANCLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "ARVANCLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "ARVANCLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.arvancloud.ir/docs/api/cdn/4.0",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package auroradns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/auroradns/auroradns.toml
+		Name:  "Aurora DNS",
+		Code:  "auroradns",
+		Since: "v0.4.0",
+		URL:   "https://www.pcextreme.com/dns-health-checks",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "AURORA_API_KEY", Description: "API key or username to used", Credential: true},
+			{Name: "AURORA_SECRET", Description: "Secret password to be used", Credential: true},
+			{Name: "AURORA_ENDPOINT", Description: "API endpoint URL"},
+			{Name: "AURORA_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "AURORA_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "AURORA_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://libcloud.readthedocs.io/en/latest/dns/drivers/auroradns.html#api-docs",
+			GoClient: "https://github.com/nrdcg/auroradns",
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package autodns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/autodns/autodns.toml
+		Name:  "Autodns",
+		Code:  "autodns",
+		Since: "v3.2.0",
+		URL:   "https://www.internetx.com/domains/autodns/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "AUTODNS_API_PASSWORD", Description: "User Password", Credential: true},
+			{Name: "AUTODNS_API_USER", Description: "Username", Credential: true},
+			{Name: "AUTODNS_CONTEXT", Description: "API context (4 for production, 1 for testing. Defaults to 4)"},
+			{Name: "AUTODNS_ENDPOINT", Description: "API endpoint URL, defaults to https://api.autodns.com/v1/"},
+			{Name: "AUTODNS_HTTP_TIMEOUT", Description: "API request timeout, defaults to 30 seconds"},
+			{Name: "AUTODNS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "AUTODNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "AUTODNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://help.internetx.com/display/APIJSONEN",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package azure
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/azure/azure.toml
+		Name:  "Azure (deprecated)",
+		Code:  "azure",
+		Since: "v0.4.0",
+		URL:   "https://azure.microsoft.com/services/dns/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "AZURE_CLIENT_ID", Description: "Client ID", Credential: true},
+			{Name: "AZURE_CLIENT_SECRET", Description: "Client secret", Credential: true},
+			{Name: "AZURE_ENVIRONMENT", Description: "Azure environment, one of: public, usgovernment, german, and china", Credential: true},
+			{Name: "AZURE_RESOURCE_GROUP", Description: "Resource group", Credential: true},
+			{Name: "AZURE_SUBSCRIPTION_ID", Description: "Subscription ID", Credential: true},
+			{Name: "AZURE_TENANT_ID", Description: "Tenant ID", Credential: true},
+			{Name: "instance metadata service", Description: "If the credentials are **not** set via the environment, then it will attempt to get a bearer token via the [instance metadata service](https://docs.microsoft.com/en-us/azure/virtual-machines/windows/instance-metadata-service).", Credential: true},
+			{Name: "AZURE_METADATA_ENDPOINT", Description: "Metadata Service endpoint URL"},
+			{Name: "AZURE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "AZURE_PRIVATE_ZONE", Description: "Set to true to use Azure Private DNS Zones and not public"},
+			{Name: "AZURE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "AZURE_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+			{Name: "AZURE_ZONE_NAME", Description: "Zone name to use inside Azure DNS service to add the TXT record in"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://docs.microsoft.com/en-us/go/azure/",
+			GoClient: "https://github.com/Azure/azure-sdk-for-go",
+		},
+	}
+}
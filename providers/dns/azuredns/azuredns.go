@@ -38,6 +38,8 @@ const (
 	EnvOIDCRequestURL    = envNamespace + "OIDC_REQUEST_URL"
 	EnvOIDCRequestToken  = envNamespace + "OIDC_REQUEST_TOKEN"
 
+	EnvFederatedTokenFile = envNamespace + "FEDERATED_TOKEN_FILE"
+
 	EnvAuthMethod     = envNamespace + "AUTH_METHOD"
 	EnvAuthMSITimeout = envNamespace + "AUTH_MSI_TIMEOUT"
 
@@ -69,6 +71,8 @@ type Config struct {
 	OIDCRequestURL    string
 	OIDCRequestToken  string
 
+	FederatedTokenFile string
+
 	AuthMethod     string
 	AuthMSITimeout time.Duration
 
@@ -126,6 +130,8 @@ func NewDNSProvider() (*DNSProvider, error) {
 	config.OIDCToken = env.GetOrFile(EnvOIDCToken)
 	config.OIDCTokenFilePath = env.GetOrFile(EnvOIDCTokenFilePath)
 
+	config.FederatedTokenFile = env.GetOrFile(EnvFederatedTokenFile)
+
 	config.ServiceDiscoveryFilter = env.GetOrFile(EnvServiceDiscoveryFilter)
 
 	oidcValues, _ := env.GetWithFallback(
@@ -201,8 +207,13 @@ func getCredentials(config *Config) (azcore.TokenCredential, error) {
 
 		return azidentity.NewEnvironmentCredential(&azidentity.EnvironmentCredentialOptions{ClientOptions: clientOptions})
 
-	case "wli":
-		return azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{ClientOptions: clientOptions})
+	case "wli", "workloadidentity":
+		return azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
+			ClientOptions: clientOptions,
+			ClientID:      config.ClientID,
+			TenantID:      config.TenantID,
+			TokenFilePath: config.FederatedTokenFile,
+		})
 
 	case "msi":
 		cred, err := azidentity.NewManagedIdentityCredential(&azidentity.ManagedIdentityCredentialOptions{ClientOptions: clientOptions})
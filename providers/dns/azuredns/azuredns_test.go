@@ -1,9 +1,12 @@
 package azuredns
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/go-acme/lego/v4/platform/tester"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -55,6 +58,124 @@ func TestNewDNSProvider(t *testing.T) {
 	}
 }
 
+func Test_getCredentials(t *testing.T) {
+	credEnvTest := tester.NewEnvTest(EnvClientID, EnvTenantID, EnvClientSecret, EnvFederatedTokenFile)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	err := os.WriteFile(tokenFile, []byte("federated-token"), 0o600)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc     string
+		config   *Config
+		expected any
+	}{
+		{
+			desc: "env",
+			config: &Config{
+				AuthMethod:   "env",
+				ClientID:     "00000000-0000-0000-0000-000000000000",
+				ClientSecret: "secret",
+				TenantID:     "00000000-0000-0000-0000-000000000000",
+			},
+			expected: &azidentity.ClientSecretCredential{},
+		},
+		{
+			desc: "wli",
+			config: &Config{
+				AuthMethod:         "wli",
+				ClientID:           "00000000-0000-0000-0000-000000000000",
+				TenantID:           "00000000-0000-0000-0000-000000000000",
+				FederatedTokenFile: tokenFile,
+			},
+			expected: &azidentity.WorkloadIdentityCredential{},
+		},
+		{
+			desc: "workloadidentity",
+			config: &Config{
+				AuthMethod:         "workloadidentity",
+				ClientID:           "00000000-0000-0000-0000-000000000000",
+				TenantID:           "00000000-0000-0000-0000-000000000000",
+				FederatedTokenFile: tokenFile,
+			},
+			expected: &azidentity.WorkloadIdentityCredential{},
+		},
+		{
+			desc:     "msi",
+			config:   &Config{AuthMethod: "msi"},
+			expected: &timeoutTokenCredential{},
+		},
+		{
+			desc:     "cli",
+			config:   &Config{AuthMethod: "cli"},
+			expected: &azidentity.AzureCLICredential{},
+		},
+		{
+			desc: "oidc",
+			config: &Config{
+				AuthMethod: "oidc",
+				ClientID:   "00000000-0000-0000-0000-000000000000",
+				TenantID:   "00000000-0000-0000-0000-000000000000",
+				OIDCToken:  "token",
+			},
+			expected: &azidentity.ClientAssertionCredential{},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			defer credEnvTest.RestoreEnv()
+			credEnvTest.ClearEnv()
+
+			cred, err := getCredentials(test.config)
+			require.NoError(t, err)
+
+			assert.IsType(t, test.expected, cred)
+		})
+	}
+}
+
+func Test_getCredentials_workloadIdentityFromEnv(t *testing.T) {
+	credEnvTest := tester.NewEnvTest(EnvClientID, EnvTenantID, EnvFederatedTokenFile)
+
+	defer credEnvTest.RestoreEnv()
+	credEnvTest.ClearEnv()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	err := os.WriteFile(tokenFile, []byte("federated-token"), 0o600)
+	require.NoError(t, err)
+
+	credEnvTest.Apply(map[string]string{
+		EnvClientID:           "00000000-0000-0000-0000-000000000000",
+		EnvTenantID:           "00000000-0000-0000-0000-000000000000",
+		EnvFederatedTokenFile: tokenFile,
+	})
+
+	// The values are read from the environment by the credential itself.
+	cred, err := getCredentials(&Config{AuthMethod: "workloadidentity"})
+	require.NoError(t, err)
+
+	assert.IsType(t, &azidentity.WorkloadIdentityCredential{}, cred)
+}
+
+func Test_getCredentials_workloadIdentityMissingTokenFile(t *testing.T) {
+	credEnvTest := tester.NewEnvTest(EnvClientID, EnvTenantID, EnvFederatedTokenFile)
+
+	defer credEnvTest.RestoreEnv()
+	credEnvTest.ClearEnv()
+
+	config := &Config{
+		AuthMethod: "workloadidentity",
+		ClientID:   "00000000-0000-0000-0000-000000000000",
+		TenantID:   "00000000-0000-0000-0000-000000000000",
+	}
+
+	_, err := getCredentials(config)
+	require.Error(t, err)
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
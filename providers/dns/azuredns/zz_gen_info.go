@@ -0,0 +1,39 @@
+package azuredns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/azuredns/azuredns.toml
+		Name:  "Azure DNS",
+		Code:  "azuredns",
+		Since: "v4.13.0",
+		URL:   "https://azure.microsoft.com/services/dns/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "AZURE_CLIENT_CERTIFICATE_PATH", Description: "Client certificate path", Credential: true},
+			{Name: "AZURE_CLIENT_ID", Description: "Client ID", Credential: true},
+			{Name: "AZURE_CLIENT_SECRET", Description: "Client secret", Credential: true},
+			{Name: "AZURE_FEDERATED_TOKEN_FILE", Description: "Path to the federated token file (workload identity)", Credential: true},
+			{Name: "AZURE_TENANT_ID", Description: "Tenant ID", Credential: true},
+			{Name: "AZURE_AUTH_METHOD", Description: "Specify which authentication method to use"},
+			{Name: "AZURE_AUTH_MSI_TIMEOUT", Description: "Managed Identity timeout duration"},
+			{Name: "AZURE_ENVIRONMENT", Description: "Azure environment, one of: public, usgovernment, and china"},
+			{Name: "AZURE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "AZURE_PRIVATE_ZONE", Description: "Set to true to use Azure Private DNS Zones and not public"},
+			{Name: "AZURE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "AZURE_RESOURCE_GROUP", Description: "DNS zone resource group"},
+			{Name: "AZURE_SERVICEDISCOVERY_FILTER", Description: "Advanced ServiceDiscovery filter using Kusto query condition"},
+			{Name: "AZURE_SUBSCRIPTION_ID", Description: "DNS zone subscription ID"},
+			{Name: "AZURE_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+			{Name: "AZURE_ZONE_NAME", Description: "Zone name to use inside Azure DNS service to add the TXT record in"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://docs.microsoft.com/en-us/go/azure/",
+			GoClient: "https://github.com/Azure/azure-sdk-for-go",
+		},
+	}
+}
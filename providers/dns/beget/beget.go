@@ -0,0 +1,200 @@
+// Package beget implements a DNS provider for solving the DNS-01 challenge using Beget.
+package beget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/go-acme/lego/v4/providers/dns/beget/internal"
+)
+
+// Environment variables names.
+const (
+	envNamespace = "BEGET_"
+
+	EnvUsername = envNamespace + "USERNAME"
+	EnvPassword = envNamespace + "PASSWORD"
+
+	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
+	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
+	EnvHTTPTimeout        = envNamespace + "HTTP_TIMEOUT"
+)
+
+// Config is used to configure the creation of the DNSProvider.
+type Config struct {
+	Username string
+	Password string
+
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+	HTTPClient         *http.Client
+}
+
+// NewDefaultConfig returns a default configuration for the DNSProvider.
+func NewDefaultConfig() *Config {
+	return &Config{
+		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 5*time.Minute),
+		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 10*time.Second),
+		HTTPClient: &http.Client{
+			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+		},
+	}
+}
+
+// DNSProvider implements the challenge.Provider interface.
+type DNSProvider struct {
+	config *Config
+	client *internal.Client
+
+	// muRecords serializes the read-modify-write of the TXT records.
+	muRecords sync.Mutex
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for Beget.
+// Credentials must be passed in the environment variables:
+// BEGET_USERNAME and BEGET_PASSWORD.
+func NewDNSProvider() (*DNSProvider, error) {
+	values, err := env.Get(EnvUsername, EnvPassword)
+	if err != nil {
+		return nil, fmt.Errorf("beget: %w", err)
+	}
+
+	config := NewDefaultConfig()
+	config.Username = values[EnvUsername]
+	config.Password = values[EnvPassword]
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewDNSProviderConfig return a DNSProvider instance configured for Beget.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("beget: the configuration of the DNS provider is nil")
+	}
+
+	client, err := internal.NewClient(config.Username, config.Password)
+	if err != nil {
+		return nil, fmt.Errorf("beget: %w", err)
+	}
+
+	if config.HTTPClient != nil {
+		client.HTTPClient = config.HTTPClient
+	}
+
+	return &DNSProvider{config: config, client: client}, nil
+}
+
+// Timeout returns the timeout and interval to use when checking for DNS propagation.
+// Adjusting here to cope with spikes in propagation times.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
+
+// Present creates a TXT record using the specified parameters.
+// The value is added to the existing TXT records of the subdomain.
+func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	fqdn, err := d.checkZone(ctx, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("beget: %w", err)
+	}
+
+	d.muRecords.Lock()
+	defer d.muRecords.Unlock()
+
+	values, err := d.getTXTValues(ctx, fqdn)
+	if err != nil {
+		return fmt.Errorf("beget: %w", err)
+	}
+
+	if slices.Contains(values, info.Value) {
+		return nil
+	}
+
+	err = d.client.ChangeTXTRecords(ctx, fqdn, append(values, info.Value))
+	if err != nil {
+		return fmt.Errorf("beget: change records: %w", err)
+	}
+
+	return nil
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+// The other TXT records of the subdomain are kept.
+func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	fqdn, err := d.checkZone(ctx, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("beget: %w", err)
+	}
+
+	d.muRecords.Lock()
+	defer d.muRecords.Unlock()
+
+	values, err := d.getTXTValues(ctx, fqdn)
+	if err != nil {
+		return fmt.Errorf("beget: %w", err)
+	}
+
+	newValues := slices.DeleteFunc(slices.Clone(values), func(v string) bool { return v == info.Value })
+	if len(newValues) == len(values) {
+		return nil
+	}
+
+	err = d.client.ChangeTXTRecords(ctx, fqdn, newValues)
+	if err != nil {
+		return fmt.Errorf("beget: change records: %w", err)
+	}
+
+	return nil
+}
+
+// getTXTValues returns the current TXT values of the FQDN.
+func (d *DNSProvider) getTXTValues(ctx context.Context, fqdn string) ([]string, error) {
+	records, err := d.client.GetTXTRecords(ctx, fqdn)
+	if err != nil {
+		return nil, fmt.Errorf("get records: %w", err)
+	}
+
+	var values []string
+	for _, record := range records {
+		values = append(values, record.TxtData)
+	}
+
+	return values, nil
+}
+
+// checkZone checks that the FQDN belongs to a domain of the account,
+// and returns the FQDN as expected by the API (lowercase, without the trailing dot).
+func (d *DNSProvider) checkZone(ctx context.Context, fqdn string) (string, error) {
+	domains, err := d.client.GetDomains(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get domains: %w", err)
+	}
+
+	name := strings.ToLower(dns01.UnFqdn(fqdn))
+
+	for _, domain := range domains {
+		domainName := strings.ToLower(dns01.UnFqdn(domain.FQDN))
+
+		if name == domainName || strings.HasSuffix(name, "."+domainName) {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("no zone found for %s", fqdn)
+}
@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
+)
+
+const defaultBaseURL = "https://api.beget.com/api"
+
+const statusSuccess = "success"
+
+// Client the Beget API client.
+type Client struct {
+	login    string
+	password string
+
+	BaseURL    *url.URL
+	HTTPClient *http.Client
+}
+
+// NewClient creates a new Client.
+func NewClient(login, password string) (*Client, error) {
+	if login == "" || password == "" {
+		return nil, errors.New("credentials missing")
+	}
+
+	baseURL, _ := url.Parse(defaultBaseURL)
+
+	return &Client{
+		login:      login,
+		password:   password,
+		BaseURL:    baseURL,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// GetDomains lists the domains of the account.
+// https://beget.com/en/kb/api/functions-for-work-with-domains#getlist
+func (c *Client) GetDomains(ctx context.Context) ([]Domain, error) {
+	var result APIResponse[[]Domain]
+
+	err := c.do(ctx, "domain/getList", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Answer.Result, nil
+}
+
+// GetTXTRecords gets the TXT records of a FQDN.
+// https://beget.com/en/kb/api/dns-administration-functions#getdata
+func (c *Client) GetTXTRecords(ctx context.Context, fqdn string) ([]TXTRecord, error) {
+	var result APIResponse[DNSData]
+
+	err := c.do(ctx, "dns/getData", map[string]string{"fqdn": fqdn}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Answer.Result.Records.TXT, nil
+}
+
+// ChangeTXTRecords replaces the TXT records of a FQDN.
+// https://beget.com/en/kb/api/dns-administration-functions#changerecords
+func (c *Client) ChangeTXTRecords(ctx context.Context, fqdn string, values []string) error {
+	payload := ChangeRecordsRequest{
+		FQDN:    fqdn,
+		Records: RecordsChange{TXT: []RecordValue{}},
+	}
+
+	for i, value := range values {
+		payload.Records.TXT = append(payload.Records.TXT, RecordValue{Priority: (i + 1) * 10, Value: value})
+	}
+
+	var result APIResponse[bool]
+
+	return c.do(ctx, "dns/changeRecords", payload, &result)
+}
+
+func (c *Client) do(ctx context.Context, method string, inputData any, result interface{ Err() error }) error {
+	endpoint := c.BaseURL.JoinPath(method)
+
+	data := url.Values{}
+	data.Set("login", c.login)
+	data.Set("passwd", c.password)
+	data.Set("output_format", "json")
+
+	if inputData != nil {
+		raw, err := json.Marshal(inputData)
+		if err != nil {
+			return fmt.Errorf("failed to create input data: %w", err)
+		}
+
+		data.Set("input_format", "json")
+		data.Set("input_data", string(raw))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), strings.NewReader(data.Encode()))
+	if err != nil {
+		return fmt.Errorf("unable to create request: %w", err)
+	}
+
+	useragent.SetHeader(req.Header)
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return errutils.NewHTTPDoError(req, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return errutils.NewUnexpectedResponseStatusCodeError(req, resp)
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errutils.NewReadResponseError(req, resp.StatusCode, err)
+	}
+
+	err = json.Unmarshal(raw, result)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
+	}
+
+	return result.Err()
+}
@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTest(t *testing.T, pattern, filename, expectedInputData string) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc(pattern, func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		if req.FormValue("login") != "user" || req.FormValue("passwd") != "secret" {
+			http.Error(rw, "invalid credentials", http.StatusUnauthorized)
+			return
+		}
+
+		if req.FormValue("output_format") != "json" {
+			http.Error(rw, "invalid output format", http.StatusBadRequest)
+			return
+		}
+
+		if req.FormValue("input_data") != expectedInputData {
+			http.Error(rw, fmt.Sprintf("invalid input data: %s", req.FormValue("input_data")), http.StatusBadRequest)
+			return
+		}
+
+		file, err := os.Open(filepath.Join("fixtures", filename))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		defer func() { _ = file.Close() }()
+
+		_, _ = io.Copy(rw, file)
+	})
+
+	client, err := NewClient("user", "secret")
+	require.NoError(t, err)
+
+	client.HTTPClient = server.Client()
+	client.BaseURL, _ = url.Parse(server.URL)
+
+	return client
+}
+
+func TestClient_GetDomains(t *testing.T) {
+	client := setupTest(t, "/domain/getList", "domains.json", "")
+
+	domains, err := client.GetDomains(context.Background())
+	require.NoError(t, err)
+
+	expected := []Domain{
+		{ID: 101, FQDN: "example.com"},
+		{ID: 102, FQDN: "example.org"},
+	}
+
+	assert.Equal(t, expected, domains)
+}
+
+func TestClient_GetDomains_error(t *testing.T) {
+	client := setupTest(t, "/domain/getList", "error.json", "")
+
+	_, err := client.GetDomains(context.Background())
+	require.EqualError(t, err, "AUTH_ERROR: Incorrect login or password")
+}
+
+func TestClient_GetTXTRecords(t *testing.T) {
+	client := setupTest(t, "/dns/getData", "dns_data.json", `{"fqdn":"_acme-challenge.example.com"}`)
+
+	records, err := client.GetTXTRecords(context.Background(), "_acme-challenge.example.com")
+	require.NoError(t, err)
+
+	assert.Equal(t, []TXTRecord{{TTL: 300, TxtData: "existing"}}, records)
+}
+
+func TestClient_GetTXTRecords_error(t *testing.T) {
+	client := setupTest(t, "/dns/getData", "answer_error.json", `{"fqdn":"_acme-challenge.example.com"}`)
+
+	_, err := client.GetTXTRecords(context.Background(), "_acme-challenge.example.com")
+	require.EqualError(t, err, "INVALID_DATA: Domain is not found")
+}
+
+func TestClient_ChangeTXTRecords(t *testing.T) {
+	client := setupTest(t, "/dns/changeRecords", "change_records.json",
+		`{"fqdn":"_acme-challenge.example.com","records":{"TXT":[{"priority":10,"value":"existing"},{"priority":20,"value":"txtTXTtxt"}]}}`)
+
+	err := client.ChangeTXTRecords(context.Background(), "_acme-challenge.example.com", []string{"existing", "txtTXTtxt"})
+	require.NoError(t, err)
+}
+
+func TestClient_ChangeTXTRecords_empty(t *testing.T) {
+	client := setupTest(t, "/dns/changeRecords", "change_records.json",
+		`{"fqdn":"_acme-challenge.example.com","records":{"TXT":[]}}`)
+
+	err := client.ChangeTXTRecords(context.Background(), "_acme-challenge.example.com", nil)
+	require.NoError(t, err)
+}
@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type APIResponse[T any] struct {
+	Status    string     `json:"status"`
+	Answer    *Answer[T] `json:"answer,omitempty"`
+	ErrorCode string     `json:"error_code,omitempty"`
+	ErrorText string     `json:"error_text,omitempty"`
+}
+
+func (a APIResponse[T]) Err() error {
+	if a.Status != statusSuccess {
+		return fmt.Errorf("%s: %s", a.ErrorCode, a.ErrorText)
+	}
+
+	if a.Answer == nil {
+		return errors.New("missing answer")
+	}
+
+	if a.Answer.Status != statusSuccess {
+		var msg []string
+		for _, e := range a.Answer.Errors {
+			msg = append(msg, e.Error())
+		}
+
+		return errors.New(strings.Join(msg, ", "))
+	}
+
+	return nil
+}
+
+type Answer[T any] struct {
+	Status string      `json:"status"`
+	Result T           `json:"result"`
+	Errors []ErrorInfo `json:"errors,omitempty"`
+}
+
+type ErrorInfo struct {
+	ErrorCode string `json:"error_code"`
+	ErrorText string `json:"error_text"`
+}
+
+func (e ErrorInfo) Error() string {
+	return fmt.Sprintf("%s: %s", e.ErrorCode, e.ErrorText)
+}
+
+type Domain struct {
+	ID   int    `json:"id"`
+	FQDN string `json:"fqdn"`
+}
+
+type DNSData struct {
+	FQDN    string  `json:"fqdn"`
+	SetType int     `json:"set_type"`
+	Records Records `json:"records"`
+}
+
+type Records struct {
+	TXT []TXTRecord `json:"TXT,omitempty"`
+}
+
+type TXTRecord struct {
+	TTL     int    `json:"ttl,omitempty"`
+	TxtData string `json:"txtdata"`
+}
+
+type ChangeRecordsRequest struct {
+	FQDN    string        `json:"fqdn"`
+	Records RecordsChange `json:"records"`
+}
+
+type RecordsChange struct {
+	TXT []RecordValue `json:"TXT"`
+}
+
+type RecordValue struct {
+	Priority int    `json:"priority"`
+	Value    string `json:"value"`
+}
@@ -0,0 +1,28 @@
+package beget
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/beget/beget.toml
+		Name:  "Beget.com",
+		Code:  "beget",
+		Since: "v4.18.0",
+		URL:   "https://beget.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "BEGET_PASSWORD", Description: "API password", Credential: true},
+			{Name: "BEGET_USERNAME", Description: "API username", Credential: true},
+			{Name: "BEGET_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "BEGET_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "BEGET_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://beget.com/en/kb/api/dns-administration-functions",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package bindman
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/bindman/bindman.toml
+		Name:  "Bindman",
+		Code:  "bindman",
+		Since: "v2.6.0",
+		URL:   "https://github.com/labbsr0x/bindman-dns-webhook",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "BINDMAN_MANAGER_ADDRESS", Description: "The server URL, should have scheme, hostname, and port (if required) of the Bindman-DNS Manager server", Credential: true},
+			{Name: "BINDMAN_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "BINDMAN_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "BINDMAN_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://gitlab.isc.org/isc-projects/bind9",
+			GoClient: "https://github.com/labbsr0x/bindman-dns-webhook",
+		},
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 // Object types.
@@ -260,6 +261,8 @@ func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, paylo
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 
 	if payload != nil {
@@ -0,0 +1,32 @@
+package bluecat
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/bluecat/bluecat.toml
+		Name:  "Bluecat",
+		Code:  "bluecat",
+		Since: "v0.5.0",
+		URL:   "https://www.bluecatnetworks.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "BLUECAT_CONFIG_NAME", Description: "Configuration name", Credential: true},
+			{Name: "BLUECAT_DNS_VIEW", Description: "External DNS View Name", Credential: true},
+			{Name: "BLUECAT_PASSWORD", Description: "API password", Credential: true},
+			{Name: "BLUECAT_SERVER_URL", Description: "The server URL, should have scheme, hostname, and port (if required) of the authoritative Bluecat BAM serve", Credential: true},
+			{Name: "BLUECAT_USER_NAME", Description: "API username", Credential: true},
+			{Name: "BLUECAT_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "BLUECAT_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "BLUECAT_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "BLUECAT_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://docs.bluecatnetworks.com/r/Address-Manager-API-Guide/REST-API/9.1.0",
+			GoClient: "",
+		},
+	}
+}
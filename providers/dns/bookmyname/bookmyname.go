@@ -0,0 +1,149 @@
+// Package bookmyname implements a DNS provider for solving the DNS-01 challenge using Bookmyname.
+package bookmyname
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/go-acme/lego/v4/providers/dns/bookmyname/internal"
+	"golang.org/x/net/publicsuffix"
+)
+
+// Environment variables names.
+const (
+	envNamespace = "BOOKMYNAME_"
+
+	EnvUsername = envNamespace + "USERNAME"
+	EnvPassword = envNamespace + "PASSWORD"
+
+	EnvTTL                = envNamespace + "TTL"
+	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
+	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
+	EnvHTTPTimeout        = envNamespace + "HTTP_TIMEOUT"
+)
+
+// Config is used to configure the creation of the DNSProvider.
+type Config struct {
+	Username string
+	Password string
+
+	TTL                int
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+	HTTPClient         *http.Client
+}
+
+// NewDefaultConfig returns a default configuration for the DNSProvider.
+func NewDefaultConfig() *Config {
+	return &Config{
+		TTL:                env.GetOrDefaultInt(EnvTTL, 300),
+		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		HTTPClient: &http.Client{
+			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+		},
+	}
+}
+
+// DNSProvider implements the challenge.Provider interface.
+type DNSProvider struct {
+	config *Config
+	client *internal.Client
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for Bookmyname.
+// Credentials must be passed in the environment variables:
+// BOOKMYNAME_USERNAME, BOOKMYNAME_PASSWORD.
+func NewDNSProvider() (*DNSProvider, error) {
+	values, err := env.Get(EnvUsername, EnvPassword)
+	if err != nil {
+		return nil, fmt.Errorf("bookmyname: %w", err)
+	}
+
+	config := NewDefaultConfig()
+	config.Username = values[EnvUsername]
+	config.Password = values[EnvPassword]
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewDNSProviderConfig return a DNSProvider instance configured for Bookmyname.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("bookmyname: the configuration of the DNS provider is nil")
+	}
+
+	if config.Username == "" || config.Password == "" {
+		return nil, errors.New("bookmyname: credentials missing")
+	}
+
+	client := internal.NewClient(config.Username, config.Password)
+
+	if config.HTTPClient != nil {
+		client.HTTPClient = config.HTTPClient
+	}
+
+	return &DNSProvider{config: config, client: client}, nil
+}
+
+// Present creates a TXT record to fulfill the dns-01 challenge.
+func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	// The dyndns API only manages the zones of the registered domains.
+	authZone, err := publicsuffix.EffectiveTLDPlusOne(dns01.UnFqdn(info.EffectiveFQDN))
+	if err != nil {
+		return fmt.Errorf("bookmyname: could not find zone for domain %q: %w", domain, err)
+	}
+
+	record := internal.Record{
+		Hostname: dns01.UnFqdn(info.EffectiveFQDN),
+		Type:     "TXT",
+		TTL:      d.config.TTL,
+		Value:    info.Value,
+	}
+
+	err = d.client.AddRecord(context.Background(), record)
+	if err != nil {
+		return fmt.Errorf("bookmyname: failed to create TXT record [domain: %s, hostname: %s]: %w",
+			authZone, record.Hostname, err)
+	}
+
+	return nil
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	authZone, err := publicsuffix.EffectiveTLDPlusOne(dns01.UnFqdn(info.EffectiveFQDN))
+	if err != nil {
+		return fmt.Errorf("bookmyname: could not find zone for domain %q: %w", domain, err)
+	}
+
+	record := internal.Record{
+		Hostname: dns01.UnFqdn(info.EffectiveFQDN),
+		Type:     "TXT",
+		TTL:      d.config.TTL,
+		Value:    info.Value,
+	}
+
+	err = d.client.RemoveRecord(context.Background(), record)
+	if err != nil {
+		return fmt.Errorf("bookmyname: failed to delete TXT record [domain: %s, hostname: %s]: %w",
+			authZone, record.Hostname, err)
+	}
+
+	return nil
+}
+
+// Timeout returns the timeout and interval to use when checking for DNS propagation.
+// Adjusting here to cope with spikes in propagation times.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
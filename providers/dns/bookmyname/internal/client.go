@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
+)
+
+const defaultBaseURL = "https://www.bookmyname.com/dyndns/"
+
+// Actions of the dyndns API.
+const (
+	actionAdd    = "add"
+	actionRemove = "remove"
+)
+
+// Client the Bookmyname API client.
+type Client struct {
+	username string
+	password string
+
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewClient Creates a new Client.
+func NewClient(username, password string) *Client {
+	return &Client{
+		username:   username,
+		password:   password,
+		BaseURL:    defaultBaseURL,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// AddRecord adds a TXT record.
+func (c *Client) AddRecord(ctx context.Context, record Record) error {
+	return c.doRequest(ctx, actionAdd, record)
+}
+
+// RemoveRecord removes a TXT record.
+func (c *Client) RemoveRecord(ctx context.Context, record Record) error {
+	return c.doRequest(ctx, actionRemove, record)
+}
+
+func (c *Client) doRequest(ctx context.Context, action string, record Record) error {
+	endpoint, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return err
+	}
+
+	query := endpoint.Query()
+	query.Set("hostname", record.Hostname)
+	query.Set("type", record.Type)
+	query.Set("ttl", strconv.Itoa(record.TTL))
+	query.Set("do", action)
+	query.Set("value", strconv.Quote(record.Value))
+	endpoint.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), http.NoBody)
+	if err != nil {
+		return fmt.Errorf("unable to create request: %w", err)
+	}
+
+	useragent.SetHeader(req.Header)
+
+	req.SetBasicAuth(c.username, c.password)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return errutils.NewHTTPDoError(req, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode/100 != 2 {
+		return errutils.NewUnexpectedResponseStatusCodeError(req, resp)
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errutils.NewReadResponseError(req, resp.StatusCode, err)
+	}
+
+	// The API responds with a plain text message: "good: ..." on success, "<code>: <message>" otherwise.
+	body := strings.TrimSpace(string(raw))
+
+	if !strings.HasPrefix(body, "good:") {
+		return fmt.Errorf("unexpected response: %s", body)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setupTest(t *testing.T, filename string, expectedQuery url.Values) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /", func(rw http.ResponseWriter, req *http.Request) {
+		username, password, ok := req.BasicAuth()
+		if !ok || username != "user" || password != "secret" {
+			http.Error(rw, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		query := req.URL.Query()
+
+		for k, v := range expectedQuery {
+			val := query.Get(k)
+			if val != v[0] {
+				http.Error(rw, fmt.Sprintf("%s: invalid value: %s != %s", k, val, v[0]), http.StatusBadRequest)
+				return
+			}
+		}
+
+		file, err := os.Open(filepath.Join("fixtures", filename))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer func() { _ = file.Close() }()
+
+		_, err = io.Copy(rw, file)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := NewClient("user", "secret")
+	client.BaseURL = server.URL
+	client.HTTPClient = server.Client()
+
+	return client
+}
+
+func TestClient_AddRecord(t *testing.T) {
+	query := url.Values{}
+	query.Set("hostname", "_acme-challenge.example.com")
+	query.Set("type", "TXT")
+	query.Set("ttl", "300")
+	query.Set("do", "add")
+	query.Set("value", `"txtTXTtxt"`)
+
+	client := setupTest(t, "add_success.txt", query)
+
+	record := Record{
+		Hostname: "_acme-challenge.example.com",
+		Type:     "TXT",
+		TTL:      300,
+		Value:    "txtTXTtxt",
+	}
+
+	err := client.AddRecord(context.Background(), record)
+	require.NoError(t, err)
+}
+
+func TestClient_AddRecord_error(t *testing.T) {
+	client := setupTest(t, "error.txt", url.Values{})
+
+	record := Record{
+		Hostname: "_acme-challenge.example.com.",
+		Type:     "TXT",
+		TTL:      300,
+		Value:    "txtTXTtxt",
+	}
+
+	err := client.AddRecord(context.Background(), record)
+	require.EqualError(t, err, "unexpected response: notfqdn: Host _acme-challenge.example.com. malformed / vhn")
+}
+
+func TestClient_AddRecord_badAuth(t *testing.T) {
+	client := setupTest(t, "badauth.txt", url.Values{})
+
+	err := client.AddRecord(context.Background(), Record{Hostname: "_acme-challenge.example.com", Type: "TXT", TTL: 300, Value: "txtTXTtxt"})
+	require.EqualError(t, err, "unexpected response: badauth: Authentication failed")
+}
+
+func TestClient_RemoveRecord(t *testing.T) {
+	query := url.Values{}
+	query.Set("hostname", "_acme-challenge.example.com")
+	query.Set("type", "TXT")
+	query.Set("ttl", "300")
+	query.Set("do", "remove")
+	query.Set("value", `"txtTXTtxt"`)
+
+	client := setupTest(t, "remove_success.txt", query)
+
+	record := Record{
+		Hostname: "_acme-challenge.example.com",
+		Type:     "TXT",
+		TTL:      300,
+		Value:    "txtTXTtxt",
+	}
+
+	err := client.RemoveRecord(context.Background(), record)
+	require.NoError(t, err)
+}
+
+func TestClient_RemoveRecord_error(t *testing.T) {
+	client := setupTest(t, "error.txt", url.Values{})
+
+	record := Record{
+		Hostname: "_acme-challenge.example.com.",
+		Type:     "TXT",
+		TTL:      300,
+		Value:    "txtTXTtxt",
+	}
+
+	err := client.RemoveRecord(context.Background(), record)
+	require.EqualError(t, err, "unexpected response: notfqdn: Host _acme-challenge.example.com. malformed / vhn")
+}
@@ -0,0 +1,9 @@
+package internal
+
+// Record a TXT record managed through the dyndns API.
+type Record struct {
+	Hostname string
+	Type     string
+	TTL      int
+	Value    string
+}
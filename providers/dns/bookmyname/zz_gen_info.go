@@ -0,0 +1,29 @@
+package bookmyname
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/bookmyname/bookmyname.toml
+		Name:  "Bookmyname",
+		Code:  "bookmyname",
+		Since: "v4.18.0",
+		URL:   "https://www.bookmyname.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "BOOKMYNAME_PASSWORD", Description: "Password of the dyndns access", Credential: true},
+			{Name: "BOOKMYNAME_USERNAME", Description: "Username of the dyndns access", Credential: true},
+			{Name: "BOOKMYNAME_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "BOOKMYNAME_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "BOOKMYNAME_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "BOOKMYNAME_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://fr.faqs.bookmyname.com/frfaqs/dyndns",
+			GoClient: "",
+		},
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 const defaultBaseURL = "https://portal.brandit.com/api/v3/"
@@ -140,6 +141,8 @@ func (c *Client) do(ctx context.Context, query url.Values, result any) error {
 		return fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 	resp, err := c.HTTPClient.Do(req)
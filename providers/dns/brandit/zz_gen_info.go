@@ -0,0 +1,29 @@
+package brandit
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/brandit/brandit.toml
+		Name:  "Brandit",
+		Code:  "brandit",
+		Since: "v4.11.0",
+		URL:   "https://www.brandit.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "BRANDIT_API_KEY", Description: "The API key", Credential: true},
+			{Name: "BRANDIT_API_USERNAME", Description: "The API username", Credential: true},
+			{Name: "BRANDIT_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "BRANDIT_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "BRANDIT_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "BRANDIT_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://portal.brandit.com/apidocv3",
+			GoClient: "",
+		},
+	}
+}
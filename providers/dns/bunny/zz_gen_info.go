@@ -0,0 +1,27 @@
+package bunny
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/bunny/bunny.toml
+		Name:  "Bunny",
+		Code:  "bunny",
+		Since: "v4.11.0",
+		URL:   "https://bunny.net",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "BUNNY_API_KEY", Description: "API key", Credential: true},
+			{Name: "BUNNY_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "BUNNY_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "BUNNY_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://docs.bunny.net/reference/dnszonepublic_index",
+			GoClient: "",
+		},
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 	"golang.org/x/oauth2"
 )
 
@@ -360,6 +361,8 @@ func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, paylo
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 
 	if payload != nil {
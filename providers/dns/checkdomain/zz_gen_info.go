@@ -0,0 +1,29 @@
+package checkdomain
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/checkdomain/checkdomain.toml
+		Name:  "Checkdomain",
+		Code:  "checkdomain",
+		Since: "v3.3.0",
+		URL:   "https://checkdomain.de/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "CHECKDOMAIN_TOKEN", Description: "API token", Credential: true},
+			{Name: "CHECKDOMAIN_ENDPOINT", Description: "API endpoint URL, defaults to https://api.checkdomain.de"},
+			{Name: "CHECKDOMAIN_HTTP_TIMEOUT", Description: "API request timeout, defaults to 30 seconds"},
+			{Name: "CHECKDOMAIN_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "CHECKDOMAIN_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "CHECKDOMAIN_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developer.checkdomain.de/reference/",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package civo
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/civo/civo.toml
+		Name:  "Civo",
+		Code:  "civo",
+		Since: "v4.9.0",
+		URL:   "https://civo.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "CIVO_TOKEN", Description: "Authentication token", Credential: true},
+			{Name: "CIVO_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "CIVO_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "CIVO_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.civo.com/api/dns",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package clouddns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/clouddns/clouddns.toml
+		Name:  "CloudDNS",
+		Code:  "clouddns",
+		Since: "v3.6.0",
+		URL:   "https://vshosting.eu/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "CLOUDDNS_CLIENT_ID", Description: "Client ID", Credential: true},
+			{Name: "CLOUDDNS_EMAIL", Description: "Account email", Credential: true},
+			{Name: "CLOUDDNS_PASSWORD", Description: "Account password", Credential: true},
+			{Name: "CLOUDDNS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "CLOUDDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "CLOUDDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "CLOUDDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://admin.vshosting.cloud/clouddns/swagger/",
+			GoClient: "",
+		},
+	}
+}
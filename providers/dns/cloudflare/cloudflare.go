@@ -13,10 +13,13 @@ import (
 	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/log"
 	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/go-acme/lego/v4/platform/transport"
 )
 
 const (
 	minTTL = 120
+
+	defaultRecordComment = "managed by lego"
 )
 
 // Config is used to configure the creation of the DNSProvider.
@@ -27,6 +30,13 @@ type Config struct {
 	AuthToken string
 	ZoneToken string
 
+	// Tag is added to the TXT records created by lego (format `name:value`).
+	// When set, the records are searched by this tag during cleanup.
+	Tag string
+
+	// RecordComment is added to the TXT records created by lego, to identify them.
+	RecordComment string
+
 	TTL                int
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
@@ -36,11 +46,14 @@ type Config struct {
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
 	return &Config{
+		Tag:                env.GetOrFile("CLOUDFLARE_RECORD_TAG"),
+		RecordComment:      env.GetOrDefaultString("CLOUDFLARE_RECORD_COMMENT", defaultRecordComment),
 		TTL:                env.GetOrDefaultInt("CLOUDFLARE_TTL", minTTL),
 		PropagationTimeout: env.GetOrDefaultSecond("CLOUDFLARE_PROPAGATION_TIMEOUT", 2*time.Minute),
 		PollingInterval:    env.GetOrDefaultSecond("CLOUDFLARE_POLLING_INTERVAL", 2*time.Second),
 		HTTPClient: &http.Client{
-			Timeout: env.GetOrDefaultSecond("CLOUDFLARE_HTTP_TIMEOUT", 30*time.Second),
+			Timeout:   env.GetOrDefaultSecond("CLOUDFLARE_HTTP_TIMEOUT", 30*time.Second),
+			Transport: transport.Shared(),
 		},
 	}
 }
@@ -50,6 +63,8 @@ type DNSProvider struct {
 	client *metaClient
 	config *Config
 
+	findZoneByFqdn func(fqdn string) (string, error)
+
 	recordIDs   map[string]string
 	recordIDsMu sync.Mutex
 }
@@ -108,9 +123,10 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 	}
 
 	return &DNSProvider{
-		client:    client,
-		config:    config,
-		recordIDs: make(map[string]string),
+		client:         client,
+		config:         config,
+		findZoneByFqdn: dns01.FindZoneByFqdn,
+		recordIDs:      make(map[string]string),
 	}, nil
 }
 
@@ -122,9 +138,14 @@ func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 
 // Present creates a TXT record to fulfill the dns-01 challenge.
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	return d.PresentContext(context.Background(), domain, token, keyAuth)
+}
+
+// PresentContext creates a TXT record to fulfill the dns-01 challenge.
+func (d *DNSProvider) PresentContext(ctx context.Context, domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	authZone, err := d.findZoneByFqdn(info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("cloudflare: could not find zone for domain %q: %w", domain, err)
 	}
@@ -134,14 +155,28 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		return fmt.Errorf("cloudflare: failed to find zone %s: %w", authZone, err)
 	}
 
+	ttl := d.config.TTL
+	if v, ok := dns01.TTLFromContext(ctx); ok {
+		if v < minTTL {
+			return fmt.Errorf("cloudflare: invalid TTL for the domain %s, TTL (%d) must be greater than %d", domain, v, minTTL)
+		}
+
+		ttl = v
+	}
+
 	dnsRecord := cloudflare.CreateDNSRecordParams{
 		Type:    "TXT",
 		Name:    dns01.UnFqdn(info.EffectiveFQDN),
 		Content: info.Value,
-		TTL:     d.config.TTL,
+		TTL:     ttl,
+		Comment: d.config.RecordComment,
+	}
+
+	if d.config.Tag != "" {
+		dnsRecord.Tags = []string{d.config.Tag}
 	}
 
-	response, err := d.client.CreateDNSRecord(context.Background(), zoneID, dnsRecord)
+	response, err := d.client.CreateDNSRecord(ctx, zoneID, dnsRecord)
 	if err != nil {
 		return fmt.Errorf("cloudflare: failed to create TXT record: %w", err)
 	}
@@ -150,16 +185,21 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	d.recordIDs[token] = response.ID
 	d.recordIDsMu.Unlock()
 
-	log.Infof("cloudflare: new record for %s, ID %s", domain, response.ID)
+	log.Info("new record", "provider", "cloudflare", "domain", domain, "recordID", response.ID)
 
 	return nil
 }
 
 // CleanUp removes the TXT record matching the specified parameters.
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	return d.CleanUpContext(context.Background(), domain, token, keyAuth)
+}
+
+// CleanUpContext removes the TXT record matching the specified parameters.
+func (d *DNSProvider) CleanUpContext(ctx context.Context, domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	authZone, err := d.findZoneByFqdn(info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("cloudflare: could not find zone for domain %q: %w", domain, err)
 	}
@@ -174,18 +214,48 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	recordID, ok := d.recordIDs[token]
 	d.recordIDsMu.Unlock()
 	if !ok {
-		return fmt.Errorf("cloudflare: unknown record ID for '%s'", info.EffectiveFQDN)
+		recordID, err = d.findTaggedRecordID(ctx, zoneID, info)
+		if err != nil {
+			return fmt.Errorf("cloudflare: unknown record ID for '%s': %w", info.EffectiveFQDN, err)
+		}
 	}
 
-	err = d.client.DeleteDNSRecord(context.Background(), zoneID, recordID)
-	if err != nil {
-		log.Printf("cloudflare: failed to delete TXT record: %w", err)
-	}
+	err = d.client.DeleteDNSRecord(ctx, zoneID, recordID)
 
 	// Delete record ID from map
 	d.recordIDsMu.Lock()
 	delete(d.recordIDs, token)
 	d.recordIDsMu.Unlock()
 
+	if err != nil {
+		return fmt.Errorf("cloudflare: failed to delete TXT record %s: %w", recordID, err)
+	}
+
 	return nil
 }
+
+// findTaggedRecordID searches the TXT record by its lego tag.
+// The filtering is done server-side, so only the records created by lego are considered.
+func (d *DNSProvider) findTaggedRecordID(ctx context.Context, zoneID string, info dns01.ChallengeInfo) (string, error) {
+	if d.config.Tag == "" {
+		return "", errors.New("no record tag configured")
+	}
+
+	params := cloudflare.ListDNSRecordsParams{
+		Type:    "TXT",
+		Name:    dns01.UnFqdn(info.EffectiveFQDN),
+		Content: info.Value,
+		Tags:    []string{d.config.Tag},
+	}
+
+	records, _, err := d.client.DNSRecords(ctx, zoneID, params)
+	if err != nil {
+		return "", fmt.Errorf("failed to list TXT records: %w", err)
+	}
+
+	if len(records) == 0 {
+		return "", fmt.Errorf("no TXT record with the tag %q", d.config.Tag)
+	}
+
+	return records[0].ID, nil
+}
@@ -1,9 +1,17 @@
 package cloudflare
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/tester"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -265,6 +273,269 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func TestNewDNSProviderConfig_proxy(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		connect []string
+	)
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		connect = append(connect, req.Method+" "+req.Host)
+		mu.Unlock()
+
+		rw.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(proxy.Close)
+
+	for _, name := range []string{"HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy", "NO_PROXY", "no_proxy"} {
+		t.Setenv(name, "")
+	}
+
+	t.Setenv("ALL_PROXY", proxy.URL)
+
+	config := NewDefaultConfig()
+	config.AuthToken = "123"
+
+	p, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	resp, err := p.config.HTTPClient.Get("https://api.cloudflare.com/client/v4/zones")
+	if err == nil {
+		_ = resp.Body.Close()
+	}
+
+	require.Error(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Equal(t, []string{"CONNECT api.cloudflare.com:443"}, connect)
+}
+
+func setupTest(t *testing.T, config *Config) (*DNSProvider, *[]cloudflare.DNSRecord) {
+	t.Helper()
+
+	var records []cloudflare.DNSRecord
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("POST /zones/zone1/dns_records", func(rw http.ResponseWriter, req *http.Request) {
+		var record cloudflare.DNSRecord
+		err := json.NewDecoder(req.Body).Decode(&record)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		record.ID = "record1"
+		records = append(records, record)
+
+		_ = json.NewEncoder(rw).Encode(cloudflare.DNSRecordResponse{Result: record, Response: cloudflare.Response{Success: true}})
+	})
+
+	mux.HandleFunc("DELETE /zones/zone1/dns_records/record1", func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = rw.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{"id":"record1"}}`))
+	})
+
+	return newTestProvider(t, config, server), &records
+}
+
+// newTestProvider creates a provider using the API server, with the zone example.com (zone1).
+func newTestProvider(t *testing.T, config *Config, server *httptest.Server) *DNSProvider {
+	t.Helper()
+
+	config.AuthToken = "secret"
+	config.HTTPClient = server.Client()
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	client, err := cloudflare.NewWithAPIToken("secret", cloudflare.HTTPClient(server.Client()), cloudflare.BaseURL(server.URL))
+	require.NoError(t, err)
+
+	provider.client.clientEdit = client
+	provider.client.clientRead = client
+	provider.client.zones["example.com."] = "zone1"
+
+	provider.findZoneByFqdn = func(_ string) (string, error) {
+		return "example.com.", nil
+	}
+
+	return provider
+}
+
+func TestDNSProvider_Present_recordComment(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		comment  string
+		expected string
+	}{
+		{
+			desc:     "default",
+			comment:  defaultRecordComment,
+			expected: "managed by lego",
+		},
+		{
+			desc:     "custom",
+			comment:  "ACME challenge",
+			expected: "ACME challenge",
+		},
+		{
+			desc: "no comment",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			config := NewDefaultConfig()
+			config.RecordComment = test.comment
+
+			provider, records := setupTest(t, config)
+
+			err := provider.Present("example.com", "token", "keyAuth")
+			require.NoError(t, err)
+
+			require.Len(t, *records, 1)
+
+			record := (*records)[0]
+			assert.Equal(t, "TXT", record.Type)
+			assert.Equal(t, "_acme-challenge.example.com", record.Name)
+			assert.Equal(t, test.expected, record.Comment)
+
+			err = provider.CleanUp("example.com", "token", "keyAuth")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDNSProvider_PresentContext_ttl(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		ctx      context.Context
+		expected int
+	}{
+		{
+			desc:     "default",
+			ctx:      context.Background(),
+			expected: 300,
+		},
+		{
+			desc:     "domain TTL",
+			ctx:      dns01.WithTTL(context.Background(), 3600),
+			expected: 3600,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			config := NewDefaultConfig()
+			config.TTL = 300
+
+			provider, records := setupTest(t, config)
+
+			err := provider.PresentContext(test.ctx, "example.com", "token", "keyAuth")
+			require.NoError(t, err)
+
+			require.Len(t, *records, 1)
+
+			assert.Equal(t, test.expected, (*records)[0].TTL)
+		})
+	}
+}
+
+func TestDNSProvider_PresentContext_invalidTTL(t *testing.T) {
+	provider, records := setupTest(t, NewDefaultConfig())
+
+	err := provider.PresentContext(dns01.WithTTL(context.Background(), 60), "example.com", "token", "keyAuth")
+	require.EqualError(t, err, "cloudflare: invalid TTL for the domain example.com, TTL (60) must be greater than 120")
+
+	assert.Empty(t, *records)
+}
+
+func TestDNSProvider_CleanUp_tag(t *testing.T) {
+	info := dns01.GetChallengeInfo("example.com", "keyAuth")
+
+	testCases := []struct {
+		desc          string
+		records       []cloudflare.DNSRecord
+		expected      []string
+		expectedError string
+	}{
+		{
+			desc: "tagged record",
+			records: []cloudflare.DNSRecord{
+				{ID: "untagged", Type: "TXT", Name: dns01.UnFqdn(info.EffectiveFQDN), Content: info.Value},
+				{ID: "tagged", Type: "TXT", Name: dns01.UnFqdn(info.EffectiveFQDN), Content: info.Value, Tags: []string{"owner:lego"}},
+			},
+			expected: []string{"tagged"},
+		},
+		{
+			desc: "untagged record only",
+			records: []cloudflare.DNSRecord{
+				{ID: "untagged", Type: "TXT", Name: dns01.UnFqdn(info.EffectiveFQDN), Content: info.Value},
+			},
+			expectedError: `cloudflare: unknown record ID for '_acme-challenge.example.com.': no TXT record with the tag "owner:lego"`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			var deleted []string
+
+			mux := http.NewServeMux()
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			mux.HandleFunc("GET /zones/zone1/dns_records", func(rw http.ResponseWriter, req *http.Request) {
+				query := req.URL.Query()
+
+				var result []cloudflare.DNSRecord
+				for _, record := range test.records {
+					if record.Type != query.Get("type") || record.Name != query.Get("name") || record.Content != query.Get("content") {
+						continue
+					}
+
+					if tag := query.Get("tag"); tag != "" && !slices.Contains(record.Tags, tag) {
+						continue
+					}
+
+					result = append(result, record)
+				}
+
+				_ = json.NewEncoder(rw).Encode(cloudflare.DNSListResponse{
+					Result:     result,
+					ResultInfo: cloudflare.ResultInfo{Page: 1},
+					Response:   cloudflare.Response{Success: true},
+				})
+			})
+
+			mux.HandleFunc("DELETE /zones/zone1/dns_records/{id}", func(rw http.ResponseWriter, req *http.Request) {
+				deleted = append(deleted, req.PathValue("id"))
+
+				_, _ = rw.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{"id":"` + req.PathValue("id") + `"}}`))
+			})
+
+			config := NewDefaultConfig()
+			config.Tag = "owner:lego"
+
+			provider := newTestProvider(t, config, server)
+
+			// The record ID is unknown: the provider has not created the record.
+			err := provider.CleanUp("example.com", "token", "keyAuth")
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expected, deleted)
+		})
+	}
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -292,3 +563,17 @@ func TestLiveCleanUp(t *testing.T) {
 	err = provider.CleanUp(envTest.GetDomain(), "", "123d==")
 	require.NoError(t, err)
 }
+
+func TestInfo(t *testing.T) {
+	info := Info()
+
+	assert.Equal(t, "cloudflare", info.Code)
+	assert.Contains(t, info.CredentialEnvVars(), "CLOUDFLARE_DNS_API_TOKEN")
+
+	var names []string
+	for _, envVar := range info.EnvVars {
+		names = append(names, envVar.Name)
+	}
+
+	assert.Subset(t, names, []string{"CLOUDFLARE_RECORD_TAG", "CLOUDFLARE_RECORD_COMMENT", "CLOUDFLARE_TTL"})
+}
@@ -0,0 +1,37 @@
+package cloudflare
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/cloudflare/cloudflare.toml
+		Name:  "Cloudflare",
+		Code:  "cloudflare",
+		Since: "v0.3.0",
+		URL:   "https://www.cloudflare.com/dns/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "CF_API_EMAIL", Description: "Account email", Credential: true},
+			{Name: "CF_API_KEY", Description: "API key", Credential: true},
+			{Name: "CF_DNS_API_TOKEN", Description: "API token with DNS:Edit permission (since v3.1.0)", Credential: true},
+			{Name: "CF_ZONE_API_TOKEN", Description: "API token with Zone:Read permission (since v3.1.0)", Credential: true},
+			{Name: "CLOUDFLARE_API_KEY", Description: "Alias to CF_API_KEY", Credential: true},
+			{Name: "CLOUDFLARE_DNS_API_TOKEN", Description: "Alias to CF_DNS_API_TOKEN", Credential: true},
+			{Name: "CLOUDFLARE_EMAIL", Description: "Alias to CF_API_EMAIL", Credential: true},
+			{Name: "CLOUDFLARE_ZONE_API_TOKEN", Description: "Alias to CF_ZONE_API_TOKEN", Credential: true},
+			{Name: "CLOUDFLARE_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "CLOUDFLARE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "CLOUDFLARE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "CLOUDFLARE_RECORD_COMMENT", Description: "Comment added to the TXT records, to identify the records created by lego (Default: managed by lego)"},
+			{Name: "CLOUDFLARE_RECORD_TAG", Description: "Tag (`name:value`) added to the TXT records, used to find the records during the cleanup (requires a plan supporting record tags)"},
+			{Name: "CLOUDFLARE_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.cloudflare.com/",
+			GoClient: "https://github.com/cloudflare/cloudflare-go",
+		},
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
@@ -21,23 +22,28 @@ const (
 
 	EnvAuthID       = envNamespace + "AUTH_ID"
 	EnvSubAuthID    = envNamespace + "SUB_AUTH_ID"
+	EnvSubAuthUser  = envNamespace + "SUB_AUTH_USER"
 	EnvAuthPassword = envNamespace + "AUTH_PASSWORD"
 
 	EnvTTL                = envNamespace + "TTL"
 	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
 	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
 	EnvHTTPTimeout        = envNamespace + "HTTP_TIMEOUT"
+	EnvMinRequestInterval = envNamespace + "MIN_REQUEST_INTERVAL"
 )
 
 // Config is used to configure the creation of the DNSProvider.
 type Config struct {
 	AuthID             string
 	SubAuthID          string
+	SubAuthUser        string
 	AuthPassword       string
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
 	TTL                int
 	HTTPClient         *http.Client
+	// MinRequestInterval is the minimum interval between two API calls (ClouDNS rate-limits the API calls).
+	MinRequestInterval time.Duration
 }
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
@@ -46,6 +52,7 @@ func NewDefaultConfig() *Config {
 		TTL:                env.GetOrDefaultInt(EnvTTL, 60),
 		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 180*time.Second),
 		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 10*time.Second),
+		MinRequestInterval: env.GetOrDefaultSecond(EnvMinRequestInterval, 0),
 		HTTPClient: &http.Client{
 			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
 		},
@@ -56,20 +63,21 @@ func NewDefaultConfig() *Config {
 type DNSProvider struct {
 	config *Config
 	client *internal.Client
+
+	recordIDs   map[string]int
+	recordIDsMu sync.Mutex
 }
 
 // NewDNSProvider returns a DNSProvider instance configured for ClouDNS.
 // Credentials must be passed in the environment variables:
-// CLOUDNS_AUTH_ID and CLOUDNS_AUTH_PASSWORD.
+// CLOUDNS_AUTH_ID (or CLOUDNS_SUB_AUTH_ID, or CLOUDNS_SUB_AUTH_USER) and CLOUDNS_AUTH_PASSWORD.
 func NewDNSProvider() (*DNSProvider, error) {
-	var subAuthID string
 	authID := env.GetOrFile(EnvAuthID)
-	if authID == "" {
-		subAuthID = env.GetOrFile(EnvSubAuthID)
-	}
+	subAuthID := env.GetOrFile(EnvSubAuthID)
+	subAuthUser := env.GetOrFile(EnvSubAuthUser)
 
-	if authID == "" && subAuthID == "" {
-		return nil, fmt.Errorf("ClouDNS: some credentials information are missing: %s or %s", EnvAuthID, EnvSubAuthID)
+	if authID == "" && subAuthID == "" && subAuthUser == "" {
+		return nil, fmt.Errorf("ClouDNS: some credentials information are missing: %s, %s or %s", EnvAuthID, EnvSubAuthID, EnvSubAuthUser)
 	}
 
 	values, err := env.Get(EnvAuthPassword)
@@ -80,6 +88,7 @@ func NewDNSProvider() (*DNSProvider, error) {
 	config := NewDefaultConfig()
 	config.AuthID = authID
 	config.SubAuthID = subAuthID
+	config.SubAuthUser = subAuthUser
 	config.AuthPassword = values[EnvAuthPassword]
 
 	return NewDNSProviderConfig(config)
@@ -91,14 +100,23 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		return nil, errors.New("ClouDNS: the configuration of the DNS provider is nil")
 	}
 
-	client, err := internal.NewClient(config.AuthID, config.SubAuthID, config.AuthPassword)
+	if countNonEmpty(config.AuthID, config.SubAuthID, config.SubAuthUser) > 1 {
+		return nil, errors.New("ClouDNS: the auth ID, the sub auth ID, and the sub auth user are mutually exclusive")
+	}
+
+	client, err := internal.NewClient(config.AuthID, config.SubAuthID, config.SubAuthUser, config.AuthPassword)
 	if err != nil {
 		return nil, fmt.Errorf("ClouDNS: %w", err)
 	}
 
 	client.HTTPClient = config.HTTPClient
+	client.SetMinRequestInterval(config.MinRequestInterval)
 
-	return &DNSProvider{client: client, config: config}, nil
+	return &DNSProvider{
+		client:    client,
+		config:    config,
+		recordIDs: make(map[string]int),
+	}, nil
 }
 
 // Present creates a TXT record to fulfill the dns-01 challenge.
@@ -112,18 +130,30 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		return fmt.Errorf("ClouDNS: %w", err)
 	}
 
-	err = d.client.AddTxtRecord(ctx, zone.Name, info.EffectiveFQDN, info.Value, d.config.TTL)
+	recordID, err := d.client.AddTxtRecord(ctx, zone.Name, info.EffectiveFQDN, info.Value, d.config.TTL)
 	if err != nil {
 		return fmt.Errorf("ClouDNS: %w", err)
 	}
 
+	d.recordIDsMu.Lock()
+	d.recordIDs[token] = recordID
+	d.recordIDsMu.Unlock()
+
 	return d.waitNameservers(ctx, domain, zone)
 }
 
-// CleanUp removes the TXT records matching the specified parameters.
+// CleanUp removes the TXT record matching the specified parameters.
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
+	d.recordIDsMu.Lock()
+	recordID, ok := d.recordIDs[token]
+	d.recordIDsMu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("ClouDNS: unknown record ID for '%s'", info.EffectiveFQDN)
+	}
+
 	ctx := context.Background()
 
 	zone, err := d.client.GetZone(ctx, info.EffectiveFQDN)
@@ -131,21 +161,14 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 		return fmt.Errorf("ClouDNS: %w", err)
 	}
 
-	records, err := d.client.ListTxtRecords(ctx, zone.Name, info.EffectiveFQDN)
+	err = d.client.RemoveTxtRecord(ctx, recordID, zone.Name)
 	if err != nil {
 		return fmt.Errorf("ClouDNS: %w", err)
 	}
 
-	if len(records) == 0 {
-		return nil
-	}
-
-	for _, record := range records {
-		err = d.client.RemoveTxtRecord(ctx, record.ID, zone.Name)
-		if err != nil {
-			return fmt.Errorf("ClouDNS: %w", err)
-		}
-	}
+	d.recordIDsMu.Lock()
+	delete(d.recordIDs, token)
+	d.recordIDsMu.Unlock()
 
 	return nil
 }
@@ -170,3 +193,15 @@ func (d *DNSProvider) waitNameservers(ctx context.Context, domain string, zone *
 		return syncProgress.Complete, nil
 	})
 }
+
+func countNonEmpty(values ...string) int {
+	var count int
+
+	for _, value := range values {
+		if value != "" {
+			count++
+		}
+	}
+
+	return count
+}
@@ -13,6 +13,7 @@ const envDomain = envNamespace + "DOMAIN"
 var envTest = tester.NewEnvTest(
 	EnvAuthID,
 	EnvSubAuthID,
+	EnvSubAuthUser,
 	EnvAuthPassword).
 	WithDomain(envDomain)
 
@@ -38,6 +39,31 @@ func TestNewDNSProvider(t *testing.T) {
 				EnvAuthPassword: "456",
 			},
 		},
+		{
+			desc: "success sub-auth-user",
+			envVars: map[string]string{
+				EnvSubAuthUser:  "user",
+				EnvAuthPassword: "456",
+			},
+		},
+		{
+			desc: "auth-id and sub-auth-id",
+			envVars: map[string]string{
+				EnvAuthID:       "123",
+				EnvSubAuthID:    "123",
+				EnvAuthPassword: "456",
+			},
+			expected: "ClouDNS: the auth ID, the sub auth ID, and the sub auth user are mutually exclusive",
+		},
+		{
+			desc: "auth-id and sub-auth-user",
+			envVars: map[string]string{
+				EnvAuthID:       "123",
+				EnvSubAuthUser:  "user",
+				EnvAuthPassword: "456",
+			},
+			expected: "ClouDNS: the auth ID, the sub auth ID, and the sub auth user are mutually exclusive",
+		},
 		{
 			desc: "missing credentials",
 			envVars: map[string]string{
@@ -45,7 +71,7 @@ func TestNewDNSProvider(t *testing.T) {
 				EnvSubAuthID:    "",
 				EnvAuthPassword: "",
 			},
-			expected: "ClouDNS: some credentials information are missing: CLOUDNS_AUTH_ID or CLOUDNS_SUB_AUTH_ID",
+			expected: "ClouDNS: some credentials information are missing: CLOUDNS_AUTH_ID, CLOUDNS_SUB_AUTH_ID or CLOUDNS_SUB_AUTH_USER",
 		},
 		{
 			desc: "missing auth-id",
@@ -54,7 +80,7 @@ func TestNewDNSProvider(t *testing.T) {
 				EnvSubAuthID:    "",
 				EnvAuthPassword: "456",
 			},
-			expected: "ClouDNS: some credentials information are missing: CLOUDNS_AUTH_ID or CLOUDNS_SUB_AUTH_ID",
+			expected: "ClouDNS: some credentials information are missing: CLOUDNS_AUTH_ID, CLOUDNS_SUB_AUTH_ID or CLOUDNS_SUB_AUTH_USER",
 		},
 		{
 			desc: "missing sub-auth-id",
@@ -63,7 +89,7 @@ func TestNewDNSProvider(t *testing.T) {
 				EnvSubAuthID:    "",
 				EnvAuthPassword: "456",
 			},
-			expected: "ClouDNS: some credentials information are missing: CLOUDNS_AUTH_ID or CLOUDNS_SUB_AUTH_ID",
+			expected: "ClouDNS: some credentials information are missing: CLOUDNS_AUTH_ID, CLOUDNS_SUB_AUTH_ID or CLOUDNS_SUB_AUTH_USER",
 		},
 		{
 			desc: "missing auth-password",
@@ -102,6 +128,7 @@ func TestNewDNSProviderConfig(t *testing.T) {
 		desc         string
 		authID       string
 		subAuthID    string
+		subAuthUser  string
 		authPassword string
 		expected     string
 	}{
@@ -117,23 +144,35 @@ func TestNewDNSProviderConfig(t *testing.T) {
 			subAuthID:    "123",
 			authPassword: "456",
 		},
+		{
+			desc:         "success sub-auth-user",
+			subAuthUser:  "user",
+			authPassword: "456",
+		},
+		{
+			desc:         "sub-auth-id and sub-auth-user",
+			subAuthID:    "123",
+			subAuthUser:  "user",
+			authPassword: "456",
+			expected:     "ClouDNS: the auth ID, the sub auth ID, and the sub auth user are mutually exclusive",
+		},
 		{
 			desc:     "missing credentials",
-			expected: "ClouDNS: credentials missing: authID or subAuthID",
+			expected: "ClouDNS: credentials missing: authID, subAuthID or subAuthUser",
 		},
 		{
 			desc:         "missing auth-id",
 			authID:       "",
 			subAuthID:    "",
 			authPassword: "456",
-			expected:     "ClouDNS: credentials missing: authID or subAuthID",
+			expected:     "ClouDNS: credentials missing: authID, subAuthID or subAuthUser",
 		},
 		{
 			desc:         "missing sub-auth-id",
 			authID:       "",
 			subAuthID:    "",
 			authPassword: "456",
-			expected:     "ClouDNS: credentials missing: authID or subAuthID",
+			expected:     "ClouDNS: credentials missing: authID, subAuthID or subAuthUser",
 		},
 		{
 			desc:     "missing auth-password",
@@ -147,6 +186,7 @@ func TestNewDNSProviderConfig(t *testing.T) {
 			config := NewDefaultConfig()
 			config.AuthID = test.authID
 			config.SubAuthID = test.subAuthID
+			config.SubAuthUser = test.subAuthUser
 			config.AuthPassword = test.authPassword
 
 			p, err := NewDNSProviderConfig(config)
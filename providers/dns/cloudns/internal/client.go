@@ -13,6 +13,8 @@ import (
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
+	"golang.org/x/time/rate"
 )
 
 const defaultBaseURL = "https://api.cloudns.net/dns/"
@@ -21,16 +23,20 @@ const defaultBaseURL = "https://api.cloudns.net/dns/"
 type Client struct {
 	authID       string
 	subAuthID    string
+	subAuthUser  string
 	authPassword string
 
+	limiter *rate.Limiter
+
 	BaseURL    *url.URL
 	HTTPClient *http.Client
 }
 
 // NewClient creates a ClouDNS client.
-func NewClient(authID, subAuthID, authPassword string) (*Client, error) {
-	if authID == "" && subAuthID == "" {
-		return nil, errors.New("credentials missing: authID or subAuthID")
+// The sub-auth-id, or the sub-auth-user, takes precedence over the auth-id.
+func NewClient(authID, subAuthID, subAuthUser, authPassword string) (*Client, error) {
+	if authID == "" && subAuthID == "" && subAuthUser == "" {
+		return nil, errors.New("credentials missing: authID, subAuthID or subAuthUser")
 	}
 
 	if authPassword == "" {
@@ -45,12 +51,25 @@ func NewClient(authID, subAuthID, authPassword string) (*Client, error) {
 	return &Client{
 		authID:       authID,
 		subAuthID:    subAuthID,
+		subAuthUser:  subAuthUser,
 		authPassword: authPassword,
+		limiter:      rate.NewLimiter(rate.Inf, 1),
 		BaseURL:      baseURL,
 		HTTPClient:   &http.Client{Timeout: 10 * time.Second},
 	}, nil
 }
 
+// SetMinRequestInterval sets the minimum interval between two API calls.
+// A zero or negative interval disables the limit.
+func (c *Client) SetMinRequestInterval(interval time.Duration) {
+	if interval <= 0 {
+		c.limiter.SetLimit(rate.Inf)
+		return
+	}
+
+	c.limiter.SetLimit(rate.Every(interval))
+}
+
 // GetZone Get domain name information for a FQDN.
 func (c *Client) GetZone(ctx context.Context, authFQDN string) (*Zone, error) {
 	authZone, err := dns01.FindZoneByFqdn(authFQDN)
@@ -180,11 +199,11 @@ func (c *Client) ListTxtRecords(ctx context.Context, zoneName, fqdn string) ([]T
 	return records, nil
 }
 
-// AddTxtRecord adds a TXT record.
-func (c *Client) AddTxtRecord(ctx context.Context, zoneName, fqdn, value string, ttl int) error {
+// AddTxtRecord adds a TXT record, and returns its ID.
+func (c *Client) AddTxtRecord(ctx context.Context, zoneName, fqdn, value string, ttl int) (int, error) {
 	subDomain, err := dns01.ExtractSubDomain(fqdn, zoneName)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	endpoint := c.BaseURL.JoinPath("add-record.json")
@@ -199,24 +218,24 @@ func (c *Client) AddTxtRecord(ctx context.Context, zoneName, fqdn, value string,
 
 	req, err := c.newRequest(ctx, http.MethodPost, endpoint)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	rawMessage, err := c.do(req)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	resp := apiResponse{}
+	resp := addRecordResponse{}
 	if err = json.Unmarshal(rawMessage, &resp); err != nil {
-		return errutils.NewUnmarshalError(req, http.StatusOK, rawMessage, err)
+		return 0, errutils.NewUnmarshalError(req, http.StatusOK, rawMessage, err)
 	}
 
 	if resp.Status != "Success" {
-		return fmt.Errorf("failed to add TXT record: %s %s", resp.Status, resp.StatusDescription)
+		return 0, fmt.Errorf("failed to add TXT record: %s %s", resp.Status, resp.StatusDescription)
 	}
 
-	return nil
+	return resp.Data.ID, nil
 }
 
 // RemoveTxtRecord removes a TXT record.
@@ -291,9 +310,12 @@ func (c *Client) GetUpdateStatus(ctx context.Context, zoneName string) (*SyncPro
 func (c *Client) newRequest(ctx context.Context, method string, endpoint *url.URL) (*http.Request, error) {
 	q := endpoint.Query()
 
-	if c.subAuthID != "" {
+	switch {
+	case c.subAuthID != "":
 		q.Set("sub-auth-id", c.subAuthID)
-	} else {
+	case c.subAuthUser != "":
+		q.Set("sub-auth-user", c.subAuthUser)
+	default:
 		q.Set("auth-id", c.authID)
 	}
 
@@ -306,10 +328,17 @@ func (c *Client) newRequest(ctx context.Context, method string, endpoint *url.UR
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	return req, nil
 }
 
 func (c *Client) do(req *http.Request) (json.RawMessage, error) {
+	err := c.limiter.Wait(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("rate limiter: %w", err)
+	}
+
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return nil, errutils.NewHTTPDoError(req, err)
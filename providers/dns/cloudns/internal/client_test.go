@@ -7,18 +7,19 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func setupTest(t *testing.T, subAuthID string, handler http.HandlerFunc) *Client {
+func setupTest(t *testing.T, subAuthID, subAuthUser string, handler http.HandlerFunc) *Client {
 	t.Helper()
 
 	server := httptest.NewServer(handler)
 	t.Cleanup(server.Close)
 
-	client, err := NewClient("myAuthID", subAuthID, "myAuthPassword")
+	client, err := NewClient("myAuthID", subAuthID, subAuthUser, "myAuthPassword")
 	require.NoError(t, err)
 
 	client.BaseURL, _ = url.Parse(server.URL)
@@ -61,7 +62,7 @@ func TestNewClient(t *testing.T) {
 			authID:       "",
 			subAuthID:    "",
 			authPassword: "no-secret",
-			expected:     "credentials missing: authID or subAuthID",
+			expected:     "credentials missing: authID, subAuthID or subAuthUser",
 		},
 		{
 			desc:         "missing authID & subAuthID",
@@ -74,7 +75,7 @@ func TestNewClient(t *testing.T) {
 
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
-			client, err := NewClient(test.authID, test.subAuthID, test.authPassword)
+			client, err := NewClient(test.authID, test.subAuthID, "", test.authPassword)
 
 			if test.expected != "" {
 				assert.Nil(t, client)
@@ -132,7 +133,7 @@ func TestClient_GetZone(t *testing.T) {
 
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
-			client := setupTest(t, "", handlerMock(http.MethodGet, []byte(test.apiResponse)))
+			client := setupTest(t, "", "", handlerMock(http.MethodGet, []byte(test.apiResponse)))
 
 			zone, err := client.GetZone(context.Background(), test.authFQDN)
 
@@ -239,7 +240,7 @@ func TestClient_FindTxtRecord(t *testing.T) {
 
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
-			client := setupTest(t, "", handlerMock(http.MethodGet, []byte(test.apiResponse)))
+			client := setupTest(t, "", "", handlerMock(http.MethodGet, []byte(test.apiResponse)))
 
 			txtRecord, err := client.FindTxtRecord(context.Background(), test.zoneName, test.authFQDN)
 
@@ -348,7 +349,7 @@ func TestClient_ListTxtRecord(t *testing.T) {
 
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
-			client := setupTest(t, "", handlerMock(http.MethodGet, []byte(test.apiResponse)))
+			client := setupTest(t, "", "", handlerMock(http.MethodGet, []byte(test.apiResponse)))
 
 			txtRecords, err := client.ListTxtRecords(context.Background(), test.zoneName, test.authFQDN)
 
@@ -365,6 +366,7 @@ func TestClient_ListTxtRecord(t *testing.T) {
 func TestClient_AddTxtRecord(t *testing.T) {
 	type expected struct {
 		query    string
+		id       int
 		errorMsg string
 	}
 
@@ -372,6 +374,7 @@ func TestClient_AddTxtRecord(t *testing.T) {
 		desc        string
 		authID      string
 		subAuthID   string
+		subAuthUser string
 		zoneName    string
 		authFQDN    string
 		value       string
@@ -415,6 +418,19 @@ func TestClient_AddTxtRecord(t *testing.T) {
 				query: `auth-password=myAuthPassword&domain-name=example.com&host=_acme-challenge&record=TXTtxtTXTtxtTXTtxtTXTtxt&record-type=TXT&sub-auth-id=mySubAuthID&ttl=60`,
 			},
 		},
+		{
+			desc:        "main zone (subAuthUser)",
+			subAuthUser: "mySubAuthUser",
+			zoneName:    "example.com",
+			authFQDN:    "_acme-challenge.example.com.",
+			value:       "TXTtxtTXTtxtTXTtxtTXTtxt",
+			ttl:         60,
+			apiResponse: `{"status":"Success","statusDescription":"The record was added successfully.","data":{"id":5769228}}`,
+			expected: expected{
+				query: `auth-password=myAuthPassword&domain-name=example.com&host=_acme-challenge&record=TXTtxtTXTtxtTXTtxtTXTtxt&record-type=TXT&sub-auth-user=mySubAuthUser&ttl=60`,
+				id:    5769228,
+			},
+		},
 		{
 			desc:        "invalid status",
 			authID:      "myAuthID",
@@ -438,14 +454,14 @@ func TestClient_AddTxtRecord(t *testing.T) {
 			apiResponse: `[{}]`,
 			expected: expected{
 				query:    `auth-id=myAuthID&auth-password=myAuthPassword&domain-name=example.com&host=_acme-challenge&record=TXTtxtTXTtxtTXTtxtTXTtxt&record-type=TXT&ttl=300`,
-				errorMsg: "unable to unmarshal response: [status code: 200] body: [{}] error: json: cannot unmarshal array into Go value of type internal.apiResponse",
+				errorMsg: "unable to unmarshal response: [status code: 200] body: [{}] error: json: cannot unmarshal array into Go value of type internal.addRecordResponse",
 			},
 		},
 	}
 
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
-			client := setupTest(t, test.subAuthID, func(rw http.ResponseWriter, req *http.Request) {
+			client := setupTest(t, test.subAuthID, test.subAuthUser, func(rw http.ResponseWriter, req *http.Request) {
 				if test.expected.query != req.URL.RawQuery {
 					msg := fmt.Sprintf("got: %s, want: %s", test.expected.query, req.URL.RawQuery)
 					http.Error(rw, msg, http.StatusBadRequest)
@@ -455,12 +471,13 @@ func TestClient_AddTxtRecord(t *testing.T) {
 				handlerMock(http.MethodPost, []byte(test.apiResponse))(rw, req)
 			})
 
-			err := client.AddTxtRecord(context.Background(), test.zoneName, test.authFQDN, test.value, test.ttl)
+			id, err := client.AddTxtRecord(context.Background(), test.zoneName, test.authFQDN, test.value, test.ttl)
 
 			if test.expected.errorMsg != "" {
 				require.EqualError(t, err, test.expected.errorMsg)
 			} else {
 				require.NoError(t, err)
+				assert.Equal(t, test.expected.id, id)
 			}
 		})
 	}
@@ -523,7 +540,7 @@ func TestClient_RemoveTxtRecord(t *testing.T) {
 			}))
 			t.Cleanup(server.Close)
 
-			client, err := NewClient("myAuthID", "", "myAuthPassword")
+			client, err := NewClient("myAuthID", "", "", "myAuthPassword")
 			require.NoError(t, err)
 
 			client.BaseURL, _ = url.Parse(server.URL)
@@ -593,7 +610,7 @@ func TestClient_GetUpdateStatus(t *testing.T) {
 			server := httptest.NewServer(handlerMock(http.MethodGet, []byte(test.apiResponse)))
 			t.Cleanup(server.Close)
 
-			client, err := NewClient("myAuthID", "", "myAuthPassword")
+			client, err := NewClient("myAuthID", "", "", "myAuthPassword")
 			require.NoError(t, err)
 
 			client.BaseURL, _ = url.Parse(server.URL)
@@ -610,3 +627,18 @@ func TestClient_GetUpdateStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_SetMinRequestInterval(t *testing.T) {
+	client := setupTest(t, "", "", handlerMock(http.MethodPost, []byte(`{"status":"Success"}`)))
+
+	client.SetMinRequestInterval(100 * time.Millisecond)
+
+	start := time.Now()
+
+	for range 3 {
+		err := client.RemoveTxtRecord(context.Background(), 1, "example.com")
+		require.NoError(t, err)
+	}
+
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
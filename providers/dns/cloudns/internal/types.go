@@ -5,6 +5,14 @@ type apiResponse struct {
 	StatusDescription string `json:"statusDescription"`
 }
 
+type addRecordResponse struct {
+	apiResponse
+
+	Data struct {
+		ID int `json:"id"`
+	} `json:"data"`
+}
+
 // Zone is a zone.
 type Zone struct {
 	Name   string
@@ -0,0 +1,32 @@
+package cloudns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/cloudns/cloudns.toml
+		Name:  "ClouDNS",
+		Code:  "cloudns",
+		Since: "v2.3.0",
+		URL:   "https://www.cloudns.net",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "CLOUDNS_AUTH_ID", Description: "The API user ID", Credential: true},
+			{Name: "CLOUDNS_AUTH_PASSWORD", Description: "The password for API user ID", Credential: true},
+			{Name: "CLOUDNS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "CLOUDNS_MIN_REQUEST_INTERVAL", Description: "Minimum time between two API calls, in seconds (default: no limit)"},
+			{Name: "CLOUDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "CLOUDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "CLOUDNS_SUB_AUTH_ID", Description: "The API sub user ID (exclusive with CLOUDNS_AUTH_ID and CLOUDNS_SUB_AUTH_USER)"},
+			{Name: "CLOUDNS_SUB_AUTH_USER", Description: "The API sub user name (exclusive with CLOUDNS_AUTH_ID and CLOUDNS_SUB_AUTH_ID)"},
+			{Name: "CLOUDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.cloudns.net/wiki/article/42/",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package cloudru
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/cloudru/cloudru.toml
+		Name:  "Cloud.ru",
+		Code:  "cloudru",
+		Since: "v4.14.0",
+		URL:   "https://cloud.ru",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "CLOUDRU_KEY_ID", Description: "Key ID (login)", Credential: true},
+			{Name: "CLOUDRU_SECRET", Description: "Key Secret", Credential: true},
+			{Name: "CLOUDRU_SERVICE_INSTANCE_ID", Description: "Service Instance ID (parentId)", Credential: true},
+			{Name: "CLOUDRU_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "CLOUDRU_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "CLOUDRU_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "CLOUDRU_SEQUENCE_INTERVAL", Description: "Time between sequential requests"},
+			{Name: "CLOUDRU_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://cloud.ru/ru/docs/clouddns/ug/topics/api-ref.html",
+			GoClient: "",
+		},
+	}
+}
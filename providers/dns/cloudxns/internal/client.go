@@ -16,6 +16,7 @@ import (
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 const defaultBaseURL = "https://www.cloudxns.net/api2/"
@@ -205,6 +206,8 @@ func (c *Client) newRequest(ctx context.Context, method string, endpoint *url.UR
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	requestDate := time.Now().Format(time.RFC1123Z)
 
 	req.Header.Set("API-KEY", c.apiKey)
@@ -0,0 +1,29 @@
+package cloudxns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/cloudxns/cloudxns.toml
+		Name:  "CloudXNS",
+		Code:  "cloudxns",
+		Since: "v0.5.0",
+		URL:   "https://www.cloudxns.net/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "CLOUDXNS_API_KEY", Description: "The API key", Credential: true},
+			{Name: "CLOUDXNS_SECRET_KEY", Description: "The API secret key", Credential: true},
+			{Name: "CLOUDXNS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "CLOUDXNS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "CLOUDXNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "CLOUDXNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.cloudxns.net/Public/Doc/CloudXNS_api2.0_doc_zh-cn.zip",
+			GoClient: "",
+		},
+	}
+}
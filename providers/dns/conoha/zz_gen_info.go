@@ -0,0 +1,31 @@
+package conoha
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/conoha/conoha.toml
+		Name:  "ConoHa",
+		Code:  "conoha",
+		Since: "v1.2.0",
+		URL:   "https://www.conoha.jp/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "CONOHA_API_PASSWORD", Description: "The API password", Credential: true},
+			{Name: "CONOHA_API_USERNAME", Description: "The API username", Credential: true},
+			{Name: "CONOHA_TENANT_ID", Description: "Tenant ID", Credential: true},
+			{Name: "CONOHA_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "CONOHA_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "CONOHA_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "CONOHA_REGION", Description: "The region"},
+			{Name: "CONOHA_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.conoha.jp/docs/",
+			GoClient: "",
+		},
+	}
+}
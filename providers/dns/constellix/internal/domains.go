@@ -7,6 +7,8 @@ import (
 	"net/http"
 
 	querystring "github.com/google/go-querystring/query"
+
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 // DomainService API access to Domain.
@@ -25,6 +27,8 @@ func (s *DomainService) GetAll(ctx context.Context, params *PaginationParameters
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	if params != nil {
 		v, errQ := querystring.Values(params)
 		if errQ != nil {
@@ -73,6 +77,8 @@ func (s *DomainService) Search(ctx context.Context, filter searchFilter, value s
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	query := req.URL.Query()
 	query.Set(string(filter), value)
 	req.URL.RawQuery = query.Encode()
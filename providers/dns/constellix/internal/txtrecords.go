@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 // TxtRecordService API access to Record.
@@ -31,6 +33,8 @@ func (s *TxtRecordService) Create(ctx context.Context, domainID int64, record Re
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	var records []Record
 	err = s.client.do(req, &records)
 	if err != nil {
@@ -53,6 +57,8 @@ func (s *TxtRecordService) GetAll(ctx context.Context, domainID int64) ([]Record
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	var records []Record
 	err = s.client.do(req, &records)
 	if err != nil {
@@ -75,6 +81,8 @@ func (s *TxtRecordService) Get(ctx context.Context, domainID, recordID int64) (*
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	var records Record
 	err = s.client.do(req, &records)
 	if err != nil {
@@ -102,6 +110,8 @@ func (s *TxtRecordService) Update(ctx context.Context, domainID, recordID int64,
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	var msg SuccessMessage
 	err = s.client.do(req, &msg)
 	if err != nil {
@@ -124,6 +134,8 @@ func (s *TxtRecordService) Delete(ctx context.Context, domainID, recordID int64)
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	var msg *SuccessMessage
 	err = s.client.do(req, &msg)
 	if err != nil {
@@ -146,6 +158,8 @@ func (s *TxtRecordService) Search(ctx context.Context, domainID int64, filter se
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	query := req.URL.Query()
 	query.Set(string(filter), value)
 	req.URL.RawQuery = query.Encode()
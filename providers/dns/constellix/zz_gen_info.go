@@ -0,0 +1,29 @@
+package constellix
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/constellix/constellix.toml
+		Name:  "Constellix",
+		Code:  "constellix",
+		Since: "v3.4.0",
+		URL:   "https://constellix.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "CONSTELLIX_API_KEY", Description: "User API key", Credential: true},
+			{Name: "CONSTELLIX_SECRET_KEY", Description: "User secret key", Credential: true},
+			{Name: "CONSTELLIX_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "CONSTELLIX_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "CONSTELLIX_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "CONSTELLIX_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api-docs.constellix.com",
+			GoClient: "",
+		},
+	}
+}
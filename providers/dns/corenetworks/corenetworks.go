@@ -0,0 +1,213 @@
+// Package corenetworks implements a DNS provider for solving the DNS-01 challenge using Core-Networks.
+package corenetworks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/go-acme/lego/v4/providers/dns/corenetworks/internal"
+)
+
+// Environment variables names.
+const (
+	envNamespace = "CORENETWORKS_"
+
+	EnvLogin    = envNamespace + "LOGIN"
+	EnvPassword = envNamespace + "PASSWORD"
+
+	EnvTTL                = envNamespace + "TTL"
+	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
+	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
+	EnvHTTPTimeout        = envNamespace + "HTTP_TIMEOUT"
+)
+
+// Config is used to configure the creation of the DNSProvider.
+type Config struct {
+	Login    string
+	Password string
+
+	TTL                int
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+	HTTPClient         *http.Client
+}
+
+// NewDefaultConfig returns a default configuration for the DNSProvider.
+func NewDefaultConfig() *Config {
+	return &Config{
+		TTL:                env.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
+		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		HTTPClient: &http.Client{
+			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+		},
+	}
+}
+
+// DNSProvider implements the challenge.Provider interface.
+type DNSProvider struct {
+	config *Config
+	client *internal.Client
+
+	// The modifications of a zone are applied by a commit of the whole zone.
+	zonesMu sync.Mutex
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for Core-Networks.
+// Credentials must be passed in the environment variables:
+// CORENETWORKS_LOGIN and CORENETWORKS_PASSWORD.
+func NewDNSProvider() (*DNSProvider, error) {
+	values, err := env.Get(EnvLogin, EnvPassword)
+	if err != nil {
+		return nil, fmt.Errorf("corenetworks: %w", err)
+	}
+
+	config := NewDefaultConfig()
+	config.Login = values[EnvLogin]
+	config.Password = values[EnvPassword]
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewDNSProviderConfig return a DNSProvider instance configured for Core-Networks.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("corenetworks: the configuration of the DNS provider is nil")
+	}
+
+	client, err := internal.NewClient(config.Login, config.Password)
+	if err != nil {
+		return nil, fmt.Errorf("corenetworks: %w", err)
+	}
+
+	if config.HTTPClient != nil {
+		client.HTTPClient = config.HTTPClient
+	}
+
+	return &DNSProvider{
+		config: config,
+		client: client,
+	}, nil
+}
+
+// Timeout returns the timeout and interval to use when checking for DNS propagation.
+// Adjusting here to cope with spikes in propagation times.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
+
+// Present creates a TXT record using the specified parameters.
+func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	ctx, err := d.client.CreateAuthenticatedContext(context.Background())
+	if err != nil {
+		return fmt.Errorf("corenetworks: authentication: %w", err)
+	}
+
+	zone, err := d.findZone(ctx, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("corenetworks: %w", err)
+	}
+
+	subDomain, err := dns01.ExtractSubDomain(strings.ToLower(info.EffectiveFQDN), zone)
+	if err != nil {
+		return fmt.Errorf("corenetworks: %w", err)
+	}
+
+	record := internal.Record{
+		Name: subDomain,
+		TTL:  d.config.TTL,
+		Type: "TXT",
+		Data: strconv.Quote(info.Value),
+	}
+
+	d.zonesMu.Lock()
+	defer d.zonesMu.Unlock()
+
+	err = d.client.AddRecord(ctx, zone, record)
+	if err != nil {
+		return fmt.Errorf("corenetworks: add record: %w", err)
+	}
+
+	err = d.client.CommitRecords(ctx, zone)
+	if err != nil {
+		return fmt.Errorf("corenetworks: commit records: %w", err)
+	}
+
+	return nil
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	ctx, err := d.client.CreateAuthenticatedContext(context.Background())
+	if err != nil {
+		return fmt.Errorf("corenetworks: authentication: %w", err)
+	}
+
+	zone, err := d.findZone(ctx, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("corenetworks: %w", err)
+	}
+
+	subDomain, err := dns01.ExtractSubDomain(strings.ToLower(info.EffectiveFQDN), zone)
+	if err != nil {
+		return fmt.Errorf("corenetworks: %w", err)
+	}
+
+	filter := internal.Record{
+		Name: subDomain,
+		Type: "TXT",
+		Data: strconv.Quote(info.Value),
+	}
+
+	d.zonesMu.Lock()
+	defer d.zonesMu.Unlock()
+
+	err = d.client.DeleteRecords(ctx, zone, filter)
+	if err != nil {
+		return fmt.Errorf("corenetworks: delete records: %w", err)
+	}
+
+	err = d.client.CommitRecords(ctx, zone)
+	if err != nil {
+		return fmt.Errorf("corenetworks: commit records: %w", err)
+	}
+
+	return nil
+}
+
+// findZone returns the most specific master zone, of the account, containing the FQDN.
+func (d *DNSProvider) findZone(ctx context.Context, fqdn string) (string, error) {
+	zones, err := d.client.ListZones(ctx)
+	if err != nil {
+		return "", fmt.Errorf("list zones: %w", err)
+	}
+
+	var names []string
+	for _, z := range zones {
+		// The records of a slave zone cannot be modified.
+		if z.Type != "" && z.Type != "master" {
+			continue
+		}
+
+		names = append(names, z.Name)
+	}
+
+	zone := dns01.FindMostSpecificZone(fqdn, names)
+	if zone == "" {
+		return "", fmt.Errorf("no zone found for %s", fqdn)
+	}
+
+	return dns01.UnFqdn(zone), nil
+}
@@ -0,0 +1,179 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
+)
+
+const defaultBaseURL = "https://beta.api.core-networks.de"
+
+// Client the Core-Networks API client.
+type Client struct {
+	login    string
+	password string
+
+	BaseURL    *url.URL
+	HTTPClient *http.Client
+
+	token   *Token
+	muToken sync.Mutex
+}
+
+// NewClient creates a new Client.
+func NewClient(login, password string) (*Client, error) {
+	if login == "" || password == "" {
+		return nil, errors.New("credentials missing")
+	}
+
+	baseURL, _ := url.Parse(defaultBaseURL)
+
+	return &Client{
+		login:      login,
+		password:   password,
+		BaseURL:    baseURL,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// ListZones lists the zones.
+// https://beta.api.core-networks.de/doc/#functon_dnszones
+func (c *Client) ListZones(ctx context.Context) ([]Zone, error) {
+	endpoint := c.BaseURL.JoinPath("dnszones", "/")
+
+	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var zones []Zone
+
+	err = c.do(req, &zones)
+	if err != nil {
+		return nil, err
+	}
+
+	return zones, nil
+}
+
+// AddRecord adds a record to a zone.
+// The modification is only applied after a commit of the zone.
+// https://beta.api.core-networks.de/doc/#functon_dnszones_records_add
+func (c *Client) AddRecord(ctx context.Context, zone string, record Record) error {
+	endpoint := c.BaseURL.JoinPath("dnszones", zone, "records", "/")
+
+	req, err := newJSONRequest(ctx, http.MethodPost, endpoint, record)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+// DeleteRecords deletes the records of a zone matching the filter (name, type and data).
+// The modification is only applied after a commit of the zone.
+// https://beta.api.core-networks.de/doc/#functon_dnszones_records_delete
+func (c *Client) DeleteRecords(ctx context.Context, zone string, filter Record) error {
+	endpoint := c.BaseURL.JoinPath("dnszones", zone, "records", "delete")
+
+	req, err := newJSONRequest(ctx, http.MethodPost, endpoint, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+// CommitRecords applies the modifications of the records of a zone.
+// https://beta.api.core-networks.de/doc/#functon_dnszones_commit
+func (c *Client) CommitRecords(ctx context.Context, zone string) error {
+	endpoint := c.BaseURL.JoinPath("dnszones", zone, "records", "commit")
+
+	req, err := newJSONRequest(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *Client) do(req *http.Request, result any) error {
+	if tok := getToken(req.Context()); tok != nil {
+		req.Header.Set("Authorization", "Bearer "+tok.Token)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return errutils.NewHTTPDoError(req, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode/100 != 2 {
+		return parseError(req, resp)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errutils.NewReadResponseError(req, resp.StatusCode, err)
+	}
+
+	err = json.Unmarshal(raw, result)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
+	}
+
+	return nil
+}
+
+func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, payload any) (*http.Request, error) {
+	buf := new(bytes.Buffer)
+
+	if payload != nil {
+		err := json.NewEncoder(buf).Encode(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request JSON body: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint.String(), buf)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %w", err)
+	}
+
+	useragent.SetHeader(req.Header)
+
+	req.Header.Set("Accept", "application/json")
+
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+func parseError(req *http.Request, resp *http.Response) error {
+	raw, _ := io.ReadAll(resp.Body)
+
+	errAPI := &APIError{StatusCode: resp.StatusCode}
+	err := json.Unmarshal(raw, errAPI)
+	if err != nil || errAPI.Message == "" {
+		return errutils.NewUnexpectedStatusCodeError(req, resp.StatusCode, raw)
+	}
+
+	return errAPI
+}
@@ -0,0 +1,170 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockContext() context.Context {
+	return context.WithValue(context.Background(), tokenKey, &Token{Token: "secret-token"})
+}
+
+func setupTest(t *testing.T, pattern string, status int, filename, expectedRequest string) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc(pattern, func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/auth/token" && req.Header.Get("Authorization") != "Bearer secret-token" {
+			http.Error(rw, fmt.Sprintf("invalid Authorization header: %q", req.Header.Get("Authorization")), http.StatusUnauthorized)
+			return
+		}
+
+		if expectedRequest != "" {
+			expected, err := os.ReadFile(filepath.Join("fixtures", expectedRequest))
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			if !jsonEqual(expected, body) {
+				http.Error(rw, fmt.Sprintf("invalid request body: %s", string(body)), http.StatusBadRequest)
+				return
+			}
+		}
+
+		if filename == "" {
+			rw.WriteHeader(status)
+			return
+		}
+
+		file, err := os.Open(filepath.Join("fixtures", filename))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		defer func() { _ = file.Close() }()
+
+		rw.WriteHeader(status)
+
+		_, err = io.Copy(rw, file)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	client, err := NewClient("user", "secret")
+	require.NoError(t, err)
+
+	client.BaseURL, _ = url.Parse(server.URL)
+	client.HTTPClient = server.Client()
+
+	return client
+}
+
+func jsonEqual(a, b []byte) bool {
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(va, vb)
+}
+
+func TestClient_CreateAuthenticatedContext(t *testing.T) {
+	client := setupTest(t, "POST /auth/token", http.StatusOK, "auth.json", "")
+
+	ctx, err := client.CreateAuthenticatedContext(context.Background())
+	require.NoError(t, err)
+
+	tok := getToken(ctx)
+	require.NotNil(t, tok)
+
+	assert.Equal(t, "secret-token", tok.Token)
+	assert.NotZero(t, tok.Deadline)
+
+	// The token is cached.
+	assert.Same(t, tok, client.token)
+
+	ctx, err = client.CreateAuthenticatedContext(context.Background())
+	require.NoError(t, err)
+
+	assert.Same(t, tok, getToken(ctx))
+}
+
+func TestClient_ListZones(t *testing.T) {
+	client := setupTest(t, "GET /dnszones/", http.StatusOK, "zones.json", "")
+
+	zones, err := client.ListZones(mockContext())
+	require.NoError(t, err)
+
+	expected := []Zone{
+		{Name: "example.com", Type: "master"},
+		{Name: "example.net", Type: "slave"},
+	}
+
+	assert.Equal(t, expected, zones)
+}
+
+func TestClient_ListZones_error(t *testing.T) {
+	client := setupTest(t, "GET /dnszones/", http.StatusNotFound, "error.json", "")
+
+	_, err := client.ListZones(mockContext())
+	require.EqualError(t, err, "404: Zone not found")
+}
+
+func TestClient_AddRecord(t *testing.T) {
+	client := setupTest(t, "POST /dnszones/example.com/records/", http.StatusOK, "", "add_record-request.json")
+
+	record := Record{Name: "_acme-challenge", TTL: 3600, Type: "TXT", Data: `"txtTXTtxt"`}
+
+	err := client.AddRecord(mockContext(), "example.com", record)
+	require.NoError(t, err)
+}
+
+func TestClient_AddRecord_error(t *testing.T) {
+	client := setupTest(t, "POST /dnszones/example.com/records/", http.StatusNotFound, "error.json", "add_record-request.json")
+
+	record := Record{Name: "_acme-challenge", TTL: 3600, Type: "TXT", Data: `"txtTXTtxt"`}
+
+	err := client.AddRecord(mockContext(), "example.com", record)
+	require.EqualError(t, err, "404: Zone not found")
+}
+
+func TestClient_DeleteRecords(t *testing.T) {
+	client := setupTest(t, "POST /dnszones/example.com/records/delete", http.StatusOK, "", "delete_records-request.json")
+
+	filter := Record{Name: "_acme-challenge", Type: "TXT", Data: `"txtTXTtxt"`}
+
+	err := client.DeleteRecords(mockContext(), "example.com", filter)
+	require.NoError(t, err)
+}
+
+func TestClient_CommitRecords(t *testing.T) {
+	client := setupTest(t, "POST /dnszones/example.com/records/commit", http.StatusOK, "", "")
+
+	err := client.CommitRecords(mockContext(), "example.com")
+	require.NoError(t, err)
+}
@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+type token string
+
+const tokenKey token = "token"
+
+// obtainToken exchanges the login and the password for a bearer token.
+// https://beta.api.core-networks.de/doc/#functon_auth_token
+func (c *Client) obtainToken(ctx context.Context) (*Token, error) {
+	endpoint := c.BaseURL.JoinPath("auth", "token")
+
+	req, err := newJSONRequest(ctx, http.MethodPost, endpoint, Credentials{Login: c.login, Password: c.password})
+	if err != nil {
+		return nil, err
+	}
+
+	tok := &Token{}
+
+	err = c.do(req, tok)
+	if err != nil {
+		return nil, err
+	}
+
+	tok.Deadline = time.Now().Add(time.Duration(tok.Expires) * time.Second)
+
+	return tok, nil
+}
+
+// CreateAuthenticatedContext returns a context containing a bearer token.
+// The token is cached by the client, and renewed shortly before its expiration.
+func (c *Client) CreateAuthenticatedContext(ctx context.Context) (context.Context, error) {
+	c.muToken.Lock()
+	defer c.muToken.Unlock()
+
+	if c.token != nil && time.Now().Add(time.Minute).Before(c.token.Deadline) {
+		// Already authenticated, stop now
+		return context.WithValue(ctx, tokenKey, c.token), nil
+	}
+
+	tok, err := c.obtainToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.token = tok
+
+	return context.WithValue(ctx, tokenKey, tok), nil
+}
+
+func getToken(ctx context.Context) *Token {
+	tok, ok := ctx.Value(tokenKey).(*Token)
+	if !ok {
+		return nil
+	}
+
+	return tok
+}
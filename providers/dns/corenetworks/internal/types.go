@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"fmt"
+	"time"
+)
+
+type APIError struct {
+	StatusCode int    `json:"-"`
+	Message    string `json:"error"`
+}
+
+func (a *APIError) Error() string {
+	return fmt.Sprintf("%d: %s", a.StatusCode, a.Message)
+}
+
+type Credentials struct {
+	Login    string `json:"login"`
+	Password string `json:"password"`
+}
+
+type Token struct {
+	Token string `json:"token"`
+	// Number in seconds before the expiration
+	Expires int `json:"expires"`
+
+	Deadline time.Time `json:"-"`
+}
+
+type Zone struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type Record struct {
+	Name string `json:"name"`
+	TTL  int    `json:"ttl,omitempty"`
+	Type string `json:"type"`
+	Data string `json:"data"`
+}
@@ -0,0 +1,29 @@
+package corenetworks
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/corenetworks/corenetworks.toml
+		Name:  "Core-Networks",
+		Code:  "corenetworks",
+		Since: "v4.18.0",
+		URL:   "https://www.core-networks.de/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "CORENETWORKS_LOGIN", Description: "The username of the API account", Credential: true},
+			{Name: "CORENETWORKS_PASSWORD", Description: "The password", Credential: true},
+			{Name: "CORENETWORKS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "CORENETWORKS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "CORENETWORKS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "CORENETWORKS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://beta.api.core-networks.de/doc/",
+			GoClient: "",
+		},
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/go-acme/lego/v4/providers/dns/cpanel/internal/shared"
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 const statusFailed = 0
@@ -122,6 +123,8 @@ func (c Client) doRequest(ctx context.Context, endpoint *url.URL, result any) er
 		return fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	// https://api.docs.cpanel.net/cpanel/tokens/#using-an-api-token
 	req.Header.Set("Authorization", fmt.Sprintf("cpanel %s:%s", c.username, c.token))
 	req.Header.Set("Accept", "application/json")
@@ -0,0 +1,32 @@
+package cpanel
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/cpanel/cpanel.toml
+		Name:  "CPanel/WHM",
+		Code:  "cpanel",
+		Since: "v4.16.0",
+		URL:   "https://cpanel.net/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "CPANEL_BASE_URL", Description: "API server URL", Credential: true},
+			{Name: "CPANEL_TOKEN", Description: "API token", Credential: true},
+			{Name: "CPANEL_USERNAME", Description: "username", Credential: true},
+			{Name: "CPANEL_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "CPANEL_MODE", Description: "use cpanel API or WHM API (Default: cpanel)"},
+			{Name: "CPANEL_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "CPANEL_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "CPANEL_REGION", Description: "The region"},
+			{Name: "CPANEL_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "",
+			GoClient: "",
+		},
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 	querystring "github.com/google/go-querystring/query"
 )
 
@@ -146,6 +147,8 @@ func (c Client) GetZones(ctx context.Context) ([]Zone, error) {
 		return nil, err
 	}
 
+	useragent.SetHeader(req.Header)
+
 	response := &APIResponse[[]Zone]{}
 	err = c.do(req, response)
 	if err != nil {
@@ -208,6 +211,8 @@ func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, paylo
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 
 	if payload != nil {
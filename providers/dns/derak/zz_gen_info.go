@@ -0,0 +1,25 @@
+package derak
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/derak/derak.toml
+		Name:  "Derak Cloud",
+		Code:  "derak",
+		Since: "v4.12.0",
+		URL:   "https://derak.cloud/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "DERAK_API_KEY", Description: "The API key", Credential: true},
+			{Name: "DERAK_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "DERAK_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "DERAK_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "DERAK_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+			{Name: "DERAK_WEBSITE_ID", Description: "Force the zone/website ID"},
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package desec
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/desec/desec.toml
+		Name:  "deSEC.io",
+		Code:  "desec",
+		Since: "v3.7.0",
+		URL:   "https://desec.io",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "DESEC_TOKEN", Description: "Domain token", Credential: true},
+			{Name: "DESEC_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "DESEC_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "DESEC_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "DESEC_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://desec.readthedocs.io/en/latest/",
+			GoClient: "",
+		},
+	}
+}
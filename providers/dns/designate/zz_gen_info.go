@@ -0,0 +1,37 @@
+package designate
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/designate/designate.toml
+		Name:  "Designate DNSaaS for Openstack",
+		Code:  "designate",
+		Since: "v2.2.0",
+		URL:   "https://docs.openstack.org/designate/latest/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "OS_APPLICATION_CREDENTIAL_ID", Description: "Application credential ID", Credential: true},
+			{Name: "OS_APPLICATION_CREDENTIAL_NAME", Description: "Application credential name", Credential: true},
+			{Name: "OS_APPLICATION_CREDENTIAL_SECRET", Description: "Application credential secret", Credential: true},
+			{Name: "OS_AUTH_URL", Description: "Identity endpoint URL", Credential: true},
+			{Name: "OS_PASSWORD", Description: "Password", Credential: true},
+			{Name: "OS_PROJECT_NAME", Description: "Project name", Credential: true},
+			{Name: "OS_REGION_NAME", Description: "Region name", Credential: true},
+			{Name: "OS_USERNAME", Description: "Username", Credential: true},
+			{Name: "OS_USER_ID", Description: "User ID", Credential: true},
+			{Name: "DESIGNATE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "DESIGNATE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "DESIGNATE_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+			{Name: "OS_PROJECT_ID", Description: "Project ID"},
+			{Name: "OS_TENANT_NAME", Description: "Tenant name (deprecated see OS_PROJECT_NAME and OS_PROJECT_ID)"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://docs.openstack.org/designate/latest/",
+			GoClient: "https://pkg.go.dev/github.com/gophercloud/gophercloud/openstack/dns/v2",
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package digitalocean
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/digitalocean/digitalocean.toml
+		Name:  "Digital Ocean",
+		Code:  "digitalocean",
+		Since: "v0.3.0",
+		URL:   "https://www.digitalocean.com/docs/networking/dns/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "DO_AUTH_TOKEN", Description: "Authentication token", Credential: true},
+			{Name: "DO_API_URL", Description: "The URL of the API"},
+			{Name: "DO_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "DO_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "DO_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "DO_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developers.digitalocean.com/documentation/v2/#domain-records",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
+)
+
+const defaultBaseURL = "https://dinahosting.com/special/api.php"
+
+// Client the Dinahosting API client.
+type Client struct {
+	username string
+	password string
+
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewClient Creates a new Client.
+func NewClient(username, password string) *Client {
+	return &Client{
+		username:   username,
+		password:   password,
+		BaseURL:    defaultBaseURL,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// AddTXTRecord adds a TXT record.
+func (c *Client) AddTXTRecord(ctx context.Context, domain, hostname, text string) error {
+	data := url.Values{}
+	data.Set("domain", domain)
+	data.Set("hostname", hostname)
+	data.Set("text", text)
+
+	return c.doRequest(ctx, "Domain_Zone_AddTypeTXT", data, nil)
+}
+
+// DeleteTXTRecord deletes a TXT record.
+func (c *Client) DeleteTXTRecord(ctx context.Context, domain, hostname, value string) error {
+	data := url.Values{}
+	data.Set("domain", domain)
+	data.Set("hostname", hostname)
+	data.Set("value", value)
+
+	return c.doRequest(ctx, "Domain_Zone_DeleteTypeTXT", data, nil)
+}
+
+// GetDomains returns the domains of the account.
+func (c *Client) GetDomains(ctx context.Context) ([]Domain, error) {
+	var domains []Domain
+
+	err := c.doRequest(ctx, "Domain_GetDomains", url.Values{}, &domains)
+	if err != nil {
+		return nil, err
+	}
+
+	return domains, nil
+}
+
+func (c *Client) doRequest(ctx context.Context, command string, data url.Values, result any) error {
+	data.Set("AUTH_USER", c.username)
+	data.Set("AUTH_PWD", c.password)
+	data.Set("responseType", "Json")
+	data.Set("command", command)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return err
+	}
+
+	useragent.SetHeader(req.Header)
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return errutils.NewHTTPDoError(req, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode/100 != 2 {
+		return errutils.NewUnexpectedResponseStatusCodeError(req, resp)
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errutils.NewReadResponseError(req, resp.StatusCode, err)
+	}
+
+	var r APIResponse
+	err = json.Unmarshal(raw, &r)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
+	}
+
+	if r.ResponseCode != successCode {
+		return &APIError{ResponseCode: r.ResponseCode, Message: r.Message, Errors: r.Errors}
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	err = json.Unmarshal(r.Data, result)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, r.Data, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTest(t *testing.T, filename string, expectedParams url.Values) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+			http.Error(rw, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		err := req.ParseForm()
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		for k, v := range expectedParams {
+			val := req.PostForm.Get(k)
+			if val != v[0] {
+				http.Error(rw, fmt.Sprintf("%s: invalid value: %s != %s", k, val, v[0]), http.StatusBadRequest)
+				return
+			}
+		}
+
+		file, err := os.Open(path.Join("fixtures", filename))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer func() { _ = file.Close() }()
+
+		_, err = io.Copy(rw, file)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := NewClient("user", "secret")
+	client.BaseURL = server.URL
+	client.HTTPClient = server.Client()
+
+	return client
+}
+
+func TestClient_AddTXTRecord(t *testing.T) {
+	data := url.Values{}
+	data.Set("AUTH_USER", "user")
+	data.Set("AUTH_PWD", "secret")
+	data.Set("responseType", "Json")
+	data.Set("command", "Domain_Zone_AddTypeTXT")
+	data.Set("domain", "example.com")
+	data.Set("hostname", "_acme-challenge")
+	data.Set("text", "txtTXTtxt")
+
+	client := setupTest(t, "success.json", data)
+
+	err := client.AddTXTRecord(context.Background(), "example.com", "_acme-challenge", "txtTXTtxt")
+	require.NoError(t, err)
+}
+
+func TestClient_AddTXTRecord_error(t *testing.T) {
+	client := setupTest(t, "error.json", url.Values{})
+
+	err := client.AddTXTRecord(context.Background(), "example.com", "_acme-challenge", "txtTXTtxt")
+	require.EqualError(t, err, "2005: Method parameter error. (2005: The domain example.com does not belong to the account.)")
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+
+	assert.Equal(t, 2005, apiErr.ResponseCode)
+}
+
+func TestClient_DeleteTXTRecord(t *testing.T) {
+	data := url.Values{}
+	data.Set("AUTH_USER", "user")
+	data.Set("AUTH_PWD", "secret")
+	data.Set("responseType", "Json")
+	data.Set("command", "Domain_Zone_DeleteTypeTXT")
+	data.Set("domain", "example.com")
+	data.Set("hostname", "_acme-challenge")
+	data.Set("value", "txtTXTtxt")
+
+	client := setupTest(t, "success.json", data)
+
+	err := client.DeleteTXTRecord(context.Background(), "example.com", "_acme-challenge", "txtTXTtxt")
+	require.NoError(t, err)
+}
+
+func TestClient_DeleteTXTRecord_error(t *testing.T) {
+	client := setupTest(t, "error.json", url.Values{})
+
+	err := client.DeleteTXTRecord(context.Background(), "example.com", "_acme-challenge", "txtTXTtxt")
+	require.EqualError(t, err, "2005: Method parameter error. (2005: The domain example.com does not belong to the account.)")
+}
+
+func TestClient_GetDomains(t *testing.T) {
+	data := url.Values{}
+	data.Set("command", "Domain_GetDomains")
+
+	client := setupTest(t, "get_domains.json", data)
+
+	domains, err := client.GetDomains(context.Background())
+	require.NoError(t, err)
+
+	expected := []Domain{
+		{Name: "example.com"},
+		{Name: "sub.example.com"},
+		{Name: "example.org"},
+	}
+
+	assert.Equal(t, expected, domains)
+}
+
+func TestClient_GetDomains_error(t *testing.T) {
+	client := setupTest(t, "error.json", url.Values{})
+
+	_, err := client.GetDomains(context.Background())
+	require.Error(t, err)
+}
@@ -0,0 +1,53 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// successCode the response code of a successful command.
+const successCode = 1000
+
+// APIResponse the response of a command.
+type APIResponse struct {
+	TrID         string          `json:"trId"`
+	ResponseCode int             `json:"responseCode"`
+	Message      string          `json:"message"`
+	Data         json.RawMessage `json:"data"`
+	Command      string          `json:"command"`
+	Errors       []ErrorDetail   `json:"errors"`
+}
+
+// ErrorDetail the details of an error.
+type ErrorDetail struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// APIError the error of a command.
+type APIError struct {
+	ResponseCode int
+	Message      string
+	Errors       []ErrorDetail
+}
+
+func (a *APIError) Error() string {
+	msg := fmt.Sprintf("%d: %s", a.ResponseCode, a.Message)
+
+	var details []string
+	for _, detail := range a.Errors {
+		details = append(details, fmt.Sprintf("%d: %s", detail.Code, detail.Message))
+	}
+
+	if len(details) > 0 {
+		msg += " (" + strings.Join(details, ", ") + ")"
+	}
+
+	return msg
+}
+
+// Domain a domain of the account.
+type Domain struct {
+	Name string `json:"domain"`
+}
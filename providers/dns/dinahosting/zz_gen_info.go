@@ -0,0 +1,28 @@
+package dinahosting
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/dinahosting/dinahosting.toml
+		Name:  "Dinahosting",
+		Code:  "dinahosting",
+		Since: "v4.18.0",
+		URL:   "https://dinahosting.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "DINAHOSTING_PASSWORD", Description: "Password of the account", Credential: true},
+			{Name: "DINAHOSTING_USERNAME", Description: "Username of the account", Credential: true},
+			{Name: "DINAHOSTING_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "DINAHOSTING_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "DINAHOSTING_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://en.dinahosting.com/api",
+			GoClient: "",
+		},
+	}
+}
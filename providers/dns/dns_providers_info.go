@@ -0,0 +1,30 @@
+package dns
+
+import (
+	"sort"
+
+	"github.com/go-acme/lego/v4/challenge"
+)
+
+// ProviderInfoByName returns the description of a DNS provider (see the Info function of the provider package).
+// The boolean is false if the provider is unknown or doesn't expose its description (e.g. manual).
+func ProviderInfoByName(name string) (challenge.ProviderInfo, bool) {
+	info, ok := providersInfo[name]
+	if !ok {
+		return challenge.ProviderInfo{}, false
+	}
+
+	return info(), true
+}
+
+// ProvidersWithInfo returns the sorted names of the DNS providers exposing their description.
+func ProvidersWithInfo() []string {
+	var names []string
+	for name := range providersInfo {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
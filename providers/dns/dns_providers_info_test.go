@@ -0,0 +1,62 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderInfoByName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected []string
+	}{
+		{
+			name: "cloudflare",
+			expected: []string{
+				"CF_API_EMAIL", "CF_API_KEY", "CF_DNS_API_TOKEN", "CF_ZONE_API_TOKEN",
+				"CLOUDFLARE_API_KEY", "CLOUDFLARE_DNS_API_TOKEN", "CLOUDFLARE_EMAIL", "CLOUDFLARE_ZONE_API_TOKEN",
+			},
+		},
+		{
+			name:     "infoblox",
+			expected: []string{"INFOBLOX_HOST", "INFOBLOX_PASSWORD", "INFOBLOX_USERNAME"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			info, ok := ProviderInfoByName(test.name)
+			require.True(t, ok)
+
+			assert.Equal(t, test.name, info.Code)
+			assert.NotEmpty(t, info.Name)
+			assert.NotEmpty(t, info.EnvVars)
+			assert.Equal(t, test.expected, info.CredentialEnvVars())
+		})
+	}
+}
+
+func TestProviderInfoByName_withoutInfo(t *testing.T) {
+	// The provider exists but doesn't expose its description.
+	_, ok := ProviderInfoByName("manual")
+	assert.False(t, ok)
+
+	_, ok = ProviderInfoByName("foobar")
+	assert.False(t, ok)
+}
+
+func TestProvidersWithInfo(t *testing.T) {
+	names := ProvidersWithInfo()
+
+	assert.Contains(t, names, "cloudflare")
+	assert.Contains(t, names, "infoblox")
+
+	for _, name := range names {
+		info, ok := ProviderInfoByName(name)
+		require.True(t, ok)
+
+		assert.Equal(t, name, info.Code)
+	}
+}
@@ -0,0 +1,20 @@
+package dnshomede
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/dnshomede/dnshomede.toml
+		Name:  "dnsHome.de",
+		Code:  "dnshomede",
+		Since: "v4.10.0",
+		URL:   "https://www.dnshome.de",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "DNSHOMEDE_CREDENTIALS", Description: "Comma-separated list of domain:password credential pairs", Credential: true},
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package dnsimple
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/dnsimple/dnsimple.toml
+		Name:  "DNSimple",
+		Code:  "dnsimple",
+		Since: "v0.3.0",
+		URL:   "https://dnsimple.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "DNSIMPLE_OAUTH_TOKEN", Description: "OAuth token", Credential: true},
+			{Name: "DNSIMPLE_BASE_URL", Description: "API endpoint URL"},
+			{Name: "DNSIMPLE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "DNSIMPLE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "DNSIMPLE_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developer.dnsimple.com/v2/",
+			GoClient: "https://github.com/dnsimple/dnsimple-go",
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package dnsmadeeasy
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/dnsmadeeasy/dnsmadeeasy.toml
+		Name:  "DNS Made Easy",
+		Code:  "dnsmadeeasy",
+		Since: "v0.4.0",
+		URL:   "https://dnsmadeeasy.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "DNSMADEEASY_API_KEY", Description: "The API key", Credential: true},
+			{Name: "DNSMADEEASY_API_SECRET", Description: "The API Secret key", Credential: true},
+			{Name: "DNSMADEEASY_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "DNSMADEEASY_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "DNSMADEEASY_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "DNSMADEEASY_SANDBOX", Description: "Activate the sandbox (boolean)"},
+			{Name: "DNSMADEEASY_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api-docs.dnsmadeeasy.com/",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package dnspod
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/dnspod/dnspod.toml
+		Name:  "DNSPod (deprecated)",
+		Code:  "dnspod",
+		Since: "v0.4.0",
+		URL:   "https://www.dnspod.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "DNSPOD_API_KEY", Description: "The user token", Credential: true},
+			{Name: "DNSPOD_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "DNSPOD_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "DNSPOD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "DNSPOD_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://docs.dnspod.com/api/",
+			GoClient: "https://github.com/nrdcg/dnspod-go",
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package dode
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/dode/dode.toml
+		Name:  "Domain Offensive (do.de)",
+		Code:  "dode",
+		Since: "v2.4.0",
+		URL:   "https://www.do.de/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "DODE_TOKEN", Description: "API token", Credential: true},
+			{Name: "DODE_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "DODE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "DODE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "DODE_SEQUENCE_INTERVAL", Description: "Time between sequential requests"},
+			{Name: "DODE_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.do.de/wiki/freie-ssl-tls-zertifikate-ueber-acme/",
+			GoClient: "",
+		},
+	}
+}
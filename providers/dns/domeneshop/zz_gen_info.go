@@ -0,0 +1,28 @@
+package domeneshop
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/domeneshop/domeneshop.toml
+		Name:  "Domeneshop",
+		Code:  "domeneshop",
+		Since: "v4.3.0",
+		URL:   "https://domene.shop",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "DOMENESHOP_API_SECRET", Description: "API secret", Credential: true},
+			{Name: "DOMENESHOP_API_TOKEN", Description: "API token", Credential: true},
+			{Name: "DOMENESHOP_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "DOMENESHOP_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "DOMENESHOP_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.domeneshop.no/docs",
+			GoClient: "",
+		},
+	}
+}
@@ -21,6 +21,8 @@ const (
 
 	EnvAPIKey = envNamespace + "API_KEY"
 
+	EnvDelay = envNamespace + "DELAY"
+
 	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
 	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
 	EnvHTTPTimeout        = envNamespace + "HTTP_TIMEOUT"
@@ -30,6 +32,7 @@ const (
 type Config struct {
 	BaseURL            string
 	APIKey             string
+	Delay              time.Duration
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
 	HTTPClient         *http.Client
@@ -39,6 +42,7 @@ type Config struct {
 func NewDefaultConfig() *Config {
 	return &Config{
 		BaseURL:            internal.DefaultBaseURL,
+		Delay:              env.GetOrDefaultSecond(EnvDelay, 0),
 		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 60*time.Minute),
 		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 1*time.Minute),
 		HTTPClient: &http.Client{
@@ -91,25 +95,45 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 }
 
 // Present creates a TXT record using the specified parameters.
+// The API rejects the duplicated records, so an existing identical record is reused.
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
 	info := dns01.GetChallengeInfo(domain, keyAuth)
-	err := d.client.AddRecord(context.Background(), dns01.UnFqdn(info.EffectiveFQDN), info.Value)
+
+	record := dns01.UnFqdn(info.EffectiveFQDN)
+
+	exists, err := d.recordExists(ctx, record, info.Value)
 	if err != nil {
 		return fmt.Errorf("dreamhost: %w", err)
 	}
 
+	if exists {
+		return nil
+	}
+
+	err = d.client.AddRecord(ctx, record, info.Value)
+	if err != nil && !internal.IsErrorCode(err, internal.ErrCodeRecordAlreadyExists) {
+		return fmt.Errorf("dreamhost: %w", err)
+	}
+
+	d.wait()
+
 	return nil
 }
 
 // CleanUp removes the TXT record matching the specified parameters.
+// A record already removed is not an error.
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
 	err := d.client.RemoveRecord(context.Background(), dns01.UnFqdn(info.EffectiveFQDN), info.Value)
-	if err != nil {
+	if err != nil && !internal.IsErrorCode(err, internal.ErrCodeNoSuchRecord) {
 		return fmt.Errorf("dreamhost: %w", err)
 	}
 
+	d.wait()
+
 	return nil
 }
 
@@ -118,3 +142,25 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 	return d.config.PropagationTimeout, d.config.PollingInterval
 }
+
+func (d *DNSProvider) recordExists(ctx context.Context, name, value string) (bool, error) {
+	records, err := d.client.ListRecords(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, record := range records {
+		if record.Type == "TXT" && record.Record == name && record.Value == value {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// wait gives time to the DreamHost backend to apply the modification of the records.
+func (d *DNSProvider) wait() {
+	if d.config.Delay > 0 {
+		time.Sleep(d.config.Delay)
+	}
+}
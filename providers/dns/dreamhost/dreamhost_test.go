@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -122,8 +124,14 @@ func TestDNSProvider_Present(t *testing.T) {
 
 		q := r.URL.Query()
 		assert.Equal(t, fakeAPIKey, q.Get("key"))
-		assert.Equal(t, "dns-add_record", q.Get("cmd"))
 		assert.Equal(t, "json", q.Get("format"))
+
+		if q.Get("cmd") == "dns-list_records" {
+			_, _ = fmt.Fprint(w, `{"data":[],"result":"success"}`)
+			return
+		}
+
+		assert.Equal(t, "dns-add_record", q.Get("cmd"))
 		assert.Equal(t, "_acme-challenge.example.com", q.Get("record"))
 		assert.Equal(t, fakeKeyAuth, q.Get("value"))
 		assert.Equal(t, "Managed+By+lego", q.Get("comment"))
@@ -145,7 +153,12 @@ func TestDNSProvider_PresentFailed(t *testing.T) {
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodGet, r.Method, "method")
 
-		_, err := fmt.Fprintf(w, `{"data":"record_already_exists_remove_first","result":"error"}`)
+		if r.URL.Query().Get("cmd") == "dns-list_records" {
+			_, _ = fmt.Fprint(w, `{"data":[],"result":"success"}`)
+			return
+		}
+
+		_, err := fmt.Fprintf(w, `{"data":"invalid_record","result":"error"}`)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -153,7 +166,7 @@ func TestDNSProvider_PresentFailed(t *testing.T) {
 	})
 
 	err := provider.Present("example.com", "", fakeChallengeToken)
-	require.EqualError(t, err, "dreamhost: add TXT record failed: record_already_exists_remove_first")
+	require.EqualError(t, err, "dreamhost: add TXT record failed: invalid_record")
 }
 
 func TestDNSProvider_Cleanup(t *testing.T) {
@@ -181,6 +194,129 @@ func TestDNSProvider_Cleanup(t *testing.T) {
 	require.NoError(t, err, "failed to remove TXT record")
 }
 
+func TestDNSProvider_CleanupFailed(t *testing.T) {
+	provider, mux := setupTest(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `{"data":"invalid_record","result":"error"}`)
+	})
+
+	err := provider.CleanUp("example.com", "", fakeChallengeToken)
+	require.EqualError(t, err, "dreamhost: remove TXT record failed: invalid_record")
+}
+
+// fakeAPI is an in-memory implementation of the DNS commands of the API,
+// the duplicated records and the removal of unknown records are rejected like the real API.
+type fakeAPI struct {
+	mu sync.Mutex
+
+	records map[string]bool
+	calls   map[string]int
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	q := r.URL.Query()
+
+	f.calls[q.Get("cmd")]++
+
+	key := q.Get("record") + " " + q.Get("value")
+
+	switch q.Get("cmd") {
+	case "dns-list_records":
+		var records []string
+		for k := range f.records {
+			name, value, _ := strings.Cut(k, " ")
+			records = append(records, fmt.Sprintf(`{"zone":"example.com","record":%q,"type":"TXT","value":%q,"editable":"1"}`, name, value))
+		}
+
+		_, _ = fmt.Fprintf(w, `{"data":[%s],"result":"success"}`, strings.Join(records, ","))
+
+	case "dns-add_record":
+		if f.records[key] {
+			_, _ = fmt.Fprint(w, `{"data":"record_already_exists_remove_first","result":"error"}`)
+			return
+		}
+
+		f.records[key] = true
+
+		_, _ = fmt.Fprint(w, `{"data":"record_added","result":"success"}`)
+
+	case "dns-remove_record":
+		if !f.records[key] {
+			_, _ = fmt.Fprint(w, `{"data":"no_such_record","result":"error"}`)
+			return
+		}
+
+		delete(f.records, key)
+
+		_, _ = fmt.Fprint(w, `{"data":"record_removed","result":"success"}`)
+
+	default:
+		http.Error(w, "unknown command", http.StatusBadRequest)
+	}
+}
+
+func TestDNSProvider_idempotent(t *testing.T) {
+	provider, mux := setupTest(t)
+
+	api := &fakeAPI{records: map[string]bool{}, calls: map[string]int{}}
+	mux.Handle("/", api)
+
+	err := provider.Present("example.com", "", fakeChallengeToken)
+	require.NoError(t, err)
+
+	// The identical record already exists: no new record is added.
+	err = provider.Present("example.com", "", fakeChallengeToken)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, api.calls["dns-add_record"])
+	assert.Len(t, api.records, 1)
+
+	err = provider.CleanUp("example.com", "", fakeChallengeToken)
+	require.NoError(t, err)
+
+	// The record is already removed.
+	err = provider.CleanUp("example.com", "", fakeChallengeToken)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, api.calls["dns-remove_record"])
+	assert.Empty(t, api.records)
+}
+
+func TestDNSProvider_Present_alreadyExists(t *testing.T) {
+	provider, mux := setupTest(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		// The record is not listed yet (replication lag) but the API rejects it.
+		if r.URL.Query().Get("cmd") == "dns-list_records" {
+			_, _ = fmt.Fprint(w, `{"data":[],"result":"success"}`)
+			return
+		}
+
+		_, _ = fmt.Fprint(w, `{"data":"record_already_exists_remove_first","result":"error"}`)
+	})
+
+	err := provider.Present("example.com", "", fakeChallengeToken)
+	require.NoError(t, err)
+}
+
+func TestDNSProvider_delay(t *testing.T) {
+	provider, mux := setupTest(t)
+	provider.config.Delay = 100 * time.Millisecond
+
+	mux.Handle("/", &fakeAPI{records: map[string]bool{}, calls: map[string]int{}})
+
+	start := time.Now()
+
+	err := provider.Present("example.com", "", fakeChallengeToken)
+	require.NoError(t, err)
+
+	assert.GreaterOrEqual(t, time.Since(start), provider.config.Delay)
+}
+
 func TestLivePresentAndCleanUp(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 // DefaultBaseURL the default API endpoint.
@@ -18,6 +19,7 @@ const DefaultBaseURL = "https://api.dreamhost.com"
 const (
 	cmdAddRecord    = "dns-add_record"
 	cmdRemoveRecord = "dns-remove_record"
+	cmdListRecords  = "dns-list_records"
 )
 
 // Client the Dreamhost API client.
@@ -37,6 +39,23 @@ func NewClient(apiKey string) *Client {
 	}
 }
 
+// ListRecords lists the DNS records of the account.
+func (c *Client) ListRecords(ctx context.Context) ([]Record, error) {
+	endpoint, err := c.baseEndpoint(cmdListRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []Record
+
+	err = c.do(ctx, cmdListRecords, endpoint, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
 // AddRecord adds a TXT record.
 func (c *Client) AddRecord(ctx context.Context, domain, value string) error {
 	query, err := c.buildEndpoint(cmdAddRecord, domain, value)
@@ -44,7 +63,7 @@ func (c *Client) AddRecord(ctx context.Context, domain, value string) error {
 		return err
 	}
 
-	return c.updateTxtRecord(ctx, query)
+	return c.do(ctx, cmdAddRecord, query, nil)
 }
 
 // RemoveRecord removes a TXT record.
@@ -54,20 +73,17 @@ func (c *Client) RemoveRecord(ctx context.Context, domain, value string) error {
 		return err
 	}
 
-	return c.updateTxtRecord(ctx, query)
+	return c.do(ctx, cmdRemoveRecord, query, nil)
 }
 
 // action is either cmdAddRecord or cmdRemoveRecord.
 func (c *Client) buildEndpoint(action, domain, txt string) (*url.URL, error) {
-	endpoint, err := url.Parse(c.BaseURL)
+	endpoint, err := c.baseEndpoint(action)
 	if err != nil {
 		return nil, err
 	}
 
 	query := endpoint.Query()
-	query.Set("key", c.apiKey)
-	query.Set("cmd", action)
-	query.Set("format", "json")
 	query.Set("record", domain)
 	query.Set("type", "TXT")
 	query.Set("value", txt)
@@ -77,13 +93,30 @@ func (c *Client) buildEndpoint(action, domain, txt string) (*url.URL, error) {
 	return endpoint, nil
 }
 
-// updateTxtRecord will either add or remove a TXT record.
-func (c *Client) updateTxtRecord(ctx context.Context, endpoint *url.URL) error {
+func (c *Client) baseEndpoint(action string) (*url.URL, error) {
+	endpoint, err := url.Parse(c.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	query := endpoint.Query()
+	query.Set("key", c.apiKey)
+	query.Set("cmd", action)
+	query.Set("format", "json")
+	endpoint.RawQuery = query.Encode()
+
+	return endpoint, nil
+}
+
+// do calls the command, result can be nil.
+func (c *Client) do(ctx context.Context, action string, endpoint *url.URL, result any) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), http.NoBody)
 	if err != nil {
 		return fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return errutils.NewHTTPDoError(req, err)
@@ -100,14 +133,26 @@ func (c *Client) updateTxtRecord(ctx context.Context, endpoint *url.URL) error {
 		return errutils.NewReadResponseError(req, resp.StatusCode, err)
 	}
 
-	var response apiResponse
+	var response apiResponse[json.RawMessage]
 	err = json.Unmarshal(raw, &response)
 	if err != nil {
 		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
 	}
 
 	if response.Result == "error" {
-		return fmt.Errorf("add TXT record failed: %s", response.Data)
+		var code string
+		_ = json.Unmarshal(response.Data, &code)
+
+		return &APIError{Command: action, Code: code}
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	err = json.Unmarshal(response.Data, result)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
 	}
 
 	return nil
@@ -1,6 +1,9 @@
 package internal
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -57,3 +60,57 @@ func TestClient_buildQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ListRecords(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/", func(rw http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		if query.Get("cmd") != cmdListRecords || query.Get("key") != fakeAPIKey {
+			http.Error(rw, "invalid request", http.StatusBadRequest)
+			return
+		}
+
+		_, _ = rw.Write([]byte(`{"data":[{"account_id":"1","zone":"example.com","record":"_acme-challenge.example.com","type":"TXT","value":"abc","comment":"","editable":"1"}],"result":"success"}`))
+	})
+
+	client := NewClient(fakeAPIKey)
+	client.BaseURL = server.URL
+	client.HTTPClient = server.Client()
+
+	records, err := client.ListRecords(context.Background())
+	require.NoError(t, err)
+
+	expected := []Record{{
+		AccountID: "1",
+		Zone:      "example.com",
+		Record:    "_acme-challenge.example.com",
+		Type:      "TXT",
+		Value:     "abc",
+		Editable:  "1",
+	}}
+
+	assert.Equal(t, expected, records)
+}
+
+func TestClient_RemoveRecord_error(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/", func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = rw.Write([]byte(`{"data":"no_such_record","result":"error"}`))
+	})
+
+	client := NewClient(fakeAPIKey)
+	client.BaseURL = server.URL
+	client.HTTPClient = server.Client()
+
+	err := client.RemoveRecord(context.Background(), "_acme-challenge.example.com", "abc")
+	require.EqualError(t, err, "remove TXT record failed: no_such_record")
+
+	assert.True(t, IsErrorCode(err, ErrCodeNoSuchRecord))
+	assert.False(t, IsErrorCode(err, ErrCodeRecordAlreadyExists))
+}
@@ -1,6 +1,52 @@
 package internal
 
-type apiResponse struct {
-	Data   string `json:"data"`
+import (
+	"errors"
+	"fmt"
+)
+
+// Error codes returned by the API.
+const (
+	ErrCodeRecordAlreadyExists = "record_already_exists_remove_first"
+	ErrCodeNoSuchRecord        = "no_such_record"
+)
+
+type apiResponse[T any] struct {
+	Data   T      `json:"data"`
 	Result string `json:"result"`
 }
+
+// APIError is the error returned by the API when the result is "error".
+type APIError struct {
+	Command string
+	Code    string
+}
+
+func (a *APIError) Error() string {
+	switch a.Command {
+	case cmdAddRecord:
+		return fmt.Sprintf("add TXT record failed: %s", a.Code)
+	case cmdRemoveRecord:
+		return fmt.Sprintf("remove TXT record failed: %s", a.Code)
+	default:
+		return fmt.Sprintf("%s failed: %s", a.Command, a.Code)
+	}
+}
+
+// IsErrorCode checks if the error is an API error with the given code.
+func IsErrorCode(err error, code string) bool {
+	var apiErr *APIError
+
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
+// Record a DNS record.
+type Record struct {
+	AccountID string `json:"account_id,omitempty"`
+	Zone      string `json:"zone,omitempty"`
+	Record    string `json:"record,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Value     string `json:"value,omitempty"`
+	Comment   string `json:"comment,omitempty"`
+	Editable  string `json:"editable,omitempty"`
+}
@@ -0,0 +1,29 @@
+package dreamhost
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/dreamhost/dreamhost.toml
+		Name:  "DreamHost",
+		Code:  "dreamhost",
+		Since: "v1.1.0",
+		URL:   "https://www.dreamhost.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "DREAMHOST_API_KEY", Description: "The API key", Credential: true},
+			{Name: "DREAMHOST_DELAY", Description: "Time to wait after each modification of the records, to let the DreamHost backend apply it (default: 0)"},
+			{Name: "DREAMHOST_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "DREAMHOST_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "DREAMHOST_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "DREAMHOST_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://help.dreamhost.com/hc/en-us/articles/217560167-API_overview",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package duckdns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/duckdns/duckdns.toml
+		Name:  "Duck DNS",
+		Code:  "duckdns",
+		Since: "v0.5.0",
+		URL:   "https://www.duckdns.org/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "DUCKDNS_TOKEN", Description: "Account token", Credential: true},
+			{Name: "DUCKDNS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "DUCKDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "DUCKDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "DUCKDNS_SEQUENCE_INTERVAL", Description: "Time between sequential requests"},
+			{Name: "DUCKDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.duckdns.org/spec.jsp",
+			GoClient: "",
+		},
+	}
+}
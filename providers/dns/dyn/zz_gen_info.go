@@ -0,0 +1,30 @@
+package dyn
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/dyn/dyn.toml
+		Name:  "Dyn",
+		Code:  "dyn",
+		Since: "v0.3.0",
+		URL:   "https://dyn.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "DYN_CUSTOMER_NAME", Description: "Customer name", Credential: true},
+			{Name: "DYN_PASSWORD", Description: "Password", Credential: true},
+			{Name: "DYN_USER_NAME", Description: "User name", Credential: true},
+			{Name: "DYN_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "DYN_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "DYN_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "DYN_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://help.dyn.com/rest/",
+			GoClient: "",
+		},
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
@@ -13,6 +15,9 @@ import (
 	"github.com/go-acme/lego/v4/providers/dns/dynu/internal"
 )
 
+// minTTL is the minimum TTL accepted by the API.
+const minTTL = 30
+
 // Environment variables names.
 const (
 	envNamespace = "DYNU_"
@@ -51,6 +56,14 @@ func NewDefaultConfig() *Config {
 type DNSProvider struct {
 	config *Config
 	client *internal.Client
+
+	records   map[string]recordRef
+	recordsMu sync.Mutex
+}
+
+type recordRef struct {
+	domainID int64
+	recordID int64
 }
 
 // NewDNSProvider returns a DNSProvider instance configured for Dynu.
@@ -77,6 +90,10 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		return nil, errors.New("dynu: incomplete credentials, missing API key")
 	}
 
+	if config.TTL < minTTL {
+		return nil, fmt.Errorf("dynu: invalid TTL, TTL (%d) must be greater than %d", config.TTL, minTTL)
+	}
+
 	tr, err := internal.NewTokenTransport(config.APIKey)
 	if err != nil {
 		return nil, fmt.Errorf("dynu: %w", err)
@@ -85,7 +102,11 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 	client := internal.NewClient()
 	client.HTTPClient = tr.Wrap(config.HTTPClient)
 
-	return &DNSProvider{config: config, client: client}, nil
+	return &DNSProvider{
+		config:  config,
+		client:  client,
+		records: make(map[string]recordRef),
+	}, nil
 }
 
 // Timeout returns the timeout and interval to use when checking for DNS propagation.
@@ -100,43 +121,37 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 
 	ctx := context.Background()
 
-	rootDomain, err := d.client.GetRootDomain(ctx, dns01.UnFqdn(info.EffectiveFQDN))
+	rootDomain, err := d.findDomain(ctx, info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("dynu: could not find root domain for %s: %w", domain, err)
 	}
 
-	records, err := d.client.GetRecords(ctx, dns01.UnFqdn(info.EffectiveFQDN), "TXT")
-	if err != nil {
-		return fmt.Errorf("dynu: failed to get records for %s: %w", domain, err)
-	}
-
-	for _, record := range records {
-		// the record already exist
-		if record.Hostname == dns01.UnFqdn(info.EffectiveFQDN) && record.TextData == info.Value {
-			return nil
-		}
-	}
+	hostname := strings.ToLower(dns01.UnFqdn(info.EffectiveFQDN))
 
-	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, rootDomain.DomainName)
+	subDomain, err := dns01.ExtractSubDomain(hostname, rootDomain.Name)
 	if err != nil {
 		return fmt.Errorf("dynu: %w", err)
 	}
 
 	record := internal.DNSRecord{
 		Type:       "TXT",
-		DomainName: rootDomain.DomainName,
-		Hostname:   dns01.UnFqdn(info.EffectiveFQDN),
+		DomainName: rootDomain.Name,
+		Hostname:   hostname,
 		NodeName:   subDomain,
 		TextData:   info.Value,
 		State:      true,
 		TTL:        d.config.TTL,
 	}
 
-	err = d.client.AddNewRecord(ctx, rootDomain.ID, record)
+	newRecord, err := d.client.AddNewRecord(ctx, rootDomain.ID, record)
 	if err != nil {
 		return fmt.Errorf("dynu: failed to add record to %s: %w", domain, err)
 	}
 
+	d.recordsMu.Lock()
+	d.records[token] = recordRef{domainID: rootDomain.ID, recordID: newRecord.ID}
+	d.recordsMu.Unlock()
+
 	return nil
 }
 
@@ -144,26 +159,45 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	ctx := context.Background()
+	d.recordsMu.Lock()
+	ref, ok := d.records[token]
+	d.recordsMu.Unlock()
 
-	rootDomain, err := d.client.GetRootDomain(ctx, dns01.UnFqdn(info.EffectiveFQDN))
+	if !ok {
+		return fmt.Errorf("dynu: unknown record ID for '%s' '%s'", info.EffectiveFQDN, token)
+	}
+
+	err := d.client.DeleteRecord(context.Background(), ref.domainID, ref.recordID)
 	if err != nil {
-		return fmt.Errorf("dynu: could not find root domain for %s: %w", domain, err)
+		return fmt.Errorf("dynu: failed to remove TXT record for %s: %w", domain, err)
 	}
 
-	records, err := d.client.GetRecords(ctx, dns01.UnFqdn(info.EffectiveFQDN), "TXT")
+	d.recordsMu.Lock()
+	delete(d.records, token)
+	d.recordsMu.Unlock()
+
+	return nil
+}
+
+// findDomain returns the most specific domain, of the account, containing the FQDN.
+func (d *DNSProvider) findDomain(ctx context.Context, fqdn string) (*internal.Domain, error) {
+	domains, err := d.client.ListDomains(ctx)
 	if err != nil {
-		return fmt.Errorf("dynu: failed to get records for %s: %w", domain, err)
+		return nil, fmt.Errorf("list domains: %w", err)
 	}
 
-	for _, record := range records {
-		if record.Hostname == dns01.UnFqdn(info.EffectiveFQDN) && record.TextData == info.Value {
-			err = d.client.DeleteRecord(ctx, rootDomain.ID, record.ID)
-			if err != nil {
-				return fmt.Errorf("dynu: failed to remove TXT record for %s: %w", domain, err)
-			}
-		}
+	ids := make(map[string]int64)
+
+	var names []string
+	for _, dom := range domains {
+		ids[dom.Name] = dom.ID
+		names = append(names, dom.Name)
 	}
 
-	return nil
+	name := dns01.FindMostSpecificZone(fqdn, names)
+	if name == "" {
+		return nil, fmt.Errorf("no domain found for %s", fqdn)
+	}
+
+	return &internal.Domain{ID: ids[name], Name: strings.ToLower(dns01.UnFqdn(name))}, nil
 }
@@ -1,10 +1,17 @@
 package dynu
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/providers/dns/dynu/internal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -60,22 +67,32 @@ func TestNewDNSProviderConfig(t *testing.T) {
 		desc     string
 		expected string
 		apiKey   string
+		ttl      int
 	}{
 		{
 			desc:   "success",
 			apiKey: "api_key",
+			ttl:    minTTL,
 		},
 		{
 			desc:     "missing api key",
 			apiKey:   "",
+			ttl:      minTTL,
 			expected: "dynu: incomplete credentials, missing API key",
 		},
+		{
+			desc:     "invalid TTL",
+			apiKey:   "api_key",
+			ttl:      10,
+			expected: "dynu: invalid TTL, TTL (10) must be greater than 30",
+		},
 	}
 
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
 			config := NewDefaultConfig()
 			config.APIKey = test.apiKey
+			config.TTL = test.ttl
 
 			p, err := NewDNSProviderConfig(config)
 
@@ -90,6 +107,131 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func setupTest(t *testing.T) (*DNSProvider, map[string]internal.DNSRecord) {
+	t.Helper()
+
+	records := map[string]internal.DNSRecord{}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("GET /dns", func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"statusCode":200,"domains":[{"id":1,"name":"example.com"},{"id":2,"name":"sub.example.com"},{"id":3,"name":"example.org"}]}`))
+	})
+
+	mux.HandleFunc("POST /dns/{domainID}/record", func(rw http.ResponseWriter, req *http.Request) {
+		record := internal.DNSRecord{}
+		err := json.NewDecoder(req.Body).Decode(&record)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		record.ID = int64(len(records) + 100)
+		records[req.PathValue("domainID")+"/"+strconv.FormatInt(record.ID, 10)] = record
+
+		_ = json.NewEncoder(rw).Encode(internal.RecordResponse{
+			APIException: &internal.APIException{StatusCode: 200},
+			DNSRecord:    record,
+		})
+	})
+
+	mux.HandleFunc("DELETE /dns/{domainID}/record/{recordID}", func(rw http.ResponseWriter, req *http.Request) {
+		key := req.PathValue("domainID") + "/" + req.PathValue("recordID")
+
+		if _, ok := records[key]; !ok {
+			_, _ = rw.Write([]byte(`{"statusCode":501,"type":"Argument Exception","message":"Invalid."}`))
+			return
+		}
+
+		delete(records, key)
+
+		_, _ = rw.Write([]byte(`{"statusCode":200}`))
+	})
+
+	config := NewDefaultConfig()
+	config.APIKey = "secret"
+	config.HTTPClient = server.Client()
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.client.BaseURL, _ = url.Parse(server.URL)
+
+	return provider, records
+}
+
+func TestDNSProvider_Present_CleanUp(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		domain      string
+		expectedKey string
+		expected    internal.DNSRecord
+	}{
+		{
+			desc:        "root domain",
+			domain:      "example.com",
+			expectedKey: "1/100",
+			expected: internal.DNSRecord{
+				ID:         100,
+				Type:       "TXT",
+				DomainName: "example.com",
+				NodeName:   "_acme-challenge",
+				Hostname:   "_acme-challenge.example.com",
+				State:      true,
+				TextData:   "Yn5FYIdZpKGY0Gl17AM7lOlQID5C4_II6Blwe54e4rk",
+				TTL:        300,
+			},
+		},
+		{
+			desc:        "most specific domain",
+			domain:      "foo.sub.example.com",
+			expectedKey: "2/100",
+			expected: internal.DNSRecord{
+				ID:         100,
+				Type:       "TXT",
+				DomainName: "sub.example.com",
+				NodeName:   "_acme-challenge.foo",
+				Hostname:   "_acme-challenge.foo.sub.example.com",
+				State:      true,
+				TextData:   "Yn5FYIdZpKGY0Gl17AM7lOlQID5C4_II6Blwe54e4rk",
+				TTL:        300,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			provider, records := setupTest(t)
+
+			err := provider.Present(test.domain, "tokenA", "keyAuthA")
+			require.NoError(t, err)
+
+			assert.Equal(t, map[string]internal.DNSRecord{test.expectedKey: test.expected}, records)
+
+			err = provider.CleanUp(test.domain, "tokenA", "keyAuthA")
+			require.NoError(t, err)
+
+			assert.Empty(t, records)
+		})
+	}
+}
+
+func TestDNSProvider_Present_unknownDomain(t *testing.T) {
+	provider, _ := setupTest(t)
+
+	err := provider.Present("example.net", "tokenA", "keyAuthA")
+	require.EqualError(t, err, "dynu: could not find root domain for example.net: no domain found for _acme-challenge.example.net.")
+}
+
+func TestDNSProvider_CleanUp_unknownToken(t *testing.T) {
+	provider, _ := setupTest(t)
+
+	err := provider.CleanUp("example.com", "tokenA", "keyAuthA")
+	require.EqualError(t, err, "dynu: unknown record ID for '_acme-challenge.example.com.' 'tokenA'")
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
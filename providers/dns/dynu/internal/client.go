@@ -15,12 +15,13 @@ import (
 	"github.com/cenkalti/backoff/v4"
 	"github.com/go-acme/lego/v4/log"
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 const defaultBaseURL = "https://api.dynu.com/v2"
 
 type Client struct {
-	baseURL    *url.URL
+	BaseURL    *url.URL
 	HTTPClient *http.Client
 }
 
@@ -29,13 +30,13 @@ func NewClient() *Client {
 
 	return &Client{
 		HTTPClient: &http.Client{Timeout: 5 * time.Second},
-		baseURL:    baseURL,
+		BaseURL:    baseURL,
 	}
 }
 
 // GetRecords Get DNS records based on a hostname and resource record type.
 func (c Client) GetRecords(ctx context.Context, hostname, recordType string) ([]DNSRecord, error) {
-	endpoint := c.baseURL.JoinPath("dns", "record", hostname)
+	endpoint := c.BaseURL.JoinPath("dns", "record", hostname)
 
 	query := endpoint.Query()
 	query.Set("recordType", recordType)
@@ -54,31 +55,48 @@ func (c Client) GetRecords(ctx context.Context, hostname, recordType string) ([]
 	return apiResp.DNSRecords, nil
 }
 
-// AddNewRecord Add a new DNS record for DNS service.
-func (c Client) AddNewRecord(ctx context.Context, domainID int64, record DNSRecord) error {
-	endpoint := c.baseURL.JoinPath("dns", strconv.FormatInt(domainID, 10), "record")
+// ListDomains Get a list of domains for DNS service.
+func (c Client) ListDomains(ctx context.Context) ([]Domain, error) {
+	endpoint := c.BaseURL.JoinPath("dns")
+
+	apiResp := DomainsResponse{}
+	err := c.doRetry(ctx, http.MethodGet, endpoint.String(), nil, &apiResp)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiResp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("API error: %w", apiResp.APIException)
+	}
+
+	return apiResp.Domains, nil
+}
+
+// AddNewRecord Add a new DNS record for DNS service, and returns the newly created record.
+func (c Client) AddNewRecord(ctx context.Context, domainID int64, record DNSRecord) (*DNSRecord, error) {
+	endpoint := c.BaseURL.JoinPath("dns", strconv.FormatInt(domainID, 10), "record")
 
 	reqBody, err := json.Marshal(record)
 	if err != nil {
-		return fmt.Errorf("failed to create request JSON body: %w", err)
+		return nil, fmt.Errorf("failed to create request JSON body: %w", err)
 	}
 
 	apiResp := RecordResponse{}
 	err = c.doRetry(ctx, http.MethodPost, endpoint.String(), reqBody, &apiResp)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if apiResp.StatusCode/100 != 2 {
-		return fmt.Errorf("API error: %w", apiResp.APIException)
+		return nil, fmt.Errorf("API error: %w", apiResp.APIException)
 	}
 
-	return nil
+	return &apiResp.DNSRecord, nil
 }
 
 // DeleteRecord Remove a DNS record from DNS service.
 func (c Client) DeleteRecord(ctx context.Context, domainID, recordID int64) error {
-	endpoint := c.baseURL.JoinPath("dns", strconv.FormatInt(domainID, 10), "record", strconv.FormatInt(recordID, 10))
+	endpoint := c.BaseURL.JoinPath("dns", strconv.FormatInt(domainID, 10), "record", strconv.FormatInt(recordID, 10))
 
 	apiResp := APIException{}
 	err := c.doRetry(ctx, http.MethodDelete, endpoint.String(), nil, &apiResp)
@@ -95,7 +113,7 @@ func (c Client) DeleteRecord(ctx context.Context, domainID, recordID int64) erro
 
 // GetRootDomain Get the root domain name based on a hostname.
 func (c Client) GetRootDomain(ctx context.Context, hostname string) (*DNSHostname, error) {
-	endpoint := c.baseURL.JoinPath("dns", "getroot", hostname)
+	endpoint := c.BaseURL.JoinPath("dns", "getroot", hostname)
 
 	apiResp := DNSHostname{}
 	err := c.doRetry(ctx, http.MethodGet, endpoint.String(), nil, &apiResp)
@@ -142,6 +160,8 @@ func (c Client) do(ctx context.Context, method, uri string, body []byte, result
 		return fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 
@@ -45,7 +45,7 @@ func setupTest(t *testing.T, method, pattern string, status int, file string) *C
 
 	client := NewClient()
 	client.HTTPClient = server.Client()
-	client.baseURL, _ = url.Parse(server.URL)
+	client.BaseURL, _ = url.Parse(server.URL)
 
 	return client
 }
@@ -112,6 +112,71 @@ func TestGetRootDomain(t *testing.T) {
 	}
 }
 
+func TestListDomains(t *testing.T) {
+	type expected struct {
+		domains []Domain
+		error   string
+	}
+
+	testCases := []struct {
+		desc     string
+		status   int
+		file     string
+		expected expected
+	}{
+		{
+			desc:   "success",
+			status: http.StatusOK,
+			file:   "./fixtures/list_domains.json",
+			expected: expected{
+				domains: []Domain{
+					{
+						ID:          9007481,
+						Name:        "lego.freeddns.org",
+						UnicodeName: "lego.freeddns.org",
+						State:       "Complete",
+						TTL:         90,
+					},
+					{
+						ID:          9007482,
+						Name:        "example.com",
+						UnicodeName: "example.com",
+						State:       "Complete",
+						TTL:         300,
+					},
+				},
+			},
+		},
+		{
+			desc:   "invalid",
+			status: http.StatusNotImplemented,
+			file:   "./fixtures/list_domains_invalid.json",
+			expected: expected{
+				error: "API error: 501: Argument Exception: Invalid.",
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			client := setupTest(t, http.MethodGet, "/dns", test.status, test.file)
+
+			domains, err := client.ListDomains(context.Background())
+
+			if test.expected.error != "" {
+				assert.EqualError(t, err, test.expected.error)
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected.domains, domains)
+		})
+	}
+}
+
 func TestGetRecords(t *testing.T) {
 	type expected struct {
 		records []DNSRecord
@@ -245,7 +310,7 @@ func TestAddNewRecord(t *testing.T) {
 				TTL:        300,
 			}
 
-			err := client.AddNewRecord(context.Background(), 9007481, record)
+			newRecord, err := client.AddNewRecord(context.Background(), 9007481, record)
 
 			if test.expected.error != "" {
 				assert.EqualError(t, err, test.expected.error)
@@ -253,6 +318,8 @@ func TestAddNewRecord(t *testing.T) {
 			}
 
 			require.NoError(t, err)
+
+			assert.Equal(t, int64(6041417), newRecord.ID)
 		})
 	}
 }
@@ -43,6 +43,22 @@ type DNSHostname struct {
 	Node       string `json:"node,omitempty"`
 }
 
+// Domain defines model for DNS.domain.
+type Domain struct {
+	ID          int64  `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	UnicodeName string `json:"unicodeName,omitempty"`
+	State       string `json:"state,omitempty"`
+	TTL         int    `json:"ttl,omitempty"`
+}
+
+// DomainsResponse defines model for domainsResponse.
+type DomainsResponse struct {
+	*APIException
+
+	Domains []Domain `json:"domains,omitempty"`
+}
+
 // RecordsResponse defines model for recordsResponse.
 type RecordsResponse struct {
 	*APIException
@@ -0,0 +1,28 @@
+package dynu
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/dynu/dynu.toml
+		Name:  "Dynu",
+		Code:  "dynu",
+		Since: "v3.5.0",
+		URL:   "https://www.dynu.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "DYNU_API_KEY", Description: "API key", Credential: true},
+			{Name: "DYNU_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "DYNU_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "DYNU_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "DYNU_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.dynu.com/en-US/Support/API",
+			GoClient: "",
+		},
+	}
+}
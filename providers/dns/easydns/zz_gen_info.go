@@ -0,0 +1,31 @@
+package easydns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/easydns/easydns.toml
+		Name:  "EasyDNS",
+		Code:  "easydns",
+		Since: "v2.6.0",
+		URL:   "https://easydns.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "EASYDNS_KEY", Description: "API Key", Credential: true},
+			{Name: "EASYDNS_TOKEN", Description: "API Token", Credential: true},
+			{Name: "EASYDNS_ENDPOINT", Description: "The endpoint URL of the API Server"},
+			{Name: "EASYDNS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "EASYDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "EASYDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "EASYDNS_SEQUENCE_INTERVAL", Description: "Time between sequential requests"},
+			{Name: "EASYDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://docs.sandbox.rest.easydns.net",
+			GoClient: "",
+		},
+	}
+}
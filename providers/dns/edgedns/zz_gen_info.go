@@ -0,0 +1,32 @@
+package edgedns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/edgedns/edgedns.toml
+		Name:  "Akamai EdgeDNS",
+		Code:  "edgedns",
+		Since: "v3.9.0",
+		URL:   "https://www.akamai.com/us/en/products/security/edge-dns.jsp",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "AKAMAI_ACCESS_TOKEN", Description: "Access token, managed by the Akamai EdgeGrid client", Credential: true},
+			{Name: "AKAMAI_CLIENT_SECRET", Description: "Client secret, managed by the Akamai EdgeGrid client", Credential: true},
+			{Name: "AKAMAI_CLIENT_TOKEN", Description: "Client token, managed by the Akamai EdgeGrid client", Credential: true},
+			{Name: "AKAMAI_EDGERC", Description: "Path to the .edgerc file, managed by the Akamai EdgeGrid client", Credential: true},
+			{Name: "AKAMAI_EDGERC_SECTION", Description: "Configuration section, managed by the Akamai EdgeGrid client", Credential: true},
+			{Name: "AKAMAI_HOST", Description: "API host, managed by the Akamai EdgeGrid client", Credential: true},
+			{Name: "AKAMAI_POLLING_INTERVAL", Description: "Time between DNS propagation check. Default: 15 seconds"},
+			{Name: "AKAMAI_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation. Default: 3 minutes"},
+			{Name: "AKAMAI_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developer.akamai.com/api/cloud_security/edge_dns_zone_management/v2.html",
+			GoClient: "https://github.com/akamai/AkamaiOPEN-edgegrid-golang",
+		},
+	}
+}
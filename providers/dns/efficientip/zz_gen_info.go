@@ -0,0 +1,29 @@
+package efficientip
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/efficientip/efficientip.toml
+		Name:  "Efficient IP",
+		Code:  "efficientip",
+		Since: "v4.13.0",
+		URL:   "https://efficientip.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "EFFICIENTIP_DNS_NAME", Description: "DNS name (ex: dns.smart)", Credential: true},
+			{Name: "EFFICIENTIP_HOSTNAME", Description: "Hostname (ex: foo.example.com)", Credential: true},
+			{Name: "EFFICIENTIP_PASSWORD", Description: "Password", Credential: true},
+			{Name: "EFFICIENTIP_USERNAME", Description: "Username", Credential: true},
+			{Name: "EFFICIENTIP_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "EFFICIENTIP_INSECURE_SKIP_VERIFY", Description: "Whether or not to verify EfficientIP API certificate"},
+			{Name: "EFFICIENTIP_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "EFFICIENTIP_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "EFFICIENTIP_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+			{Name: "EFFICIENTIP_VIEW_NAME", Description: "View name (ex: external)"},
+		},
+	}
+}
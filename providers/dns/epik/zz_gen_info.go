@@ -0,0 +1,28 @@
+package epik
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/epik/epik.toml
+		Name:  "Epik",
+		Code:  "epik",
+		Since: "v4.5.0",
+		URL:   "https://www.epik.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "EPIK_SIGNATURE", Description: "Epik API signature (https://registrar.epik.com/account/api-settings/)", Credential: true},
+			{Name: "EPIK_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "EPIK_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "EPIK_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "EPIK_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://docs.userapi.epik.com/v2/#/",
+			GoClient: "",
+		},
+	}
+}
@@ -95,6 +95,12 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	return nil
 }
 
+// UsesKeyAuth reports whether the key authorization is sent instead of the record (RAW mode).
+// See dns01.KeyAuthProvider.
+func (d *DNSProvider) UsesKeyAuth() bool {
+	return d.config.Mode == "RAW"
+}
+
 // Timeout returns the timeout and interval to use when checking for DNS propagation.
 // Adjusting here to cope with spikes in propagation times.
 func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
@@ -0,0 +1,17 @@
+package exec
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/exec/exec.toml
+		Name:  "External program",
+		Code:  "exec",
+		Since: "v0.5.0",
+		URL:   "/dns/exec",
+	}
+}
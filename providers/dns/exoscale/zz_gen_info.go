@@ -0,0 +1,31 @@
+package exoscale
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/exoscale/exoscale.toml
+		Name:  "Exoscale",
+		Code:  "exoscale",
+		Since: "v0.4.0",
+		URL:   "https://www.exoscale.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "EXOSCALE_API_KEY", Description: "API key", Credential: true},
+			{Name: "EXOSCALE_API_SECRET", Description: "API secret", Credential: true},
+			{Name: "EXOSCALE_API_ZONE", Description: "API zone"},
+			{Name: "EXOSCALE_ENDPOINT", Description: "API endpoint URL"},
+			{Name: "EXOSCALE_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "EXOSCALE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "EXOSCALE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "EXOSCALE_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://openapi-v2.exoscale.com/#endpoint-dns",
+			GoClient: "https://github.com/exoscale/egoscale",
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package freemyip
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/freemyip/freemyip.toml
+		Name:  "freemyip.com",
+		Code:  "freemyip",
+		Since: "v4.5.0",
+		URL:   "https://freemyip.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "FREEMYIP_TOKEN", Description: "Account token", Credential: true},
+			{Name: "FREEMYIP_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "FREEMYIP_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "FREEMYIP_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "FREEMYIP_SEQUENCE_INTERVAL", Description: "Time between sequential requests"},
+			{Name: "FREEMYIP_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://freemyip.com/help",
+			GoClient: "",
+		},
+	}
+}
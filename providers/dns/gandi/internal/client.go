@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 // defaultBaseURL Gandi XML-RPC endpoint used by Present and CleanUp.
@@ -283,6 +284,8 @@ func newXMLRequest(ctx context.Context, endpoint string, payload *methodCall) (*
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Content-Type", "text/xml")
 
 	return req, nil
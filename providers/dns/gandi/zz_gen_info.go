@@ -0,0 +1,28 @@
+package gandi
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/gandi/gandi.toml
+		Name:  "Gandi",
+		Code:  "gandi",
+		Since: "v0.3.0",
+		URL:   "https://www.gandi.net",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "GANDI_API_KEY", Description: "API key", Credential: true},
+			{Name: "GANDI_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "GANDI_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "GANDI_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "GANDI_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://doc.rpc.gandi.net/index.html",
+			GoClient: "",
+		},
+	}
+}
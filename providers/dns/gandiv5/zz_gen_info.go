@@ -0,0 +1,29 @@
+package gandiv5
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/gandiv5/gandiv5.toml
+		Name:  "Gandi Live DNS (v5)",
+		Code:  "gandiv5",
+		Since: "v0.5.0",
+		URL:   "https://www.gandi.net",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "GANDIV5_API_KEY", Description: "API key (Deprecated)", Credential: true},
+			{Name: "GANDIV5_PERSONAL_ACCESS_TOKEN", Description: "Personal Access Token", Credential: true},
+			{Name: "GANDIV5_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "GANDIV5_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "GANDIV5_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "GANDIV5_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.gandi.net/docs/livedns/",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package gcloud
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/gcloud/gcloud.toml
+		Name:  "Google Cloud",
+		Code:  "gcloud",
+		Since: "v0.3.0",
+		URL:   "https://cloud.google.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "Application Default Credentials", Description: "[Documentation](https://cloud.google.com/docs/authentication/production#providing_credentials_to_your_application)", Credential: true},
+			{Name: "GCE_PROJECT", Description: "Project name (by default, the project name is auto-detected by using the metadata service)", Credential: true},
+			{Name: "GCE_SERVICE_ACCOUNT", Description: "Account", Credential: true},
+			{Name: "GCE_SERVICE_ACCOUNT_FILE", Description: "Account file path", Credential: true},
+			{Name: "GCE_ALLOW_PRIVATE_ZONE", Description: "Allows requested domain to be in private DNS zone, works only with a private ACME server (by default: false)"},
+			{Name: "GCE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "GCE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "GCE_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+			{Name: "GCE_ZONE_ID", Description: "Allows to skip the automatic detection of the zone"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://cloud.google.com/dns/api/v1/",
+			GoClient: "https://github.com/googleapis/google-api-go-client",
+		},
+	}
+}
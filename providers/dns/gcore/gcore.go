@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
@@ -18,6 +17,9 @@ const (
 	defaultPollingInterval    = 20 * time.Second
 )
 
+// minTTL is the minimum TTL accepted for a RRSet.
+const minTTL = 120
+
 // Environment variables names.
 const (
 	envNamespace = "GCORE_"
@@ -35,8 +37,9 @@ type Config struct {
 	APIToken           string
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
-	TTL                int
-	HTTPClient         *http.Client
+	// TTL is the TTL of the TXT RRSet, a TTL lower than 120 seconds is replaced by 120 seconds.
+	TTL        int
+	HTTPClient *http.Client
 }
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
@@ -98,12 +101,12 @@ func (d *DNSProvider) Present(domain, _, keyAuth string) error {
 
 	ctx := context.Background()
 
-	zone, err := d.guessZone(ctx, info.EffectiveFQDN)
+	zone, err := d.findZone(ctx, info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("gcore: %w", err)
 	}
 
-	err = d.client.AddRRSet(ctx, zone, dns01.UnFqdn(info.EffectiveFQDN), info.Value, d.config.TTL)
+	err = d.client.AddRRSet(ctx, zone, dns01.UnFqdn(info.EffectiveFQDN), info.Value, max(d.config.TTL, minTTL))
 	if err != nil {
 		return fmt.Errorf("gcore: add txt record: %w", err)
 	}
@@ -112,17 +115,18 @@ func (d *DNSProvider) Present(domain, _, keyAuth string) error {
 }
 
 // CleanUp removes the record matching the specified parameters.
+// The other values of the TXT RRSet are kept.
 func (d *DNSProvider) CleanUp(domain, _, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
 	ctx := context.Background()
 
-	zone, err := d.guessZone(ctx, info.EffectiveFQDN)
+	zone, err := d.findZone(ctx, info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("gcore: %w", err)
 	}
 
-	err = d.client.DeleteRRSet(ctx, zone, dns01.UnFqdn(info.EffectiveFQDN))
+	err = d.client.RemoveRRSetValue(ctx, zone, dns01.UnFqdn(info.EffectiveFQDN), info.Value)
 	if err != nil {
 		return fmt.Errorf("gcore: remove txt record: %w", err)
 	}
@@ -136,31 +140,22 @@ func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 	return d.config.PropagationTimeout, d.config.PollingInterval
 }
 
-func (d *DNSProvider) guessZone(ctx context.Context, fqdn string) (string, error) {
-	var lastErr error
-
-	for _, zone := range extractAllZones(fqdn) {
-		dnsZone, err := d.client.GetZone(ctx, zone)
-		if err == nil {
-			return dnsZone.Name, nil
-		}
-
-		lastErr = err
+// findZone returns the most specific zone, of the account, containing the FQDN.
+func (d *DNSProvider) findZone(ctx context.Context, fqdn string) (string, error) {
+	zones, err := d.client.ListZones(ctx)
+	if err != nil {
+		return "", err
 	}
 
-	return "", fmt.Errorf("zone %q not found: %w", fqdn, lastErr)
-}
-
-func extractAllZones(fqdn string) []string {
-	parts := strings.Split(dns01.UnFqdn(fqdn), ".")
-	if len(parts) < 3 {
-		return nil
+	var names []string
+	for _, z := range zones {
+		names = append(names, z.Name)
 	}
 
-	var zones []string
-	for i := 1; i < len(parts)-1; i++ {
-		zones = append(zones, strings.Join(parts[i:], "."))
+	zone := dns01.FindMostSpecificZone(fqdn, names)
+	if zone == "" {
+		return "", fmt.Errorf("zone not found for %q", fqdn)
 	}
 
-	return zones
+	return dns01.UnFqdn(zone), nil
 }
@@ -1,9 +1,15 @@
 package gcore
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/providers/dns/gcore/internal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -113,29 +119,142 @@ func TestLiveCleanUp(t *testing.T) {
 	require.NoError(t, err)
 }
 
-func Test_extractAllZones(t *testing.T) {
-	testCases := []struct {
-		desc     string
-		fqdn     string
-		expected []string
-	}{
-		{
-			desc:     "success",
-			fqdn:     "_acme-challenge.my.test.domain.com.",
-			expected: []string{"my.test.domain.com", "test.domain.com", "domain.com"},
-		},
-		{
-			desc: "empty",
-			fqdn: "_acme-challenge.com.",
-		},
-	}
+func setupTest(t *testing.T, ttl int) (*DNSProvider, *http.ServeMux) {
+	t.Helper()
 
-	for _, test := range testCases {
-		t.Run(test.desc, func(t *testing.T) {
-			t.Parallel()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
 
-			got := extractAllZones(test.fqdn)
-			assert.Equal(t, test.expected, got)
-		})
-	}
+	mux.HandleFunc("GET /v2/zones", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "APIKey secret" {
+			http.Error(rw, `{"error":"invalid token"}`, http.StatusUnauthorized)
+			return
+		}
+
+		zones := internal.ListZonesResponse{
+			Zones:       []internal.Zone{{Name: "example.com"}, {Name: "sub.example.com"}, {Name: "example.org"}},
+			TotalAmount: 3,
+		}
+
+		_ = json.NewEncoder(rw).Encode(zones)
+	})
+
+	config := NewDefaultConfig()
+	config.APIToken = "secret"
+	config.TTL = ttl
+	config.HTTPClient = server.Client()
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.client.BaseURL, _ = url.Parse(server.URL)
+
+	return provider, mux
+}
+
+func TestDNSProvider_Present_create(t *testing.T) {
+	provider, mux := setupTest(t, 10)
+
+	var created internal.RRSet
+
+	mux.HandleFunc("/v2/zones/sub.example.com/_acme-challenge.sub.example.com/TXT", func(rw http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			http.Error(rw, `{"error":"record is not found"}`, http.StatusNotFound)
+		case http.MethodPost:
+			_ = json.NewDecoder(req.Body).Decode(&created)
+		default:
+			http.Error(rw, "wrong method", http.StatusMethodNotAllowed)
+		}
+	})
+
+	err := provider.Present("sub.example.com", "", "keyAuth")
+	require.NoError(t, err)
+
+	// The TTL is clamped to the minimum.
+	assert.Equal(t, minTTL, created.TTL)
+	require.Len(t, created.Records, 1)
+}
+
+func TestDNSProvider_Present_update(t *testing.T) {
+	provider, mux := setupTest(t, 300)
+
+	var updated internal.RRSet
+
+	mux.HandleFunc("/v2/zones/example.com/_acme-challenge.example.com/TXT", func(rw http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(rw).Encode(internal.RRSet{TTL: 300, Records: []internal.Records{{Content: []string{"existing"}}}})
+		case http.MethodPut:
+			_ = json.NewDecoder(req.Body).Decode(&updated)
+		default:
+			http.Error(rw, "wrong method", http.StatusMethodNotAllowed)
+		}
+	})
+
+	err := provider.Present("example.com", "", "keyAuth")
+	require.NoError(t, err)
+
+	assert.Equal(t, 300, updated.TTL)
+	require.Len(t, updated.Records, 2)
+	assert.Equal(t, []string{"existing"}, updated.Records[1].Content)
+}
+
+func TestDNSProvider_CleanUp_delete(t *testing.T) {
+	provider, mux := setupTest(t, 300)
+
+	value := dns01.GetChallengeInfo("example.com", "keyAuth").Value
+
+	var deleted bool
+
+	mux.HandleFunc("/v2/zones/example.com/_acme-challenge.example.com/TXT", func(rw http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(rw).Encode(internal.RRSet{TTL: 300, Records: []internal.Records{{Content: []string{value}}}})
+		case http.MethodDelete:
+			deleted = true
+		default:
+			http.Error(rw, "wrong method", http.StatusMethodNotAllowed)
+		}
+	})
+
+	err := provider.CleanUp("example.com", "", "keyAuth")
+	require.NoError(t, err)
+
+	assert.True(t, deleted)
+}
+
+func TestDNSProvider_CleanUp_keepOtherValues(t *testing.T) {
+	provider, mux := setupTest(t, 300)
+
+	value := dns01.GetChallengeInfo("example.com", "keyAuth").Value
+
+	var updated internal.RRSet
+
+	mux.HandleFunc("/v2/zones/example.com/_acme-challenge.example.com/TXT", func(rw http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(rw).Encode(internal.RRSet{TTL: 300, Records: []internal.Records{
+				{Content: []string{"existing"}},
+				{Content: []string{value}},
+			}})
+		case http.MethodPut:
+			_ = json.NewDecoder(req.Body).Decode(&updated)
+		default:
+			http.Error(rw, "wrong method", http.StatusMethodNotAllowed)
+		}
+	})
+
+	err := provider.CleanUp("example.com", "", "keyAuth")
+	require.NoError(t, err)
+
+	assert.Equal(t, internal.RRSet{TTL: 300, Records: []internal.Records{{Content: []string{"existing"}}}}, updated)
+}
+
+func TestDNSProvider_Present_zoneNotFound(t *testing.T) {
+	provider, _ := setupTest(t, 300)
+
+	err := provider.Present("example.net", "", "keyAuth")
+	require.EqualError(t, err, `gcore: zone not found for "_acme-challenge.example.net."`)
 }
@@ -9,9 +9,12 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 const defaultBaseURL = "https://api.gcore.com/dns"
@@ -23,11 +26,13 @@ const (
 
 const txtRecordType = "TXT"
 
+const zonesPageSize = 100
+
 // Client for DNS API.
 type Client struct {
 	token string
 
-	baseURL    *url.URL
+	BaseURL    *url.URL
 	HTTPClient *http.Client
 }
 
@@ -37,15 +42,42 @@ func NewClient(token string) *Client {
 
 	return &Client{
 		token:      token,
-		baseURL:    baseURL,
+		BaseURL:    baseURL,
 		HTTPClient: &http.Client{Timeout: 10 * time.Second},
 	}
 }
 
+// ListZones lists all the zones.
+// https://api.gcore.com/docs/dns#tag/zones/operation/Zones
+func (c *Client) ListZones(ctx context.Context) ([]Zone, error) {
+	var zones []Zone
+
+	for {
+		endpoint := c.BaseURL.JoinPath("v2", "zones")
+
+		query := endpoint.Query()
+		query.Set("limit", strconv.Itoa(zonesPageSize))
+		query.Set("offset", strconv.Itoa(len(zones)))
+		endpoint.RawQuery = query.Encode()
+
+		var result ListZonesResponse
+		err := c.doRequest(ctx, http.MethodGet, endpoint, nil, &result)
+		if err != nil {
+			return nil, fmt.Errorf("list zones: %w", err)
+		}
+
+		zones = append(zones, result.Zones...)
+
+		if len(result.Zones) == 0 || len(zones) >= result.TotalAmount {
+			return zones, nil
+		}
+	}
+}
+
 // GetZone gets zone information.
 // https://api.gcore.com/docs/dns#tag/zones/operation/Zone
 func (c *Client) GetZone(ctx context.Context, name string) (Zone, error) {
-	endpoint := c.baseURL.JoinPath("v2", "zones", name)
+	endpoint := c.BaseURL.JoinPath("v2", "zones", name)
 
 	zone := Zone{}
 	err := c.doRequest(ctx, http.MethodGet, endpoint, nil, &zone)
@@ -59,7 +91,7 @@ func (c *Client) GetZone(ctx context.Context, name string) (Zone, error) {
 // GetRRSet gets RRSet item.
 // https://api.gcore.com/docs/dns#tag/rrsets/operation/RRSet
 func (c *Client) GetRRSet(ctx context.Context, zone, name string) (RRSet, error) {
-	endpoint := c.baseURL.JoinPath("v2", "zones", zone, name, txtRecordType)
+	endpoint := c.BaseURL.JoinPath("v2", "zones", zone, name, txtRecordType)
 
 	var result RRSet
 	err := c.doRequest(ctx, http.MethodGet, endpoint, nil, &result)
@@ -73,7 +105,7 @@ func (c *Client) GetRRSet(ctx context.Context, zone, name string) (RRSet, error)
 // DeleteRRSet removes RRSet record.
 // https://api.gcore.com/docs/dns#tag/rrsets/operation/DeleteRRSet
 func (c *Client) DeleteRRSet(ctx context.Context, zone, name string) error {
-	endpoint := c.baseURL.JoinPath("v2", "zones", zone, name, txtRecordType)
+	endpoint := c.BaseURL.JoinPath("v2", "zones", zone, name, txtRecordType)
 
 	err := c.doRequest(ctx, http.MethodDelete, endpoint, nil, nil)
 	if err != nil {
@@ -95,6 +127,10 @@ func (c *Client) AddRRSet(ctx context.Context, zone, recordName, value string, t
 
 	txt, err := c.GetRRSet(ctx, zone, recordName)
 	if err == nil && len(txt.Records) > 0 {
+		if slices.ContainsFunc(txt.Records, matchContent(value)) {
+			return nil
+		}
+
 		record.Records = append(record.Records, txt.Records...)
 		return c.updateRRSet(ctx, zone, recordName, record)
 	}
@@ -102,20 +138,51 @@ func (c *Client) AddRRSet(ctx context.Context, zone, recordName, value string, t
 	return c.createRRSet(ctx, zone, recordName, record)
 }
 
+// RemoveRRSetValue removes a value from the TXT records.
+// The other values are kept, and the RRSet is removed when there is no value left.
+func (c *Client) RemoveRRSetValue(ctx context.Context, zone, recordName, value string) error {
+	txt, err := c.GetRRSet(ctx, zone, recordName)
+	if err != nil {
+		statusErr := new(APIError)
+		if errors.As(err, statusErr) && statusErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+
+		return err
+	}
+
+	records := slices.DeleteFunc(slices.Clone(txt.Records), matchContent(value))
+	if len(records) == len(txt.Records) {
+		return nil
+	}
+
+	if len(records) == 0 {
+		return c.DeleteRRSet(ctx, zone, recordName)
+	}
+
+	return c.updateRRSet(ctx, zone, recordName, RRSet{TTL: txt.TTL, Records: records})
+}
+
 // https://api.gcore.com/docs/dns#tag/rrsets/operation/CreateRRSet
 func (c *Client) createRRSet(ctx context.Context, zone, name string, record RRSet) error {
-	endpoint := c.baseURL.JoinPath("v2", "zones", zone, name, txtRecordType)
+	endpoint := c.BaseURL.JoinPath("v2", "zones", zone, name, txtRecordType)
 
 	return c.doRequest(ctx, http.MethodPost, endpoint, record, nil)
 }
 
 // https://api.gcore.com/docs/dns#tag/rrsets/operation/UpdateRRSet
 func (c *Client) updateRRSet(ctx context.Context, zone, name string, record RRSet) error {
-	endpoint := c.baseURL.JoinPath("v2", "zones", zone, name, txtRecordType)
+	endpoint := c.BaseURL.JoinPath("v2", "zones", zone, name, txtRecordType)
 
 	return c.doRequest(ctx, http.MethodPut, endpoint, record, nil)
 }
 
+func matchContent(value string) func(Records) bool {
+	return func(record Records) bool {
+		return slices.Contains(record.Content, value)
+	}
+}
+
 func (c *Client) doRequest(ctx context.Context, method string, endpoint *url.URL, bodyParams any, result any) error {
 	req, err := newJSONRequest(ctx, method, endpoint, bodyParams)
 	if err != nil {
@@ -167,6 +234,8 @@ func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, paylo
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 
 	if payload != nil {
@@ -29,11 +29,39 @@ func setupTest(t *testing.T) (*Client, *http.ServeMux) {
 	t.Cleanup(server.Close)
 
 	client := NewClient(testToken)
-	client.baseURL, _ = url.Parse(server.URL)
+	client.BaseURL, _ = url.Parse(server.URL)
 
 	return client, mux
 }
 
+func TestClient_ListZones(t *testing.T) {
+	client, mux := setupTest(t)
+
+	mux.Handle("/v2/zones", validationHandler{
+		method: http.MethodGet,
+		next: http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			// 2 pages: the first one is full.
+			if req.URL.Query().Get("offset") == "0" {
+				zones := make([]Zone, zonesPageSize)
+				for i := range zones {
+					zones[i] = Zone{Name: fmt.Sprintf("example%d.com", i)}
+				}
+
+				handleJSONResponse(ListZonesResponse{Zones: zones, TotalAmount: zonesPageSize + 1}).ServeHTTP(rw, req)
+				return
+			}
+
+			handleJSONResponse(ListZonesResponse{Zones: []Zone{{Name: "example.org"}}, TotalAmount: zonesPageSize + 1}).ServeHTTP(rw, req)
+		}),
+	})
+
+	zones, err := client.ListZones(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, zones, zonesPageSize+1)
+	assert.Equal(t, Zone{Name: "example.org"}, zones[zonesPageSize])
+}
+
 func TestClient_GetZone(t *testing.T) {
 	client, mux := setupTest(t)
 
@@ -194,6 +222,56 @@ func TestClient_AddRRSet(t *testing.T) {
 	}
 }
 
+func TestClient_RemoveRRSetValue(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		records  []Records
+		expected string
+	}{
+		{
+			desc:     "delete the RRSet",
+			records:  []Records{{Content: []string{testRecordContent}}},
+			expected: http.MethodDelete,
+		},
+		{
+			desc:     "update the RRSet",
+			records:  []Records{{Content: []string{testRecordContent}}, {Content: []string{testRecordContent2}}},
+			expected: http.MethodPut,
+		},
+		{
+			desc:    "value not found",
+			records: []Records{{Content: []string{testRecordContent2}}},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			client, mux := setupTest(t)
+
+			var method string
+
+			mux.HandleFunc("/v2/zones/test.example.com/my.test.example.com/"+txtRecordType, func(rw http.ResponseWriter, req *http.Request) {
+				switch req.Method {
+				case http.MethodGet:
+					handleJSONResponse(RRSet{TTL: testTTL, Records: test.records}).ServeHTTP(rw, req)
+				case http.MethodPut:
+					method = req.Method
+					handleAddRRSet([]Records{{Content: []string{testRecordContent2}}}).ServeHTTP(rw, req)
+				case http.MethodDelete:
+					method = req.Method
+				default:
+					http.Error(rw, "wrong method", http.StatusMethodNotAllowed)
+				}
+			})
+
+			err := client.RemoveRRSetValue(context.Background(), "test.example.com", "my.test.example.com", testRecordContent)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, method)
+		})
+	}
+}
+
 type validationHandler struct {
 	method string
 	next   http.Handler
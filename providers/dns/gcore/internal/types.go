@@ -6,6 +6,11 @@ type Zone struct {
 	Name string `json:"name"`
 }
 
+type ListZonesResponse struct {
+	Zones       []Zone `json:"zones"`
+	TotalAmount int    `json:"total_amount"`
+}
+
 type RRSet struct {
 	TTL     int       `json:"ttl"`
 	Records []Records `json:"resource_records"`
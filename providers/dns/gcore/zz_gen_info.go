@@ -0,0 +1,28 @@
+package gcore
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/gcore/gcore.toml
+		Name:  "G-Core",
+		Code:  "gcore",
+		Since: "v4.5.0",
+		URL:   "https://gcore.com/dns/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "GCORE_PERMANENT_API_TOKEN", Description: "Permanent API token (https://gcore.com/blog/permanent-api-token-explained/)", Credential: true},
+			{Name: "GCORE_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "GCORE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "GCORE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "GCORE_TTL", Description: "The TTL of the TXT record used for the DNS challenge (minimum: 120)"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.gcore.com/docs/dns#tag/zones",
+			GoClient: "",
+		},
+	}
+}
@@ -107,8 +107,9 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	// find authZone
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	ctx := context.Background()
+
+	authZone, err := d.findZone(ctx, info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("glesys: could not find zone for domain %q: %w", domain, err)
 	}
@@ -118,18 +119,15 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		return fmt.Errorf("glesys: %w", err)
 	}
 
-	// acquire lock and check there is not a challenge already in progress for this value of authZone
-	d.inProgressMu.Lock()
-	defer d.inProgressMu.Unlock()
-
-	// add TXT record into authZone
-	recordID, err := d.client.AddTXTRecord(context.Background(), dns01.UnFqdn(authZone), subDomain, info.Value, d.config.TTL)
+	recordID, err := d.client.AddTXTRecord(ctx, authZone, subDomain, info.Value, d.config.TTL)
 	if err != nil {
-		return err
+		return fmt.Errorf("glesys: add record: %w", err)
 	}
 
-	// save data necessary for CleanUp
-	d.activeRecords[info.EffectiveFQDN] = recordID
+	d.inProgressMu.Lock()
+	d.activeRecords[token] = recordID
+	d.inProgressMu.Unlock()
+
 	return nil
 }
 
@@ -137,19 +135,24 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	// acquire lock and retrieve authZone
 	d.inProgressMu.Lock()
-	defer d.inProgressMu.Unlock()
-	if _, ok := d.activeRecords[info.EffectiveFQDN]; !ok {
-		// if there is no cleanup information then just return
-		return nil
+	recordID, ok := d.activeRecords[token]
+	d.inProgressMu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("glesys: unknown record ID for '%s'", info.EffectiveFQDN)
 	}
 
-	recordID := d.activeRecords[info.EffectiveFQDN]
-	delete(d.activeRecords, info.EffectiveFQDN)
+	err := d.client.DeleteTXTRecord(context.Background(), recordID)
+	if err != nil {
+		return fmt.Errorf("glesys: delete record: %w", err)
+	}
+
+	d.inProgressMu.Lock()
+	delete(d.activeRecords, token)
+	d.inProgressMu.Unlock()
 
-	// delete TXT record from authZone
-	return d.client.DeleteTXTRecord(context.Background(), recordID)
+	return nil
 }
 
 // Timeout returns the values (20*time.Minute, 20*time.Second) which
@@ -158,3 +161,23 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 	return d.config.PropagationTimeout, d.config.PollingInterval
 }
+
+// findZone returns the most specific domain of the account containing the FQDN.
+func (d *DNSProvider) findZone(ctx context.Context, fqdn string) (string, error) {
+	domains, err := d.client.ListDomains(ctx)
+	if err != nil {
+		return "", fmt.Errorf("list domains: %w", err)
+	}
+
+	var names []string
+	for _, domain := range domains {
+		names = append(names, domain.DomainName)
+	}
+
+	zone := dns01.FindMostSpecificZone(fqdn, names)
+	if zone == "" {
+		return "", fmt.Errorf("no domain found for %s", fqdn)
+	}
+
+	return zone, nil
+}
@@ -1,9 +1,15 @@
 package glesys
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -124,6 +130,136 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+type fakeRecord struct {
+	RecordID   int    `json:"recordid"`
+	DomainName string `json:"domainname"`
+	Host       string `json:"host"`
+	Type       string `json:"type"`
+	Data       string `json:"data"`
+	TTL        int    `json:"ttl"`
+}
+
+func setupTest(t *testing.T) (*DNSProvider, map[int]fakeRecord) {
+	t.Helper()
+
+	records := map[int]fakeRecord{}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("POST /domain/list", func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"response":{"status":{"code":200},"domains":[{"domainname":"example.com"},{"domainname":"sub.example.com"},{"domainname":"example.org"}]}}`))
+	})
+
+	mux.HandleFunc("POST /domain/addrecord", func(rw http.ResponseWriter, req *http.Request) {
+		record := fakeRecord{}
+		err := json.NewDecoder(req.Body).Decode(&record)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		record.RecordID = len(records) + 100
+		records[record.RecordID] = record
+
+		_, _ = fmt.Fprintf(rw, `{"response":{"status":{"code":200},"record":{"recordid":%d}}}`, record.RecordID)
+	})
+
+	mux.HandleFunc("POST /domain/deleterecord", func(rw http.ResponseWriter, req *http.Request) {
+		record := fakeRecord{}
+		err := json.NewDecoder(req.Body).Decode(&record)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if _, ok := records[record.RecordID]; !ok {
+			http.Error(rw, `{"response":{"status":{"code":400,"text":"Record not found."}}}`, http.StatusBadRequest)
+			return
+		}
+
+		delete(records, record.RecordID)
+
+		_, _ = rw.Write([]byte(`{"response":{"status":{"code":200}}}`))
+	})
+
+	config := NewDefaultConfig()
+	config.APIUser = "user"
+	config.APIKey = "secret"
+	config.HTTPClient = server.Client()
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.client.BaseURL, _ = url.Parse(server.URL)
+
+	return provider, records
+}
+
+func TestDNSProvider_Present_CleanUp(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		domain   string
+		expected fakeRecord
+	}{
+		{
+			desc:   "root domain",
+			domain: "example.com",
+			expected: fakeRecord{
+				RecordID:   100,
+				DomainName: "example.com",
+				Host:       "_acme-challenge",
+				Type:       "TXT",
+				Data:       "Yn5FYIdZpKGY0Gl17AM7lOlQID5C4_II6Blwe54e4rk",
+				TTL:        60,
+			},
+		},
+		{
+			desc:   "most specific domain",
+			domain: "foo.sub.example.com",
+			expected: fakeRecord{
+				RecordID:   100,
+				DomainName: "sub.example.com",
+				Host:       "_acme-challenge.foo",
+				Type:       "TXT",
+				Data:       "Yn5FYIdZpKGY0Gl17AM7lOlQID5C4_II6Blwe54e4rk",
+				TTL:        60,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			provider, records := setupTest(t)
+
+			err := provider.Present(test.domain, "tokenA", "keyAuthA")
+			require.NoError(t, err)
+
+			assert.Equal(t, map[int]fakeRecord{100: test.expected}, records)
+
+			err = provider.CleanUp(test.domain, "tokenA", "keyAuthA")
+			require.NoError(t, err)
+
+			assert.Empty(t, records)
+		})
+	}
+}
+
+func TestDNSProvider_Present_unknownDomain(t *testing.T) {
+	provider, _ := setupTest(t)
+
+	err := provider.Present("example.net", "tokenA", "keyAuthA")
+	require.EqualError(t, err, `glesys: could not find zone for domain "example.net": no domain found for _acme-challenge.example.net.`)
+}
+
+func TestDNSProvider_CleanUp_unknownToken(t *testing.T) {
+	provider, _ := setupTest(t)
+
+	err := provider.CleanUp("example.com", "tokenA", "keyAuthA")
+	require.EqualError(t, err, "glesys: unknown record ID for '_acme-challenge.example.com.'")
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 // defaultBaseURL is the GleSYS API endpoint used by Present and CleanUp.
@@ -20,7 +21,7 @@ type Client struct {
 	apiUser string
 	apiKey  string
 
-	baseURL    *url.URL
+	BaseURL    *url.URL
 	HTTPClient *http.Client
 }
 
@@ -30,7 +31,7 @@ func NewClient(apiUser string, apiKey string) *Client {
 	return &Client{
 		apiUser:    apiUser,
 		apiKey:     apiKey,
-		baseURL:    baseURL,
+		BaseURL:    baseURL,
 		HTTPClient: &http.Client{Timeout: 5 * time.Second},
 	}
 }
@@ -38,7 +39,7 @@ func NewClient(apiUser string, apiKey string) *Client {
 // AddTXTRecord adds a dns record to a domain.
 // https://github.com/GleSYS/API/wiki/API-Documentation#domainaddrecord
 func (c *Client) AddTXTRecord(ctx context.Context, domain, name, value string, ttl int) (int, error) {
-	endpoint := c.baseURL.JoinPath("domain", "addrecord")
+	endpoint := c.BaseURL.JoinPath("domain", "addrecord")
 
 	request := addRecordRequest{
 		DomainName: domain,
@@ -58,17 +59,17 @@ func (c *Client) AddTXTRecord(ctx context.Context, domain, name, value string, t
 		return 0, err
 	}
 
-	if response != nil && response.Response.Status.Code == http.StatusOK {
-		return response.Response.Record.RecordID, nil
+	if response.Response.Status.Code != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status code: %d", response.Response.Status.Code)
 	}
 
-	return 0, err
+	return response.Response.Record.RecordID, nil
 }
 
 // DeleteTXTRecord removes a dns record from a domain.
 // https://github.com/GleSYS/API/wiki/API-Documentation#domaindeleterecord
 func (c *Client) DeleteTXTRecord(ctx context.Context, recordID int) error {
-	endpoint := c.baseURL.JoinPath("domain", "deleterecord")
+	endpoint := c.BaseURL.JoinPath("domain", "deleterecord")
 
 	request := deleteRecordRequest{RecordID: recordID}
 
@@ -82,6 +83,28 @@ func (c *Client) DeleteTXTRecord(ctx context.Context, recordID int) error {
 	return err
 }
 
+// ListDomains lists the domains of the account.
+// https://github.com/GleSYS/API/wiki/API-Documentation#domainlist
+func (c *Client) ListDomains(ctx context.Context) ([]Domain, error) {
+	endpoint := c.BaseURL.JoinPath("domain", "list")
+
+	req, err := newJSONRequest(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Response.Status.Code != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", response.Response.Status.Code)
+	}
+
+	return response.Response.Domains, nil
+}
+
 func (c *Client) do(req *http.Request) (*apiResponse, error) {
 	req.SetBasicAuth(c.apiUser, c.apiKey)
 
@@ -125,6 +148,8 @@ func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, paylo
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 
 	if payload != nil {
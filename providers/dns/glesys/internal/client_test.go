@@ -57,7 +57,7 @@ func setupTest(t *testing.T, method, pattern string, status int, file string) *C
 
 	client := NewClient("user", "secret")
 	client.HTTPClient = server.Client()
-	client.baseURL, _ = url.Parse(server.URL)
+	client.BaseURL, _ = url.Parse(server.URL)
 
 	return client
 }
@@ -77,3 +77,17 @@ func TestClient_DeleteTXTRecord(t *testing.T) {
 	err := client.DeleteTXTRecord(context.Background(), 123)
 	require.NoError(t, err)
 }
+
+func TestClient_ListDomains(t *testing.T) {
+	client := setupTest(t, http.MethodPost, "/domain/list", http.StatusOK, "list-domains.json")
+
+	domains, err := client.ListDomains(context.Background())
+	require.NoError(t, err)
+
+	expected := []Domain{
+		{DomainName: "example.com"},
+		{DomainName: "sub.example.com"},
+	}
+
+	assert.Equal(t, expected, domains)
+}
@@ -17,8 +17,9 @@ type apiResponse struct {
 }
 
 type Response struct {
-	Status Status `json:"status"`
-	Record Record `json:"record"`
+	Status  Status   `json:"status"`
+	Record  Record   `json:"record"`
+	Domains []Domain `json:"domains"`
 }
 
 type Status struct {
@@ -28,3 +29,7 @@ type Status struct {
 type Record struct {
 	RecordID int `json:"recordid"`
 }
+
+type Domain struct {
+	DomainName string `json:"domainname"`
+}
@@ -0,0 +1,29 @@
+package glesys
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/glesys/glesys.toml
+		Name:  "Glesys",
+		Code:  "glesys",
+		Since: "v0.5.0",
+		URL:   "https://glesys.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "GLESYS_API_KEY", Description: "API key", Credential: true},
+			{Name: "GLESYS_API_USER", Description: "API user", Credential: true},
+			{Name: "GLESYS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "GLESYS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "GLESYS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "GLESYS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://github.com/GleSYS/API/wiki/API-Documentation",
+			GoClient: "",
+		},
+	}
+}
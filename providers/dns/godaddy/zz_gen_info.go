@@ -0,0 +1,29 @@
+package godaddy
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/godaddy/godaddy.toml
+		Name:  "Go Daddy",
+		Code:  "godaddy",
+		Since: "v0.5.0",
+		URL:   "https://godaddy.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "GODADDY_API_KEY", Description: "API key", Credential: true},
+			{Name: "GODADDY_API_SECRET", Description: "API secret", Credential: true},
+			{Name: "GODADDY_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "GODADDY_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "GODADDY_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "GODADDY_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developer.godaddy.com/doc/endpoint/domains",
+			GoClient: "",
+		},
+	}
+}
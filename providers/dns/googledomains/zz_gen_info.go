@@ -0,0 +1,27 @@
+package googledomains
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/googledomains/googledomains.toml
+		Name:  "Google Domains",
+		Code:  "googledomains",
+		Since: "v4.11.0",
+		URL:   "https://domains.google",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "GOOGLE_DOMAINS_ACCESS_TOKEN", Description: "Access token", Credential: true},
+			{Name: "GOOGLE_DOMAINS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "GOOGLE_DOMAINS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "GOOGLE_DOMAINS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "",
+			GoClient: "https://github.com/googleapis/google-api-go-client",
+		},
+	}
+}
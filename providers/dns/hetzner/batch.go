@@ -0,0 +1,186 @@
+package hetzner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/log"
+	"github.com/go-acme/lego/v4/providers/dns/hetzner/internal"
+)
+
+// batchRecord a TXT record handled by a batch.
+type batchRecord struct {
+	fqdn   string
+	record internal.DNSRecord
+}
+
+// batch the records of a zone collected during the batch window.
+type batch struct {
+	records []batchRecord
+
+	done chan struct{}
+	err  error
+}
+
+// batcher groups the records of a zone received during the batch window,
+// and flushes them all at once at the end of the window.
+type batcher struct {
+	window time.Duration
+	flush  func(ctx context.Context, zoneID string, records []batchRecord) error
+
+	mu      sync.Mutex
+	batches map[string]*batch
+}
+
+func newBatcher(window time.Duration, flush func(ctx context.Context, zoneID string, records []batchRecord) error) *batcher {
+	return &batcher{
+		window:  window,
+		flush:   flush,
+		batches: make(map[string]*batch),
+	}
+}
+
+// do adds the record to the current batch of the zone, and waits for the flush of the batch.
+func (b *batcher) do(zoneID string, record batchRecord) error {
+	b.mu.Lock()
+
+	current, ok := b.batches[zoneID]
+	if !ok {
+		current = &batch{done: make(chan struct{})}
+		b.batches[zoneID] = current
+
+		time.AfterFunc(b.window, func() {
+			// After this point, the records are added to a new batch.
+			b.mu.Lock()
+			delete(b.batches, zoneID)
+			b.mu.Unlock()
+
+			current.err = b.flush(context.Background(), zoneID, current.records)
+			close(current.done)
+		})
+	}
+
+	current.records = append(current.records, record)
+
+	b.mu.Unlock()
+
+	<-current.done
+
+	return current.err
+}
+
+// flushCreate creates the records of a batch with a single zone file import.
+func (d *DNSProvider) flushCreate(ctx context.Context, zoneID string, records []batchRecord) error {
+	if len(records) > 1 {
+		err := d.importZoneFile(ctx, zoneID, func(zoneFile string) string {
+			return addTXTRecords(zoneFile, records)
+		})
+		if err == nil {
+			return nil
+		}
+
+		log.Warnf("hetzner: the zone file import failed, fallback to the creation of the records one by one: %v", err)
+	}
+
+	var errs []error
+
+	for _, r := range records {
+		err := d.client.CreateRecord(ctx, r.record)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to add TXT record: fqdn=%s, zoneID=%s: %w", r.fqdn, zoneID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// flushDelete deletes the records of a batch with a single zone file import.
+func (d *DNSProvider) flushDelete(ctx context.Context, zoneID string, records []batchRecord) error {
+	if len(records) > 1 {
+		err := d.importZoneFile(ctx, zoneID, func(zoneFile string) string {
+			return removeTXTRecords(zoneFile, records)
+		})
+		if err == nil {
+			return nil
+		}
+
+		log.Warnf("hetzner: the zone file import failed, fallback to the deletion of the records one by one: %v", err)
+	}
+
+	var errs []error
+
+	for _, r := range records {
+		errs = append(errs, d.deleteRecord(ctx, zoneID, r.record))
+	}
+
+	return errors.Join(errs...)
+}
+
+// importZoneFile exports the zone file, updates it, and imports it.
+func (d *DNSProvider) importZoneFile(ctx context.Context, zoneID string, update func(zoneFile string) string) error {
+	zoneFile, err := d.client.ExportZoneFile(ctx, zoneID)
+	if err != nil {
+		return fmt.Errorf("export zone file: %w", err)
+	}
+
+	err = d.client.ImportZoneFile(ctx, zoneID, update(zoneFile))
+	if err != nil {
+		return fmt.Errorf("import zone file: %w", err)
+	}
+
+	return nil
+}
+
+// addTXTRecords appends the TXT records to the zone file.
+// The names are fully qualified, so they don't depend on the $ORIGIN of the zone file.
+func addTXTRecords(zoneFile string, records []batchRecord) string {
+	var sb strings.Builder
+
+	sb.WriteString(zoneFile)
+
+	if zoneFile != "" && !strings.HasSuffix(zoneFile, "\n") {
+		sb.WriteString("\n")
+	}
+
+	for _, r := range records {
+		sb.WriteString(r.fqdn + " " + strconv.Itoa(r.record.TTL) + " IN TXT " + strconv.Quote(r.record.Value) + "\n")
+	}
+
+	return sb.String()
+}
+
+// removeTXTRecords removes the lines of the TXT records from the zone file.
+// The values of the challenges are unique, so a line is matched by its type and its value.
+func removeTXTRecords(zoneFile string, records []batchRecord) string {
+	lines := strings.SplitAfter(zoneFile, "\n")
+
+	var sb strings.Builder
+
+	for _, line := range lines {
+		if !isTXTRecordLine(line, records) {
+			sb.WriteString(line)
+		}
+	}
+
+	return sb.String()
+}
+
+func isTXTRecordLine(line string, records []batchRecord) bool {
+	if !slices.Contains(strings.Fields(line), "TXT") {
+		return false
+	}
+
+	for _, r := range records {
+		if strings.Contains(line, strconv.Quote(r.record.Value)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -21,6 +21,8 @@ const (
 
 	EnvAPIKey = envNamespace + "API_KEY"
 
+	EnvBatchWindow = envNamespace + "BATCH_WINDOW"
+
 	EnvTTL                = envNamespace + "TTL"
 	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
 	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
@@ -34,6 +36,12 @@ type Config struct {
 	PollingInterval    time.Duration
 	TTL                int
 	HTTPClient         *http.Client
+
+	// BatchWindow enables the zone file import mode (disabled if 0):
+	// the records of a zone, created (or deleted) during the window, are added (or removed) with a single zone file import.
+	// The challenges must be presented concurrently (see lego.Config.MaxConcurrentAuthz, or the CLI option '--max-concurrent-authz'),
+	// otherwise the records are not grouped and each record waits for the end of the window.
+	BatchWindow time.Duration
 }
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
@@ -45,6 +53,7 @@ func NewDefaultConfig() *Config {
 		HTTPClient: &http.Client{
 			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
 		},
+		BatchWindow: env.GetOrDefaultSecond(EnvBatchWindow, 0),
 	}
 }
 
@@ -52,6 +61,12 @@ func NewDefaultConfig() *Config {
 type DNSProvider struct {
 	config *Config
 	client *internal.Client
+
+	// only used in the zone file import mode (see Config.BatchWindow).
+	creations *batcher
+	deletions *batcher
+
+	findZoneByFqdn func(fqdn string) (string, error)
 }
 
 // NewDNSProvider returns a DNSProvider instance configured for hetzner.
@@ -88,7 +103,18 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		client.HTTPClient = config.HTTPClient
 	}
 
-	return &DNSProvider{config: config, client: client}, nil
+	provider := &DNSProvider{
+		config:         config,
+		client:         client,
+		findZoneByFqdn: dns01.FindZoneByFqdn,
+	}
+
+	if config.BatchWindow > 0 {
+		provider.creations = newBatcher(config.BatchWindow, provider.flushCreate)
+		provider.deletions = newBatcher(config.BatchWindow, provider.flushDelete)
+	}
+
+	return provider, nil
 }
 
 // Timeout returns the timeout and interval to use when checking for DNS propagation.
@@ -101,7 +127,7 @@ func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	authZone, err := d.findZoneByFqdn(info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("hetzner: could not find zone for domain %q: %w", domain, err)
 	}
@@ -128,6 +154,15 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		ZoneID: zoneID,
 	}
 
+	if d.creations != nil {
+		err = d.creations.do(zoneID, batchRecord{fqdn: info.EffectiveFQDN, record: record})
+		if err != nil {
+			return fmt.Errorf("hetzner: %w", err)
+		}
+
+		return nil
+	}
+
 	if err := d.client.CreateRecord(ctx, record); err != nil {
 		return fmt.Errorf("hetzner: failed to add TXT record: fqdn=%s, zoneID=%s: %w", info.EffectiveFQDN, zoneID, err)
 	}
@@ -139,7 +174,7 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	authZone, err := d.findZoneByFqdn(info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("hetzner: could not find zone for domain %q: %w", domain, err)
 	}
@@ -158,13 +193,33 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 		return fmt.Errorf("hetzner: %w", err)
 	}
 
-	record, err := d.client.GetTxtRecord(ctx, subDomain, info.Value, zoneID)
+	record := internal.DNSRecord{
+		Type:  "TXT",
+		Name:  subDomain,
+		Value: info.Value,
+	}
+
+	if d.deletions != nil {
+		err = d.deletions.do(zoneID, batchRecord{fqdn: info.EffectiveFQDN, record: record})
+	} else {
+		err = d.deleteRecord(ctx, zoneID, record)
+	}
+
 	if err != nil {
 		return fmt.Errorf("hetzner: %w", err)
 	}
 
-	if err := d.client.DeleteRecord(ctx, record.ID); err != nil {
-		return fmt.Errorf("hetzner: failed to delete TXT record: id=%s, name=%s: %w", record.ID, record.Name, err)
+	return nil
+}
+
+func (d *DNSProvider) deleteRecord(ctx context.Context, zoneID string, record internal.DNSRecord) error {
+	existing, err := d.client.GetTxtRecord(ctx, record.Name, record.Value, zoneID)
+	if err != nil {
+		return err
+	}
+
+	if err := d.client.DeleteRecord(ctx, existing.ID); err != nil {
+		return fmt.Errorf("failed to delete TXT record: id=%s, name=%s: %w", existing.ID, existing.Name, err)
 	}
 
 	return nil
@@ -1,9 +1,20 @@
 package hetzner
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/providers/dns/hetzner/internal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -98,6 +109,256 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func TestDNSProvider_batchWindow(t *testing.T) {
+	domains := []string{"a.example.com", "b.example.com", "c.example.com"}
+
+	testCases := []struct {
+		desc     string
+		window   time.Duration
+		expected map[string]int
+	}{
+		{
+			desc: "per-record mode",
+			expected: map[string]int{
+				"POST /api/v1/records":   3,
+				"GET /api/v1/records":    3,
+				"DELETE /api/v1/records": 3,
+			},
+		},
+		{
+			desc:   "zone file import mode",
+			window: 50 * time.Millisecond,
+			expected: map[string]int{
+				"GET /api/v1/zones/zoneA/export":  2,
+				"POST /api/v1/zones/zoneA/import": 2,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			api := newFakeAPI(t)
+
+			provider := setupProvider(t, api, test.window)
+
+			forEach(domains, func(domain string) {
+				err := provider.Present(domain, "token", "key-"+domain)
+				assert.NoError(t, err)
+			})
+
+			assert.Equal(t, 3, strings.Count(api.zoneFile, " TXT "))
+
+			forEach(domains, func(domain string) {
+				err := provider.CleanUp(domain, "token", "key-"+domain)
+				assert.NoError(t, err)
+			})
+
+			assert.Equal(t, 0, strings.Count(api.zoneFile, " TXT "))
+			assert.Contains(t, api.zoneFile, "www 86400 IN A 192.0.2.1")
+
+			calls := api.recordCalls()
+			assert.Equal(t, test.expected, calls)
+		})
+	}
+}
+
+func TestDNSProvider_batchWindow_fallback(t *testing.T) {
+	api := newFakeAPI(t)
+	api.failImport = true
+
+	provider := setupProvider(t, api, 50*time.Millisecond)
+
+	domains := []string{"a.example.com", "b.example.com"}
+
+	forEach(domains, func(domain string) {
+		err := provider.Present(domain, "token", "key-"+domain)
+		assert.NoError(t, err)
+	})
+
+	forEach(domains, func(domain string) {
+		err := provider.CleanUp(domain, "token", "key-"+domain)
+		assert.NoError(t, err)
+	})
+
+	expected := map[string]int{
+		"GET /api/v1/zones/zoneA/export":  2,
+		"POST /api/v1/zones/zoneA/import": 2,
+		"POST /api/v1/records":            2,
+		"GET /api/v1/records":             2,
+		"DELETE /api/v1/records":          2,
+	}
+
+	assert.Equal(t, expected, api.recordCalls())
+}
+
+func Test_removeTXTRecords(t *testing.T) {
+	zoneFile := `$ORIGIN example.com.
+www 86400 IN A 192.0.2.1
+_acme-challenge.a.example.com. 60 IN TXT "aaa"
+_acme-challenge 60 IN TXT "other"
+_acme-challenge.b.example.com.	60	IN	TXT	"bbb"
+`
+
+	records := []batchRecord{
+		{record: internal.DNSRecord{Value: "aaa"}},
+		{record: internal.DNSRecord{Value: "bbb"}},
+	}
+
+	expected := `$ORIGIN example.com.
+www 86400 IN A 192.0.2.1
+_acme-challenge 60 IN TXT "other"
+`
+
+	assert.Equal(t, expected, removeTXTRecords(zoneFile, records))
+}
+
+func setupProvider(t *testing.T, api *fakeAPI, window time.Duration) *DNSProvider {
+	t.Helper()
+
+	config := NewDefaultConfig()
+	config.APIKey = "secret"
+	config.BatchWindow = window
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.client.BaseURL, _ = url.Parse(api.server.URL)
+	provider.client.HTTPClient = api.server.Client()
+	provider.findZoneByFqdn = func(_ string) (string, error) {
+		return "example.com.", nil
+	}
+
+	return provider
+}
+
+// forEach calls fn concurrently for each domain, like the challenges solved concurrently.
+func forEach(domains []string, fn func(domain string)) {
+	var wg sync.WaitGroup
+
+	for _, domain := range domains {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			fn(domain)
+		}()
+	}
+
+	wg.Wait()
+}
+
+// fakeAPI a minimal Hetzner DNS API: the records are stored in a zone file.
+type fakeAPI struct {
+	server *httptest.Server
+
+	failImport bool
+
+	mu       sync.Mutex
+	zoneFile string
+	calls    map[string]int
+}
+
+func newFakeAPI(t *testing.T) *fakeAPI {
+	t.Helper()
+
+	api := &fakeAPI{
+		zoneFile: "$ORIGIN example.com.\nwww 86400 IN A 192.0.2.1\n",
+		calls:    make(map[string]int),
+	}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/v1/zones", func(rw http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(rw).Encode(internal.Zones{Zones: []internal.Zone{{ID: "zoneA", Name: "example.com"}}})
+	})
+
+	mux.HandleFunc("GET /api/v1/zones/zoneA/export", func(rw http.ResponseWriter, req *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		api.calls[req.Method+" "+req.URL.Path]++
+
+		_, _ = fmt.Fprint(rw, api.zoneFile)
+	})
+
+	mux.HandleFunc("POST /api/v1/zones/zoneA/import", func(rw http.ResponseWriter, req *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		api.calls[req.Method+" "+req.URL.Path]++
+
+		if api.failImport {
+			http.Error(rw, "import failed", http.StatusUnprocessableEntity)
+			return
+		}
+
+		raw, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		api.zoneFile = string(raw)
+	})
+
+	mux.HandleFunc("POST /api/v1/records", func(rw http.ResponseWriter, req *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		api.calls[req.Method+" "+req.URL.Path]++
+
+		record := internal.DNSRecord{}
+
+		err := json.NewDecoder(req.Body).Decode(&record)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		api.zoneFile += fmt.Sprintf("%s %d IN TXT %q\n", record.Name, record.TTL, record.Value)
+	})
+
+	mux.HandleFunc("GET /api/v1/records", func(rw http.ResponseWriter, req *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		api.calls[req.Method+" "+req.URL.Path]++
+
+		records := internal.DNSRecords{}
+
+		for _, line := range strings.Split(api.zoneFile, "\n") {
+			fields := strings.Fields(line)
+			if len(fields) == 5 && fields[3] == "TXT" {
+				value := strings.Trim(fields[4], `"`)
+				records.Records = append(records.Records, internal.DNSRecord{ID: value, Name: fields[0], Type: "TXT", Value: value})
+			}
+		}
+
+		_ = json.NewEncoder(rw).Encode(records)
+	})
+
+	mux.HandleFunc("DELETE /api/v1/records/{id}", func(rw http.ResponseWriter, req *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		api.calls[req.Method+" /api/v1/records"]++
+
+		api.zoneFile = removeTXTRecords(api.zoneFile, []batchRecord{{record: internal.DNSRecord{Value: req.PathValue("id")}}})
+	})
+
+	api.server = httptest.NewServer(mux)
+	t.Cleanup(api.server.Close)
+
+	return api
+}
+
+func (f *fakeAPI) recordCalls() map[string]int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.calls
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -8,9 +8,11 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 // defaultBaseURL represents the API endpoint to call.
@@ -22,7 +24,7 @@ const authHeader = "Auth-API-Token"
 type Client struct {
 	apiKey string
 
-	baseURL    *url.URL
+	BaseURL    *url.URL
 	HTTPClient *http.Client
 }
 
@@ -32,7 +34,7 @@ func NewClient(apiKey string) *Client {
 
 	return &Client{
 		apiKey:     apiKey,
-		baseURL:    baseURL,
+		BaseURL:    baseURL,
 		HTTPClient: &http.Client{Timeout: 5 * time.Second},
 	}
 }
@@ -55,7 +57,7 @@ func (c *Client) GetTxtRecord(ctx context.Context, name, value, zoneID string) (
 
 // https://dns.hetzner.com/api-docs#operation/GetRecords
 func (c *Client) getRecords(ctx context.Context, zoneID string) (*DNSRecords, error) {
-	endpoint := c.baseURL.JoinPath("api", "v1", "records")
+	endpoint := c.BaseURL.JoinPath("api", "v1", "records")
 
 	query := endpoint.Query()
 	query.Set("zone_id", zoneID)
@@ -94,7 +96,7 @@ func (c *Client) getRecords(ctx context.Context, zoneID string) (*DNSRecords, er
 // CreateRecord creates a DNS record.
 // https://dns.hetzner.com/api-docs#operation/CreateRecord
 func (c *Client) CreateRecord(ctx context.Context, record DNSRecord) error {
-	endpoint := c.baseURL.JoinPath("api", "v1", "records")
+	endpoint := c.BaseURL.JoinPath("api", "v1", "records")
 
 	req, err := c.newRequest(ctx, http.MethodPost, endpoint, record)
 	if err != nil {
@@ -118,7 +120,7 @@ func (c *Client) CreateRecord(ctx context.Context, record DNSRecord) error {
 // DeleteRecord deletes a DNS record.
 // https://dns.hetzner.com/api-docs#operation/DeleteRecord
 func (c *Client) DeleteRecord(ctx context.Context, recordID string) error {
-	endpoint := c.baseURL.JoinPath("api", "v1", "records", recordID)
+	endpoint := c.BaseURL.JoinPath("api", "v1", "records", recordID)
 
 	req, err := c.newRequest(ctx, http.MethodDelete, endpoint, nil)
 	if err != nil {
@@ -139,6 +141,61 @@ func (c *Client) DeleteRecord(ctx context.Context, recordID string) error {
 	return nil
 }
 
+// ExportZoneFile exports the zone as a zone file (BIND format).
+// https://dns.hetzner.com/api-docs#operation/ExportZoneFile
+func (c *Client) ExportZoneFile(ctx context.Context, zoneID string) (string, error) {
+	endpoint := c.BaseURL.JoinPath("api", "v1", "zones", zoneID, "export")
+
+	req, err := c.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Accept", "text/plain")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", errutils.NewHTTPDoError(req, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errutils.NewUnexpectedResponseStatusCodeError(req, resp)
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errutils.NewReadResponseError(req, resp.StatusCode, err)
+	}
+
+	return string(raw), nil
+}
+
+// ImportZoneFile replaces all the records of the zone by the records of the zone file (BIND format).
+// https://dns.hetzner.com/api-docs#operation/ImportZoneFile
+func (c *Client) ImportZoneFile(ctx context.Context, zoneID, zoneFile string) error {
+	endpoint := c.BaseURL.JoinPath("api", "v1", "zones", zoneID, "import")
+
+	req, err := c.newZoneFileRequest(ctx, endpoint, zoneFile)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return errutils.NewHTTPDoError(req, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return errutils.NewUnexpectedResponseStatusCodeError(req, resp)
+	}
+
+	return nil
+}
+
 // GetZoneID gets the zone ID for a domain.
 func (c *Client) GetZoneID(ctx context.Context, domain string) (string, error) {
 	zones, err := c.getZones(ctx, domain)
@@ -157,7 +214,7 @@ func (c *Client) GetZoneID(ctx context.Context, domain string) (string, error) {
 
 // https://dns.hetzner.com/api-docs#operation/GetZones
 func (c *Client) getZones(ctx context.Context, name string) (*Zones, error) {
-	endpoint := c.baseURL.JoinPath("api", "v1", "zones")
+	endpoint := c.BaseURL.JoinPath("api", "v1", "zones")
 
 	query := endpoint.Query()
 	query.Set("name", name)
@@ -213,6 +270,8 @@ func (c *Client) newRequest(ctx context.Context, method string, endpoint *url.UR
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 
 	if payload != nil {
@@ -223,3 +282,19 @@ func (c *Client) newRequest(ctx context.Context, method string, endpoint *url.UR
 
 	return req, nil
 }
+
+func (c *Client) newZoneFileRequest(ctx context.Context, endpoint *url.URL, zoneFile string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), strings.NewReader(zoneFile))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %w", err)
+	}
+
+	useragent.SetHeader(req.Header)
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "text/plain")
+
+	req.Header.Set(authHeader, c.apiKey)
+
+	return req, nil
+}
@@ -22,7 +22,7 @@ func setupTest(t *testing.T, apiKey string) (*Client, *http.ServeMux) {
 	t.Cleanup(server.Close)
 
 	client := NewClient(apiKey)
-	client.baseURL, _ = url.Parse(server.URL)
+	client.BaseURL, _ = url.Parse(server.URL)
 	client.HTTPClient = server.Client()
 
 	return client, mux
@@ -174,3 +174,83 @@ func TestClient_GetZoneID(t *testing.T) {
 
 	assert.Equal(t, "zoneA", zoneID)
 }
+
+func TestClient_ExportZoneFile(t *testing.T) {
+	const apiKey = "myKeyE"
+
+	client, mux := setupTest(t, apiKey)
+
+	mux.HandleFunc("/api/v1/zones/zoneA/export", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		auth := req.Header.Get(authHeader)
+		if auth != apiKey {
+			http.Error(rw, fmt.Sprintf("invalid API key: %s", auth), http.StatusUnauthorized)
+			return
+		}
+
+		file, err := os.Open("./fixtures/export_zone_file.txt")
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer func() { _ = file.Close() }()
+
+		_, err = io.Copy(rw, file)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	zoneFile, err := client.ExportZoneFile(context.Background(), "zoneA")
+	require.NoError(t, err)
+
+	expected, err := os.ReadFile("./fixtures/export_zone_file.txt")
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expected), zoneFile)
+}
+
+func TestClient_ImportZoneFile(t *testing.T) {
+	const apiKey = "myKeyF"
+
+	client, mux := setupTest(t, apiKey)
+
+	const zoneFile = "www 86400 IN A 192.0.2.1\n"
+
+	mux.HandleFunc("/api/v1/zones/zoneA/import", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		auth := req.Header.Get(authHeader)
+		if auth != apiKey {
+			http.Error(rw, fmt.Sprintf("invalid API key: %s", auth), http.StatusUnauthorized)
+			return
+		}
+
+		if req.Header.Get("Content-Type") != "text/plain" {
+			http.Error(rw, fmt.Sprintf("invalid content type: %s", req.Header.Get("Content-Type")), http.StatusBadRequest)
+			return
+		}
+
+		raw, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if string(raw) != zoneFile {
+			http.Error(rw, fmt.Sprintf("invalid zone file: %q", string(raw)), http.StatusBadRequest)
+			return
+		}
+	})
+
+	err := client.ImportZoneFile(context.Background(), "zoneA", zoneFile)
+	require.NoError(t, err)
+}
@@ -0,0 +1,29 @@
+package hetzner
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/hetzner/hetzner.toml
+		Name:  "Hetzner",
+		Code:  "hetzner",
+		Since: "v3.7.0",
+		URL:   "https://hetzner.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "HETZNER_API_KEY", Description: "API key", Credential: true},
+			{Name: "HETZNER_BATCH_WINDOW", Description: "Enable the zone file import mode: the records created (or deleted) during the window (in seconds) are grouped in a single import, requires '--max-concurrent-authz' (Default: 0, disabled)"},
+			{Name: "HETZNER_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "HETZNER_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "HETZNER_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "HETZNER_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://dns.hetzner.com/api-docs",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,185 @@
+// Package hetznerrobot implements a DNS provider for solving the DNS-01 challenge using Hetzner Robot.
+package hetznerrobot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/go-acme/lego/v4/providers/dns/hetznerrobot/internal"
+)
+
+// Environment variables names.
+const (
+	envNamespace = "HETZNER_ROBOT_"
+
+	EnvUsername = envNamespace + "USERNAME"
+	EnvPassword = envNamespace + "PASSWORD"
+
+	EnvTTL                = envNamespace + "TTL"
+	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
+	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
+	EnvHTTPTimeout        = envNamespace + "HTTP_TIMEOUT"
+)
+
+// Config is used to configure the creation of the DNSProvider.
+type Config struct {
+	Username string
+	Password string
+
+	TTL                int
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+	HTTPClient         *http.Client
+}
+
+// NewDefaultConfig returns a default configuration for the DNSProvider.
+func NewDefaultConfig() *Config {
+	return &Config{
+		TTL:                env.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
+		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 5*time.Minute),
+		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 10*time.Second),
+		HTTPClient: &http.Client{
+			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+		},
+	}
+}
+
+// DNSProvider implements the challenge.Provider interface.
+type DNSProvider struct {
+	config *Config
+	client *internal.Client
+
+	// The zone file is updated as a whole: the read-modify-write must not be interleaved.
+	zoneFileMu sync.Mutex
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for Hetzner Robot.
+// Credentials must be passed in the environment variables:
+// HETZNER_ROBOT_USERNAME and HETZNER_ROBOT_PASSWORD.
+func NewDNSProvider() (*DNSProvider, error) {
+	values, err := env.Get(EnvUsername, EnvPassword)
+	if err != nil {
+		return nil, fmt.Errorf("hetznerrobot: %w", err)
+	}
+
+	config := NewDefaultConfig()
+	config.Username = values[EnvUsername]
+	config.Password = values[EnvPassword]
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewDNSProviderConfig return a DNSProvider instance configured for Hetzner Robot.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("hetznerrobot: the configuration of the DNS provider is nil")
+	}
+
+	client, err := internal.NewClient(config.Username, config.Password)
+	if err != nil {
+		return nil, fmt.Errorf("hetznerrobot: %w", err)
+	}
+
+	if config.HTTPClient != nil {
+		client.HTTPClient = config.HTTPClient
+	}
+
+	return &DNSProvider{
+		config: config,
+		client: client,
+	}, nil
+}
+
+// Timeout returns the timeout and interval to use when checking for DNS propagation.
+// Adjusting here to cope with spikes in propagation times.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
+
+// Present creates a TXT record to fulfill the dns-01 challenge.
+func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("hetznerrobot: could not find zone for domain %q: %w", domain, err)
+	}
+
+	err = d.addTXTRecord(context.Background(), authZone, info.EffectiveFQDN, info.Value)
+	if err != nil {
+		return fmt.Errorf("hetznerrobot: %w", err)
+	}
+
+	return nil
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("hetznerrobot: could not find zone for domain %q: %w", domain, err)
+	}
+
+	err = d.removeTXTRecord(context.Background(), authZone, info.EffectiveFQDN, info.Value)
+	if err != nil {
+		return fmt.Errorf("hetznerrobot: %w", err)
+	}
+
+	return nil
+}
+
+func (d *DNSProvider) addTXTRecord(ctx context.Context, authZone, fqdn, value string) error {
+	d.zoneFileMu.Lock()
+	defer d.zoneFileMu.Unlock()
+
+	zoneName := dns01.UnFqdn(authZone)
+
+	zoneFile, err := d.client.GetZoneFile(ctx, zoneName)
+	if err != nil {
+		return fmt.Errorf("get zone file: %w", err)
+	}
+
+	updated := internal.AddTXTRecord(zoneFile, authZone, fqdn, value, d.config.TTL)
+	if updated == zoneFile {
+		return nil
+	}
+
+	err = d.client.UpdateZoneFile(ctx, zoneName, updated)
+	if err != nil {
+		return fmt.Errorf("update zone file: %w", err)
+	}
+
+	return nil
+}
+
+func (d *DNSProvider) removeTXTRecord(ctx context.Context, authZone, fqdn, value string) error {
+	d.zoneFileMu.Lock()
+	defer d.zoneFileMu.Unlock()
+
+	zoneName := dns01.UnFqdn(authZone)
+
+	zoneFile, err := d.client.GetZoneFile(ctx, zoneName)
+	if err != nil {
+		return fmt.Errorf("get zone file: %w", err)
+	}
+
+	updated, found := internal.RemoveTXTRecord(zoneFile, authZone, fqdn, value)
+	if !found {
+		return nil
+	}
+
+	err = d.client.UpdateZoneFile(ctx, zoneName, updated)
+	if err != nil {
+		return fmt.Errorf("update zone file: %w", err)
+	}
+
+	return nil
+}
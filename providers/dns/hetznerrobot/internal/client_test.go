@@ -0,0 +1,106 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTest(t *testing.T, method, pattern string, status int, filename string) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc(pattern, func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != method {
+			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		username, password, ok := req.BasicAuth()
+		if !ok || username != "user" || password != "secret" {
+			http.Error(rw, "invalid credentials", http.StatusUnauthorized)
+			return
+		}
+
+		if req.Method == http.MethodPost {
+			err := req.ParseForm()
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			if req.PostForm.Get("zonefile") == "" {
+				http.Error(rw, "missing zonefile", http.StatusBadRequest)
+				return
+			}
+		}
+
+		file, err := os.Open(filepath.Join("fixtures", filename))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		defer func() { _ = file.Close() }()
+
+		rw.WriteHeader(status)
+
+		_, err = io.Copy(rw, file)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	client, err := NewClient("user", "secret")
+	require.NoError(t, err)
+
+	client.BaseURL, _ = url.Parse(server.URL)
+	client.HTTPClient = server.Client()
+
+	return client
+}
+
+func TestClient_GetZoneFile(t *testing.T) {
+	client := setupTest(t, http.MethodGet, "/zonefile/example.com", http.StatusOK, "get_zonefile.json")
+
+	zoneFile, err := client.GetZoneFile(context.Background(), "example.com")
+	require.NoError(t, err)
+
+	expected := "$TTL 86400\n@ IN SOA ns1.first-ns.de. postmaster.robot.first-ns.de. (2024061801 14400 1800 604800 86400)\n@ IN NS ns1.first-ns.de.\n@ IN A 192.0.2.1\n"
+
+	assert.Equal(t, expected, zoneFile)
+}
+
+func TestClient_GetZoneFile_error(t *testing.T) {
+	client := setupTest(t, http.MethodGet, "/zonefile/example.com", http.StatusUnauthorized, "error.json")
+
+	_, err := client.GetZoneFile(context.Background(), "example.com")
+	require.EqualError(t, err, "401: UNAUTHORIZED: Unauthorized")
+}
+
+func TestClient_UpdateZoneFile(t *testing.T) {
+	client := setupTest(t, http.MethodPost, "/zonefile/example.com", http.StatusOK, "update_zonefile.json")
+
+	err := client.UpdateZoneFile(context.Background(), "example.com", "@ IN A 192.0.2.1\n")
+	require.NoError(t, err)
+}
+
+func TestClient_UpdateZoneFile_error(t *testing.T) {
+	client := setupTest(t, http.MethodPost, "/zonefile/example.com", http.StatusUnauthorized, "error.json")
+
+	err := client.UpdateZoneFile(context.Background(), "example.com", "@ IN A 192.0.2.1\n")
+	require.EqualError(t, err, "401: UNAUTHORIZED: Unauthorized")
+}
@@ -0,0 +1,26 @@
+package internal
+
+import "fmt"
+
+type APIError struct {
+	Error *ErrorInfo `json:"error"`
+}
+
+type ErrorInfo struct {
+	Status  int    `json:"status"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *ErrorInfo) Error() string {
+	return fmt.Sprintf("%d: %s: %s", e.Status, e.Code, e.Message)
+}
+
+type ZoneFileResponse struct {
+	ZoneFile ZoneFile `json:"zonefile"`
+}
+
+type ZoneFile struct {
+	Domain   string `json:"domain"`
+	ZoneFile string `json:"zonefile"`
+}
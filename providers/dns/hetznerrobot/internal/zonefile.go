@@ -0,0 +1,226 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// AddTXTRecord adds a TXT record to the zone file.
+// The other lines of the zone file are kept unchanged.
+// The zone file is returned unchanged if the record already exists.
+func AddTXTRecord(zoneFile, origin, fqdn, value string, ttl int) string {
+	if len(findTXTRecords(zoneFile, origin, fqdn, value)) > 0 {
+		return zoneFile
+	}
+
+	line := fmt.Sprintf("%s %d IN TXT %s", relativeName(fqdn, origin), ttl, strconv.Quote(value))
+
+	if zoneFile != "" && !strings.HasSuffix(zoneFile, "\n") {
+		zoneFile += "\n"
+	}
+
+	return zoneFile + line + "\n"
+}
+
+// RemoveTXTRecord removes a TXT record from the zone file.
+// The other lines of the zone file are kept unchanged.
+// Returns false if the record has not been found.
+func RemoveTXTRecord(zoneFile, origin, fqdn, value string) (string, bool) {
+	matches := findTXTRecords(zoneFile, origin, fqdn, value)
+	if len(matches) == 0 {
+		return zoneFile, false
+	}
+
+	lines := strings.SplitAfter(zoneFile, "\n")
+
+	var sb strings.Builder
+	for i, line := range lines {
+		if !matches[i] {
+			sb.WriteString(line)
+		}
+	}
+
+	return sb.String(), true
+}
+
+// findTXTRecords returns the indexes of the lines defining the TXT record.
+// Only the records defined on a single line are considered.
+func findTXTRecords(zoneFile, origin, fqdn, value string) map[int]bool {
+	origin = dns.CanonicalName(origin)
+	fqdn = dns.CanonicalName(fqdn)
+
+	matches := map[int]bool{}
+
+	var owner string
+	depth := 0
+
+	for i, line := range strings.SplitAfter(zoneFile, "\n") {
+		fields, parens := tokenize(line)
+
+		// Inside a multi-line record (ex: SOA).
+		if depth > 0 || parens > 0 {
+			depth += parens
+
+			if depth == 0 {
+				continue
+			}
+
+			if len(fields) > 0 && !startsWithSpace(line) {
+				owner = absoluteName(fields[0], origin)
+			}
+
+			continue
+		}
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		if strings.HasPrefix(fields[0], "$") {
+			if strings.EqualFold(fields[0], "$ORIGIN") && len(fields) > 1 {
+				origin = absoluteName(fields[1], origin)
+			}
+
+			continue
+		}
+
+		if !startsWithSpace(line) {
+			owner = absoluteName(fields[0], origin)
+			fields = fields[1:]
+		}
+
+		rrType, rdata, ok := splitRecord(fields)
+		if !ok || !strings.EqualFold(rrType, "TXT") || owner != fqdn {
+			continue
+		}
+
+		if strings.Join(rdata, "") == value {
+			matches[i] = true
+		}
+	}
+
+	return matches
+}
+
+// splitRecord skips the optional TTL and class, and returns the type and the data of the record.
+func splitRecord(fields []string) (string, []string, bool) {
+	for i := 0; i < len(fields) && i < 3; i++ {
+		field := fields[i]
+
+		if _, err := strconv.ParseUint(field, 10, 32); err == nil {
+			continue
+		}
+
+		if _, ok := dns.StringToClass[strings.ToUpper(field)]; ok {
+			continue
+		}
+
+		if _, ok := dns.StringToType[strings.ToUpper(field)]; ok {
+			return field, unquote(fields[i+1:]), true
+		}
+
+		return "", nil, false
+	}
+
+	return "", nil, false
+}
+
+// tokenize splits a line into fields, the comments are ignored.
+// A quoted string is a single field and keeps its quotes.
+// Returns the balance of the parentheses outside the quoted strings.
+func tokenize(line string) ([]string, int) {
+	var fields []string
+	var current strings.Builder
+
+	var quoted, escaped bool
+	parens := 0
+
+	flush := func() {
+		if current.Len() > 0 {
+			fields = append(fields, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, c := range line {
+		switch {
+		case escaped:
+			current.WriteRune(c)
+			escaped = false
+		case c == '\\':
+			current.WriteRune(c)
+			escaped = true
+		case c == '"':
+			current.WriteRune(c)
+			quoted = !quoted
+		case quoted:
+			current.WriteRune(c)
+		case c == ';':
+			flush()
+			return fields, parens
+		case c == '(':
+			parens++
+			flush()
+		case c == ')':
+			parens--
+			flush()
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			flush()
+		default:
+			current.WriteRune(c)
+		}
+	}
+
+	flush()
+
+	return fields, parens
+}
+
+func unquote(fields []string) []string {
+	values := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		value, err := strconv.Unquote(field)
+		if err != nil {
+			value = strings.Trim(field, `"`)
+		}
+
+		values = append(values, value)
+	}
+
+	return values
+}
+
+func absoluteName(name, origin string) string {
+	if name == "@" {
+		return origin
+	}
+
+	if strings.HasSuffix(name, ".") {
+		return dns.CanonicalName(name)
+	}
+
+	return dns.CanonicalName(name + "." + origin)
+}
+
+func relativeName(fqdn, origin string) string {
+	fqdn = dns.CanonicalName(fqdn)
+	origin = dns.CanonicalName(origin)
+
+	if fqdn == origin {
+		return "@"
+	}
+
+	if name, ok := strings.CutSuffix(fqdn, "."+origin); ok {
+		return name
+	}
+
+	return fqdn
+}
+
+func startsWithSpace(line string) bool {
+	return line != "" && (line[0] == ' ' || line[0] == '\t')
+}
@@ -0,0 +1,141 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readZoneFile(t *testing.T) string {
+	t.Helper()
+
+	raw, err := os.ReadFile(filepath.Join("fixtures", "example.com.zone"))
+	require.NoError(t, err)
+
+	return string(raw)
+}
+
+func TestAddTXTRecord_roundTrip(t *testing.T) {
+	zoneFile := readZoneFile(t)
+
+	updated := AddTXTRecord(zoneFile, "example.com.", "_acme-challenge.example.com.", "txtTXTtxt", 120)
+
+	assert.Equal(t, zoneFile+"_acme-challenge 120 IN TXT \"txtTXTtxt\"\n", updated)
+
+	restored, ok := RemoveTXTRecord(updated, "example.com.", "_acme-challenge.example.com.", "txtTXTtxt")
+	require.True(t, ok)
+
+	assert.Equal(t, zoneFile, restored)
+}
+
+func TestAddTXTRecord(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		zoneFile string
+		fqdn     string
+		expected string
+	}{
+		{
+			desc:     "empty zone file",
+			fqdn:     "_acme-challenge.example.com.",
+			expected: "_acme-challenge 120 IN TXT \"value\"\n",
+		},
+		{
+			desc:     "missing trailing newline",
+			zoneFile: "@ IN A 192.0.2.1",
+			fqdn:     "_acme-challenge.example.com.",
+			expected: "@ IN A 192.0.2.1\n_acme-challenge 120 IN TXT \"value\"\n",
+		},
+		{
+			desc:     "apex",
+			zoneFile: "@ IN A 192.0.2.1\n",
+			fqdn:     "example.com.",
+			expected: "@ IN A 192.0.2.1\n@ 120 IN TXT \"value\"\n",
+		},
+		{
+			desc:     "already exists",
+			zoneFile: "_acme-challenge.example.com. IN TXT \"value\"\n",
+			fqdn:     "_acme-challenge.example.com.",
+			expected: "_acme-challenge.example.com. IN TXT \"value\"\n",
+		},
+		{
+			desc:     "same value on another name",
+			zoneFile: "_acme-challenge.sub IN TXT \"value\"\n",
+			fqdn:     "_acme-challenge.example.com.",
+			expected: "_acme-challenge.sub IN TXT \"value\"\n_acme-challenge 120 IN TXT \"value\"\n",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			updated := AddTXTRecord(test.zoneFile, "example.com.", test.fqdn, "value", 120)
+
+			assert.Equal(t, test.expected, updated)
+		})
+	}
+}
+
+func TestRemoveTXTRecord(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		zoneFile string
+		expected string
+		found    bool
+	}{
+		{
+			desc:     "relative name",
+			zoneFile: "@ IN A 192.0.2.1\n_acme-challenge 120 IN TXT \"value\"\nwww IN A 192.0.2.2\n",
+			expected: "@ IN A 192.0.2.1\nwww IN A 192.0.2.2\n",
+			found:    true,
+		},
+		{
+			desc:     "absolute name without TTL and with a comment",
+			zoneFile: "_acme-challenge.example.com. TXT \"value\" ; comment\nwww IN A 192.0.2.2\n",
+			expected: "www IN A 192.0.2.2\n",
+			found:    true,
+		},
+		{
+			desc:     "unquoted value and lowercase type",
+			zoneFile: "_ACME-CHALLENGE in txt value\n",
+			expected: "",
+			found:    true,
+		},
+		{
+			desc:     "$ORIGIN directive",
+			zoneFile: "$ORIGIN sub.example.com.\n_acme-challenge IN TXT \"value\"\n$ORIGIN example.com.\n_acme-challenge.sub IN TXT \"other\"\n",
+			expected: "$ORIGIN sub.example.com.\n_acme-challenge IN TXT \"value\"\n$ORIGIN example.com.\n_acme-challenge.sub IN TXT \"other\"\n",
+		},
+		{
+			desc:     "previous owner",
+			zoneFile: "_acme-challenge IN TXT \"existing\"\n                IN TXT \"value\"\n",
+			expected: "_acme-challenge IN TXT \"existing\"\n",
+			found:    true,
+		},
+		{
+			desc:     "other value",
+			zoneFile: "_acme-challenge IN TXT \"existing\"\n",
+			expected: "_acme-challenge IN TXT \"existing\"\n",
+		},
+		{
+			desc:     "multi-line record",
+			zoneFile: "@ IN SOA ns1.example.com. admin.example.com. (\n  1 ; serial\n  _acme-challenge IN TXT \"value\"\n)\n",
+			expected: "@ IN SOA ns1.example.com. admin.example.com. (\n  1 ; serial\n  _acme-challenge IN TXT \"value\"\n)\n",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			updated, found := RemoveTXTRecord(test.zoneFile, "example.com.", "_acme-challenge.example.com.", "value")
+
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, updated)
+		})
+	}
+}
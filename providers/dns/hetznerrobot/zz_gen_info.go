@@ -0,0 +1,29 @@
+package hetznerrobot
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/hetznerrobot/hetznerrobot.toml
+		Name:  "Hetzner Robot",
+		Code:  "hetznerrobot",
+		Since: "v4.18.0",
+		URL:   "https://robot.hetzner.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "HETZNER_ROBOT_PASSWORD", Description: "Webservice password", Credential: true},
+			{Name: "HETZNER_ROBOT_USERNAME", Description: "Webservice username", Credential: true},
+			{Name: "HETZNER_ROBOT_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "HETZNER_ROBOT_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "HETZNER_ROBOT_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "HETZNER_ROBOT_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://robot.hetzner.com/doc/webservice/en.html",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package hostingde
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/hostingde/hostingde.toml
+		Name:  "Hosting.de",
+		Code:  "hostingde",
+		Since: "v1.1.0",
+		URL:   "https://www.hosting.de/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "HOSTINGDE_API_KEY", Description: "API key", Credential: true},
+			{Name: "HOSTINGDE_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "HOSTINGDE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "HOSTINGDE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "HOSTINGDE_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+			{Name: "HOSTINGDE_ZONE_NAME", Description: "Zone name in ACE format"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.hosting.de/api/#dns",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,235 @@
+// Package hostinger implements a DNS provider for solving the DNS-01 challenge using Hostinger.
+package hostinger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/go-acme/lego/v4/providers/dns/hostinger/internal"
+)
+
+// Environment variables names.
+const (
+	envNamespace = "HOSTINGER_"
+
+	EnvAPIToken = envNamespace + "API_TOKEN"
+
+	EnvTTL                = envNamespace + "TTL"
+	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
+	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
+	EnvHTTPTimeout        = envNamespace + "HTTP_TIMEOUT"
+)
+
+// minTTL is the minimum TTL accepted by the API for a record set.
+const minTTL = 60
+
+// Config is used to configure the creation of the DNSProvider.
+type Config struct {
+	APIToken string
+
+	// TTL is the TTL of the record set containing the TXT record.
+	// The TTL is defined by record set: it also applies to the other values of the record set.
+	// A TTL lower than 60 seconds is replaced by 60 seconds.
+	TTL                int
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+	HTTPClient         *http.Client
+}
+
+// NewDefaultConfig returns a default configuration for the DNSProvider.
+func NewDefaultConfig() *Config {
+	return &Config{
+		TTL:                env.GetOrDefaultInt(EnvTTL, 300),
+		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		HTTPClient: &http.Client{
+			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+		},
+	}
+}
+
+// DNSProvider implements the challenge.Provider interface.
+type DNSProvider struct {
+	config *Config
+	client *internal.Client
+
+	// muZone serializes the read-modify-write of the record sets.
+	muZone sync.Mutex
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for Hostinger.
+// Credentials must be passed in the environment variable: HOSTINGER_API_TOKEN.
+func NewDNSProvider() (*DNSProvider, error) {
+	values, err := env.Get(EnvAPIToken)
+	if err != nil {
+		return nil, fmt.Errorf("hostinger: %w", err)
+	}
+
+	config := NewDefaultConfig()
+	config.APIToken = values[EnvAPIToken]
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewDNSProviderConfig return a DNSProvider instance configured for Hostinger.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("hostinger: the configuration of the DNS provider is nil")
+	}
+
+	client, err := internal.NewClient(config.APIToken)
+	if err != nil {
+		return nil, fmt.Errorf("hostinger: %w", err)
+	}
+
+	if config.HTTPClient != nil {
+		client.HTTPClient = config.HTTPClient
+	}
+
+	return &DNSProvider{config: config, client: client}, nil
+}
+
+// Timeout returns the timeout and interval to use when checking for DNS propagation.
+// Adjusting here to cope with spikes in propagation times.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
+
+// Present creates a TXT record using the specified parameters.
+// The value is appended to the existing TXT record set.
+func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	zone, err := d.findZone(ctx, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("hostinger: %w", err)
+	}
+
+	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, zone)
+	if err != nil {
+		return fmt.Errorf("hostinger: %w", err)
+	}
+
+	d.muZone.Lock()
+	defer d.muZone.Unlock()
+
+	recordSet, err := d.getTXTRecordSet(ctx, zone, subDomain)
+	if err != nil {
+		return fmt.Errorf("hostinger: %w", err)
+	}
+
+	if slices.ContainsFunc(recordSet.Records, matchValue(info.Value)) {
+		return nil
+	}
+
+	recordSet.Records = append(recordSet.Records, internal.Record{Content: info.Value})
+	recordSet.TTL = max(d.config.TTL, minTTL)
+
+	err = d.client.UpdateRecords(ctx, zone, true, *recordSet)
+	if err != nil {
+		return fmt.Errorf("hostinger: update records: %w", err)
+	}
+
+	return nil
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+// The other values of the TXT record set are kept.
+func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	zone, err := d.findZone(ctx, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("hostinger: %w", err)
+	}
+
+	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, zone)
+	if err != nil {
+		return fmt.Errorf("hostinger: %w", err)
+	}
+
+	d.muZone.Lock()
+	defer d.muZone.Unlock()
+
+	recordSet, err := d.getTXTRecordSet(ctx, zone, subDomain)
+	if err != nil {
+		return fmt.Errorf("hostinger: %w", err)
+	}
+
+	records := slices.DeleteFunc(slices.Clone(recordSet.Records), matchValue(info.Value))
+	if len(records) == len(recordSet.Records) {
+		return nil
+	}
+
+	if len(records) == 0 {
+		err = d.client.DeleteRecords(ctx, zone, internal.Filter{Name: subDomain, Type: "TXT"})
+		if err != nil {
+			return fmt.Errorf("hostinger: delete records: %w", err)
+		}
+
+		return nil
+	}
+
+	recordSet.Records = records
+
+	err = d.client.UpdateRecords(ctx, zone, true, *recordSet)
+	if err != nil {
+		return fmt.Errorf("hostinger: update records: %w", err)
+	}
+
+	return nil
+}
+
+// getTXTRecordSet returns the current TXT record set of the subdomain, or an empty one if it doesn't exist.
+func (d *DNSProvider) getTXTRecordSet(ctx context.Context, zone, subDomain string) (*internal.RecordSet, error) {
+	recordSets, err := d.client.GetRecords(ctx, zone)
+	if err != nil {
+		return nil, fmt.Errorf("get records: %w", err)
+	}
+
+	for _, recordSet := range recordSets {
+		if recordSet.Type == "TXT" && strings.EqualFold(recordSet.Name, subDomain) {
+			return &recordSet, nil
+		}
+	}
+
+	return &internal.RecordSet{Name: subDomain, Type: "TXT"}, nil
+}
+
+// findZone returns the most specific domain, of the portfolio, containing the FQDN.
+func (d *DNSProvider) findZone(ctx context.Context, fqdn string) (string, error) {
+	domains, err := d.client.ListDomains(ctx)
+	if err != nil {
+		return "", fmt.Errorf("list domains: %w", err)
+	}
+
+	var names []string
+	for _, domain := range domains {
+		names = append(names, domain.Domain)
+	}
+
+	zone := dns01.FindMostSpecificZone(fqdn, names)
+	if zone == "" {
+		return "", fmt.Errorf("no zone found for %s", fqdn)
+	}
+
+	return zone, nil
+}
+
+func matchValue(value string) func(internal.Record) bool {
+	return func(record internal.Record) bool {
+		return strings.Trim(record.Content, `"`) == value
+	}
+}
@@ -0,0 +1,184 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
+)
+
+const defaultBaseURL = "https://developers.hostinger.com"
+
+const authorizationHeader = "Authorization"
+
+// Client the Hostinger API client.
+type Client struct {
+	apiToken string
+
+	BaseURL    *url.URL
+	HTTPClient *http.Client
+}
+
+// NewClient creates a new Client.
+func NewClient(apiToken string) (*Client, error) {
+	if apiToken == "" {
+		return nil, errors.New("credentials missing")
+	}
+
+	baseURL, _ := url.Parse(defaultBaseURL)
+
+	return &Client{
+		apiToken:   apiToken,
+		BaseURL:    baseURL,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// ListDomains lists the domains of the portfolio.
+// https://developers.hostinger.com/#tag/domains-portfolio/GET/api/domains/v1/portfolio
+func (c *Client) ListDomains(ctx context.Context) ([]Domain, error) {
+	endpoint := c.BaseURL.JoinPath("api", "domains", "v1", "portfolio")
+
+	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Domain
+
+	err = c.do(req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetRecords gets the record sets of a zone.
+// https://developers.hostinger.com/#tag/dns-zone/GET/api/dns/v1/zones/{domain}
+func (c *Client) GetRecords(ctx context.Context, domain string) ([]RecordSet, error) {
+	endpoint := c.BaseURL.JoinPath("api", "dns", "v1", "zones", domain)
+
+	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []RecordSet
+
+	err = c.do(req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateRecords updates the record sets of a zone.
+// With overwrite, the record sets matching the name and the type of the provided ones are replaced,
+// otherwise the records are appended to the existing record sets.
+// https://developers.hostinger.com/#tag/dns-zone/PUT/api/dns/v1/zones/{domain}
+func (c *Client) UpdateRecords(ctx context.Context, domain string, overwrite bool, recordSets ...RecordSet) error {
+	endpoint := c.BaseURL.JoinPath("api", "dns", "v1", "zones", domain)
+
+	payload := UpdateZoneRequest{Overwrite: overwrite, Zone: recordSets}
+
+	req, err := newJSONRequest(ctx, http.MethodPut, endpoint, payload)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+// DeleteRecords deletes the record sets of a zone matching the filters.
+// https://developers.hostinger.com/#tag/dns-zone/DELETE/api/dns/v1/zones/{domain}
+func (c *Client) DeleteRecords(ctx context.Context, domain string, filters ...Filter) error {
+	endpoint := c.BaseURL.JoinPath("api", "dns", "v1", "zones", domain)
+
+	payload := DeleteRecordSetsRequest{Filters: filters}
+
+	req, err := newJSONRequest(ctx, http.MethodDelete, endpoint, payload)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *Client) do(req *http.Request, result any) error {
+	req.Header.Set(authorizationHeader, "Bearer "+c.apiToken)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return errutils.NewHTTPDoError(req, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode/100 != 2 {
+		return parseError(req, resp)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errutils.NewReadResponseError(req, resp.StatusCode, err)
+	}
+
+	err = json.Unmarshal(raw, result)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
+	}
+
+	return nil
+}
+
+func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, payload any) (*http.Request, error) {
+	buf := new(bytes.Buffer)
+
+	if payload != nil {
+		err := json.NewEncoder(buf).Encode(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request JSON body: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint.String(), buf)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %w", err)
+	}
+
+	useragent.SetHeader(req.Header)
+
+	req.Header.Set("Accept", "application/json")
+
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+func parseError(req *http.Request, resp *http.Response) error {
+	raw, _ := io.ReadAll(resp.Body)
+
+	var errAPI APIError
+	err := json.Unmarshal(raw, &errAPI)
+	if err != nil || errAPI.Message == "" {
+		return errutils.NewUnexpectedStatusCodeError(req, resp.StatusCode, raw)
+	}
+
+	return fmt.Errorf("%d: %w", resp.StatusCode, &errAPI)
+}
@@ -0,0 +1,160 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTest(t *testing.T, method, pattern string, status int, filename, expectedRequest string) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc(pattern, func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != method {
+			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		if req.Header.Get(authorizationHeader) != "Bearer secret" {
+			http.Error(rw, fmt.Sprintf("invalid authorization header: %q", req.Header.Get(authorizationHeader)), http.StatusUnauthorized)
+			return
+		}
+
+		if expectedRequest != "" {
+			expected, err := os.ReadFile(filepath.Join("fixtures", expectedRequest))
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			if !jsonEqual(expected, body) {
+				http.Error(rw, fmt.Sprintf("invalid request body: %s", string(body)), http.StatusBadRequest)
+				return
+			}
+		}
+
+		file, err := os.Open(filepath.Join("fixtures", filename))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		defer func() { _ = file.Close() }()
+
+		rw.WriteHeader(status)
+
+		_, err = io.Copy(rw, file)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	client, err := NewClient("secret")
+	require.NoError(t, err)
+
+	client.HTTPClient = server.Client()
+	client.BaseURL, _ = url.Parse(server.URL)
+
+	return client
+}
+
+func jsonEqual(a, b []byte) bool {
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(va, vb)
+}
+
+func TestClient_ListDomains(t *testing.T) {
+	client := setupTest(t, http.MethodGet, "/api/domains/v1/portfolio", http.StatusOK, "domains.json", "")
+
+	domains, err := client.ListDomains(context.Background())
+	require.NoError(t, err)
+
+	expected := []Domain{
+		{ID: 1034358, Domain: "example.com", Type: "domain", Status: "active"},
+		{ID: 1034359, Domain: "sub.example.com", Type: "domain", Status: "active"},
+	}
+
+	assert.Equal(t, expected, domains)
+}
+
+func TestClient_ListDomains_error(t *testing.T) {
+	client := setupTest(t, http.MethodGet, "/api/domains/v1/portfolio", http.StatusUnauthorized, "error.json", "")
+
+	_, err := client.ListDomains(context.Background())
+	require.EqualError(t, err, "401: Unauthenticated. [correlation ID: 4fc1ca0a-9c5a-4b6e-9c4b-7c8b2a1f3d5e]")
+}
+
+func TestClient_GetRecords(t *testing.T) {
+	client := setupTest(t, http.MethodGet, "/api/dns/v1/zones/example.com", http.StatusOK, "records.json", "")
+
+	recordSets, err := client.GetRecords(context.Background(), "example.com")
+	require.NoError(t, err)
+
+	expected := []RecordSet{
+		{Name: "@", Records: []Record{{Content: "192.0.2.1"}}, TTL: 14400, Type: "A"},
+		{Name: "_acme-challenge", Records: []Record{{Content: "existing"}}, TTL: 300, Type: "TXT"},
+	}
+
+	assert.Equal(t, expected, recordSets)
+}
+
+func TestClient_UpdateRecords(t *testing.T) {
+	client := setupTest(t, http.MethodPut, "/api/dns/v1/zones/example.com", http.StatusOK, "success.json", "update_records-request.json")
+
+	recordSet := RecordSet{
+		Name:    "_acme-challenge",
+		Records: []Record{{Content: "existing"}, {Content: "txtTXTtxt"}},
+		TTL:     120,
+		Type:    "TXT",
+	}
+
+	err := client.UpdateRecords(context.Background(), "example.com", true, recordSet)
+	require.NoError(t, err)
+}
+
+func TestClient_UpdateRecords_error(t *testing.T) {
+	client := setupTest(t, http.MethodPut, "/api/dns/v1/zones/example.com", http.StatusUnprocessableEntity, "error_validation.json", "")
+
+	recordSet := RecordSet{
+		Name:    "_acme-challenge",
+		Records: []Record{{Content: "txtTXTtxt"}},
+		TTL:     10,
+		Type:    "TXT",
+	}
+
+	err := client.UpdateRecords(context.Background(), "example.com", true, recordSet)
+	require.EqualError(t, err, "422: The zone.0.ttl field must be at least 60. (zone.0.ttl: The zone.0.ttl field must be at least 60.) [correlation ID: 4fc1ca0a-9c5a-4b6e-9c4b-7c8b2a1f3d5e]")
+}
+
+func TestClient_DeleteRecords(t *testing.T) {
+	client := setupTest(t, http.MethodDelete, "/api/dns/v1/zones/example.com", http.StatusOK, "success.json", "delete_records-request.json")
+
+	err := client.DeleteRecords(context.Background(), "example.com", Filter{Name: "_acme-challenge", Type: "TXT"})
+	require.NoError(t, err)
+}
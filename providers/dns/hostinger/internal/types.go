@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type APIError struct {
+	Message       string              `json:"message"`
+	Errors        map[string][]string `json:"errors,omitempty"`
+	CorrelationID string              `json:"correlation_id,omitempty"`
+}
+
+func (a *APIError) Error() string {
+	msg := a.Message
+
+	if len(a.Errors) > 0 {
+		var fields []string
+		for field := range a.Errors {
+			fields = append(fields, field)
+		}
+
+		sort.Strings(fields)
+
+		var details []string
+		for _, field := range fields {
+			details = append(details, fmt.Sprintf("%s: %s", field, strings.Join(a.Errors[field], ", ")))
+		}
+
+		msg += fmt.Sprintf(" (%s)", strings.Join(details, "; "))
+	}
+
+	if a.CorrelationID != "" {
+		msg += fmt.Sprintf(" [correlation ID: %s]", a.CorrelationID)
+	}
+
+	return msg
+}
+
+type Domain struct {
+	ID     int    `json:"id,omitempty"`
+	Domain string `json:"domain,omitempty"`
+	Type   string `json:"type,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// RecordSet is a set of records sharing the same name and type.
+type RecordSet struct {
+	Name    string   `json:"name"`
+	Records []Record `json:"records"`
+	TTL     int      `json:"ttl"`
+	Type    string   `json:"type"`
+}
+
+type Record struct {
+	Content    string `json:"content"`
+	IsDisabled bool   `json:"is_disabled,omitempty"`
+}
+
+type UpdateZoneRequest struct {
+	Overwrite bool        `json:"overwrite"`
+	Zone      []RecordSet `json:"zone"`
+}
+
+type DeleteRecordSetsRequest struct {
+	Filters []Filter `json:"filters"`
+}
+
+type Filter struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
@@ -0,0 +1,28 @@
+package hostinger
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/hostinger/hostinger.toml
+		Name:  "Hostinger",
+		Code:  "hostinger",
+		Since: "v4.18.0",
+		URL:   "https://www.hostinger.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "HOSTINGER_API_TOKEN", Description: "API token", Credential: true},
+			{Name: "HOSTINGER_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "HOSTINGER_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "HOSTINGER_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "HOSTINGER_TTL", Description: "The TTL of the TXT record set used for the DNS challenge (minimum: 60)"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developers.hostinger.com/",
+			GoClient: "",
+		},
+	}
+}
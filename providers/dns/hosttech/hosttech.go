@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,6 +16,9 @@ import (
 	"github.com/go-acme/lego/v4/providers/dns/hosttech/internal"
 )
 
+// minTTL is the minimum TTL accepted by the API.
+const minTTL = 600
+
 // Environment variables names.
 const (
 	envNamespace = "HOSTTECH_"
@@ -53,8 +57,13 @@ type DNSProvider struct {
 	config *Config
 	client *internal.Client
 
-	recordIDs   map[string]int
-	recordIDsMu sync.Mutex
+	records   map[string]recordRef
+	recordsMu sync.Mutex
+}
+
+type recordRef struct {
+	zoneID   int
+	recordID int
 }
 
 // NewDNSProvider returns a DNSProvider instance configured for hosttech.
@@ -81,12 +90,16 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		return nil, errors.New("hosttech: missing credentials")
 	}
 
+	if config.TTL < minTTL {
+		return nil, fmt.Errorf("hosttech: invalid TTL, TTL (%d) must be greater than %d", config.TTL, minTTL)
+	}
+
 	client := internal.NewClient(internal.OAuthStaticAccessToken(config.HTTPClient, config.APIKey))
 
 	return &DNSProvider{
-		config:    config,
-		client:    client,
-		recordIDs: map[string]int{},
+		config:  config,
+		client:  client,
+		records: map[string]recordRef{},
 	}, nil
 }
 
@@ -100,19 +113,14 @@ func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
-	if err != nil {
-		return fmt.Errorf("hosttech: could not find zone for domain %q: %w", domain, err)
-	}
-
 	ctx := context.Background()
 
-	zone, err := d.client.GetZone(ctx, dns01.UnFqdn(authZone))
+	zone, err := d.findZone(ctx, info.EffectiveFQDN)
 	if err != nil {
-		return fmt.Errorf("hosttech: could not find zone for domain %q (%s): %w", domain, authZone, err)
+		return fmt.Errorf("hosttech: could not find zone for domain %q: %w", domain, err)
 	}
 
-	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, authZone)
+	subDomain, err := dns01.ExtractSubDomain(strings.ToLower(info.EffectiveFQDN), zone.Name)
 	if err != nil {
 		return fmt.Errorf("hosttech: %w", err)
 	}
@@ -129,9 +137,9 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		return fmt.Errorf("hosttech: %w", err)
 	}
 
-	d.recordIDsMu.Lock()
-	d.recordIDs[token] = newRecord.ID
-	d.recordIDsMu.Unlock()
+	d.recordsMu.Lock()
+	d.records[token] = recordRef{zoneID: zone.ID, recordID: newRecord.ID}
+	d.recordsMu.Unlock()
 
 	return nil
 }
@@ -140,30 +148,55 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
-	if err != nil {
-		return fmt.Errorf("hosttech: could not find zone for domain %q: %w", domain, err)
-	}
-
-	ctx := context.Background()
-
-	zone, err := d.client.GetZone(ctx, dns01.UnFqdn(authZone))
-	if err != nil {
-		return fmt.Errorf("hosttech: could not find zone for domain %q (%s): %w", domain, authZone, err)
-	}
-
 	// gets the record's unique ID from when we created it
-	d.recordIDsMu.Lock()
-	recordID, ok := d.recordIDs[token]
-	d.recordIDsMu.Unlock()
+	d.recordsMu.Lock()
+	ref, ok := d.records[token]
+	d.recordsMu.Unlock()
+
 	if !ok {
 		return fmt.Errorf("hosttech: unknown record ID for '%s' '%s'", info.EffectiveFQDN, token)
 	}
 
-	err = d.client.DeleteRecord(ctx, strconv.Itoa(zone.ID), strconv.Itoa(recordID))
+	err := d.client.DeleteRecord(context.Background(), strconv.Itoa(ref.zoneID), strconv.Itoa(ref.recordID))
 	if err != nil {
 		return fmt.Errorf("hosttech: %w", err)
 	}
 
+	d.recordsMu.Lock()
+	delete(d.records, token)
+	d.recordsMu.Unlock()
+
 	return nil
 }
+
+// findZone returns the most specific zone, of the account, containing the FQDN.
+func (d *DNSProvider) findZone(ctx context.Context, fqdn string) (*internal.Zone, error) {
+	const limit = 100
+
+	ids := make(map[string]int)
+
+	var names []string
+
+	for offset := 0; ; offset += limit {
+		zones, err := d.client.GetZones(ctx, "", limit, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, z := range zones {
+			ids[z.Name] = z.ID
+			names = append(names, z.Name)
+		}
+
+		if len(zones) < limit {
+			break
+		}
+	}
+
+	name := dns01.FindMostSpecificZone(fqdn, names)
+	if name == "" {
+		return nil, fmt.Errorf("no zone found for %s", fqdn)
+	}
+
+	return &internal.Zone{ID: ids[name], Name: strings.ToLower(dns01.UnFqdn(name))}, nil
+}
@@ -1,10 +1,18 @@
 package hosttech
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/providers/dns/hosttech/internal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -55,22 +63,32 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	testCases := []struct {
 		desc     string
 		apiKey   string
+		ttl      int
 		expected string
 	}{
 		{
 			desc:   "success",
 			apiKey: "secret",
+			ttl:    minTTL,
 		},
 		{
 			desc:     "missing API key",
+			ttl:      minTTL,
 			expected: "hosttech: missing credentials",
 		},
+		{
+			desc:     "invalid TTL",
+			apiKey:   "secret",
+			ttl:      60,
+			expected: "hosttech: invalid TTL, TTL (60) must be greater than 600",
+		},
 	}
 
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
 			config := NewDefaultConfig()
 			config.APIKey = test.apiKey
+			config.TTL = test.ttl
 
 			p, err := NewDNSProviderConfig(config)
 
@@ -86,6 +104,107 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func setupTest(t *testing.T) (*DNSProvider, map[string]internal.Record) {
+	t.Helper()
+
+	records := map[string]internal.Record{}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("GET /user/v1/zones", func(rw http.ResponseWriter, req *http.Request) {
+		// The zones are split into 2 pages.
+		zones := []internal.Zone{{ID: 10, Name: "example.com"}}
+		if req.URL.Query().Get("offset") == "" {
+			zones = nil
+			for i := range 100 {
+				zones = append(zones, internal.Zone{ID: 100 + i, Name: fmt.Sprintf("example%d.org", i)})
+			}
+		}
+
+		_ = json.NewEncoder(rw).Encode(map[string]any{"data": zones})
+	})
+
+	mux.HandleFunc("POST /user/v1/zones/10/records", func(rw http.ResponseWriter, req *http.Request) {
+		record := internal.Record{}
+		err := json.NewDecoder(req.Body).Decode(&record)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		record.ID = len(records) + 1
+		records[strconv.Itoa(record.ID)] = record
+
+		rw.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(rw).Encode(map[string]any{"data": record})
+	})
+
+	mux.HandleFunc("DELETE /user/v1/zones/10/records/{id}", func(rw http.ResponseWriter, req *http.Request) {
+		id := req.PathValue("id")
+
+		if _, ok := records[id]; !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			_, _ = rw.Write([]byte(`{"message":"record not found"}`))
+			return
+		}
+
+		delete(records, id)
+
+		rw.WriteHeader(http.StatusNoContent)
+	})
+
+	config := NewDefaultConfig()
+	config.APIKey = "secret"
+	config.HTTPClient = server.Client()
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.client.BaseURL, _ = url.Parse(server.URL)
+
+	return provider, records
+}
+
+func TestDNSProvider_Present_CleanUp(t *testing.T) {
+	provider, records := setupTest(t)
+
+	err := provider.Present("foo.example.com", "tokenA", "keyAuthA")
+	require.NoError(t, err)
+
+	expected := internal.Record{
+		ID:   1,
+		Type: "TXT",
+		Name: "_acme-challenge.foo",
+		Text: "Yn5FYIdZpKGY0Gl17AM7lOlQID5C4_II6Blwe54e4rk",
+		TTL:  3600,
+	}
+
+	assert.Equal(t, map[string]internal.Record{"1": expected}, records)
+
+	err = provider.CleanUp("foo.example.com", "tokenA", "keyAuthA")
+	require.NoError(t, err)
+
+	assert.Empty(t, records)
+}
+
+func TestDNSProvider_Present_unknownDomain(t *testing.T) {
+	provider, _ := setupTest(t)
+
+	err := provider.Present("example.net", "tokenA", "keyAuthA")
+	require.EqualError(t, err, `hosttech: could not find zone for domain "example.net": no zone found for _acme-challenge.example.net.`)
+}
+
+func TestDNSProvider_CleanUp_error(t *testing.T) {
+	provider, _ := setupTest(t)
+
+	provider.records["tokenA"] = recordRef{zoneID: 10, recordID: 42}
+
+	err := provider.CleanUp("example.com", "tokenA", "keyAuthA")
+	require.EqualError(t, err, "hosttech: 404: record not found")
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 	"golang.org/x/oauth2"
 )
 
@@ -19,8 +20,8 @@ const defaultBaseURL = "https://api.ns1.hosttech.eu/api"
 
 // Client a Hosttech client.
 type Client struct {
-	baseURL    *url.URL
-	httpClient *http.Client
+	BaseURL    *url.URL
+	HTTPClient *http.Client
 }
 
 // NewClient creates a new Client.
@@ -31,13 +32,13 @@ func NewClient(hc *http.Client) *Client {
 		hc = &http.Client{Timeout: 10 * time.Second}
 	}
 
-	return &Client{baseURL: baseURL, httpClient: hc}
+	return &Client{BaseURL: baseURL, HTTPClient: hc}
 }
 
 // GetZones Get a list of all zones.
 // https://api.ns1.hosttech.eu/api/documentation/#/Zones/get_api_user_v1_zones
 func (c Client) GetZones(ctx context.Context, query string, limit, offset int) ([]Zone, error) {
-	endpoint := c.baseURL.JoinPath("user", "v1", "zones")
+	endpoint := c.BaseURL.JoinPath("user", "v1", "zones")
 
 	values := endpoint.Query()
 	values.Set("query", query)
@@ -69,7 +70,7 @@ func (c Client) GetZones(ctx context.Context, query string, limit, offset int) (
 // GetZone Get a single zone.
 // https://api.ns1.hosttech.eu/api/documentation/#/Zones/get_api_user_v1_zones__zoneId_
 func (c Client) GetZone(ctx context.Context, zoneID string) (*Zone, error) {
-	endpoint := c.baseURL.JoinPath("user", "v1", "zones", zoneID)
+	endpoint := c.BaseURL.JoinPath("user", "v1", "zones", zoneID)
 
 	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
@@ -88,7 +89,7 @@ func (c Client) GetZone(ctx context.Context, zoneID string) (*Zone, error) {
 // GetRecords Returns a list of all records for the given zone.
 // https://api.ns1.hosttech.eu/api/documentation/#/Records/get_api_user_v1_zones__zoneId__records
 func (c Client) GetRecords(ctx context.Context, zoneID, recordType string) ([]Record, error) {
-	endpoint := c.baseURL.JoinPath("user", "v1", "zones", zoneID, "records")
+	endpoint := c.BaseURL.JoinPath("user", "v1", "zones", zoneID, "records")
 
 	values := endpoint.Query()
 
@@ -115,7 +116,7 @@ func (c Client) GetRecords(ctx context.Context, zoneID, recordType string) ([]Re
 // AddRecord Adds a new record to the zone and returns the newly created record.
 // https://api.ns1.hosttech.eu/api/documentation/#/Records/post_api_user_v1_zones__zoneId__records
 func (c Client) AddRecord(ctx context.Context, zoneID string, record Record) (*Record, error) {
-	endpoint := c.baseURL.JoinPath("user", "v1", "zones", zoneID, "records")
+	endpoint := c.BaseURL.JoinPath("user", "v1", "zones", zoneID, "records")
 
 	req, err := newJSONRequest(ctx, http.MethodPost, endpoint, record)
 	if err != nil {
@@ -134,7 +135,7 @@ func (c Client) AddRecord(ctx context.Context, zoneID string, record Record) (*R
 // DeleteRecord Deletes a single record for the given id.
 // https://api.ns1.hosttech.eu/api/documentation/#/Records/delete_api_user_v1_zones__zoneId__records__recordId_
 func (c Client) DeleteRecord(ctx context.Context, zoneID, recordID string) error {
-	endpoint := c.baseURL.JoinPath("user", "v1", "zones", zoneID, "records", recordID)
+	endpoint := c.BaseURL.JoinPath("user", "v1", "zones", zoneID, "records", recordID)
 
 	req, err := newJSONRequest(ctx, http.MethodDelete, endpoint, nil)
 	if err != nil {
@@ -145,7 +146,7 @@ func (c Client) DeleteRecord(ctx context.Context, zoneID, recordID string) error
 }
 
 func (c Client) do(req *http.Request, result any) error {
-	resp, errD := c.httpClient.Do(req)
+	resp, errD := c.HTTPClient.Do(req)
 	if errD != nil {
 		return errutils.NewHTTPDoError(req, errD)
 	}
@@ -189,6 +190,8 @@ func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, paylo
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 
 	if payload != nil {
@@ -223,7 +223,7 @@ func setupTest(t *testing.T, path string, handler http.Handler) *Client {
 	mux.Handle(path, handler)
 
 	client := NewClient(OAuthStaticAccessToken(server.Client(), testAPIKey))
-	client.baseURL, _ = url.Parse(server.URL)
+	client.BaseURL, _ = url.Parse(server.URL)
 
 	return client
 }
@@ -0,0 +1,28 @@
+package hosttech
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/hosttech/hosttech.toml
+		Name:  "Hosttech",
+		Code:  "hosttech",
+		Since: "v4.5.0",
+		URL:   "https://www.hosttech.eu/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "HOSTTECH_API_KEY", Description: "API token", Credential: true},
+			{Name: "HOSTTECH_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "HOSTTECH_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "HOSTTECH_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "HOSTTECH_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.ns1.hosttech.eu/api/documentation",
+			GoClient: "",
+		},
+	}
+}
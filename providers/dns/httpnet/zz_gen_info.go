@@ -0,0 +1,29 @@
+package httpnet
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/httpnet/httpnet.toml
+		Name:  "http.net",
+		Code:  "httpnet",
+		Since: "v4.15.0",
+		URL:   "https://www.http.net/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "HTTPNET_API_KEY", Description: "API key", Credential: true},
+			{Name: "HTTPNET_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "HTTPNET_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "HTTPNET_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "HTTPNET_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+			{Name: "HTTPNET_ZONE_NAME", Description: "Zone name in ACE format"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.http.net/docs/api/#dns",
+			GoClient: "",
+		},
+	}
+}
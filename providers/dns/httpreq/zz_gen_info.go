@@ -0,0 +1,26 @@
+package httpreq
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/httpreq/httpreq.toml
+		Name:  "HTTP request",
+		Code:  "httpreq",
+		Since: "v2.0.0",
+		URL:   "/lego/dns/httpreq/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "HTTPREQ_ENDPOINT", Description: "The URL of the server", Credential: true},
+			{Name: "HTTPREQ_MODE", Description: "`RAW`, none", Credential: true},
+			{Name: "HTTPREQ_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "HTTPREQ_PASSWORD", Description: "Basic authentication password"},
+			{Name: "HTTPREQ_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "HTTPREQ_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "HTTPREQ_USERNAME", Description: "Basic authentication username"},
+		},
+	}
+}
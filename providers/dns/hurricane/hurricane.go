@@ -120,17 +120,30 @@ func (d *DNSProvider) Sequential() time.Duration {
 	return d.config.SequenceInterval
 }
 
+// parseCredentials parses the record name/token pairs (`name:token,name:token`).
+// The record names are normalized: lowercase, without the `_acme-challenge.` prefix and the trailing dot.
 func parseCredentials(raw string) (map[string]string, error) {
 	credentials := make(map[string]string)
 
 	credStrings := strings.Split(strings.TrimSuffix(raw, ","), ",")
 	for _, credPair := range credStrings {
-		data := strings.Split(credPair, ":")
-		if len(data) != 2 {
+		name, token, ok := strings.Cut(credPair, ":")
+		if !ok {
 			return nil, fmt.Errorf("incorrect credential pair: %s", credPair)
 		}
 
-		credentials[strings.TrimSpace(data[0])] = strings.TrimSpace(data[1])
+		name = strings.TrimPrefix(strings.ToLower(dns01.UnFqdn(strings.TrimSpace(name))), "_acme-challenge.")
+		token = strings.TrimSpace(token)
+
+		if name == "" || token == "" {
+			return nil, fmt.Errorf("incorrect credential pair: %s", credPair)
+		}
+
+		if _, exists := credentials[name]; exists {
+			return nil, fmt.Errorf("duplicate credential for the record name: %s", name)
+		}
+
+		credentials[name] = token
 	}
 
 	return credentials, nil
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -114,6 +115,81 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func Test_parseCredentials(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		raw      string
+		expected map[string]string
+		errorMsg string
+	}{
+		{
+			desc:     "one pair",
+			raw:      "example.org:123",
+			expected: map[string]string{"example.org": "123"},
+		},
+		{
+			desc: "multiple pairs",
+			raw:  "example.org:123,my.example.com:456",
+			expected: map[string]string{
+				"example.org":    "123",
+				"my.example.com": "456",
+			},
+		},
+		{
+			desc:     "trailing comma",
+			raw:      "example.org:123,",
+			expected: map[string]string{"example.org": "123"},
+		},
+		{
+			desc: "spaces",
+			raw:  " example.org : 123 , example.com:456",
+			expected: map[string]string{
+				"example.org": "123",
+				"example.com": "456",
+			},
+		},
+		{
+			desc:     "challenge FQDN",
+			raw:      "_acme-challenge.Example.org.:123",
+			expected: map[string]string{"example.org": "123"},
+		},
+		{
+			desc:     "missing token",
+			raw:      "example.org:",
+			errorMsg: "incorrect credential pair: example.org:",
+		},
+		{
+			desc:     "missing name",
+			raw:      ":123",
+			errorMsg: "incorrect credential pair: :123",
+		},
+		{
+			desc:     "missing separator",
+			raw:      "example.org",
+			errorMsg: "incorrect credential pair: example.org",
+		},
+		{
+			desc:     "duplicate name",
+			raw:      "example.org:123,_acme-challenge.example.org:456",
+			errorMsg: "duplicate credential for the record name: example.org",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			credentials, err := parseCredentials(test.raw)
+
+			if test.errorMsg != "" {
+				require.EqualError(t, err, test.errorMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, credentials)
+		})
+	}
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 	"golang.org/x/time/rate"
 )
 
@@ -53,14 +54,14 @@ func NewClient(credentials map[string]string) *Client {
 
 // UpdateTxtRecord updates a TXT record.
 func (c *Client) UpdateTxtRecord(ctx context.Context, hostname string, txt string) error {
-	domain := strings.TrimPrefix(hostname, "_acme-challenge.")
+	domain := strings.TrimPrefix(strings.ToLower(hostname), "_acme-challenge.")
 
 	c.credMu.Lock()
 	token, ok := c.credentials[domain]
 	c.credMu.Unlock()
 
 	if !ok {
-		return fmt.Errorf("domain %s not found in credentials, check your credentials map", domain)
+		return fmt.Errorf("no token for the TXT record %s: the credentials map must contain an entry for %s", hostname, domain)
 	}
 
 	data := url.Values{}
@@ -73,6 +74,8 @@ func (c *Client) UpdateTxtRecord(ctx context.Context, hostname string, txt strin
 		return fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 	rl, _ := c.rateLimiters.LoadOrStore(hostname, rate.NewLimiter(limit(defaultBurst), defaultBurst))
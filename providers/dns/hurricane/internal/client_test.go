@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestClient_UpdateTxtRecord(t *testing.T) {
@@ -80,3 +81,11 @@ func TestClient_UpdateTxtRecord(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_UpdateTxtRecord_missingToken(t *testing.T) {
+	client := NewClient(map[string]string{"example.com": "secret"})
+	client.baseURL = "http://localhost:0"
+
+	err := client.UpdateTxtRecord(context.Background(), "_acme-challenge.example.org", "foo")
+	require.EqualError(t, err, "no token for the TXT record _acme-challenge.example.org: the credentials map must contain an entry for example.org")
+}
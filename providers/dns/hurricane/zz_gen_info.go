@@ -0,0 +1,24 @@
+package hurricane
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/hurricane/hurricane.toml
+		Name:  "Hurricane Electric DNS",
+		Code:  "hurricane",
+		Since: "v4.3.0",
+		URL:   "https://dns.he.net/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "HURRICANE_TOKENS", Description: "TXT record names and tokens", Credential: true},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://dns.he.net/",
+			GoClient: "",
+		},
+	}
+}
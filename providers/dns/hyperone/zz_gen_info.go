@@ -0,0 +1,29 @@
+package hyperone
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/hyperone/hyperone.toml
+		Name:  "HyperOne",
+		Code:  "hyperone",
+		Since: "v3.9.0",
+		URL:   "https://www.hyperone.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "HYPERONE_API_URL", Description: "Allows to pass custom API Endpoint to be used in the challenge (default https://api.hyperone.com/v2)"},
+			{Name: "HYPERONE_LOCATION_ID", Description: "Specifies location (region) to be used in API calls. (default pl-waw-1)"},
+			{Name: "HYPERONE_PASSPORT_LOCATION", Description: "Allows to pass custom passport file location (default ~/.h1/passport.json)"},
+			{Name: "HYPERONE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "HYPERONE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "HYPERONE_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.hyperone.com/v2/docs",
+			GoClient: "",
+		},
+	}
+}
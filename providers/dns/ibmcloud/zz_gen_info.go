@@ -0,0 +1,29 @@
+package ibmcloud
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/ibmcloud/ibmcloud.toml
+		Name:  "IBM Cloud (SoftLayer)",
+		Code:  "ibmcloud",
+		Since: "v4.5.0",
+		URL:   "https://www.ibm.com/cloud/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "SOFTLAYER_API_KEY", Description: "Classic Infrastructure API key", Credential: true},
+			{Name: "SOFTLAYER_USERNAME", Description: "Username (IBM Cloud is <accountID>_<emailAddress>)", Credential: true},
+			{Name: "SOFTLAYER_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "SOFTLAYER_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "SOFTLAYER_TIMEOUT", Description: "API request timeout"},
+			{Name: "SOFTLAYER_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://cloud.ibm.com/docs/dns?topic=dns-getting-started-with-the-dns-api",
+			GoClient: "https://github.com/softlayer/softlayer-go",
+		},
+	}
+}
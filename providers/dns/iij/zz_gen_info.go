@@ -0,0 +1,29 @@
+package iij
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/iij/iij.toml
+		Name:  "Internet Initiative Japan",
+		Code:  "iij",
+		Since: "v1.1.0",
+		URL:   "https://www.iij.ad.jp/en/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "IIJ_API_ACCESS_KEY", Description: "API access key", Credential: true},
+			{Name: "IIJ_API_SECRET_KEY", Description: "API secret key", Credential: true},
+			{Name: "IIJ_DO_SERVICE_CODE", Description: "DO service code", Credential: true},
+			{Name: "IIJ_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "IIJ_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "IIJ_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://manual.iij.jp/p2/pubapi/",
+			GoClient: "https://github.com/iij/doapi",
+		},
+	}
+}
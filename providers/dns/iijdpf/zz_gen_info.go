@@ -0,0 +1,29 @@
+package iijdpf
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/iijdpf/iijdpf.toml
+		Name:  "IIJ DNS Platform Service",
+		Code:  "iijdpf",
+		Since: "v4.7.0",
+		URL:   "https://www.iij.ad.jp/en/biz/dns-pfm/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "IIJ_DPF_API_TOKEN", Description: "API token", Credential: true},
+			{Name: "IIJ_DPF_DPM_SERVICE_CODE", Description: "IIJ Managed DNS Service's service code", Credential: true},
+			{Name: "IIJ_DPF_API_ENDPOINT", Description: "API endpoint URL, defaults to https://api.dns-platform.jp/dpf/v1"},
+			{Name: "IIJ_DPF_POLLING_INTERVAL", Description: "Time between DNS propagation check, defaults to 5 second"},
+			{Name: "IIJ_DPF_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation, defaults to 660 second"},
+			{Name: "IIJ_DPF_TTL", Description: "The TTL of the TXT record used for the DNS challenge, default to 300"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://manual.iij.jp/dpf/dpfapi/",
+			GoClient: "https://github.com/mimuret/golang-iij-dpf",
+		},
+	}
+}
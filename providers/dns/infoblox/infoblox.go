@@ -2,8 +2,10 @@
 package infoblox
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"sync"
 	"time"
@@ -17,13 +19,14 @@ import (
 const (
 	envNamespace = "INFOBLOX_"
 
-	EnvHost        = envNamespace + "HOST"
-	EnvPort        = envNamespace + "PORT"
-	EnvUsername    = envNamespace + "USERNAME"
-	EnvPassword    = envNamespace + "PASSWORD"
-	EnvDNSView     = envNamespace + "DNS_VIEW"
-	EnvWApiVersion = envNamespace + "WAPI_VERSION"
-	EnvSSLVerify   = envNamespace + "SSL_VERIFY"
+	EnvHost          = envNamespace + "HOST"
+	EnvPort          = envNamespace + "PORT"
+	EnvUsername      = envNamespace + "USERNAME"
+	EnvPassword      = envNamespace + "PASSWORD"
+	EnvDNSView       = envNamespace + "DNS_VIEW"
+	EnvWApiVersion   = envNamespace + "WAPI_VERSION"
+	EnvSSLVerify     = envNamespace + "SSL_VERIFY"
+	EnvCACertificate = envNamespace + "CA_CERTIFICATE"
 
 	EnvTTL                = envNamespace + "TTL"
 	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
@@ -56,10 +59,18 @@ type Config struct {
 	// SSLVerify is whether or not to verify the ssl of the server being hit.
 	SSLVerify bool
 
+	// CACertificate is the path to a PEM file (which can contain a full chain),
+	// or to a directory of PEM files, containing the CA certificates used to verify the grid manager.
+	CACertificate string
+
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
 	TTL                int
 	HTTPTimeout        int
+
+	// HTTPClient is used to communicate with the grid manager (proxy, custom root CAs, etc.).
+	// When set, it takes precedence over SSLVerify and HTTPTimeout.
+	HTTPClient *http.Client
 }
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
@@ -80,6 +91,7 @@ func NewDefaultConfig() *Config {
 // DNSProvider implements the challenge.Provider interface.
 type DNSProvider struct {
 	config          *Config
+	httpClient      *http.Client
 	transportConfig infoblox.TransportConfig
 	ibConfig        infoblox.HostConfig
 
@@ -103,6 +115,7 @@ func NewDNSProvider() (*DNSProvider, error) {
 	config.Host = values[EnvHost]
 	config.Username = values[EnvUsername]
 	config.Password = values[EnvPassword]
+	config.CACertificate = env.GetOrFile(EnvCACertificate)
 
 	return NewDNSProviderConfig(config)
 }
@@ -121,8 +134,27 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		return nil, errors.New("infoblox: missing credentials")
 	}
 
+	httpClient := config.HTTPClient
+
+	if httpClient == nil && config.CACertificate != "" {
+		pool, err := loadCertPool(config.CACertificate)
+		if err != nil {
+			return nil, fmt.Errorf("infoblox: %w", err)
+		}
+
+		httpClient = &http.Client{
+			Timeout: time.Duration(config.HTTPTimeout) * time.Second,
+			Transport: &http.Transport{
+				Proxy:               http.ProxyFromEnvironment,
+				TLSClientConfig:     &tls.Config{RootCAs: pool, Renegotiation: tls.RenegotiateOnceAsClient},
+				MaxIdleConnsPerHost: defaultPoolConnections,
+			},
+		}
+	}
+
 	return &DNSProvider{
 		config:          config,
+		httpClient:      httpClient,
 		transportConfig: infoblox.NewTransportConfig(strconv.FormatBool(config.SSLVerify), config.HTTPTimeout, defaultPoolConnections),
 		ibConfig: infoblox.HostConfig{
 			Host:     config.Host,
@@ -144,7 +176,7 @@ func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	connector, err := infoblox.NewConnector(d.ibConfig, d.transportConfig, &infoblox.WapiRequestBuilder{}, &infoblox.WapiHttpRequestor{})
+	connector, err := infoblox.NewConnector(d.ibConfig, d.transportConfig, &infoblox.WapiRequestBuilder{}, d.newRequestor())
 	if err != nil {
 		return fmt.Errorf("infoblox: %w", err)
 	}
@@ -169,7 +201,7 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	connector, err := infoblox.NewConnector(d.ibConfig, d.transportConfig, &infoblox.WapiRequestBuilder{}, &infoblox.WapiHttpRequestor{})
+	connector, err := infoblox.NewConnector(d.ibConfig, d.transportConfig, &infoblox.WapiRequestBuilder{}, d.newRequestor())
 	if err != nil {
 		return fmt.Errorf("infoblox: %w", err)
 	}
@@ -198,3 +230,11 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 
 	return nil
 }
+
+func (d *DNSProvider) newRequestor() infoblox.HttpRequestor {
+	if d.httpClient != nil {
+		return &httpRequestor{client: d.httpClient}
+	}
+
+	return &infoblox.WapiHttpRequestor{}
+}
@@ -1,10 +1,18 @@
 package infoblox
 
 import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -143,6 +151,130 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func setupTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/wapi/v2.11/userprofile", func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = rw.Write([]byte("[]"))
+	})
+
+	mux.HandleFunc("/wapi/v2.11/record:txt", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(rw, "unsupported method", http.StatusMethodNotAllowed)
+			return
+		}
+
+		rw.WriteHeader(http.StatusCreated)
+		_, _ = rw.Write([]byte(`"record:txt/ZG5zLmJpbmRfdHh0:_acme-challenge.example.com/External"`))
+	})
+
+	mux.HandleFunc("/wapi/v2.11/logout", func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = rw.Write([]byte(`""`))
+	})
+
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newTestConfig(t *testing.T, server *httptest.Server) *Config {
+	t.Helper()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	config := NewDefaultConfig()
+	config.Host = serverURL.Hostname()
+	config.Port = serverURL.Port()
+	config.Username = "user"
+	config.Password = "secret"
+
+	return config
+}
+
+func TestDNSProvider_Present_customHTTPClient(t *testing.T) {
+	server := setupTestServer(t)
+
+	var calls atomic.Int32
+
+	// The server uses a self-signed certificate:
+	// the request can only succeed if the client trusting this certificate is used.
+	client := server.Client()
+	transport := client.Transport
+	client.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		return transport.RoundTrip(req)
+	})
+
+	config := newTestConfig(t, server)
+	config.HTTPClient = client
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = provider.Present("example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	assert.Positive(t, calls.Load())
+	assert.Equal(t, "record:txt/ZG5zLmJpbmRfdHh0:_acme-challenge.example.com/External", provider.recordRefs["token"])
+}
+
+func TestDNSProvider_Present_caCertificate(t *testing.T) {
+	server := setupTestServer(t)
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	dir := t.TempDir()
+
+	// a file with several certificates.
+	chainPath := filepath.Join(dir, "chain.pem")
+	err := os.WriteFile(chainPath, append(caPEM, caPEM...), 0o600)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		desc string
+		path string
+	}{
+		{desc: "file", path: chainPath},
+		{desc: "directory", path: dir},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			config := newTestConfig(t, server)
+			config.CACertificate = test.path
+
+			provider, err := NewDNSProviderConfig(config)
+			require.NoError(t, err)
+
+			err = provider.Present("example.com", "token", "keyAuth")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_loadCertPool_error(t *testing.T) {
+	dir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, "invalid.pem"), []byte("invalid"), 0o600)
+	require.NoError(t, err)
+
+	_, err = loadCertPool(dir)
+	require.EqualError(t, err, "CA certificate: no valid PEM certificate found in "+dir)
+
+	_, err = loadCertPool(filepath.Join(dir, "missing.pem"))
+	require.Error(t, err)
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -170,3 +302,17 @@ func TestLiveCleanUp(t *testing.T) {
 	err = provider.CleanUp(envTest.GetDomain(), "", "123d==")
 	require.NoError(t, err)
 }
+
+func TestInfo(t *testing.T) {
+	info := Info()
+
+	assert.Equal(t, "infoblox", info.Code)
+	assert.Equal(t, []string{EnvHost, EnvPassword, EnvUsername}, info.CredentialEnvVars())
+
+	var names []string
+	for _, envVar := range info.EnvVars {
+		names = append(names, envVar.Name)
+	}
+
+	assert.Subset(t, names, []string{EnvPort, EnvDNSView, EnvWApiVersion, EnvSSLVerify, EnvCACertificate, EnvTTL, EnvPropagationTimeout})
+}
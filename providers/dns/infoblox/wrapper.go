@@ -0,0 +1,90 @@
+package infoblox
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	infoblox "github.com/infobloxopen/infoblox-go-client"
+)
+
+// httpRequestor is an infoblox.HttpRequestor using a user-supplied HTTP client.
+// The HTTP client takes precedence over the transport configuration.
+type httpRequestor struct {
+	client *http.Client
+}
+
+// Init does nothing: the transport is provided by the HTTP client.
+func (r *httpRequestor) Init(_ infoblox.TransportConfig) {}
+
+// SendRequest sends the request and returns the response body.
+func (r *httpRequestor) SendRequest(req *http.Request) ([]byte, error) {
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK && (resp.StatusCode != http.StatusCreated || req.Method != http.MethodPost) {
+		return nil, fmt.Errorf("unexpected status code: %d: %s", resp.StatusCode, string(raw))
+	}
+
+	return raw, nil
+}
+
+// loadCertPool creates a cert pool from a PEM file (which can contain several certificates),
+// or from all the files of a directory.
+func loadCertPool(path string) (*x509.CertPool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("CA certificate: %w", err)
+	}
+
+	files := []string{path}
+
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, fmt.Errorf("CA certificate: %w", err)
+		}
+
+		files = nil
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+	}
+
+	pool := x509.NewCertPool()
+
+	var loaded bool
+	for _, file := range files {
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("CA certificate: %w", err)
+		}
+
+		if pool.AppendCertsFromPEM(raw) {
+			loaded = true
+		}
+	}
+
+	if !loaded {
+		return nil, errors.New("CA certificate: no valid PEM certificate found in " + path)
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,35 @@
+package infoblox
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/infoblox/infoblox.toml
+		Name:  "Infoblox",
+		Code:  "infoblox",
+		Since: "v4.4.0",
+		URL:   "https://www.infoblox.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "INFOBLOX_HOST", Description: "Host URI", Credential: true},
+			{Name: "INFOBLOX_PASSWORD", Description: "Account Password", Credential: true},
+			{Name: "INFOBLOX_USERNAME", Description: "Account Username", Credential: true},
+			{Name: "INFOBLOX_CA_CERTIFICATE", Description: "The path to a PEM file (can contain a full chain) or a directory of PEM files, containing the CA certificates of the grid manager"},
+			{Name: "INFOBLOX_DNS_VIEW", Description: "The view for the TXT records, default: External"},
+			{Name: "INFOBLOX_HTTP_TIMEOUT", Description: "HTTP request timeout"},
+			{Name: "INFOBLOX_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "INFOBLOX_PORT", Description: "The port for the infoblox grid manager, default: 443"},
+			{Name: "INFOBLOX_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "INFOBLOX_SSL_VERIFY", Description: "Whether or not to verify the TLS certificate, default: true"},
+			{Name: "INFOBLOX_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+			{Name: "INFOBLOX_WAPI_VERSION", Description: "The version of WAPI being used, default: 2.11"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://your.infoblox.server/wapidoc/",
+			GoClient: "https://github.com/infobloxopen/infoblox-go-client",
+		},
+	}
+}
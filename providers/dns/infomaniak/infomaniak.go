@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -23,6 +24,7 @@ const (
 
 	EnvEndpoint    = envNamespace + "ENDPOINT"
 	EnvAccessToken = envNamespace + "ACCESS_TOKEN"
+	EnvDomainID    = envNamespace + "DOMAIN_ID"
 
 	EnvTTL                = envNamespace + "TTL"
 	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
@@ -34,6 +36,7 @@ const (
 type Config struct {
 	APIEndpoint        string
 	AccessToken        string
+	DomainID           uint64
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
 	TTL                int
@@ -63,6 +66,9 @@ type DNSProvider struct {
 
 	domainIDs   map[string]uint64
 	domainIDsMu sync.Mutex
+
+	// findZoneByFqdn determines the DNS zone of a FQDN when the domain is pinned.
+	findZoneByFqdn func(fqdn string) (string, error)
 }
 
 // NewDNSProvider returns a DNSProvider instance configured for Infomaniak.
@@ -76,6 +82,13 @@ func NewDNSProvider() (*DNSProvider, error) {
 	config := NewDefaultConfig()
 	config.AccessToken = values[EnvAccessToken]
 
+	if domainID := env.GetOrFile(EnvDomainID); domainID != "" {
+		config.DomainID, err = strconv.ParseUint(domainID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("infomaniak: invalid domain ID %q: %w", domainID, err)
+		}
+	}
+
 	return NewDNSProviderConfig(config)
 }
 
@@ -99,10 +112,11 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 	}
 
 	return &DNSProvider{
-		config:    config,
-		client:    client,
-		recordIDs: make(map[string]string),
-		domainIDs: make(map[string]uint64),
+		config:         config,
+		client:         client,
+		recordIDs:      make(map[string]string),
+		domainIDs:      make(map[string]uint64),
+		findZoneByFqdn: dns01.FindZoneByFqdn,
 	}, nil
 }
 
@@ -112,7 +126,7 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 
 	ctx := context.Background()
 
-	ikDomain, err := d.client.GetDomainByName(ctx, dns01.UnFqdn(info.EffectiveFQDN))
+	ikDomain, err := d.getDomain(ctx, info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("infomaniak: could not get domain %q: %w", info.EffectiveFQDN, err)
 	}
@@ -183,6 +197,19 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	return nil
 }
 
+func (d *DNSProvider) getDomain(ctx context.Context, fqdn string) (*internal.DNSDomain, error) {
+	if d.config.DomainID == 0 {
+		return d.client.GetDomainByName(ctx, dns01.UnFqdn(fqdn))
+	}
+
+	authZone, err := d.findZoneByFqdn(fqdn)
+	if err != nil {
+		return nil, fmt.Errorf("could not find zone: %w", err)
+	}
+
+	return &internal.DNSDomain{ID: d.config.DomainID, CustomerName: dns01.UnFqdn(authZone)}, nil
+}
+
 // Timeout returns the timeout and interval to use when checking for DNS propagation.
 // Adjusting here to cope with spikes in propagation times.
 func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
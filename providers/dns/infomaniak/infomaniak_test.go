@@ -1,10 +1,15 @@
 package infomaniak
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/providers/dns/infomaniak/internal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -12,7 +17,8 @@ const envDomain = envNamespace + "DOMAIN"
 
 var envTest = tester.NewEnvTest(
 	EnvEndpoint,
-	EnvAccessToken).
+	EnvAccessToken,
+	EnvDomainID).
 	WithDomain(envDomain)
 
 func TestNewDNSProvider(t *testing.T) {
@@ -34,6 +40,14 @@ func TestNewDNSProvider(t *testing.T) {
 			},
 			expected: "infomaniak: some credentials information are missing: INFOMANIAK_ACCESS_TOKEN",
 		},
+		{
+			desc: "invalid domain ID",
+			envVars: map[string]string{
+				EnvAccessToken: "123",
+				EnvDomainID:    "abc",
+			},
+			expected: `infomaniak: invalid domain ID "abc": strconv.ParseUint: parsing "abc": invalid syntax`,
+		},
 	}
 
 	for _, test := range testCases {
@@ -95,6 +109,55 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func TestDNSProvider_domainID(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/1/product", func(rw http.ResponseWriter, req *http.Request) {
+		t.Error("the domain discovery must be skipped when the domain is pinned")
+		http.Error(rw, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+	})
+
+	mux.HandleFunc("POST /1/domain/666/dns/record", func(rw http.ResponseWriter, req *http.Request) {
+		var record internal.Record
+		err := json.NewDecoder(req.Body).Decode(&record)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if record.Source != "_acme-challenge.sub" {
+			http.Error(rw, fmt.Sprintf("invalid source: %s", record.Source), http.StatusBadRequest)
+			return
+		}
+
+		_, _ = rw.Write([]byte(`{"result":"success","data":"123"}`))
+	})
+
+	mux.HandleFunc("DELETE /1/domain/666/dns/record/123", func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(`{"result":"success","data":true}`))
+	})
+
+	config := NewDefaultConfig()
+	config.APIEndpoint = server.URL
+	config.AccessToken = "secret"
+	config.DomainID = 666
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.findZoneByFqdn = func(_ string) (string, error) {
+		return "example.com.", nil
+	}
+
+	err = provider.Present("sub.example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	err = provider.CleanUp("sub.example.com", "token", "keyAuth")
+	require.NoError(t, err)
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -0,0 +1,30 @@
+package infomaniak
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/infomaniak/infomaniak.toml
+		Name:  "Infomaniak",
+		Code:  "infomaniak",
+		Since: "v4.1.0",
+		URL:   "https://www.infomaniak.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "INFOMANIAK_ACCESS_TOKEN", Description: "Access token", Credential: true},
+			{Name: "INFOMANIAK_DOMAIN_ID", Description: "The ID of the domain, skips the domain discovery (for tokens without the permission to list the domains)"},
+			{Name: "INFOMANIAK_ENDPOINT", Description: "https://api.infomaniak.com"},
+			{Name: "INFOMANIAK_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "INFOMANIAK_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "INFOMANIAK_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "INFOMANIAK_TTL", Description: "The TTL of the TXT record used for the DNS challenge in seconds"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.infomaniak.com/doc",
+			GoClient: "",
+		},
+	}
+}
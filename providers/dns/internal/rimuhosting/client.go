@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 	querystring "github.com/google/go-querystring/query"
 )
 
@@ -128,6 +129,8 @@ func (c Client) do(ctx context.Context, params, result any) error {
 		return fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return errutils.NewHTTPDoError(req, err)
@@ -148,6 +151,12 @@ func (c Client) do(ctx context.Context, params, result any) error {
 		return errutils.NewReadResponseError(req, resp.StatusCode, err)
 	}
 
+	// Some errors are returned with a 2xx status code.
+	var errAPI APIError
+	if xml.Unmarshal(raw, &errAPI) == nil {
+		return errAPI
+	}
+
 	err = xml.Unmarshal(raw, result)
 	if err != nil {
 		return fmt.Errorf("unmarshaling %T error: %w: %s", result, err, string(raw))
@@ -304,6 +304,24 @@ func TestClient_DoActions(t *testing.T) {
 	}
 }
 
+func TestClient_DoActions_errorStatusOK(t *testing.T) {
+	client, mux := setupTest(t)
+
+	mux.HandleFunc("/", func(rw http.ResponseWriter, req *http.Request) {
+		err := writeResponse(rw, "./fixtures/add_record_error.xml")
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	_, err := client.DoActions(context.Background(), NewAddRecordAction("example.com", "txttxtx", 0))
+	require.EqualError(t, err, "ERROR: No zone found for example.com")
+
+	var errAPI APIError
+	require.ErrorAs(t, err, &errAPI)
+}
+
 func writeResponse(rw io.Writer, filename string) error {
 	file, err := os.Open(filename)
 	if err != nil {
@@ -0,0 +1,50 @@
+package useragent
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// EnvUserAgentSuffix is the environment variable used to append an identifier to the User-Agent of all the requests.
+const EnvUserAgentSuffix = "LEGO_USER_AGENT_SUFFIX"
+
+var (
+	suffix   string
+	muSuffix sync.RWMutex
+)
+
+// SetSuffix defines the identifier appended to the User-Agent.
+// It overrides the value of the environment variable LEGO_USER_AGENT_SUFFIX, an empty suffix restores it.
+func SetSuffix(s string) {
+	muSuffix.Lock()
+	defer muSuffix.Unlock()
+
+	suffix = s
+}
+
+// Get builds and returns the User-Agent string used by the DNS providers.
+// The suffix (see SetSuffix), or the value of the environment variable LEGO_USER_AGENT_SUFFIX, is appended to the User-Agent.
+func Get() string {
+	ua := fmt.Sprintf("%s (%s; %s; %s) %s", ourUserAgent, ourUserAgentComment, runtime.GOOS, runtime.GOARCH, getSuffix())
+	return strings.TrimSpace(ua)
+}
+
+func getSuffix() string {
+	muSuffix.RLock()
+	defer muSuffix.RUnlock()
+
+	if suffix != "" {
+		return suffix
+	}
+
+	return os.Getenv(EnvUserAgentSuffix)
+}
+
+// SetHeader sets the User-Agent header.
+func SetHeader(h http.Header) {
+	h.Set("User-Agent", Get())
+}
@@ -0,0 +1,49 @@
+package useragent
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	t.Setenv(EnvUserAgentSuffix, "")
+
+	ua := Get()
+
+	assert.Contains(t, ua, ourUserAgent)
+	assert.Contains(t, ua, ourUserAgentComment)
+	assert.True(t, strings.HasSuffix(ua, ")"), ua)
+}
+
+func TestSetHeader_suffix(t *testing.T) {
+	t.Setenv(EnvUserAgentSuffix, "my-gateway/1.0")
+
+	header := http.Header{}
+
+	SetHeader(header)
+
+	ua := header.Get("User-Agent")
+
+	assert.Contains(t, ua, ourUserAgent)
+	assert.True(t, strings.HasSuffix(ua, ") my-gateway/1.0"), ua)
+}
+
+func TestSetSuffix(t *testing.T) {
+	t.Setenv(EnvUserAgentSuffix, "my-gateway/1.0")
+
+	SetSuffix("my-company/2.0")
+	t.Cleanup(func() { SetSuffix("") })
+
+	ua := Get()
+
+	assert.True(t, strings.HasSuffix(ua, ") my-company/2.0"), ua)
+
+	SetSuffix("")
+
+	ua = Get()
+
+	assert.True(t, strings.HasSuffix(ua, ") my-gateway/1.0"), ua)
+}
@@ -0,0 +1,14 @@
+package useragent
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+const (
+	// ourUserAgent is the User-Agent of the DNS providers.
+	ourUserAgent = "goacme-lego/4.17.4"
+
+	// ourUserAgentComment is part of the UA comment linked to the version status of the DNS providers.
+	// values: detach|release
+	// NOTE: Update this with each tagged release.
+	ourUserAgentComment = "detach"
+)
@@ -0,0 +1,29 @@
+package internetbs
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/internetbs/internetbs.toml
+		Name:  "Internet.bs",
+		Code:  "internetbs",
+		Since: "v4.5.0",
+		URL:   "https://internetbs.net",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "INTERNET_BS_API_KEY", Description: "API key", Credential: true},
+			{Name: "INTERNET_BS_PASSWORD", Description: "API password", Credential: true},
+			{Name: "INTERNET_BS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "INTERNET_BS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "INTERNET_BS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "INTERNET_BS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://internetbs.net/internet-bs-api.pdf",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package inwx
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/inwx/inwx.toml
+		Name:  "INWX",
+		Code:  "inwx",
+		Since: "v2.0.0",
+		URL:   "https://www.inwx.de/en",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "INWX_PASSWORD", Description: "Password", Credential: true},
+			{Name: "INWX_USERNAME", Description: "Username", Credential: true},
+			{Name: "INWX_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "INWX_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation (default 360s)"},
+			{Name: "INWX_SANDBOX", Description: "Activate the sandbox (boolean)"},
+			{Name: "INWX_SHARED_SECRET", Description: "shared secret related to 2FA"},
+			{Name: "INWX_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.inwx.de/en/help/apidoc",
+			GoClient: "https://github.com/nrdcg/goinwx",
+		},
+	}
+}
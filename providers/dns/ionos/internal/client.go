@@ -11,16 +11,24 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 	querystring "github.com/google/go-querystring/query"
 )
 
 // defaultBaseURL represents the API endpoint to call.
 const defaultBaseURL = "https://api.hosting.ionos.com/dns"
 
+// customerIDHeader is the header used to select the sub-customer (reseller context).
+const customerIDHeader = "X-Customer-Id"
+
 // Client Ionos API client.
 type Client struct {
 	apiKey string
 
+	// CustomerID is the ID of the sub-customer owning the zones (reseller context).
+	// If empty, the zones of the account of the API key are managed.
+	CustomerID string
+
 	BaseURL    *url.URL
 	HTTPClient *http.Client
 }
@@ -121,6 +129,10 @@ func (c *Client) RemoveRecord(ctx context.Context, zoneID, recordID string) erro
 func (c *Client) do(req *http.Request, result any) error {
 	req.Header.Set("X-API-Key", c.apiKey)
 
+	if c.CustomerID != "" {
+		req.Header.Set(customerIDHeader, c.CustomerID)
+	}
+
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return errutils.NewHTTPDoError(req, err)
@@ -164,6 +176,8 @@ func makeJSONRequest(ctx context.Context, method string, endpoint *url.URL, payl
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 
 	if payload != nil {
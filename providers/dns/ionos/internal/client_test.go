@@ -33,6 +33,26 @@ func TestClient_ListZones(t *testing.T) {
 	assert.Equal(t, expected, zones)
 }
 
+func TestClient_ListZones_customerID(t *testing.T) {
+	client, mux := setupTest(t)
+
+	client.CustomerID = "456"
+
+	mux.HandleFunc("/v1/zones", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get(customerIDHeader) != "456" {
+			http.Error(rw, fmt.Sprintf("invalid customer ID: %q", req.Header.Get(customerIDHeader)), http.StatusBadRequest)
+			return
+		}
+
+		mockHandler(http.MethodGet, http.StatusOK, "list_zones.json")(rw, req)
+	})
+
+	zones, err := client.ListZones(context.Background())
+	require.NoError(t, err)
+
+	assert.Len(t, zones, 1)
+}
+
 func TestClient_ListZones_error(t *testing.T) {
 	client, mux := setupTest(t)
 
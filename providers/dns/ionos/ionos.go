@@ -21,7 +21,8 @@ const minTTL = 300
 const (
 	envNamespace = "IONOS_"
 
-	EnvAPIKey = envNamespace + "API_KEY"
+	EnvAPIKey     = envNamespace + "API_KEY"
+	EnvCustomerID = envNamespace + "CUSTOMER_ID"
 
 	EnvTTL                = envNamespace + "TTL"
 	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
@@ -31,7 +32,10 @@ const (
 
 // Config is used to configure the creation of the DNSProvider.
 type Config struct {
-	APIKey             string
+	APIKey string
+	// CustomerID is the ID of the sub-customer owning the zones (reseller context).
+	CustomerID string
+
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
 	TTL                int
@@ -41,6 +45,7 @@ type Config struct {
 // NewDefaultConfig returns a default configuration for the DNSProvider.
 func NewDefaultConfig() *Config {
 	return &Config{
+		CustomerID:         env.GetOrFile(EnvCustomerID),
 		TTL:                env.GetOrDefaultInt(EnvTTL, minTTL),
 		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
 		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
@@ -93,6 +98,8 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		client.HTTPClient = config.HTTPClient
 	}
 
+	client.CustomerID = config.CustomerID
+
 	return &DNSProvider{config: config, client: client}, nil
 }
 
@@ -108,9 +115,9 @@ func (d *DNSProvider) Present(domain, _, keyAuth string) error {
 
 	ctx := context.Background()
 
-	zones, err := d.client.ListZones(ctx)
+	zones, err := d.listZones(ctx)
 	if err != nil {
-		return fmt.Errorf("ionos: failed to get zones: %w", err)
+		return fmt.Errorf("ionos: %w", err)
 	}
 
 	// TODO(ldez) replace domain by FQDN to follow CNAME.
@@ -150,9 +157,9 @@ func (d *DNSProvider) CleanUp(domain, _, keyAuth string) error {
 
 	ctx := context.Background()
 
-	zones, err := d.client.ListZones(ctx)
+	zones, err := d.listZones(ctx)
 	if err != nil {
-		return fmt.Errorf("ionos: failed to get zones: %w", err)
+		return fmt.Errorf("ionos: %w", err)
 	}
 
 	// TODO(ldez) replace domain by FQDN to follow CNAME.
@@ -184,6 +191,22 @@ func (d *DNSProvider) CleanUp(domain, _, keyAuth string) error {
 	return fmt.Errorf("ionos: failed to remove record, record not found (zone=%s, domain=%s, fqdn=%s, value=%s)", zone.ID, domain, info.EffectiveFQDN, info.Value)
 }
 
+// listZones lists the zones of the account, or of the sub-customer if a customer ID is defined.
+func (d *DNSProvider) listZones(ctx context.Context) ([]internal.Zone, error) {
+	zones, err := d.client.ListZones(ctx)
+	if err == nil {
+		return zones, nil
+	}
+
+	var cErr *internal.ClientError
+	if d.config.CustomerID != "" && errors.As(err, &cErr) &&
+		(cErr.StatusCode == http.StatusUnauthorized || cErr.StatusCode == http.StatusForbidden) {
+		return nil, fmt.Errorf("the API key is not allowed to manage the zones of the customer %s: %w", d.config.CustomerID, err)
+	}
+
+	return nil, fmt.Errorf("failed to get zones: %w", err)
+}
+
 func findZone(zones []internal.Zone, domain string) *internal.Zone {
 	var result *internal.Zone
 
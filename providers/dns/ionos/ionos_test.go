@@ -1,16 +1,24 @@
 package ionos
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/providers/dns/ionos/internal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 const envDomain = envNamespace + "DOMAIN"
 
 var envTest = tester.NewEnvTest(
-	EnvAPIKey).
+	EnvAPIKey,
+	EnvCustomerID).
 	WithDomain(envDomain)
 
 func TestNewDNSProvider(t *testing.T) {
@@ -25,6 +33,13 @@ func TestNewDNSProvider(t *testing.T) {
 				EnvAPIKey: "123",
 			},
 		},
+		{
+			desc: "success with customer ID",
+			envVars: map[string]string{
+				EnvAPIKey:     "123",
+				EnvCustomerID: "456",
+			},
+		},
 		{
 			desc: "missing credentials",
 			envVars: map[string]string{
@@ -48,6 +63,8 @@ func TestNewDNSProvider(t *testing.T) {
 				require.NotNil(t, p)
 				require.NotNil(t, p.config)
 				require.NotNil(t, p.client)
+
+				assert.Equal(t, test.envVars[EnvCustomerID], p.client.CustomerID)
 			} else {
 				require.EqualError(t, err, test.expected)
 			}
@@ -100,6 +117,102 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+// setupCustomerTest creates a server where the zones depend on the customer context.
+// The zones of the account are used when no customer ID is provided.
+func setupCustomerTest(t *testing.T, customerID string) (*DNSProvider, *[]string) {
+	t.Helper()
+
+	customerZones := map[string][]internal.Zone{
+		"":    {{ID: "zone-account", Name: "example.com", Type: "NATIVE"}},
+		"456": {{ID: "zone-456", Name: "example.com", Type: "NATIVE"}, {ID: "zone-456-sub", Name: "sub.example.com", Type: "NATIVE"}},
+	}
+
+	var patched []string
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("GET /v1/zones", func(rw http.ResponseWriter, req *http.Request) {
+		zones, ok := customerZones[req.Header.Get("X-Customer-Id")]
+		if !ok {
+			rw.WriteHeader(http.StatusForbidden)
+			_, _ = fmt.Fprint(rw, `[{"code":"FORBIDDEN","message":"The customer is not authorized to do this operation."}]`)
+
+			return
+		}
+
+		_ = json.NewEncoder(rw).Encode(zones)
+	})
+
+	mux.HandleFunc("GET /v1/zones/{id}", func(rw http.ResponseWriter, req *http.Request) {
+		_ = json.NewEncoder(rw).Encode(internal.CustomerZone{ID: req.PathValue("id")})
+	})
+
+	mux.HandleFunc("PATCH /v1/zones/{id}", func(rw http.ResponseWriter, req *http.Request) {
+		patched = append(patched, req.PathValue("id"))
+	})
+
+	config := NewDefaultConfig()
+	config.APIKey = "secret"
+	config.CustomerID = customerID
+	config.HTTPClient = server.Client()
+
+	p, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	p.client.BaseURL, _ = url.Parse(server.URL)
+
+	return p, &patched
+}
+
+func TestDNSProvider_Present_customer(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		customerID string
+		domain     string
+		expected   []string
+	}{
+		{
+			desc:     "account",
+			domain:   "www.sub.example.com",
+			expected: []string{"zone-account"},
+		},
+		{
+			desc:       "customer",
+			customerID: "456",
+			domain:     "www.example.com",
+			expected:   []string{"zone-456"},
+		},
+		{
+			desc:       "customer subdomain zone",
+			customerID: "456",
+			domain:     "www.sub.example.com",
+			expected:   []string{"zone-456-sub"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			provider, patched := setupCustomerTest(t, test.customerID)
+
+			err := provider.Present(test.domain, "token", "keyAuth")
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, *patched)
+		})
+	}
+}
+
+func TestDNSProvider_Present_customerForbidden(t *testing.T) {
+	provider, patched := setupCustomerTest(t, "789")
+
+	err := provider.Present("www.example.com", "token", "keyAuth")
+	require.EqualError(t, err, "ionos: the API key is not allowed to manage the zones of the customer 789: failed to call API: 403: FORBIDDEN: The customer is not authorized to do this operation.")
+
+	assert.Empty(t, *patched)
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -0,0 +1,29 @@
+package ionos
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/ionos/ionos.toml
+		Name:  "Ionos",
+		Code:  "ionos",
+		Since: "v4.2.0",
+		URL:   "https://ionos.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "IONOS_API_KEY", Description: "API key `<prefix>.<secret>` https://developer.hosting.ionos.com/docs/getstarted", Credential: true},
+			{Name: "IONOS_CUSTOMER_ID", Description: "The ID of the sub-customer owning the zones (reseller context)"},
+			{Name: "IONOS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "IONOS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "IONOS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "IONOS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developer.hosting.ionos.com/docs/dns",
+			GoClient: "",
+		},
+	}
+}
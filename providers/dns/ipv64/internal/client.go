@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 	"golang.org/x/oauth2"
 )
 
@@ -46,6 +47,8 @@ func (c Client) GetDomains(ctx context.Context) (*Domains, error) {
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	results := &Domains{}
 
 	err = c.do(req, results)
@@ -70,6 +73,8 @@ func (c Client) AddRecord(ctx context.Context, domain, prefix, recordType, conte
 		return fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	return c.do(req, nil)
 }
 
@@ -87,6 +92,8 @@ func (c Client) DeleteRecord(ctx context.Context, domain, prefix, recordType, co
 		return fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	return c.do(req, nil)
 }
 
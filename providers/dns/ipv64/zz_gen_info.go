@@ -0,0 +1,28 @@
+package ipv64
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/ipv64/ipv64.toml
+		Name:  "IPv64",
+		Code:  "ipv64",
+		Since: "v4.13.0",
+		URL:   "https://ipv64.net/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "IPV64_API_KEY", Description: "Account API Key", Credential: true},
+			{Name: "IPV64_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "IPV64_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "IPV64_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "IPV64_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://ipv64.net/dyndns_updater_api",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package iwantmyname
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/iwantmyname/iwantmyname.toml
+		Name:  "iwantmyname",
+		Code:  "iwantmyname",
+		Since: "v4.7.0",
+		URL:   "https://iwantmyname.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "IWANTMYNAME_PASSWORD", Description: "API password", Credential: true},
+			{Name: "IWANTMYNAME_USERNAME", Description: "API username", Credential: true},
+			{Name: "IWANTMYNAME_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "IWANTMYNAME_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "IWANTMYNAME_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "IWANTMYNAME_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://iwantmyname.com/developer/domain-dns-api",
+			GoClient: "",
+		},
+	}
+}
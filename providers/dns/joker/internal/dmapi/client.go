@@ -17,6 +17,7 @@ import (
 	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/log"
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 const defaultBaseURL = "https://dmapi.joker.com/request/"
@@ -99,6 +100,8 @@ func (c *Client) postRequest(ctx context.Context, cmd string, data url.Values) (
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 	resp, err := c.HTTPClient.Do(req)
@@ -171,14 +174,15 @@ func fixTxtLines(line string) string {
 	return strings.Join(fields, " ")
 }
 
-// RemoveTxtEntryFromZone clean-ups all TXT records with given name.
-func RemoveTxtEntryFromZone(zone, relative string) (string, bool) {
-	prefix := fmt.Sprintf("%s TXT 0 ", relative)
-
+// RemoveTxtEntryFromZone returns DNS zone without the TXT record with given name and value.
+// The other records, including the other TXT records with the same name, are kept.
+func RemoveTxtEntryFromZone(zone, relative, value string) (string, bool) {
 	modified := false
 	var zoneEntries []string
 	for _, line := range strings.Split(zone, "\n") {
-		if strings.HasPrefix(line, prefix) {
+		line = fixTxtLines(line)
+
+		if isTxtEntry(line, relative, value) {
 			modified = true
 			continue
 		}
@@ -188,6 +192,18 @@ func RemoveTxtEntryFromZone(zone, relative string) (string, bool) {
 	return strings.TrimSpace(strings.Join(zoneEntries, "\n")), modified
 }
 
+// isTxtEntry checks if the line is the TXT record with given name and value.
+// The line format is: "<label> TXT 0 <quoted value> <ttl>".
+func isTxtEntry(line, relative, value string) bool {
+	fields := strings.Fields(line)
+
+	if len(fields) < 4 || fields[1] != "TXT" {
+		return false
+	}
+
+	return fields[0] == relative && fields[3] == strconv.Quote(value)
+}
+
 // AddTxtEntryToZone returns DNS zone with added TXT record.
 func AddTxtEntryToZone(zone, relative, value string, ttl int) string {
 	var zoneEntries []string
@@ -200,30 +200,36 @@ func Test_RemoveTxtEntryFromZone(t *testing.T) {
 			modified: false,
 		},
 		{
-			desc:     "zone with only clenup entry",
-			input:    "_acme-challenge TXT 0  \"old \" 120",
+			desc:     "zone with only cleanup entry",
+			input:    "_acme-challenge TXT 0  \"test \" 120",
 			expected: "",
 			modified: true,
 		},
 		{
 			desc:     "zone with one A and one cleanup entries",
-			input:    "@ A 0 192.0.2.2 3600\n_acme-challenge TXT 0  \"old \" 120",
+			input:    "@ A 0 192.0.2.2 3600\n_acme-challenge TXT 0 \"test\" 120",
 			expected: "@ A 0 192.0.2.2 3600",
 			modified: true,
 		},
 		{
-			desc:     "zone with one A and multiple cleanup entries",
-			input:    "@ A 0 192.0.2.2 3600\n_acme-challenge TXT 0  \"old \" 120\n_acme-challenge TXT 0  \"another \" 120",
-			expected: "@ A 0 192.0.2.2 3600",
+			desc:     "zone with other TXT entries with the same name",
+			input:    "@ A 0 192.0.2.2 3600\n_acme-challenge TXT 0  \"old \" 120\n_acme-challenge TXT 0 \"test\" 120",
+			expected: "@ A 0 192.0.2.2 3600\n_acme-challenge TXT 0 \"old\" 120",
 			modified: true,
 		},
+		{
+			desc:     "zone with the same value on another name",
+			input:    "@ A 0 192.0.2.2 3600\n_acme-challenge.sub TXT 0 \"test\" 120",
+			expected: "@ A 0 192.0.2.2 3600\n_acme-challenge.sub TXT 0 \"test\" 120",
+			modified: false,
+		},
 	}
 
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
 			t.Parallel()
 
-			zone, modified := RemoveTxtEntryFromZone(test.input, "_acme-challenge")
+			zone, modified := RemoveTxtEntryFromZone(test.input, "_acme-challenge", "test")
 			assert.Equal(t, test.expected, zone)
 			assert.Equal(t, test.modified, modified)
 		})
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
@@ -16,6 +17,12 @@ import (
 type dmapiProvider struct {
 	config *Config
 	client *dmapi.Client
+
+	// The DMAPI only allows to replace the whole zone,
+	// so the get/put sequences must not be interleaved to avoid losing records.
+	zoneMu sync.Mutex
+
+	findZoneByFqdn func(fqdn string) (string, error)
 }
 
 // newDmapiProvider returns a DNSProvider instance configured for Joker.
@@ -63,7 +70,11 @@ func newDmapiProviderConfig(config *Config) (*dmapiProvider, error) {
 		client.HTTPClient = config.HTTPClient
 	}
 
-	return &dmapiProvider{config: config, client: client}, nil
+	return &dmapiProvider{
+		config:         config,
+		client:         client,
+		findZoneByFqdn: dns01.FindZoneByFqdn,
+	}, nil
 }
 
 // Timeout returns the timeout and interval to use when checking for DNS propagation.
@@ -76,7 +87,7 @@ func (d *dmapiProvider) Timeout() (timeout, interval time.Duration) {
 func (d *dmapiProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	zone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	zone, err := d.findZoneByFqdn(info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("joker: could not find zone for domain %q: %w", domain, err)
 	}
@@ -90,6 +101,9 @@ func (d *dmapiProvider) Present(domain, token, keyAuth string) error {
 		log.Infof("[%s] joker: adding TXT record %q to zone %q with value %q", domain, subDomain, zone, info.Value)
 	}
 
+	d.zoneMu.Lock()
+	defer d.zoneMu.Unlock()
+
 	ctx, err := d.client.CreateAuthenticatedContext(context.Background())
 	if err != nil {
 		return err
@@ -114,7 +128,7 @@ func (d *dmapiProvider) Present(domain, token, keyAuth string) error {
 func (d *dmapiProvider) CleanUp(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	zone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	zone, err := d.findZoneByFqdn(info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("joker: could not find zone for domain %q: %w", domain, err)
 	}
@@ -125,9 +139,12 @@ func (d *dmapiProvider) CleanUp(domain, token, keyAuth string) error {
 	}
 
 	if d.config.Debug {
-		log.Infof("[%s] joker: removing entry %q from zone %q", domain, subDomain, zone)
+		log.Infof("[%s] joker: removing TXT record %q from zone %q with value %q", domain, subDomain, zone, info.Value)
 	}
 
+	d.zoneMu.Lock()
+	defer d.zoneMu.Unlock()
+
 	ctx, err := d.client.CreateAuthenticatedContext(context.Background())
 	if err != nil {
 		return err
@@ -143,7 +160,7 @@ func (d *dmapiProvider) CleanUp(domain, token, keyAuth string) error {
 		return formatResponseError(response, err)
 	}
 
-	dnsZone, modified := dmapi.RemoveTxtEntryFromZone(response.Body, subDomain)
+	dnsZone, modified := dmapi.RemoveTxtEntryFromZone(response.Body, subDomain, info.Value)
 	if modified {
 		response, err = d.client.PutZone(ctx, zone, dnsZone)
 		if err != nil || response.StatusCode != 0 {
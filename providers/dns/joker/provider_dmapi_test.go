@@ -1,6 +1,10 @@
 package joker
 
 import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -127,3 +131,86 @@ func Test_newDmapiProviderConfig(t *testing.T) {
 		})
 	}
 }
+
+func setupDmapiTest(t *testing.T, zone string) (*dmapiProvider, *string) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "Status-Code: 0\nStatus-Text: OK\nAuth-Sid: 123\n\ncom\nnet")
+	})
+
+	mux.HandleFunc("/logout", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "Status-Code: 0\nStatus-Text: OK\n")
+	})
+
+	mux.HandleFunc("/dns-zone-get", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("auth-sid") != "123" || r.FormValue("domain") != "example.com" {
+			_, _ = io.WriteString(w, "Status-Code: 2202\nStatus-Text: Authorization error\n")
+			return
+		}
+
+		_, _ = fmt.Fprintf(w, "Status-Code: 0\nStatus-Text: OK\n\n%s", zone)
+	})
+
+	var uploaded string
+
+	mux.HandleFunc("/dns-zone-put", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("auth-sid") != "123" || r.FormValue("domain") != "example.com" {
+			_, _ = io.WriteString(w, "Status-Code: 2202\nStatus-Text: Authorization error\n")
+			return
+		}
+
+		uploaded = r.FormValue("zone")
+
+		_, _ = io.WriteString(w, "Status-Code: 0\nStatus-Text: OK\n")
+	})
+
+	config := NewDefaultConfig()
+	config.APIKey = "secret"
+	config.TTL = 120
+
+	provider, err := newDmapiProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.client.BaseURL = server.URL
+	provider.findZoneByFqdn = func(_ string) (string, error) {
+		return "example.com.", nil
+	}
+
+	return provider, &uploaded
+}
+
+func Test_dmapiProvider_Present(t *testing.T) {
+	zone := "@ A 0 192.0.2.2 3600\n@ MX 10 mx.example.com 3600\n_acme-challenge TXT 0 \"other\" 120"
+
+	provider, uploaded := setupDmapiTest(t, zone)
+
+	err := provider.Present("example.com", "", "123d==")
+	require.NoError(t, err)
+
+	expected := "@ A 0 192.0.2.2 3600\n" +
+		"@ MX 10 mx.example.com 3600\n" +
+		"_acme-challenge TXT 0 \"other\" 120\n" +
+		"_acme-challenge TXT 0 \"ADw2sEd82DUgXcQ9hNBZThJs7zVJkR5v9JeSbAb9mZY\" 120"
+
+	assert.Equal(t, expected, *uploaded)
+}
+
+func Test_dmapiProvider_CleanUp(t *testing.T) {
+	zone := "@ A 0 192.0.2.2 3600\n" +
+		"_acme-challenge TXT 0 \"other\" 120\n" +
+		"_acme-challenge TXT 0 \"ADw2sEd82DUgXcQ9hNBZThJs7zVJkR5v9JeSbAb9mZY\" 120"
+
+	provider, uploaded := setupDmapiTest(t, zone)
+
+	err := provider.CleanUp("example.com", "", "123d==")
+	require.NoError(t, err)
+
+	expected := "@ A 0 192.0.2.2 3600\n_acme-challenge TXT 0 \"other\" 120"
+
+	assert.Equal(t, expected, *uploaded)
+}
@@ -0,0 +1,32 @@
+package joker
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/joker/joker.toml
+		Name:  "Joker",
+		Code:  "joker",
+		Since: "v2.6.0",
+		URL:   "https://joker.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "JOKER_API_KEY", Description: "API key (only with DMAPI mode)", Credential: true},
+			{Name: "JOKER_API_MODE", Description: "'DMAPI' or 'SVC'. DMAPI is for resellers accounts. (Default: DMAPI)", Credential: true},
+			{Name: "JOKER_PASSWORD", Description: "Joker.com password", Credential: true},
+			{Name: "JOKER_USERNAME", Description: "Joker.com username", Credential: true},
+			{Name: "JOKER_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "JOKER_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "JOKER_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "JOKER_SEQUENCE_INTERVAL", Description: "Time between sequential requests (only with 'SVC' mode)"},
+			{Name: "JOKER_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://joker.com/faq/category/39/22-dmapi.html",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package liara
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/liara/liara.toml
+		Name:  "Liara",
+		Code:  "liara",
+		Since: "v4.10.0",
+		URL:   "https://liara.ir",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "LIARA_API_KEY", Description: "The API key", Credential: true},
+			{Name: "LIARA_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "LIARA_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "LIARA_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "LIARA_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://dns-service.iran.liara.ir/swagger",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package lightsail
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/lightsail/lightsail.toml
+		Name:  "Amazon Lightsail",
+		Code:  "lightsail",
+		Since: "v0.5.0",
+		URL:   "https://aws.amazon.com/lightsail/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "AWS_ACCESS_KEY_ID", Description: "Managed by the AWS client. Access key ID (`AWS_ACCESS_KEY_ID_FILE` is not supported, use `AWS_SHARED_CREDENTIALS_FILE` instead)", Credential: true},
+			{Name: "AWS_SECRET_ACCESS_KEY", Description: "Managed by the AWS client. Secret access key (`AWS_SECRET_ACCESS_KEY_FILE` is not supported, use `AWS_SHARED_CREDENTIALS_FILE` instead)", Credential: true},
+			{Name: "DNS_ZONE", Description: "Domain name of the DNS zone", Credential: true},
+			{Name: "AWS_SHARED_CREDENTIALS_FILE", Description: "Managed by the AWS client. Shared credentials file."},
+			{Name: "LIGHTSAIL_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "LIGHTSAIL_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "",
+			GoClient: "https://github.com/aws/aws-sdk-go-v2",
+		},
+	}
+}
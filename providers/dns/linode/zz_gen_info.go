@@ -0,0 +1,28 @@
+package linode
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/linode/linode.toml
+		Name:  "Linode (v4)",
+		Code:  "linode",
+		Since: "v1.1.0",
+		URL:   "https://www.linode.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "LINODE_TOKEN", Description: "API token", Credential: true},
+			{Name: "LINODE_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "LINODE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "LINODE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "LINODE_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developers.linode.com/api/v4",
+			GoClient: "https://github.com/linode/linodego",
+		},
+	}
+}
@@ -4,7 +4,6 @@ package liquidweb
 import (
 	"errors"
 	"fmt"
-	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -174,26 +173,20 @@ func (d *DNSProvider) findZone(domain string) (string, error) {
 		return "", fmt.Errorf("failed to retrieve zones for account: %w", err)
 	}
 
-	// filter the zones on the account to only ones that match
-	var zs []network.DNSZone
+	var names []string
 	for _, item := range zones.Items {
-		if strings.HasSuffix(domain, item.Name) {
-			zs = append(zs, item)
-		}
-	}
-
-	if len(zs) < 1 {
-		return "", fmt.Errorf("no valid zone in account for certificate '%s'", domain)
+		names = append(names, item.Name)
 	}
 
 	// powerdns _only_ looks for records on the longest matching subdomain zone aka,
 	// for test.sub.example.com if sub.example.com exists,
 	// it will look there it will not look atexample.com even if it also exists
-	sort.Slice(zs, func(i, j int) bool {
-		return len(zs[i].Name) > len(zs[j].Name)
-	})
+	zone := dns01.FindMostSpecificZone(domain, names)
+	if zone == "" {
+		return "", fmt.Errorf("no valid zone in account for certificate '%s'", domain)
+	}
 
-	return zs[0].Name, nil
+	return zone, nil
 }
 
 func altEnvName(v string) string {
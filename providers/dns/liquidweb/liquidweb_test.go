@@ -1,11 +1,13 @@
 package liquidweb
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/go-acme/lego/v4/platform/tester"
 	"github.com/liquidweb/liquidweb-go/network"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -258,6 +260,50 @@ func TestDNSProvider(t *testing.T) {
 	}
 }
 
+func TestDNSProvider_findZone(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		domain   string
+		expected string
+	}{
+		{
+			desc:     "zone apex",
+			domain:   "_acme-challenge.tacoman.com",
+			expected: "tacoman.com",
+		},
+		{
+			desc:     "most specific zone",
+			domain:   "_acme-challenge.always.money.stand.banana.com",
+			expected: "money.stand.banana.com",
+		},
+		{
+			desc:     "intermediate zone",
+			domain:   "_acme-challenge.foo.stand.banana.com",
+			expected: "stand.banana.com",
+		},
+		{
+			desc:     "same suffix but another zone",
+			domain:   "_acme-challenge.notexample.com",
+			expected: "",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			provider := setupTest(t)
+
+			zone, err := provider.findZone(test.domain)
+			if test.expected == "" {
+				require.EqualError(t, err, fmt.Sprintf("no valid zone in account for certificate '%s'", test.domain))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, zone)
+		})
+	}
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -0,0 +1,31 @@
+package liquidweb
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/liquidweb/liquidweb.toml
+		Name:  "Liquid Web",
+		Code:  "liquidweb",
+		Since: "v3.1.0",
+		URL:   "https://liquidweb.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "LWAPI_PASSWORD", Description: "Liquid Web API Password", Credential: true},
+			{Name: "LWAPI_USERNAME", Description: "Liquid Web API Username", Credential: true},
+			{Name: "LWAPI_HTTP_TIMEOUT", Description: "Maximum waiting time for the DNS records to be created (not verified)"},
+			{Name: "LWAPI_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "LWAPI_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "LWAPI_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+			{Name: "LWAPI_URL", Description: "Liquid Web API endpoint"},
+			{Name: "LWAPI_ZONE", Description: "DNS Zone"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.liquidweb.com/docs/",
+			GoClient: "https://github.com/liquidweb/liquidweb-go",
+		},
+	}
+}
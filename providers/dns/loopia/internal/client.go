@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 // DefaultBaseURL is url to the XML-RPC api.
@@ -22,6 +23,9 @@ type Client struct {
 	apiUser     string
 	apiPassword string
 
+	// CustomerNumber is the customer number of the sub-account (reseller accounts only).
+	CustomerNumber string
+
 	BaseURL    string
 	HTTPClient *http.Client
 }
@@ -40,9 +44,7 @@ func NewClient(apiUser, apiPassword string) *Client {
 func (c *Client) AddTXTRecord(ctx context.Context, domain string, subdomain string, ttl int, value string) error {
 	call := &methodCall{
 		MethodName: "addZoneRecord",
-		Params: []param{
-			paramString{Value: c.apiUser},
-			paramString{Value: c.apiPassword},
+		Params: append(c.credentials(),
 			paramString{Value: domain},
 			paramString{Value: subdomain},
 			paramStruct{
@@ -54,7 +56,7 @@ func (c *Client) AddTXTRecord(ctx context.Context, domain string, subdomain stri
 					structMemberInt{Name: "record_id", Value: 0},
 				},
 			},
-		},
+		),
 	}
 	resp := &responseString{}
 
@@ -70,13 +72,11 @@ func (c *Client) AddTXTRecord(ctx context.Context, domain string, subdomain stri
 func (c *Client) RemoveTXTRecord(ctx context.Context, domain string, subdomain string, recordID int) error {
 	call := &methodCall{
 		MethodName: "removeZoneRecord",
-		Params: []param{
-			paramString{Value: c.apiUser},
-			paramString{Value: c.apiPassword},
+		Params: append(c.credentials(),
 			paramString{Value: domain},
 			paramString{Value: subdomain},
 			paramInt{Value: recordID},
-		},
+		),
 	}
 	resp := &responseString{}
 
@@ -92,12 +92,10 @@ func (c *Client) RemoveTXTRecord(ctx context.Context, domain string, subdomain s
 func (c *Client) GetTXTRecords(ctx context.Context, domain string, subdomain string) ([]RecordObj, error) {
 	call := &methodCall{
 		MethodName: "getZoneRecords",
-		Params: []param{
-			paramString{Value: c.apiUser},
-			paramString{Value: c.apiPassword},
+		Params: append(c.credentials(),
 			paramString{Value: domain},
 			paramString{Value: subdomain},
-		},
+		),
 	}
 	resp := &recordObjectsResponse{}
 
@@ -110,12 +108,10 @@ func (c *Client) GetTXTRecords(ctx context.Context, domain string, subdomain str
 func (c *Client) RemoveSubdomain(ctx context.Context, domain, subdomain string) error {
 	call := &methodCall{
 		MethodName: "removeSubdomain",
-		Params: []param{
-			paramString{Value: c.apiUser},
-			paramString{Value: c.apiPassword},
+		Params: append(c.credentials(),
 			paramString{Value: domain},
 			paramString{Value: subdomain},
-		},
+		),
 	}
 	resp := &responseString{}
 
@@ -127,6 +123,20 @@ func (c *Client) RemoveSubdomain(ctx context.Context, domain, subdomain string)
 	return checkResponse(resp.Value)
 }
 
+// credentials returns the authentication parameters, these are the first parameters of all the methods.
+func (c *Client) credentials() []param {
+	params := []param{
+		paramString{Value: c.apiUser},
+		paramString{Value: c.apiPassword},
+	}
+
+	if c.CustomerNumber != "" {
+		params = append(params, paramString{Value: c.CustomerNumber})
+	}
+
+	return params
+}
+
 // rpcCall makes an XML-RPC call to Loopia's RPC endpoint by marshaling the data given in the call argument to XML
 // and sending that via HTTP Post to Loopia.
 // The response is then unmarshalled into the resp argument.
@@ -184,6 +194,8 @@ func newXMLRequest(ctx context.Context, endpoint string, payload any) (*http.Req
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Content-Type", "text/xml")
 
 	return req, nil
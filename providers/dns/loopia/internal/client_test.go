@@ -209,6 +209,36 @@ func TestClient_GetZoneRecord(t *testing.T) {
 	assert.EqualValues(t, expected, recordObjs)
 }
 
+func TestClient_customerNumber(t *testing.T) {
+	var params []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		call := struct {
+			Params []string `xml:"params>param>value>string"`
+		}{}
+
+		err := xml.NewDecoder(req.Body).Decode(&call)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		params = call.Params
+
+		_, _ = fmt.Fprint(w, responseOk)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient("apiuser", "goodpassword")
+	client.BaseURL = server.URL + "/"
+	client.CustomerNumber = "C12345"
+
+	err := client.RemoveSubdomain(context.Background(), exampleDomain, exampleSubDomain)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"apiuser", "goodpassword", "C12345", exampleDomain, exampleSubDomain}, params)
+}
+
 func TestClient_rpcCall_404(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		_, err := io.ReadAll(r.Body)
@@ -24,6 +24,8 @@ const (
 	EnvAPIPassword = envNamespace + "API_PASSWORD"
 	EnvAPIURL      = envNamespace + "API_URL"
 
+	EnvCustomerNumber = envNamespace + "CUSTOMER_NUMBER"
+
 	EnvTTL                = envNamespace + "TTL"
 	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
 	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
@@ -42,6 +44,7 @@ type Config struct {
 	BaseURL            string
 	APIUser            string
 	APIPassword        string
+	CustomerNumber     string
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
 	TTL                int
@@ -85,6 +88,7 @@ func NewDNSProvider() (*DNSProvider, error) {
 	config.APIUser = values[EnvAPIUser]
 	config.APIPassword = values[EnvAPIPassword]
 	config.BaseURL = env.GetOrDefaultString(EnvAPIURL, internal.DefaultBaseURL)
+	config.CustomerNumber = env.GetOrFile(EnvCustomerNumber)
 
 	return NewDNSProviderConfig(config)
 }
@@ -105,6 +109,7 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 	}
 
 	client := internal.NewClient(config.APIUser, config.APIPassword)
+	client.CustomerNumber = config.CustomerNumber
 
 	if config.HTTPClient != nil {
 		client.HTTPClient = config.HTTPClient
@@ -174,13 +179,23 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	d.inProgressMu.Lock()
 	defer d.inProgressMu.Unlock()
 
+	recordID, ok := d.inProgressInfo[token]
+	if !ok {
+		return fmt.Errorf("loopia: unknown record ID for '%s' '%s'", info.EffectiveFQDN, token)
+	}
+
 	ctx := context.Background()
 
-	err = d.client.RemoveTXTRecord(ctx, authZone, subDomain, d.inProgressInfo[token])
+	err = d.client.RemoveTXTRecord(ctx, authZone, subDomain, recordID)
 	if err != nil {
 		return fmt.Errorf("loopia: failed to remove TXT record: %w", err)
 	}
 
+	delete(d.inProgressInfo, token)
+
+	// Loopia keeps the subdomain when its last record is removed:
+	// the subdomain is removed, unless other records remain (e.g. the TXT records of other challenges).
+
 	records, err := d.client.GetTXTRecords(ctx, authZone, subDomain)
 	if err != nil {
 		return fmt.Errorf("loopia: failed to get TXT records: %w", err)
@@ -211,6 +211,27 @@ func TestDNSProvider_Cleanup(t *testing.T) {
 	}
 }
 
+func TestDNSProvider_Cleanup_unknownToken(t *testing.T) {
+	config := NewDefaultConfig()
+	config.APIUser = "apiuser"
+	config.APIPassword = "password"
+
+	client := &mockedClient{}
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.findZoneByFqdn = func(fqdn string) (string, error) {
+		return "example.com.", nil
+	}
+	provider.client = client
+
+	err = provider.CleanUp("example.com", "token", "key")
+	require.EqualError(t, err, "loopia: unknown record ID for '_acme-challenge.example.com.' 'token'")
+
+	client.AssertExpectations(t)
+}
+
 type mockedClient struct {
 	mock.Mock
 }
@@ -0,0 +1,31 @@
+package loopia
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/loopia/loopia.toml
+		Name:  "Loopia",
+		Code:  "loopia",
+		Since: "v4.2.0",
+		URL:   "https://loopia.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "LOOPIA_API_PASSWORD", Description: "API password", Credential: true},
+			{Name: "LOOPIA_API_USER", Description: "API username", Credential: true},
+			{Name: "LOOPIA_API_URL", Description: "API endpoint. Ex: https://api.loopia.se/RPCSERV or https://api.loopia.rs/RPCSERV"},
+			{Name: "LOOPIA_CUSTOMER_NUMBER", Description: "Customer number of the sub-account (reseller accounts only)"},
+			{Name: "LOOPIA_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "LOOPIA_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "LOOPIA_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "LOOPIA_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.loopia.com/api",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package luadns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/luadns/luadns.toml
+		Name:  "LuaDNS",
+		Code:  "luadns",
+		Since: "v3.7.0",
+		URL:   "https://luadns.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "LUADNS_API_TOKEN", Description: "API token", Credential: true},
+			{Name: "LUADNS_API_USERNAME", Description: "Username (your email)", Credential: true},
+			{Name: "LUADNS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "LUADNS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "LUADNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "LUADNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://luadns.com/api.html",
+			GoClient: "",
+		},
+	}
+}
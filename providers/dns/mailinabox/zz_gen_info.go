@@ -0,0 +1,28 @@
+package mailinabox
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/mailinabox/mailinabox.toml
+		Name:  "Mail-in-a-Box",
+		Code:  "mailinabox",
+		Since: "v4.16.0",
+		URL:   "https://mailinabox.email",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "MAILINABOX_BASE_URL", Description: "Base API URL (ex: https://box.example.com)", Credential: true},
+			{Name: "MAILINABOX_EMAIL", Description: "User email", Credential: true},
+			{Name: "MAILINABOX_PASSWORD", Description: "User password", Credential: true},
+			{Name: "MAILINABOX_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "MAILINABOX_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://mailinabox.email/api-docs.html",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,141 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
+)
+
+const defaultBaseURL = "https://metaname.net/api/1.1"
+
+// Client is a Metaname API client.
+type Client struct {
+	accountReference string
+	apiKey           string
+
+	lastID atomic.Int64
+
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewClient creates a new Client.
+func NewClient(accountReference, apiKey string) (*Client, error) {
+	if accountReference == "" || apiKey == "" {
+		return nil, errors.New("credentials missing")
+	}
+
+	return &Client{
+		accountReference: accountReference,
+		apiKey:           apiKey,
+		BaseURL:          defaultBaseURL,
+		HTTPClient:       &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// CreateDNSRecord creates a record in the zone, and returns the reference of the record.
+func (c *Client) CreateDNSRecord(ctx context.Context, zone string, record ResourceRecord) (string, error) {
+	var result APIResponse[string]
+
+	err := c.do(ctx, "create_dns_record", &result, zone, record)
+	if err != nil {
+		return "", err
+	}
+
+	return result.Result, nil
+}
+
+// DeleteDNSRecord deletes the record of the zone identified by the reference.
+func (c *Client) DeleteDNSRecord(ctx context.Context, zone, reference string) error {
+	return c.do(ctx, "delete_dns_record", &APIResponse[json.RawMessage]{}, zone, reference)
+}
+
+// DNSZone lists the records of the zone.
+func (c *Client) DNSZone(ctx context.Context, zone string) ([]ResourceRecord, error) {
+	var result APIResponse[[]ResourceRecord]
+
+	err := c.do(ctx, "dns_zone", &result, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Result, nil
+}
+
+func (c *Client) do(ctx context.Context, method string, result Response, params ...any) error {
+	payload := APIRequest{
+		JSONRPC: "2.0",
+		ID:      c.lastID.Add(1),
+		Method:  method,
+		// The credentials are the first parameters of all the methods.
+		Params: append([]any{c.accountReference, c.apiKey}, params...),
+	}
+
+	req, err := newJSONRequest(ctx, http.MethodPost, c.BaseURL, payload)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return errutils.NewHTTPDoError(req, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return errutils.NewUnexpectedResponseStatusCodeError(req, resp)
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errutils.NewReadResponseError(req, resp.StatusCode, err)
+	}
+
+	err = json.Unmarshal(raw, result)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
+	}
+
+	err = result.GetError()
+	if err != nil {
+		return fmt.Errorf("%s: %w", method, err)
+	}
+
+	return nil
+}
+
+func newJSONRequest(ctx context.Context, method string, endpoint string, payload any) (*http.Request, error) {
+	buf := new(bytes.Buffer)
+
+	if payload != nil {
+		err := json.NewEncoder(buf).Encode(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request JSON body: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, buf)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %w", err)
+	}
+
+	useragent.SetHeader(req.Header)
+
+	req.Header.Set("Accept", "application/json")
+
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
@@ -0,0 +1,153 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTest(t *testing.T, requestFile, responseFile string) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		expected, err := os.ReadFile(filepath.Join("fixtures", requestFile))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if !assert.JSONEq(t, string(expected), string(body)) {
+			http.Error(rw, "unexpected request body", http.StatusBadRequest)
+			return
+		}
+
+		file, err := os.Open(filepath.Join("fixtures", responseFile))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		defer func() { _ = file.Close() }()
+
+		_, err = io.Copy(rw, file)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	client, err := NewClient("1234", "secret")
+	require.NoError(t, err)
+
+	client.BaseURL = server.URL
+	client.HTTPClient = server.Client()
+
+	return client
+}
+
+func TestClient_CreateDNSRecord(t *testing.T) {
+	client := setupTest(t, "create_dns_record-request.json", "create_dns_record.json")
+
+	record := ResourceRecord{
+		Name: "_acme-challenge",
+		Type: "TXT",
+		TTL:  120,
+		Data: "txtTXTtxt",
+	}
+
+	ref, err := client.CreateDNSRecord(context.Background(), "example.com", record)
+	require.NoError(t, err)
+
+	assert.Equal(t, "c2c3d5b8-7a8f-4f2a-9d0b-2b1f8a3e4d5f", ref)
+}
+
+func TestClient_CreateDNSRecord_error(t *testing.T) {
+	client := setupTest(t, "create_dns_record-request.json", "error.json")
+
+	record := ResourceRecord{
+		Name: "_acme-challenge",
+		Type: "TXT",
+		TTL:  120,
+		Data: "txtTXTtxt",
+	}
+
+	_, err := client.CreateDNSRecord(context.Background(), "example.com", record)
+	require.EqualError(t, err, "create_dns_record: code: -4, message: Domain name not found: example.com")
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+
+	assert.Equal(t, -4, apiErr.Code)
+}
+
+func TestClient_DeleteDNSRecord(t *testing.T) {
+	client := setupTest(t, "delete_dns_record-request.json", "delete_dns_record.json")
+
+	err := client.DeleteDNSRecord(context.Background(), "example.com", "c2c3d5b8-7a8f-4f2a-9d0b-2b1f8a3e4d5f")
+	require.NoError(t, err)
+}
+
+func TestClient_DeleteDNSRecord_error(t *testing.T) {
+	client := setupTest(t, "delete_dns_record-request.json", "error.json")
+
+	err := client.DeleteDNSRecord(context.Background(), "example.com", "c2c3d5b8-7a8f-4f2a-9d0b-2b1f8a3e4d5f")
+	require.EqualError(t, err, "delete_dns_record: code: -4, message: Domain name not found: example.com")
+}
+
+func TestClient_DNSZone(t *testing.T) {
+	client := setupTest(t, "dns_zone-request.json", "dns_zone.json")
+
+	records, err := client.DNSZone(context.Background(), "example.com")
+	require.NoError(t, err)
+
+	aux := 10
+
+	expected := []ResourceRecord{
+		{
+			Name:      "www",
+			Type:      "A",
+			TTL:       3600,
+			Data:      "192.0.2.1",
+			Reference: "4f7f1c9e-0a1b-4c2d-8e3f-5a6b7c8d9e0f",
+		},
+		{
+			Type:      "MX",
+			Aux:       &aux,
+			TTL:       3600,
+			Data:      "mail.example.com",
+			Reference: "9a8b7c6d-5e4f-3a2b-1c0d-e9f8a7b6c5d4",
+		},
+	}
+
+	assert.Equal(t, expected, records)
+}
+
+func TestClient_DNSZone_error(t *testing.T) {
+	client := setupTest(t, "dns_zone-request.json", "error.json")
+
+	_, err := client.DNSZone(context.Background(), "example.com")
+	require.EqualError(t, err, "dns_zone: code: -4, message: Domain name not found: example.com")
+}
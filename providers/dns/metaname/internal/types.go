@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// APIRequest represents a JSON-RPC request.
+type APIRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int64  `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type Response interface {
+	GetError() error
+}
+
+// APIResponse represents a JSON-RPC response.
+type APIResponse[T any] struct {
+	JSONRPC string    `json:"jsonrpc"`
+	ID      int64     `json:"id"`
+	Result  T         `json:"result"`
+	Error   *APIError `json:"error,omitempty"`
+}
+
+func (a APIResponse[T]) GetError() error {
+	if a.Error == nil {
+		return nil
+	}
+
+	return a.Error
+}
+
+// APIError is an API error (JSON-RPC error object).
+type APIError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+func (a *APIError) Error() string {
+	msg := fmt.Sprintf("code: %d, message: %s", a.Code, a.Message)
+
+	if len(a.Data) > 0 && string(a.Data) != "null" {
+		msg += fmt.Sprintf(", data: %s", a.Data)
+	}
+
+	return msg
+}
+
+// ResourceRecord is a DNS record.
+type ResourceRecord struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// Aux is the priority of the MX and SRV records, null for the other types.
+	Aux       *int   `json:"aux"`
+	TTL       int    `json:"ttl"`
+	Data      string `json:"data"`
+	Reference string `json:"reference,omitempty"`
+}
@@ -5,12 +5,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/config/env"
-	"github.com/nzdjb/go-metaname"
+	"github.com/go-acme/lego/v4/providers/dns/metaname/internal"
 )
 
 // Environment variables names.
@@ -23,6 +25,7 @@ const (
 	EnvTTL                = envNamespace + "TTL"
 	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
 	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
+	EnvHTTPTimeout        = envNamespace + "HTTP_TIMEOUT"
 )
 
 // Config is used to configure the creation of the DNSProvider.
@@ -32,6 +35,7 @@ type Config struct {
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
 	TTL                int
+	HTTPClient         *http.Client
 }
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
@@ -40,20 +44,28 @@ func NewDefaultConfig() *Config {
 		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
 		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
 		TTL:                env.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
+		HTTPClient: &http.Client{
+			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+		},
 	}
 }
 
+type reqKey struct {
+	zone      string
+	reference string
+}
+
 // DNSProvider implements the challenge.Provider interface.
 type DNSProvider struct {
 	config *Config
-	client *metaname.MetanameClient
+	client *internal.Client
 
-	records   map[string]string
+	records   map[string]reqKey
 	recordsMu sync.Mutex
 }
 
 // NewDNSProvider returns a new DNS provider
-// using environment variable METANAME_API_KEY for adding and removing the DNS record.
+// using environment variables METANAME_ACCOUNT_REFERENCE and METANAME_API_KEY for adding and removing the DNS record.
 func NewDNSProvider() (*DNSProvider, error) {
 	values, err := env.Get(EnvAccountReference, EnvAPIKey)
 	if err != nil {
@@ -80,75 +92,78 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		return nil, errors.New("metaname: missing api key")
 	}
 
+	client, err := internal.NewClient(config.AccountReference, config.APIKey)
+	if err != nil {
+		return nil, fmt.Errorf("metaname: %w", err)
+	}
+
+	if config.HTTPClient != nil {
+		client.HTTPClient = config.HTTPClient
+	}
+
 	return &DNSProvider{
 		config:  config,
-		client:  metaname.NewMetanameClient(config.AccountReference, config.APIKey),
-		records: make(map[string]string),
+		client:  client,
+		records: make(map[string]reqKey),
 	}, nil
 }
 
+// Present creates a TXT record using the specified parameters.
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	authZone, err := d.findZone(ctx, info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("metaname: could not find zone for domain %q: %w", domain, err)
 	}
 
-	authZone = dns01.UnFqdn(authZone)
-
 	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, authZone)
 	if err != nil {
 		return fmt.Errorf("metaname: could not extract subDomain: %w", err)
 	}
 
-	ctx := context.Background()
-
-	r := metaname.ResourceRecord{
+	r := internal.ResourceRecord{
 		Name: subDomain,
 		Type: "TXT",
-		Aux:  nil,
-		Ttl:  d.config.TTL,
+		TTL:  d.config.TTL,
 		Data: info.Value,
 	}
 
-	ref, err := d.client.CreateDnsRecord(ctx, authZone, r)
+	ref, err := d.client.CreateDNSRecord(ctx, authZone, r)
 	if err != nil {
 		return fmt.Errorf("metaname: add record: %w", err)
 	}
 
 	d.recordsMu.Lock()
-	d.records[token] = ref
+	d.records[token] = reqKey{zone: authZone, reference: ref}
 	d.recordsMu.Unlock()
 
 	return nil
 }
 
+// CleanUp removes the TXT record matching the specified parameters.
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
-	if err != nil {
-		return fmt.Errorf("metaname: could not find zone for domain %q: %w", domain, err)
-	}
-
-	authZone = dns01.UnFqdn(authZone)
-
-	ctx := context.Background()
-
 	d.recordsMu.Lock()
-	ref, ok := d.records[token]
+	key, ok := d.records[token]
 	d.recordsMu.Unlock()
 
 	if !ok {
 		return fmt.Errorf("metaname: unknown ref for %s", info.EffectiveFQDN)
 	}
 
-	err = d.client.DeleteDnsRecord(ctx, authZone, ref)
+	err := d.client.DeleteDNSRecord(context.Background(), key.zone, key.reference)
 	if err != nil {
 		return fmt.Errorf("metaname: delete record: %w", err)
 	}
 
+	d.recordsMu.Lock()
+	delete(d.records, token)
+	d.recordsMu.Unlock()
+
 	return nil
 }
 
@@ -157,3 +172,32 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 	return d.config.PropagationTimeout, d.config.PollingInterval
 }
+
+// findZone finds the zone of the FQDN with the dns_zone method:
+// the first parent domain of the FQDN managed by the account is the zone.
+func (d *DNSProvider) findZone(ctx context.Context, fqdn string) (string, error) {
+	labels := strings.Split(dns01.UnFqdn(fqdn), ".")
+
+	var lastErr error
+
+	// The TLD alone cannot be a zone of the account.
+	for i := range len(labels) - 1 {
+		zone := strings.Join(labels[i:], ".")
+
+		_, err := d.client.DNSZone(ctx, zone)
+		if err == nil {
+			return zone, nil
+		}
+
+		// A JSON-RPC error means that the domain is not a zone of the account,
+		// the other errors (network, HTTP status, etc.) stop the lookup.
+		var apiErr *internal.APIError
+		if !errors.As(err, &apiErr) {
+			return "", err
+		}
+
+		lastErr = err
+	}
+
+	return "", fmt.Errorf("zone not found: %w", lastErr)
+}
@@ -1,10 +1,16 @@
 package metaname
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -116,6 +122,144 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+// rpcCall a JSON-RPC call received by the fake API (the credentials are removed from the parameters).
+type rpcCall struct {
+	Method string
+	Params []any
+}
+
+func setupTest(t *testing.T) (*DNSProvider, *[]rpcCall) {
+	t.Helper()
+
+	var calls []rpcCall
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("POST /", func(rw http.ResponseWriter, req *http.Request) {
+		var rpcReq struct {
+			JSONRPC string `json:"jsonrpc"`
+			ID      int64  `json:"id"`
+			Method  string `json:"method"`
+			Params  []any  `json:"params"`
+		}
+
+		err := json.NewDecoder(req.Body).Decode(&rpcReq)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if rpcReq.JSONRPC != "2.0" || len(rpcReq.Params) < 2 {
+			http.Error(rw, "invalid JSON-RPC request", http.StatusBadRequest)
+			return
+		}
+
+		if rpcReq.Params[0] != "1234" || rpcReq.Params[1] != "secret" {
+			_, _ = fmt.Fprintf(rw, `{"jsonrpc":"2.0","id":%d,"error":{"code":-1,"message":"Authentication failed"}}`, rpcReq.ID)
+			return
+		}
+
+		calls = append(calls, rpcCall{Method: rpcReq.Method, Params: rpcReq.Params[2:]})
+
+		switch {
+		case rpcReq.Method == "dns_zone" && rpcReq.Params[2] == "example.com":
+			_, _ = fmt.Fprintf(rw, `{"jsonrpc":"2.0","id":%d,"result":[]}`, rpcReq.ID)
+
+		case rpcReq.Method == "dns_zone":
+			_, _ = fmt.Fprintf(rw, `{"jsonrpc":"2.0","id":%d,"error":{"code":-4,"message":"Domain name not found"}}`, rpcReq.ID)
+
+		case rpcReq.Method == "create_dns_record":
+			_, _ = fmt.Fprintf(rw, `{"jsonrpc":"2.0","id":%d,"result":"ref-123"}`, rpcReq.ID)
+
+		case rpcReq.Method == "delete_dns_record":
+			_, _ = fmt.Fprintf(rw, `{"jsonrpc":"2.0","id":%d,"result":null}`, rpcReq.ID)
+
+		default:
+			_, _ = fmt.Fprintf(rw, `{"jsonrpc":"2.0","id":%d,"error":{"code":-32601,"message":"Method not found"}}`, rpcReq.ID)
+		}
+	})
+
+	config := NewDefaultConfig()
+	config.AccountReference = "1234"
+	config.APIKey = "secret"
+	config.TTL = 120
+	config.HTTPClient = server.Client()
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.client.BaseURL = server.URL
+
+	return provider, &calls
+}
+
+func TestDNSProvider_Present(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	provider, calls := setupTest(t)
+
+	err := provider.Present("www.example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	expected := []rpcCall{
+		{Method: "dns_zone", Params: []any{"_acme-challenge.www.example.com"}},
+		{Method: "dns_zone", Params: []any{"www.example.com"}},
+		{Method: "dns_zone", Params: []any{"example.com"}},
+		{Method: "create_dns_record", Params: []any{"example.com", map[string]any{
+			"name": "_acme-challenge.www",
+			"type": "TXT",
+			"aux":  nil,
+			"ttl":  float64(120),
+			"data": dns01.GetChallengeInfo("www.example.com", "keyAuth").Value,
+		}}},
+	}
+
+	assert.Equal(t, expected, *calls)
+	assert.Equal(t, map[string]reqKey{"token": {zone: "example.com", reference: "ref-123"}}, provider.records)
+}
+
+func TestDNSProvider_Present_unknownZone(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	provider, _ := setupTest(t)
+
+	err := provider.Present("example.org", "token", "keyAuth")
+	require.EqualError(t, err, `metaname: could not find zone for domain "example.org": zone not found: dns_zone: code: -4, message: Domain name not found`)
+
+	assert.Empty(t, provider.records)
+}
+
+func TestDNSProvider_CleanUp(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	provider, calls := setupTest(t)
+
+	provider.records["token"] = reqKey{zone: "example.com", reference: "ref-123"}
+
+	err := provider.CleanUp("www.example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	expected := []rpcCall{
+		{Method: "delete_dns_record", Params: []any{"example.com", "ref-123"}},
+	}
+
+	assert.Equal(t, expected, *calls)
+	assert.Empty(t, provider.records)
+}
+
+func TestDNSProvider_CleanUp_unknownToken(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	provider, calls := setupTest(t)
+
+	err := provider.CleanUp("www.example.com", "token", "keyAuth")
+	require.EqualError(t, err, "metaname: unknown ref for _acme-challenge.www.example.com.")
+
+	assert.Empty(t, *calls)
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
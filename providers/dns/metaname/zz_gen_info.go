@@ -0,0 +1,29 @@
+package metaname
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/metaname/metaname.toml
+		Name:  "Metaname",
+		Code:  "metaname",
+		Since: "v4.13.0",
+		URL:   "https://metaname.net",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "METANAME_ACCOUNT_REFERENCE", Description: "The four-digit reference of a Metaname account", Credential: true},
+			{Name: "METANAME_API_KEY", Description: "API Key", Credential: true},
+			{Name: "METANAME_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "METANAME_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "METANAME_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "METANAME_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://metaname.net/api/1.1/doc",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,216 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
+)
+
+const defaultBaseURL = "https://api.mittwald.de/v2/"
+
+// Client the mittwald mStudio API client.
+type Client struct {
+	token string
+
+	BaseURL    *url.URL
+	HTTPClient *http.Client
+}
+
+// NewClient creates a new Client.
+func NewClient(token string) (*Client, error) {
+	if token == "" {
+		return nil, errors.New("credentials missing")
+	}
+
+	baseURL, _ := url.Parse(defaultBaseURL)
+
+	return &Client{
+		token:      token,
+		BaseURL:    baseURL,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// ListDomains lists the domains of the account.
+// https://api.mittwald.de/v2/docs/#/Domain/domain-list-domains
+func (c *Client) ListDomains(ctx context.Context) ([]Domain, error) {
+	endpoint := c.BaseURL.JoinPath("domains")
+
+	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var domains []Domain
+
+	err = c.do(req, &domains)
+	if err != nil {
+		return nil, err
+	}
+
+	return domains, nil
+}
+
+// ListDNSZones lists the DNS zones of a project.
+// https://api.mittwald.de/v2/docs/#/Domain/dns-list-dns-zones
+func (c *Client) ListDNSZones(ctx context.Context, projectID string) ([]DNSZone, error) {
+	endpoint := c.BaseURL.JoinPath("projects", projectID, "dns-zones")
+
+	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var zones []DNSZone
+
+	err = c.do(req, &zones)
+	if err != nil {
+		return nil, err
+	}
+
+	return zones, nil
+}
+
+// GetDNSZone gets a DNS zone.
+// https://api.mittwald.de/v2/docs/#/Domain/dns-get-dns-zone
+func (c *Client) GetDNSZone(ctx context.Context, zoneID string) (*DNSZone, error) {
+	endpoint := c.BaseURL.JoinPath("dns-zones", zoneID)
+
+	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	zone := &DNSZone{}
+
+	err = c.do(req, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	return zone, nil
+}
+
+// CreateDNSZone creates a DNS zone for a subdomain of a parent zone.
+// https://api.mittwald.de/v2/docs/#/Domain/dns-create-dns-zone
+func (c *Client) CreateDNSZone(ctx context.Context, request CreateDNSZoneRequest) (*NewDNSZone, error) {
+	endpoint := c.BaseURL.JoinPath("dns-zones")
+
+	req, err := newJSONRequest(ctx, http.MethodPost, endpoint, request)
+	if err != nil {
+		return nil, err
+	}
+
+	zone := &NewDNSZone{}
+
+	err = c.do(req, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	return zone, nil
+}
+
+// UpdateTXTRecord replaces the TXT record set of a DNS zone.
+// https://api.mittwald.de/v2/docs/#/Domain/dns-update-record-set
+func (c *Client) UpdateTXTRecord(ctx context.Context, zoneID string, record TXTRecord) error {
+	endpoint := c.BaseURL.JoinPath("dns-zones", zoneID, "record-sets", "txt")
+
+	req, err := newJSONRequest(ctx, http.MethodPut, endpoint, record)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+// DeleteDNSZone deletes a DNS zone.
+// https://api.mittwald.de/v2/docs/#/Domain/dns-delete-dns-zone
+func (c *Client) DeleteDNSZone(ctx context.Context, zoneID string) error {
+	endpoint := c.BaseURL.JoinPath("dns-zones", zoneID)
+
+	req, err := newJSONRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *Client) do(req *http.Request, result any) error {
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return errutils.NewHTTPDoError(req, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode/100 != 2 {
+		return parseError(req, resp)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errutils.NewReadResponseError(req, resp.StatusCode, err)
+	}
+
+	err = json.Unmarshal(raw, result)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
+	}
+
+	return nil
+}
+
+func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, payload any) (*http.Request, error) {
+	buf := new(bytes.Buffer)
+
+	if payload != nil {
+		err := json.NewEncoder(buf).Encode(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request JSON body: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint.String(), buf)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %w", err)
+	}
+
+	useragent.SetHeader(req.Header)
+
+	req.Header.Set("Accept", "application/json")
+
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+func parseError(req *http.Request, resp *http.Response) error {
+	raw, _ := io.ReadAll(resp.Body)
+
+	errAPI := &APIError{StatusCode: resp.StatusCode}
+	err := json.Unmarshal(raw, errAPI)
+	if err != nil || errAPI.Message == "" {
+		return errutils.NewUnexpectedStatusCodeError(req, resp.StatusCode, raw)
+	}
+
+	return errAPI
+}
@@ -0,0 +1,185 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTest(t *testing.T, pattern string, status int, filename, expectedRequest string) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc(pattern, func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(rw, fmt.Sprintf("invalid Authorization header: %q", req.Header.Get("Authorization")), http.StatusUnauthorized)
+			return
+		}
+
+		if expectedRequest != "" {
+			expected, err := os.ReadFile(filepath.Join("fixtures", expectedRequest))
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			if !jsonEqual(expected, body) {
+				http.Error(rw, fmt.Sprintf("invalid request body: %s", string(body)), http.StatusBadRequest)
+				return
+			}
+		}
+
+		if filename == "" {
+			rw.WriteHeader(status)
+			return
+		}
+
+		file, err := os.Open(filepath.Join("fixtures", filename))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		defer func() { _ = file.Close() }()
+
+		rw.WriteHeader(status)
+
+		_, err = io.Copy(rw, file)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	client, err := NewClient("secret")
+	require.NoError(t, err)
+
+	client.BaseURL, _ = url.Parse(server.URL)
+	client.HTTPClient = server.Client()
+
+	return client
+}
+
+func jsonEqual(a, b []byte) bool {
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(va, vb)
+}
+
+func TestClient_ListDomains(t *testing.T) {
+	client := setupTest(t, "GET /domains", http.StatusOK, "domains.json", "")
+
+	domains, err := client.ListDomains(context.Background())
+	require.NoError(t, err)
+
+	expected := []Domain{
+		{DomainID: "d1", Domain: "example.com", ProjectID: "p1"},
+		{DomainID: "d2", Domain: "example.org", ProjectID: "p2"},
+	}
+
+	assert.Equal(t, expected, domains)
+}
+
+func TestClient_ListDNSZones(t *testing.T) {
+	client := setupTest(t, "GET /projects/p1/dns-zones", http.StatusOK, "dns_zones.json", "")
+
+	zones, err := client.ListDNSZones(context.Background(), "p1")
+	require.NoError(t, err)
+
+	expected := []DNSZone{
+		{ID: "z1", Domain: "example.com"},
+		{
+			ID:     "z2",
+			Domain: "_acme-challenge.example.com",
+			RecordSet: RecordSet{TXT: &TXTRecord{
+				Settings: Settings{TTL: TTL{Seconds: 300}},
+				Entries:  []string{"foo"},
+			}},
+		},
+	}
+
+	assert.Equal(t, expected, zones)
+}
+
+func TestClient_GetDNSZone(t *testing.T) {
+	client := setupTest(t, "GET /dns-zones/z2", http.StatusOK, "dns_zone.json", "")
+
+	zone, err := client.GetDNSZone(context.Background(), "z2")
+	require.NoError(t, err)
+
+	expected := &DNSZone{
+		ID:     "z2",
+		Domain: "_acme-challenge.example.com",
+		RecordSet: RecordSet{TXT: &TXTRecord{
+			Settings: Settings{TTL: TTL{Seconds: 300}},
+			Entries:  []string{"foo", "bar"},
+		}},
+	}
+
+	assert.Equal(t, expected, zone)
+}
+
+func TestClient_GetDNSZone_error(t *testing.T) {
+	client := setupTest(t, "GET /dns-zones/z2", http.StatusNotFound, "error.json", "")
+
+	_, err := client.GetDNSZone(context.Background(), "z2")
+	require.EqualError(t, err, "404: NotFound: dns zone not found")
+}
+
+func TestClient_CreateDNSZone(t *testing.T) {
+	client := setupTest(t, "POST /dns-zones", http.StatusCreated, "create_dns_zone.json", "create_dns_zone-request.json")
+
+	zone, err := client.CreateDNSZone(context.Background(), CreateDNSZoneRequest{Name: "_acme-challenge", ParentZoneID: "z1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, &NewDNSZone{ID: "z3"}, zone)
+}
+
+func TestClient_UpdateTXTRecord(t *testing.T) {
+	client := setupTest(t, "PUT /dns-zones/z2/record-sets/txt", http.StatusNoContent, "", "update_txt_record-request.json")
+
+	record := TXTRecord{
+		Settings: Settings{TTL: TTL{Seconds: 300}},
+		Entries:  []string{"foo", "bar"},
+	}
+
+	err := client.UpdateTXTRecord(context.Background(), "z2", record)
+	require.NoError(t, err)
+}
+
+func TestClient_DeleteDNSZone(t *testing.T) {
+	client := setupTest(t, "DELETE /dns-zones/z3", http.StatusNoContent, "", "")
+
+	err := client.DeleteDNSZone(context.Background(), "z3")
+	require.NoError(t, err)
+}
+
+func TestClient_DeleteDNSZone_error(t *testing.T) {
+	client := setupTest(t, "DELETE /dns-zones/z3", http.StatusNotFound, "error.json", "")
+
+	err := client.DeleteDNSZone(context.Background(), "z3")
+	require.EqualError(t, err, "404: NotFound: dns zone not found")
+}
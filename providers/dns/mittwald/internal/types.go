@@ -0,0 +1,53 @@
+package internal
+
+import "fmt"
+
+type APIError struct {
+	StatusCode int    `json:"-"`
+	Type       string `json:"type"`
+	Message    string `json:"message"`
+}
+
+func (a *APIError) Error() string {
+	return fmt.Sprintf("%d: %s: %s", a.StatusCode, a.Type, a.Message)
+}
+
+type Domain struct {
+	DomainID  string `json:"domainId"`
+	Domain    string `json:"domain"`
+	ProjectID string `json:"projectId"`
+}
+
+type DNSZone struct {
+	ID        string    `json:"id"`
+	Domain    string    `json:"domain"`
+	RecordSet RecordSet `json:"recordSet"`
+}
+
+type RecordSet struct {
+	TXT *TXTRecord `json:"txt,omitempty"`
+}
+
+// TXTRecord the TXT record set of a DNS zone: all the TXT values of the name.
+type TXTRecord struct {
+	Settings Settings `json:"settings"`
+	Entries  []string `json:"entries"`
+}
+
+type Settings struct {
+	TTL TTL `json:"ttl"`
+}
+
+type TTL struct {
+	Seconds int  `json:"seconds,omitempty"`
+	Auto    bool `json:"auto,omitempty"`
+}
+
+type CreateDNSZoneRequest struct {
+	Name         string `json:"name"`
+	ParentZoneID string `json:"parentZoneId"`
+}
+
+type NewDNSZone struct {
+	ID string `json:"id"`
+}
@@ -0,0 +1,278 @@
+// Package mittwald implements a DNS provider for solving the DNS-01 challenge using Mittwald.
+package mittwald
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/go-acme/lego/v4/providers/dns/mittwald/internal"
+)
+
+// Environment variables names.
+const (
+	envNamespace = "MITTWALD_"
+
+	EnvToken = envNamespace + "TOKEN"
+
+	EnvTTL                = envNamespace + "TTL"
+	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
+	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
+	EnvHTTPTimeout        = envNamespace + "HTTP_TIMEOUT"
+)
+
+// Config is used to configure the creation of the DNSProvider.
+type Config struct {
+	Token string
+
+	TTL                int
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+	HTTPClient         *http.Client
+}
+
+// NewDefaultConfig returns a default configuration for the DNSProvider.
+func NewDefaultConfig() *Config {
+	return &Config{
+		TTL:                env.GetOrDefaultInt(EnvTTL, 300),
+		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 2*time.Minute),
+		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		HTTPClient: &http.Client{
+			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+		},
+	}
+}
+
+// DNSProvider implements the challenge.Provider interface.
+type DNSProvider struct {
+	config *Config
+	client *internal.Client
+
+	// The TXT records of a name are a single record set:
+	// the updates of the record sets are serialized to not lose the values of the other challenges.
+	recordsMu    sync.Mutex
+	records      map[string]string
+	createdZones map[string]struct{}
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for Mittwald.
+// Credentials must be passed in the environment variable: MITTWALD_TOKEN.
+func NewDNSProvider() (*DNSProvider, error) {
+	values, err := env.Get(EnvToken)
+	if err != nil {
+		return nil, fmt.Errorf("mittwald: %w", err)
+	}
+
+	config := NewDefaultConfig()
+	config.Token = values[EnvToken]
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewDNSProviderConfig return a DNSProvider instance configured for Mittwald.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("mittwald: the configuration of the DNS provider is nil")
+	}
+
+	client, err := internal.NewClient(config.Token)
+	if err != nil {
+		return nil, fmt.Errorf("mittwald: %w", err)
+	}
+
+	if config.HTTPClient != nil {
+		client.HTTPClient = config.HTTPClient
+	}
+
+	return &DNSProvider{
+		config:       config,
+		client:       client,
+		records:      make(map[string]string),
+		createdZones: make(map[string]struct{}),
+	}, nil
+}
+
+// Timeout returns the timeout and interval to use when checking for DNS propagation.
+// Adjusting here to cope with spikes in propagation times.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
+
+// Present creates a TXT record using the specified parameters.
+func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	projectID, err := d.findProject(ctx, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("mittwald: %w", err)
+	}
+
+	d.recordsMu.Lock()
+	defer d.recordsMu.Unlock()
+
+	zones, err := d.client.ListDNSZones(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("mittwald: list DNS zones: %w", err)
+	}
+
+	zone, err := d.getOrCreateZone(ctx, zones, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("mittwald: %w", err)
+	}
+
+	record := internal.TXTRecord{
+		Settings: internal.Settings{TTL: internal.TTL{Seconds: d.config.TTL}},
+	}
+
+	if zone.RecordSet.TXT != nil {
+		record.Entries = zone.RecordSet.TXT.Entries
+
+		if zone.RecordSet.TXT.Settings.TTL != (internal.TTL{}) {
+			record.Settings = zone.RecordSet.TXT.Settings
+		}
+	}
+
+	if !slices.Contains(record.Entries, info.Value) {
+		record.Entries = append(record.Entries, info.Value)
+	}
+
+	err = d.client.UpdateTXTRecord(ctx, zone.ID, record)
+	if err != nil {
+		return fmt.Errorf("mittwald: update TXT record: %w", err)
+	}
+
+	d.records[token] = zone.ID
+
+	return nil
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	d.recordsMu.Lock()
+	defer d.recordsMu.Unlock()
+
+	zoneID, ok := d.records[token]
+	if !ok {
+		return fmt.Errorf("mittwald: unknown DNS zone ID for '%s'", info.EffectiveFQDN)
+	}
+
+	zone, err := d.client.GetDNSZone(ctx, zoneID)
+	if err != nil {
+		return fmt.Errorf("mittwald: get DNS zone: %w", err)
+	}
+
+	record := internal.TXTRecord{
+		Settings: internal.Settings{TTL: internal.TTL{Seconds: d.config.TTL}},
+	}
+
+	if zone.RecordSet.TXT != nil {
+		record.Settings = zone.RecordSet.TXT.Settings
+
+		for _, entry := range zone.RecordSet.TXT.Entries {
+			if entry != info.Value {
+				record.Entries = append(record.Entries, entry)
+			}
+		}
+	}
+
+	_, created := d.createdZones[zoneID]
+
+	if len(record.Entries) == 0 && created {
+		err = d.client.DeleteDNSZone(ctx, zoneID)
+		if err != nil {
+			return fmt.Errorf("mittwald: delete DNS zone: %w", err)
+		}
+
+		delete(d.createdZones, zoneID)
+	} else {
+		if record.Entries == nil {
+			record.Entries = []string{}
+		}
+
+		err = d.client.UpdateTXTRecord(ctx, zoneID, record)
+		if err != nil {
+			return fmt.Errorf("mittwald: update TXT record: %w", err)
+		}
+	}
+
+	delete(d.records, token)
+
+	return nil
+}
+
+// findProject returns the ID of the project of the most specific domain, of the account, containing the FQDN.
+func (d *DNSProvider) findProject(ctx context.Context, fqdn string) (string, error) {
+	domains, err := d.client.ListDomains(ctx)
+	if err != nil {
+		return "", fmt.Errorf("list domains: %w", err)
+	}
+
+	projects := make(map[string]string)
+
+	var names []string
+	for _, dom := range domains {
+		projects[dom.Domain] = dom.ProjectID
+		names = append(names, dom.Domain)
+	}
+
+	name := dns01.FindMostSpecificZone(fqdn, names)
+	if name == "" {
+		return "", fmt.Errorf("no domain found for %s", fqdn)
+	}
+
+	return projects[name], nil
+}
+
+// getOrCreateZone returns the DNS zone of the FQDN.
+// A DNS zone holds the records of a single name, so the zone is created, under the most specific parent zone, if it doesn't exist.
+func (d *DNSProvider) getOrCreateZone(ctx context.Context, zones []internal.DNSZone, fqdn string) (*internal.DNSZone, error) {
+	name := strings.ToLower(dns01.UnFqdn(fqdn))
+
+	byDomain := make(map[string]internal.DNSZone)
+
+	var domains []string
+	for _, zone := range zones {
+		byDomain[zone.Domain] = zone
+		domains = append(domains, zone.Domain)
+	}
+
+	domain := dns01.FindMostSpecificZone(fqdn, domains)
+	if domain == "" {
+		return nil, fmt.Errorf("no DNS zone found for %s", fqdn)
+	}
+
+	zone := byDomain[domain]
+
+	if strings.ToLower(dns01.UnFqdn(domain)) == name {
+		return &zone, nil
+	}
+
+	parent := &internal.DNSZone{ID: zone.ID, Domain: strings.ToLower(dns01.UnFqdn(domain))}
+
+	subDomain, err := dns01.ExtractSubDomain(strings.ToLower(fqdn), parent.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	newZone, err := d.client.CreateDNSZone(ctx, internal.CreateDNSZoneRequest{Name: subDomain, ParentZoneID: parent.ID})
+	if err != nil {
+		return nil, fmt.Errorf("create DNS zone: %w", err)
+	}
+
+	d.createdZones[newZone.ID] = struct{}{}
+
+	return &internal.DNSZone{ID: newZone.ID, Domain: name}, nil
+}
@@ -0,0 +1,28 @@
+package mittwald
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/mittwald/mittwald.toml
+		Name:  "Mittwald",
+		Code:  "mittwald",
+		Since: "v4.18.0",
+		URL:   "https://www.mittwald.de/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "MITTWALD_TOKEN", Description: "API token", Credential: true},
+			{Name: "MITTWALD_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "MITTWALD_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "MITTWALD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "MITTWALD_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.mittwald.de/v2/docs/",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package mydnsjp
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/mydnsjp/mydnsjp.toml
+		Name:  "MyDNS.jp",
+		Code:  "mydnsjp",
+		Since: "v1.2.0",
+		URL:   "https://www.mydns.jp",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "MYDNSJP_MASTER_ID", Description: "Master ID", Credential: true},
+			{Name: "MYDNSJP_PASSWORD", Description: "Password", Credential: true},
+			{Name: "MYDNSJP_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "MYDNSJP_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "MYDNSJP_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "MYDNSJP_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.mydns.jp/?MENU=030",
+			GoClient: "",
+		},
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 // Default API endpoints.
@@ -48,15 +49,81 @@ func NewClient(username string, password string) *Client {
 	}
 }
 
+// ListZones lists the zones accessible with the API key.
+// https://www.mythic-beasts.com/support/api/dnsv2#ep-get-zones
+func (c *Client) ListZones(ctx context.Context) ([]string, error) {
+	endpoint := c.APIEndpoint.JoinPath("zones")
+
+	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &zonesResponse{}
+	err = c.do(req, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Zones, nil
+}
+
+// GetTXTRecords gets the TXT records of a host.
+// https://www.mythic-beasts.com/support/api/dnsv2#ep-get-zoneszonerecordshosttype
+func (c *Client) GetTXTRecords(ctx context.Context, zone, leaf string) ([]Record, error) {
+	endpoint := c.APIEndpoint.JoinPath("zones", zone, "records", leaf, "TXT")
+
+	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &recordsResponse{}
+	err = c.do(req, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Records, nil
+}
+
 // CreateTXTRecord creates a TXT record.
-// https://www.mythic-beasts.com/support/api/dnsv2#ep-get-zoneszonerecords
+// The PUT replaces all the TXT records of the host,
+// so the existing values are sent along with the new one.
+// https://www.mythic-beasts.com/support/api/dnsv2#ep-put-zoneszonerecordshosttype
 func (c *Client) CreateTXTRecord(ctx context.Context, zone, leaf, value string, ttl int) error {
-	resp, err := c.createTXTRecord(ctx, zone, leaf, "TXT", value, ttl)
+	existing, err := c.GetTXTRecords(ctx, zone, leaf)
+	if err != nil {
+		return err
+	}
+
+	records := []Record{{
+		Host: leaf,
+		TTL:  ttl,
+		Type: "TXT",
+		Data: value,
+	}}
+
+	for _, record := range existing {
+		if record.Data == value {
+			// Already present.
+			return nil
+		}
+
+		records = append(records, Record{
+			Host: leaf,
+			TTL:  record.TTL,
+			Type: "TXT",
+			Data: record.Data,
+		})
+	}
+
+	resp, err := c.updateRecords(ctx, zone, leaf, "TXT", records)
 	if err != nil {
 		return err
 	}
 
-	if resp.Added != 1 {
+	if resp.Added < 1 {
 		return fmt.Errorf("did not add TXT record for some reason: %s", resp.Message)
 	}
 
@@ -80,20 +147,11 @@ func (c *Client) RemoveTXTRecord(ctx context.Context, zone, leaf, value string)
 	return nil
 }
 
-// https://www.mythic-beasts.com/support/api/dnsv2#ep-post-zoneszonerecords
-func (c *Client) createTXTRecord(ctx context.Context, zone, leaf, recordType, value string, ttl int) (*createTXTResponse, error) {
+// https://www.mythic-beasts.com/support/api/dnsv2#ep-put-zoneszonerecordshosttype
+func (c *Client) updateRecords(ctx context.Context, zone, leaf, recordType string, records []Record) (*createTXTResponse, error) {
 	endpoint := c.APIEndpoint.JoinPath("zones", zone, "records", leaf, recordType)
 
-	createReq := createTXTRequest{
-		Records: []createTXTRecord{{
-			Host: leaf,
-			TTL:  ttl,
-			Type: "TXT",
-			Data: value,
-		}},
-	}
-
-	req, err := newJSONRequest(ctx, http.MethodPost, endpoint, createReq)
+	req, err := newJSONRequest(ctx, http.MethodPut, endpoint, recordsRequest{Records: records})
 	if err != nil {
 		return nil, err
 	}
@@ -177,6 +235,8 @@ func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, paylo
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 
 	if payload != nil {
@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -8,9 +9,11 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -54,13 +57,68 @@ func writeFixtureHandler(method, filename string) http.HandlerFunc {
 	}
 }
 
+func TestClient_ListZones(t *testing.T) {
+	client := setupTest(t, "/zones", writeFixtureHandler(http.MethodGet, "get-zones.json"))
+
+	zones, err := client.ListZones(mockContext())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"example.com", "example.org"}, zones)
+}
+
+func TestClient_GetTXTRecords(t *testing.T) {
+	client := setupTest(t, "/zones/example.com/records/foo/TXT", writeFixtureHandler(http.MethodGet, "get-zoneszonerecords.json"))
+
+	records, err := client.GetTXTRecords(mockContext(), "example.com", "foo")
+	require.NoError(t, err)
+
+	expected := []Record{{Host: "foo", TTL: 300, Type: "TXT", Data: "existing"}}
+
+	assert.Equal(t, expected, records)
+}
+
 func TestClient_CreateTXTRecord(t *testing.T) {
-	client := setupTest(t, "/zones/example.com/records/foo/TXT", writeFixtureHandler(http.MethodPost, "post-zoneszonerecords.json"))
+	client := setupTest(t, "/zones/example.com/records/foo/TXT", func(rw http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			writeFixtureHandler(http.MethodGet, "get-zoneszonerecords.json").ServeHTTP(rw, req)
+
+		case http.MethodPut:
+			body := recordsRequest{}
+			err := json.NewDecoder(req.Body).Decode(&body)
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			expected := []Record{
+				{Host: "foo", TTL: 120, Type: "TXT", Data: "txt"},
+				{Host: "foo", TTL: 300, Type: "TXT", Data: "existing"},
+			}
+
+			if !reflect.DeepEqual(expected, body.Records) {
+				http.Error(rw, fmt.Sprintf("unexpected records: %v", body.Records), http.StatusBadRequest)
+				return
+			}
+
+			writeFixtureHandler(http.MethodPut, "put-zoneszonerecords.json").ServeHTTP(rw, req)
+
+		default:
+			http.Error(rw, fmt.Sprintf("unsupported method %s", req.Method), http.StatusBadRequest)
+		}
+	})
 
 	err := client.CreateTXTRecord(mockContext(), "example.com", "foo", "txt", 120)
 	require.NoError(t, err)
 }
 
+func TestClient_CreateTXTRecord_alreadyExists(t *testing.T) {
+	client := setupTest(t, "/zones/example.com/records/foo/TXT", writeFixtureHandler(http.MethodGet, "get-zoneszonerecords.json"))
+
+	err := client.CreateTXTRecord(mockContext(), "example.com", "foo", "existing", 120)
+	require.NoError(t, err)
+}
+
 func TestClient_RemoveTXTRecord(t *testing.T) {
 	client := setupTest(t, "/zones/example.com/records/foo/TXT", writeFixtureHandler(http.MethodDelete, "delete-zoneszonerecords.json"))
 
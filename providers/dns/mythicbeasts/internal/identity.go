@@ -10,12 +10,16 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 type token string
 
 const tokenKey token = "token"
 
+// tokenExpirationMargin is the remaining lifetime under which a token is renewed.
+const tokenExpirationMargin = 30 * time.Second
+
 // obtainToken Logs into mythic beasts and acquires a bearer token for use in future API calls.
 // https://www.mythic-beasts.com/support/api/auth#sec-obtaining-a-token
 func (c *Client) obtainToken(ctx context.Context) (*Token, error) {
@@ -24,6 +28,8 @@ func (c *Client) obtainToken(ctx context.Context) (*Token, error) {
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.SetBasicAuth(c.username, c.password)
 
@@ -58,11 +64,13 @@ func (c *Client) obtainToken(ctx context.Context) (*Token, error) {
 	return &tok, nil
 }
 
+// CreateAuthenticatedContext returns a context containing a bearer token.
+// The token is cached and reused until it is about to expire.
 func (c *Client) CreateAuthenticatedContext(ctx context.Context) (context.Context, error) {
 	c.muToken.Lock()
 	defer c.muToken.Unlock()
 
-	if c.token != nil && time.Now().Before(c.token.Deadline) {
+	if c.token != nil && time.Now().Add(tokenExpirationMargin).Before(c.token.Deadline) {
 		// Already authenticated, stop now
 		return context.WithValue(ctx, tokenKey, c.token), nil
 	}
@@ -72,6 +80,8 @@ func (c *Client) CreateAuthenticatedContext(ctx context.Context) (context.Contex
 		return nil, err
 	}
 
+	c.token = tok
+
 	return context.WithValue(ctx, tokenKey, tok), nil
 }
 
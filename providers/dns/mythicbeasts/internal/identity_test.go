@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -79,3 +80,54 @@ func TestClient_CreateAuthenticatedContext(t *testing.T) {
 	assert.NotZero(t, tok.Deadline)
 	assert.Equal(t, "xxx", tok.Token)
 }
+
+func TestClient_CreateAuthenticatedContext_reuseToken(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	var calls int
+	mux.HandleFunc("/", func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+		tokenHandler(rw, req)
+	})
+
+	client := NewClient("user", "secret")
+	client.HTTPClient = server.Client()
+	client.AuthEndpoint, _ = url.Parse(server.URL)
+
+	for range 3 {
+		_, err := client.CreateAuthenticatedContext(context.Background())
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, calls)
+}
+
+func TestClient_CreateAuthenticatedContext_renewToken(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	var calls int
+	mux.HandleFunc("/", func(rw http.ResponseWriter, req *http.Request) {
+		calls++
+		tokenHandler(rw, req)
+	})
+
+	client := NewClient("user", "secret")
+	client.HTTPClient = server.Client()
+	client.AuthEndpoint, _ = url.Parse(server.URL)
+	client.token = &Token{
+		Token:     "old",
+		TokenType: "bearer",
+		Deadline:  time.Now().Add(tokenExpirationMargin / 2),
+	}
+
+	ctx, err := client.CreateAuthenticatedContext(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, "xxx", getToken(ctx).Token)
+	assert.Equal(t, "xxx", client.token.Token)
+}
@@ -27,11 +27,19 @@ func (a authResponseError) Error() string {
 	return fmt.Sprintf("%s: %s", a.ErrorMsg, a.ErrorDescription)
 }
 
-type createTXTRequest struct {
-	Records []createTXTRecord `json:"records"`
+type zonesResponse struct {
+	Zones []string `json:"zones"`
 }
 
-type createTXTRecord struct {
+type recordsRequest struct {
+	Records []Record `json:"records"`
+}
+
+type recordsResponse struct {
+	Records []Record `json:"records"`
+}
+
+type Record struct {
 	Host string `json:"host"`
 	TTL  int    `json:"ttl"`
 	Type string `json:"type"`
@@ -18,6 +18,8 @@ import (
 const (
 	envNamespace = "MYTHICBEASTS_"
 
+	EnvAPIKey          = envNamespace + "API_KEY"
+	EnvAPISecret       = envNamespace + "API_SECRET"
 	EnvUserName        = envNamespace + "USERNAME"
 	EnvPassword        = envNamespace + "PASSWORD"
 	EnvAPIEndpoint     = envNamespace + "API_ENDPOINT"
@@ -31,8 +33,11 @@ const (
 
 // Config is used to configure the creation of the DNSProvider.
 type Config struct {
-	UserName           string
-	Password           string
+	// UserName is the API key ID.
+	UserName string
+	// Password is the API key secret.
+	Password string
+
 	HTTPClient         *http.Client
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
@@ -73,9 +78,13 @@ type DNSProvider struct {
 
 // NewDNSProvider returns a DNSProvider instance configured for mythicbeasts DNSv2 API.
 // Credentials must be passed in the environment variables:
-// MYTHICBEASTS_USERNAME and MYTHICBEASTS_PASSWORD.
+// MYTHICBEASTS_API_KEY and MYTHICBEASTS_API_SECRET
+// (or the older MYTHICBEASTS_USERNAME and MYTHICBEASTS_PASSWORD).
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvUserName, EnvPassword)
+	values, err := env.GetWithFallback(
+		[]string{EnvAPIKey, EnvUserName},
+		[]string{EnvAPISecret, EnvPassword},
+	)
 	if err != nil {
 		return nil, fmt.Errorf("mythicbeasts: %w", err)
 	}
@@ -84,8 +93,8 @@ func NewDNSProvider() (*DNSProvider, error) {
 	if err != nil {
 		return nil, fmt.Errorf("mythicbeasts: %w", err)
 	}
-	config.UserName = values[EnvUserName]
-	config.Password = values[EnvPassword]
+	config.UserName = values[EnvAPIKey]
+	config.Password = values[EnvAPISecret]
 
 	return NewDNSProviderConfig(config)
 }
@@ -97,7 +106,7 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 	}
 
 	if config.UserName == "" || config.Password == "" {
-		return nil, errors.New("mythicbeasts: incomplete credentials, missing username and/or password")
+		return nil, errors.New("mythicbeasts: incomplete credentials, missing API key and/or secret")
 	}
 
 	client := internal.NewClient(config.UserName, config.Password)
@@ -121,21 +130,19 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	ctx, err := d.client.CreateAuthenticatedContext(context.Background())
 	if err != nil {
-		return fmt.Errorf("mythicbeasts: could not find zone for domain %q: %w", domain, err)
+		return fmt.Errorf("mythicbeasts: login: %w", err)
 	}
 
-	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, authZone)
+	authZone, err := d.findZone(ctx, info.EffectiveFQDN)
 	if err != nil {
-		return fmt.Errorf("mythicbeasts: %w", err)
+		return fmt.Errorf("mythicbeasts: could not find zone for domain %q: %w", domain, err)
 	}
 
-	authZone = dns01.UnFqdn(authZone)
-
-	ctx, err := d.client.CreateAuthenticatedContext(context.Background())
+	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, authZone)
 	if err != nil {
-		return fmt.Errorf("mythicbeasts: login: %w", err)
+		return fmt.Errorf("mythicbeasts: %w", err)
 	}
 
 	err = d.client.CreateTXTRecord(ctx, authZone, subDomain, info.Value, d.config.TTL)
@@ -150,21 +157,19 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	ctx, err := d.client.CreateAuthenticatedContext(context.Background())
 	if err != nil {
-		return fmt.Errorf("mythicbeasts: could not find zone for domain %q: %w", domain, err)
+		return fmt.Errorf("mythicbeasts: login: %w", err)
 	}
 
-	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, authZone)
+	authZone, err := d.findZone(ctx, info.EffectiveFQDN)
 	if err != nil {
-		return fmt.Errorf("mythicbeasts: %w", err)
+		return fmt.Errorf("mythicbeasts: could not find zone for domain %q: %w", domain, err)
 	}
 
-	authZone = dns01.UnFqdn(authZone)
-
-	ctx, err := d.client.CreateAuthenticatedContext(context.Background())
+	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, authZone)
 	if err != nil {
-		return fmt.Errorf("mythicbeasts: login: %w", err)
+		return fmt.Errorf("mythicbeasts: %w", err)
 	}
 
 	err = d.client.RemoveTXTRecord(ctx, authZone, subDomain, info.Value)
@@ -175,6 +180,21 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	return nil
 }
 
+// findZone returns the most specific zone, of the account, containing the FQDN.
+func (d *DNSProvider) findZone(ctx context.Context, fqdn string) (string, error) {
+	zones, err := d.client.ListZones(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	zone := dns01.FindMostSpecificZone(fqdn, zones)
+	if zone == "" {
+		return "", fmt.Errorf("zone not found for %q", fqdn)
+	}
+
+	return dns01.UnFqdn(zone), nil
+}
+
 // Timeout returns the timeout and interval to use when checking for DNS propagation.
 // Adjusting here to cope with spikes in propagation times.
 func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
@@ -1,16 +1,25 @@
 package mythicbeasts
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 const envDomain = envNamespace + "DOMAIN"
 
 var envTest = tester.NewEnvTest(
+	EnvAPIKey,
+	EnvAPISecret,
 	EnvUserName,
 	EnvPassword).
 	WithDomain(envDomain)
@@ -23,6 +32,13 @@ func TestNewDNSProvider(t *testing.T) {
 	}{
 		{
 			desc: "success",
+			envVars: map[string]string{
+				EnvAPIKey:    "123",
+				EnvAPISecret: "456",
+			},
+		},
+		{
+			desc: "success with username and password",
 			envVars: map[string]string{
 				EnvUserName: "123",
 				EnvPassword: "456",
@@ -31,26 +47,26 @@ func TestNewDNSProvider(t *testing.T) {
 		{
 			desc: "missing credentials",
 			envVars: map[string]string{
-				EnvUserName: "",
-				EnvPassword: "",
+				EnvAPIKey:    "",
+				EnvAPISecret: "",
 			},
-			expected: "mythicbeasts: some credentials information are missing: MYTHICBEASTS_USERNAME,MYTHICBEASTS_PASSWORD",
+			expected: "mythicbeasts: some credentials information are missing: MYTHICBEASTS_API_KEY,MYTHICBEASTS_API_SECRET",
 		},
 		{
 			desc: "missing api key",
 			envVars: map[string]string{
-				EnvUserName: "",
-				EnvPassword: "api_password",
+				EnvAPIKey:    "",
+				EnvAPISecret: "api_secret",
 			},
-			expected: "mythicbeasts: some credentials information are missing: MYTHICBEASTS_USERNAME",
+			expected: "mythicbeasts: some credentials information are missing: MYTHICBEASTS_API_KEY",
 		},
 		{
 			desc: "missing secret key",
 			envVars: map[string]string{
-				EnvUserName: "api_username",
-				EnvPassword: "",
+				EnvAPIKey:    "api_key",
+				EnvAPISecret: "",
 			},
-			expected: "mythicbeasts: some credentials information are missing: MYTHICBEASTS_PASSWORD",
+			expected: "mythicbeasts: some credentials information are missing: MYTHICBEASTS_API_SECRET",
 		},
 	}
 
@@ -88,19 +104,19 @@ func TestNewDNSProviderConfig(t *testing.T) {
 		},
 		{
 			desc:     "missing credentials",
-			expected: "mythicbeasts: incomplete credentials, missing username and/or password",
+			expected: "mythicbeasts: incomplete credentials, missing API key and/or secret",
 		},
 		{
 			desc:     "missing username",
 			username: "",
 			password: "api_password",
-			expected: "mythicbeasts: incomplete credentials, missing username and/or password",
+			expected: "mythicbeasts: incomplete credentials, missing API key and/or secret",
 		},
 		{
 			desc:     "missing password",
 			username: "api_username",
 			password: "",
-			expected: "mythicbeasts: incomplete credentials, missing username and/or password",
+			expected: "mythicbeasts: incomplete credentials, missing API key and/or secret",
 		},
 	}
 
@@ -124,6 +140,111 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func TestDNSProvider_PresentCleanUp(t *testing.T) {
+	var tokenCalls int
+	records := map[string][]string{}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("POST /login", func(rw http.ResponseWriter, req *http.Request) {
+		tokenCalls++
+
+		_, _ = fmt.Fprint(rw, `{"access_token":"xxx","expires_in":300,"token_type":"bearer"}`)
+	})
+
+	mux.HandleFunc("GET /zones", func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = fmt.Fprint(rw, `{"zones":["example.com","sub.example.com"]}`)
+	})
+
+	mux.HandleFunc("/zones/{zone}/records/{host}/TXT", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer xxx" {
+			http.Error(rw, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		key := req.PathValue("host") + "." + req.PathValue("zone")
+
+		switch req.Method {
+		case http.MethodGet:
+			var result []map[string]any
+			for _, value := range records[key] {
+				result = append(result, map[string]any{"host": req.PathValue("host"), "ttl": 120, "type": "TXT", "data": value})
+			}
+
+			_ = json.NewEncoder(rw).Encode(map[string]any{"records": result})
+
+		case http.MethodPut:
+			var body struct {
+				Records []struct {
+					Data string `json:"data"`
+				} `json:"records"`
+			}
+
+			err := json.NewDecoder(req.Body).Decode(&body)
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			added := len(body.Records) - len(records[key])
+
+			records[key] = nil
+			for _, record := range body.Records {
+				records[key] = append(records[key], record.Data)
+			}
+
+			_, _ = fmt.Fprintf(rw, `{"records_added":%d,"message":"ok"}`, added)
+
+		case http.MethodDelete:
+			value := req.URL.Query().Get("data")
+
+			before := len(records[key])
+			records[key] = slices.DeleteFunc(records[key], func(s string) bool { return s == value })
+
+			_, _ = fmt.Fprintf(rw, `{"records_removed":%d,"message":"ok"}`, before-len(records[key]))
+
+		default:
+			http.Error(rw, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		}
+	})
+
+	config, err := NewDefaultConfig()
+	require.NoError(t, err)
+
+	config.UserName = "user"
+	config.Password = "secret"
+	config.APIEndpoint, _ = url.Parse(server.URL)
+	config.AuthAPIEndpoint, _ = url.Parse(server.URL + "/login")
+	config.HTTPClient = server.Client()
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	domains := []string{"example.com", "a.example.com", "b.sub.example.com"}
+
+	for _, domain := range domains {
+		err = provider.Present(domain, "", "123d==")
+		require.NoError(t, err)
+	}
+
+	assert.Len(t, records["_acme-challenge.example.com"], 1)
+	assert.Len(t, records["_acme-challenge.a.example.com"], 1)
+	assert.Len(t, records["_acme-challenge.b.sub.example.com"], 1)
+
+	for _, domain := range domains {
+		err = provider.CleanUp(domain, "", "123d==")
+		require.NoError(t, err)
+	}
+
+	for key, values := range records {
+		assert.Empty(t, values, key)
+	}
+
+	assert.Equal(t, 1, tokenCalls)
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
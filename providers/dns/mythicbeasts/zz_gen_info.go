@@ -0,0 +1,33 @@
+package mythicbeasts
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/mythicbeasts/mythicbeasts.toml
+		Name:  "MythicBeasts",
+		Code:  "mythicbeasts",
+		Since: "v0.3.7",
+		URL:   "https://www.mythic-beasts.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "MYTHICBEASTS_API_KEY", Description: "API key ID", Credential: true},
+			{Name: "MYTHICBEASTS_API_SECRET", Description: "API key secret", Credential: true},
+			{Name: "MYTHICBEASTS_API_ENDPOINT", Description: "The endpoint for the API (must implement v2)"},
+			{Name: "MYTHICBEASTS_AUTH_API_ENDPOINT", Description: "The endpoint for Mythic Beasts' Authentication"},
+			{Name: "MYTHICBEASTS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "MYTHICBEASTS_PASSWORD", Description: "Password (deprecated, use MYTHICBEASTS_API_SECRET)"},
+			{Name: "MYTHICBEASTS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "MYTHICBEASTS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "MYTHICBEASTS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+			{Name: "MYTHICBEASTS_USERNAME", Description: "User name (deprecated, use MYTHICBEASTS_API_KEY)"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.mythic-beasts.com/support/api/dnsv2",
+			GoClient: "",
+		},
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 // Default API endpoints.
@@ -138,6 +139,8 @@ func (c *Client) newRequestGet(ctx context.Context, cmd string, params ...func(u
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	return req, nil
 }
 
@@ -149,6 +152,8 @@ func (c *Client) newRequestPost(ctx context.Context, cmd string, params ...func(
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 	return req, nil
@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-acme/lego/v4/log"
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 const getIPURL = "https://dynamicdns.park-your-domain.com/getip"
@@ -25,6 +26,8 @@ func GetClientIP(ctx context.Context, client *http.Client, debug bool) (addr str
 		return "", fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
@@ -45,8 +45,8 @@ const (
 	EnvHTTPTimeout        = envNamespace + "HTTP_TIMEOUT"
 )
 
-// A challenge represents all the data needed to specify a dns-01 challenge to lets-encrypt.
-type challenge struct {
+// A challengeRecord represents all the data needed to specify a dns-01 challenge to lets-encrypt.
+type challengeRecord struct {
 	domain   string
 	key      string
 	keyFqdn  string
@@ -57,7 +57,7 @@ type challenge struct {
 }
 
 // newChallenge builds a challenge record from a domain name and a challenge authentication key.
-func newChallenge(domain, keyAuth string) (*challenge, error) {
+func newChallenge(domain, keyAuth string) (*challengeRecord, error) {
 	domain = dns01.UnFqdn(domain)
 
 	tld, _ := publicsuffix.PublicSuffix(domain)
@@ -76,7 +76,7 @@ func newChallenge(domain, keyAuth string) (*challenge, error) {
 
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	return &challenge{
+	return &challengeRecord{
 		domain:   domain,
 		key:      "_acme-challenge." + host,
 		keyFqdn:  info.EffectiveFQDN,
@@ -0,0 +1,30 @@
+package namecheap
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/namecheap/namecheap.toml
+		Name:  "Namecheap",
+		Code:  "namecheap",
+		Since: "v0.3.0",
+		URL:   "https://www.namecheap.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "NAMECHEAP_API_KEY", Description: "API key", Credential: true},
+			{Name: "NAMECHEAP_API_USER", Description: "API user", Credential: true},
+			{Name: "NAMECHEAP_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "NAMECHEAP_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "NAMECHEAP_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "NAMECHEAP_SANDBOX", Description: "Activate the sandbox (boolean)"},
+			{Name: "NAMECHEAP_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.namecheap.com/support/api/methods.aspx",
+			GoClient: "",
+		},
+	}
+}
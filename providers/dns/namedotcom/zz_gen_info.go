@@ -0,0 +1,29 @@
+package namedotcom
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/namedotcom/namedotcom.toml
+		Name:  "Name.com",
+		Code:  "namedotcom",
+		Since: "v0.5.0",
+		URL:   "https://www.name.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "NAMECOM_API_TOKEN", Description: "API token", Credential: true},
+			{Name: "NAMECOM_USERNAME", Description: "Username", Credential: true},
+			{Name: "NAMECOM_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "NAMECOM_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "NAMECOM_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "NAMECOM_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.name.com/api-docs/DNS",
+			GoClient: "https://github.com/namedotcom/go",
+		},
+	}
+}
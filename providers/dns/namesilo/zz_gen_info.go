@@ -0,0 +1,27 @@
+package namesilo
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/namesilo/namesilo.toml
+		Name:  "Namesilo",
+		Code:  "namesilo",
+		Since: "v2.7.0",
+		URL:   "https://www.namesilo.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "NAMESILO_API_KEY", Description: "Client ID", Credential: true},
+			{Name: "NAMESILO_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "NAMESILO_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation, it is better to set larger than 15m"},
+			{Name: "NAMESILO_TTL", Description: "The TTL of the TXT record used for the DNS challenge, should be in [3600, 2592000]"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.namesilo.com/api_reference.php",
+			GoClient: "https://github.com/nrdcg/namesilo",
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package nearlyfreespeech
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/nearlyfreespeech/nearlyfreespeech.toml
+		Name:  "NearlyFreeSpeech.NET",
+		Code:  "nearlyfreespeech",
+		Since: "v4.8.0",
+		URL:   "https://nearlyfreespeech.net/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "NEARLYFREESPEECH_API_KEY", Description: "API Key for API requests", Credential: true},
+			{Name: "NEARLYFREESPEECH_LOGIN", Description: "Username for API requests", Credential: true},
+			{Name: "NEARLYFREESPEECH_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "NEARLYFREESPEECH_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "NEARLYFREESPEECH_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "NEARLYFREESPEECH_SEQUENCE_INTERVAL", Description: "Time between sequential requests"},
+			{Name: "NEARLYFREESPEECH_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://members.nearlyfreespeech.net/wiki/API/Reference",
+			GoClient: "",
+		},
+	}
+}
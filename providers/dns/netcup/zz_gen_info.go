@@ -0,0 +1,30 @@
+package netcup
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/netcup/netcup.toml
+		Name:  "Netcup",
+		Code:  "netcup",
+		Since: "v1.1.0",
+		URL:   "https://www.netcup.eu/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "NETCUP_API_KEY", Description: "API key", Credential: true},
+			{Name: "NETCUP_API_PASSWORD", Description: "API password", Credential: true},
+			{Name: "NETCUP_CUSTOMER_NUMBER", Description: "Customer number", Credential: true},
+			{Name: "NETCUP_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "NETCUP_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "NETCUP_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "NETCUP_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.netcup-wiki.de/wiki/DNS_API",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package netlify
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/netlify/netlify.toml
+		Name:  "Netlify",
+		Code:  "netlify",
+		Since: "v3.7.0",
+		URL:   "https://www.netlify.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "NETLIFY_TOKEN", Description: "Token", Credential: true},
+			{Name: "NETLIFY_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "NETLIFY_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "NETLIFY_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "NETLIFY_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://open-api.netlify.com/",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package nicmanager
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/nicmanager/nicmanager.toml
+		Name:  "Nicmanager",
+		Code:  "nicmanager",
+		Since: "v4.5.0",
+		URL:   "https://www.nicmanager.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "NICMANAGER_API_EMAIL", Description: "Email-based login", Credential: true},
+			{Name: "NICMANAGER_API_LOGIN", Description: "Login, used for Username-based login", Credential: true},
+			{Name: "NICMANAGER_API_PASSWORD", Description: "Password, always required", Credential: true},
+			{Name: "NICMANAGER_API_USERNAME", Description: "Username, used for Username-based login", Credential: true},
+			{Name: "NICMANAGER_API_MODE", Description: "mode: 'anycast' or 'zone' (default: 'anycast')"},
+			{Name: "NICMANAGER_API_OTP", Description: "TOTP Secret (optional)"},
+			{Name: "NICMANAGER_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "NICMANAGER_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "NICMANAGER_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "NICMANAGER_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.nicmanager.com/docs/v1/",
+			GoClient: "",
+		},
+	}
+}
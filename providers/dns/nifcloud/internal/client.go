@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 const (
@@ -164,6 +165,8 @@ func newXMLRequest(ctx context.Context, method string, endpoint *url.URL, payloa
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	if payload != nil {
 		req.Header.Set("Content-Type", "text/xml; charset=utf-8")
 	}
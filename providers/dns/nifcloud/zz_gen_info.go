@@ -0,0 +1,29 @@
+package nifcloud
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/nifcloud/nifcloud.toml
+		Name:  "NIFCloud",
+		Code:  "nifcloud",
+		Since: "v1.1.0",
+		URL:   "https://www.nifcloud.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "NIFCLOUD_ACCESS_KEY_ID", Description: "Access key", Credential: true},
+			{Name: "NIFCLOUD_SECRET_ACCESS_KEY", Description: "Secret access key", Credential: true},
+			{Name: "NIFCLOUD_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "NIFCLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "NIFCLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "NIFCLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://mbaas.nifcloud.com/doc/current/rest/common/format.html",
+			GoClient: "",
+		},
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 const apiEndpoint = "https://njal.la/api/1/"
@@ -20,16 +21,16 @@ const authorizationHeader = "Authorization"
 type Client struct {
 	token string
 
-	apiEndpoint string
-	HTTPClient  *http.Client
+	BaseURL    string
+	HTTPClient *http.Client
 }
 
 // NewClient creates a new Client.
 func NewClient(token string) *Client {
 	return &Client{
-		token:       token,
-		apiEndpoint: apiEndpoint,
-		HTTPClient:  &http.Client{Timeout: 5 * time.Second},
+		token:      token,
+		BaseURL:    apiEndpoint,
+		HTTPClient: &http.Client{Timeout: 5 * time.Second},
 	}
 }
 
@@ -40,13 +41,8 @@ func (c *Client) AddRecord(ctx context.Context, record Record) (*Record, error)
 		Params: record,
 	}
 
-	req, err := newJSONRequest(ctx, http.MethodPost, c.apiEndpoint, data)
-	if err != nil {
-		return nil, err
-	}
-
 	var result APIResponse[*Record]
-	err = c.do(req, &result)
+	err := c.do(ctx, data, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -64,17 +60,7 @@ func (c *Client) RemoveRecord(ctx context.Context, id string, domain string) err
 		},
 	}
 
-	req, err := newJSONRequest(ctx, http.MethodPost, c.apiEndpoint, data)
-	if err != nil {
-		return err
-	}
-
-	err = c.do(req, &APIResponse[json.RawMessage]{})
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return c.do(ctx, data, &APIResponse[json.RawMessage]{})
 }
 
 // ListRecords list the records for one domain.
@@ -86,21 +72,37 @@ func (c *Client) ListRecords(ctx context.Context, domain string) ([]Record, erro
 		},
 	}
 
-	req, err := newJSONRequest(ctx, http.MethodPost, c.apiEndpoint, data)
+	var result APIResponse[Records]
+	err := c.do(ctx, data, &result)
 	if err != nil {
 		return nil, err
 	}
 
-	var result APIResponse[Records]
-	err = c.do(req, &result)
+	return result.Result.Records, nil
+}
+
+// ListDomains lists the domains of the account.
+func (c *Client) ListDomains(ctx context.Context) ([]Domain, error) {
+	data := APIRequest{
+		Method: "list-domains",
+		Params: struct{}{},
+	}
+
+	var result APIResponse[Domains]
+	err := c.do(ctx, data, &result)
 	if err != nil {
 		return nil, err
 	}
 
-	return result.Result.Records, nil
+	return result.Result.Domains, nil
 }
 
-func (c *Client) do(req *http.Request, result Response) error {
+func (c *Client) do(ctx context.Context, data APIRequest, result Response) error {
+	req, err := newJSONRequest(ctx, http.MethodPost, c.BaseURL, data)
+	if err != nil {
+		return err
+	}
+
 	req.Header.Set(authorizationHeader, "Njalla "+c.token)
 
 	resp, err := c.HTTPClient.Do(req)
@@ -124,7 +126,12 @@ func (c *Client) do(req *http.Request, result Response) error {
 		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
 	}
 
-	return result.GetError()
+	err = result.GetError()
+	if err != nil {
+		return fmt.Errorf("%s: %w", data.Method, err)
+	}
+
+	return nil
 }
 
 func newJSONRequest(ctx context.Context, method string, endpoint string, payload any) (*http.Request, error) {
@@ -142,6 +149,8 @@ func newJSONRequest(ctx context.Context, method string, endpoint string, payload
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 
 	if payload != nil {
@@ -39,7 +39,7 @@ func setupTest(t *testing.T, handler func(http.ResponseWriter, *http.Request)) *
 	})
 
 	client := NewClient("secret")
-	client.apiEndpoint = server.URL
+	client.BaseURL = server.URL
 
 	return client
 }
@@ -229,3 +229,67 @@ func TestClient_RemoveRecord_error(t *testing.T) {
 	err := client.RemoveRecord(context.Background(), "123", "example.com")
 	require.Error(t, err)
 }
+
+func TestClient_ListDomains(t *testing.T) {
+	client := setupTest(t, func(rw http.ResponseWriter, req *http.Request) {
+		apiReq := struct {
+			Method string `json:"method"`
+		}{}
+
+		err := json.NewDecoder(req.Body).Decode(&apiReq)
+		if err != nil {
+			http.Error(rw, "failed to marshal test request body", http.StatusInternalServerError)
+			return
+		}
+
+		if apiReq.Method != "list-domains" {
+			http.Error(rw, fmt.Sprintf("unexpected method: %s", apiReq.Method), http.StatusBadRequest)
+			return
+		}
+
+		_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","id":"1","result":{"domains":[{"name":"example.com","status":"active","expiry":"2030-01-01T00:00:00Z"},{"name":"example.co.uk","status":"active"}]}}`))
+	})
+
+	domains, err := client.ListDomains(context.Background())
+	require.NoError(t, err)
+
+	expected := []Domain{
+		{Name: "example.com", Status: "active", Expiry: "2030-01-01T00:00:00Z"},
+		{Name: "example.co.uk", Status: "active"},
+	}
+
+	assert.Equal(t, expected, domains)
+}
+
+func TestClient_do_error(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		response string
+		expected string
+	}{
+		{
+			desc:     "error object",
+			response: `{"jsonrpc":"2.0","id":"1","error":{"code":403,"message":"Invalid token."}}`,
+			expected: "list-domains: code: 403, message: Invalid token.",
+		},
+		{
+			desc:     "error object with data",
+			response: `{"jsonrpc":"2.0","id":"1","error":{"code":400,"message":"Invalid params.","data":{"domain":"missing"}}}`,
+			expected: `list-domains: code: 400, message: Invalid params., data: {"domain":"missing"}`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			client := setupTest(t, func(rw http.ResponseWriter, _ *http.Request) {
+				_, _ = rw.Write([]byte(test.response))
+			})
+
+			_, err := client.ListDomains(context.Background())
+			require.EqualError(t, err, test.expected)
+
+			var apiErr *APIError
+			require.ErrorAs(t, err, &apiErr)
+		})
+	}
+}
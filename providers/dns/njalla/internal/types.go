@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"encoding/json"
 	"fmt"
 )
 
@@ -30,14 +31,21 @@ func (a APIResponse[T]) GetError() error {
 	return a.Error
 }
 
-// APIError is an API error.
+// APIError is an API error (JSON-RPC error object).
 type APIError struct {
-	Code    int
-	Message string
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
 }
 
 func (a APIError) Error() string {
-	return fmt.Sprintf("code: %d, message: %s", a.Code, a.Message)
+	msg := fmt.Sprintf("code: %d, message: %s", a.Code, a.Message)
+
+	if len(a.Data) > 0 && string(a.Data) != "null" {
+		msg += fmt.Sprintf(", data: %s", a.Data)
+	}
+
+	return msg
 }
 
 // Record is a DNS record.
@@ -54,3 +62,15 @@ type Record struct {
 type Records struct {
 	Records []Record `json:"records,omitempty"`
 }
+
+// Domain is a domain of the account.
+type Domain struct {
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status,omitempty"`
+	Expiry string `json:"expiry,omitempty"`
+}
+
+// Domains is a list of domains.
+type Domains struct {
+	Domains []Domain `json:"domains,omitempty"`
+}
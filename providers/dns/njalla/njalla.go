@@ -12,7 +12,6 @@ import (
 	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/config/env"
 	"github.com/go-acme/lego/v4/providers/dns/njalla/internal"
-	"github.com/miekg/dns"
 )
 
 // Environment variables names.
@@ -53,7 +52,7 @@ type DNSProvider struct {
 	config *Config
 	client *internal.Client
 
-	recordIDs   map[string]string
+	recordIDs   map[string]recordRef
 	recordIDsMu sync.Mutex
 }
 
@@ -90,7 +89,7 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 	return &DNSProvider{
 		config:    config,
 		client:    client,
-		recordIDs: make(map[string]string),
+		recordIDs: make(map[string]recordRef),
 	}, nil
 }
 
@@ -102,28 +101,35 @@ func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 
 // Present creates a TXT record using the specified parameters.
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	rootDomain, subDomain, err := splitDomain(info.EffectiveFQDN)
+	zone, err := d.findZone(ctx, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("njalla: %w", err)
+	}
+
+	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, zone)
 	if err != nil {
 		return fmt.Errorf("njalla: %w", err)
 	}
 
 	record := internal.Record{
-		Name:    subDomain,                // TODO need to be tested
-		Domain:  dns01.UnFqdn(rootDomain), // TODO need to be tested
+		Name:    subDomain,
+		Domain:  zone,
 		Content: info.Value,
 		TTL:     d.config.TTL,
 		Type:    "TXT",
 	}
 
-	resp, err := d.client.AddRecord(context.Background(), record)
+	resp, err := d.client.AddRecord(ctx, record)
 	if err != nil {
 		return fmt.Errorf("njalla: failed to add record: %w", err)
 	}
 
 	d.recordIDsMu.Lock()
-	d.recordIDs[token] = resp.ID
+	d.recordIDs[token] = recordRef{ID: resp.ID, Zone: zone}
 	d.recordIDsMu.Unlock()
 
 	return nil
@@ -133,22 +139,17 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	rootDomain, _, err := splitDomain(info.EffectiveFQDN)
-	if err != nil {
-		return fmt.Errorf("njalla: %w", err)
-	}
-
 	// gets the record's unique ID from when we created it
 	d.recordIDsMu.Lock()
-	recordID, ok := d.recordIDs[token]
+	record, ok := d.recordIDs[token]
 	d.recordIDsMu.Unlock()
 	if !ok {
 		return fmt.Errorf("njalla: unknown record ID for '%s' '%s'", info.EffectiveFQDN, token)
 	}
 
-	err = d.client.RemoveRecord(context.Background(), recordID, dns01.UnFqdn(rootDomain))
+	err := d.client.RemoveRecord(context.Background(), record.ID, record.Zone)
 	if err != nil {
-		return fmt.Errorf("njalla: failed to delete TXT records: fqdn=%s, recordID=%s: %w", info.EffectiveFQDN, recordID, err)
+		return fmt.Errorf("njalla: failed to delete TXT records: fqdn=%s, recordID=%s: %w", info.EffectiveFQDN, record.ID, err)
 	}
 
 	// deletes record ID from map
@@ -159,18 +160,27 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	return nil
 }
 
-func splitDomain(full string) (string, string, error) {
-	split := dns.Split(full)
-	if len(split) < 2 {
-		return "", "", fmt.Errorf("unsupported domain: %s", full)
+// findZone finds the domain of the account (the longest match) containing the FQDN.
+func (d *DNSProvider) findZone(ctx context.Context, fqdn string) (string, error) {
+	domains, err := d.client.ListDomains(ctx)
+	if err != nil {
+		return "", fmt.Errorf("list domains: %w", err)
+	}
+
+	var names []string
+	for _, domain := range domains {
+		names = append(names, domain.Name)
 	}
 
-	if len(split) == 2 {
-		return full, "", nil
+	zone := dns01.FindMostSpecificZone(fqdn, names)
+	if zone == "" {
+		return "", fmt.Errorf("no domain found for %s", fqdn)
 	}
 
-	domain := full[split[len(split)-2]:]
-	subDomain := full[:split[len(split)-2]-1]
+	return dns01.UnFqdn(zone), nil
+}
 
-	return domain, subDomain, nil
+type recordRef struct {
+	ID   string
+	Zone string
 }
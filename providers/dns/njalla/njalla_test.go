@@ -1,10 +1,18 @@
 package njalla
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/providers/dns/njalla/internal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -89,6 +97,161 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func setupTest(t *testing.T) (*DNSProvider, *fakeAPI) {
+	t.Helper()
+
+	api := &fakeAPI{records: map[string]internal.Record{}}
+
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	config := NewDefaultConfig()
+	config.Token = "secret"
+	config.HTTPClient = server.Client()
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.client.BaseURL = server.URL
+
+	return provider, api
+}
+
+// fakeAPI is a minimal in-memory implementation of the JSON-RPC API.
+type fakeAPI struct {
+	mu sync.Mutex
+
+	records map[string]internal.Record
+	nextID  int
+}
+
+func (f *fakeAPI) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var apiReq struct {
+		Method string          `json:"method"`
+		Params internal.Record `json:"params"`
+	}
+
+	err := json.NewDecoder(req.Body).Decode(&apiReq)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	switch apiReq.Method {
+	case "list-domains":
+		_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","result":{"domains":[{"name":"example.com"},{"name":"example.co.uk"},{"name":"sub.example.co.uk"}]}}`))
+
+	case "add-record":
+		f.nextID++
+
+		record := apiReq.Params
+		record.ID = strconv.Itoa(f.nextID)
+
+		f.records[record.ID] = record
+
+		_ = json.NewEncoder(rw).Encode(map[string]any{"jsonrpc": "2.0", "result": record})
+
+	case "remove-record":
+		record, ok := f.records[apiReq.Params.ID]
+		if !ok || record.Domain != apiReq.Params.Domain {
+			_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","error":{"code":404,"message":"Record not found."}}`))
+			return
+		}
+
+		delete(f.records, apiReq.Params.ID)
+
+		_, _ = rw.Write([]byte(`{"jsonrpc":"2.0","result":{}}`))
+
+	default:
+		_, _ = fmt.Fprintf(rw, `{"jsonrpc":"2.0","error":{"code":400,"message":"Unknown method %s."}}`, apiReq.Method)
+	}
+}
+
+func TestDNSProvider_Present_CleanUp(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		domain       string
+		expectedZone string
+		expectedName string
+	}{
+		{
+			desc:         "domain apex",
+			domain:       "example.com",
+			expectedZone: "example.com",
+			expectedName: "_acme-challenge",
+		},
+		{
+			desc:         "subdomain",
+			domain:       "foo.example.com",
+			expectedZone: "example.com",
+			expectedName: "_acme-challenge.foo",
+		},
+		{
+			desc:         "multi-level TLD",
+			domain:       "foo.example.co.uk",
+			expectedZone: "example.co.uk",
+			expectedName: "_acme-challenge.foo",
+		},
+		{
+			desc:         "longest match",
+			domain:       "foo.sub.example.co.uk",
+			expectedZone: "sub.example.co.uk",
+			expectedName: "_acme-challenge.foo",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			provider, api := setupTest(t)
+
+			err := provider.Present(test.domain, "token", "keyAuth")
+			require.NoError(t, err)
+
+			require.Len(t, api.records, 1)
+
+			record := api.records["1"]
+			assert.Equal(t, test.expectedZone, record.Domain)
+			assert.Equal(t, test.expectedName, record.Name)
+			assert.Equal(t, "TXT", record.Type)
+			assert.Equal(t, 300, record.TTL)
+
+			err = provider.CleanUp(test.domain, "token", "keyAuth")
+			require.NoError(t, err)
+
+			assert.Empty(t, api.records)
+		})
+	}
+}
+
+func TestDNSProvider_Present_unknownDomain(t *testing.T) {
+	provider, _ := setupTest(t)
+
+	err := provider.Present("example.org", "token", "keyAuth")
+	require.EqualError(t, err, "njalla: no domain found for _acme-challenge.example.org.")
+}
+
+func TestDNSProvider_CleanUp_error(t *testing.T) {
+	provider, api := setupTest(t)
+
+	err := provider.Present("example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	api.records = map[string]internal.Record{}
+
+	err = provider.CleanUp("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "njalla: failed to delete TXT records: fqdn=_acme-challenge.example.com., recordID=1: remove-record: code: 404, message: Record not found.")
+}
+
+func TestDNSProvider_CleanUp_unknownRecord(t *testing.T) {
+	provider, _ := setupTest(t)
+
+	err := provider.CleanUp("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "njalla: unknown record ID for '_acme-challenge.example.com.' 'token'")
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
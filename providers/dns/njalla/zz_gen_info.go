@@ -0,0 +1,28 @@
+package njalla
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/njalla/njalla.toml
+		Name:  "Njalla",
+		Code:  "njalla",
+		Since: "v4.3.0",
+		URL:   "https://njal.la",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "NJALLA_TOKEN", Description: "API token", Credential: true},
+			{Name: "NJALLA_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "NJALLA_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "NJALLA_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "NJALLA_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://njal.la/api/",
+			GoClient: "",
+		},
+	}
+}
@@ -14,6 +14,9 @@ import (
 	"github.com/nrdcg/nodion"
 )
 
+// minTTL the minimum TTL accepted by the API.
+const minTTL = 60
+
 // Environment variables names.
 const (
 	envNamespace = "NODION_"
@@ -53,8 +56,15 @@ type DNSProvider struct {
 	config *Config
 	client *nodion.Client
 
-	zoneIDs   map[string]string
-	zoneIDsMu sync.Mutex
+	findZoneByFqdn func(fqdn string) (string, error)
+
+	records   map[string]recordRef
+	recordsMu sync.Mutex
+}
+
+type recordRef struct {
+	ZoneID   string
+	RecordID string
 }
 
 // NewDNSProvider returns a DNSProvider instance configured for Nodion.
@@ -81,9 +91,13 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		return nil, errors.New("nodion: incomplete credentials, missing API token")
 	}
 
+	if config.TTL < minTTL {
+		return nil, fmt.Errorf("nodion: invalid TTL, TTL (%d) must be greater than %d", config.TTL, minTTL)
+	}
+
 	client, err := nodion.NewClient(config.APIToken)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("nodion: %w", err)
 	}
 
 	if config.HTTPClient != nil {
@@ -91,9 +105,10 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 	}
 
 	return &DNSProvider{
-		config:  config,
-		client:  client,
-		zoneIDs: map[string]string{},
+		config:         config,
+		client:         client,
+		findZoneByFqdn: dns01.FindZoneByFqdn,
+		records:        map[string]recordRef{},
 	}, nil
 }
 
@@ -107,7 +122,7 @@ func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	authZone, err := d.findZoneByFqdn(info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("nodion: could not find zone for domain %q: %w", domain, err)
 	}
@@ -141,15 +156,15 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		TTL:        d.config.TTL,
 	}
 
-	_, err = d.client.CreateRecord(ctx, zoneID, record)
+	newRecord, err := d.client.CreateRecord(ctx, zoneID, record)
 	if err != nil {
 		return fmt.Errorf("nodion: failed to create TXT records [domain: %s, sub domain: %s]: %w",
 			dns01.UnFqdn(authZone), subDomain, err)
 	}
 
-	d.zoneIDsMu.Lock()
-	d.zoneIDs[token] = zoneID
-	d.zoneIDsMu.Unlock()
+	d.recordsMu.Lock()
+	d.records[token] = recordRef{ZoneID: zoneID, RecordID: newRecord.ID}
+	d.recordsMu.Unlock()
 
 	return nil
 }
@@ -158,48 +173,25 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
-	if err != nil {
-		return fmt.Errorf("nodion: could not find zone for domain %q: %w", domain, err)
-	}
-
-	d.zoneIDsMu.Lock()
-	zoneID, ok := d.zoneIDs[token]
-	d.zoneIDsMu.Unlock()
+	d.recordsMu.Lock()
+	ref, ok := d.records[token]
+	d.recordsMu.Unlock()
 	if !ok {
-		return fmt.Errorf("nodion: unknown zone ID for '%s' '%s'", info.EffectiveFQDN, token)
-	}
-
-	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, authZone)
-	if err != nil {
-		return fmt.Errorf("nodion: %w", err)
-	}
-
-	ctx := context.Background()
-
-	filter := &nodion.RecordsFilter{
-		Name:       subDomain,
-		RecordType: nodion.TypeTXT,
-		Content:    info.Value,
+		return fmt.Errorf("nodion: unknown record ID for '%s' '%s'", info.EffectiveFQDN, token)
 	}
 
-	records, err := d.client.GetRecords(ctx, zoneID, filter)
+	deleted, err := d.client.DeleteRecord(context.Background(), ref.ZoneID, ref.RecordID)
 	if err != nil {
-		return fmt.Errorf("nodion: %w", err)
+		return fmt.Errorf("nodion: failed to remove TXT record [fqdn: %s, record ID: %s]: %w", info.EffectiveFQDN, ref.RecordID, err)
 	}
 
-	if len(records) == 0 {
-		return fmt.Errorf("nodion: record not found: %s", authZone)
+	if !deleted {
+		return fmt.Errorf("nodion: the TXT record has not been removed [fqdn: %s, record ID: %s]", info.EffectiveFQDN, ref.RecordID)
 	}
 
-	if len(records) > 1 {
-		return fmt.Errorf("nodion: too many possible records for the domain %s: %v", info.EffectiveFQDN, records)
-	}
-
-	_, err = d.client.DeleteRecord(ctx, zoneID, records[0].ID)
-	if err != nil {
-		return fmt.Errorf("regru: failed to remove TXT records [domain: %s]: %w", dns01.UnFqdn(authZone), err)
-	}
+	d.recordsMu.Lock()
+	delete(d.records, token)
+	d.recordsMu.Unlock()
 
 	return nil
 }
@@ -1,10 +1,20 @@
 package nodion
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/nrdcg/nodion"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -55,22 +65,32 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	testCases := []struct {
 		desc     string
 		apiToken string
+		ttl      int
 		expected string
 	}{
 		{
 			desc:     "success",
 			apiToken: "123",
+			ttl:      minTTL,
 		},
 		{
 			desc:     "missing credentials",
+			ttl:      minTTL,
 			expected: "nodion: incomplete credentials, missing API token",
 		},
+		{
+			desc:     "invalid TTL",
+			apiToken: "123",
+			ttl:      30,
+			expected: "nodion: invalid TTL, TTL (30) must be greater than 60",
+		},
 	}
 
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
 			config := NewDefaultConfig()
 			config.APIToken = test.apiToken
+			config.TTL = test.ttl
 
 			p, err := NewDNSProviderConfig(config)
 
@@ -85,6 +105,186 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+type fakeAPI struct {
+	mu      sync.Mutex
+	records map[string]nodion.Record
+	nextID  int
+}
+
+// redirectTransport sends the requests of the Nodion client to the test server.
+type redirectTransport struct {
+	target *url.URL
+	next   http.RoundTripper
+}
+
+func (r *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = r.target.Scheme
+	req.URL.Host = r.target.Host
+
+	return r.next.RoundTrip(req)
+}
+
+func setupTest(t *testing.T) (*DNSProvider, *fakeAPI) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	api := &fakeAPI{records: map[string]nodion.Record{}}
+
+	mux.HandleFunc("GET /v1/dns_zones", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(rw, `{"errors":["unauthorized"]}`, http.StatusUnauthorized)
+			return
+		}
+
+		var zones []nodion.Zone
+		if req.URL.Query().Get("name") == "example.com" {
+			zones = append(zones, nodion.Zone{ID: "zone1", Name: "example.com"})
+		}
+
+		_ = json.NewEncoder(rw).Encode(nodion.ZonesResponse{Zones: zones})
+	})
+
+	mux.HandleFunc("POST /v1/dns_zones/{zone}/records", func(rw http.ResponseWriter, req *http.Request) {
+		if req.PathValue("zone") != "zone1" {
+			http.NotFound(rw, req)
+			return
+		}
+
+		var record nodion.Record
+		err := json.NewDecoder(req.Body).Decode(&record)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		api.nextID++
+
+		record.ID = fmt.Sprintf("record%d", api.nextID)
+		record.ZoneID = req.PathValue("zone")
+
+		api.records[record.ID] = record
+
+		_ = json.NewEncoder(rw).Encode(nodion.RecordResponse{Record: record})
+	})
+
+	mux.HandleFunc("DELETE /v1/dns_zones/{zone}/records/{id}", func(rw http.ResponseWriter, req *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		record, ok := api.records[req.PathValue("id")]
+		if !ok || record.ZoneID != req.PathValue("zone") {
+			_ = json.NewEncoder(rw).Encode(nodion.DeleteResponse{Deleted: false})
+			return
+		}
+
+		delete(api.records, record.ID)
+
+		_ = json.NewEncoder(rw).Encode(nodion.DeleteResponse{Deleted: true})
+	})
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	config := NewDefaultConfig()
+	config.APIToken = "secret"
+	config.HTTPClient = &http.Client{
+		Transport: &redirectTransport{target: serverURL, next: server.Client().Transport},
+	}
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.findZoneByFqdn = func(_ string) (string, error) {
+		return "example.com.", nil
+	}
+
+	return provider, api
+}
+
+func TestDNSProvider_Present_CleanUp(t *testing.T) {
+	provider, api := setupTest(t)
+
+	err := provider.Present("example.com", "token1", "keyAuth1")
+	require.NoError(t, err)
+
+	err = provider.Present("example.com", "token2", "keyAuth2")
+	require.NoError(t, err)
+
+	require.Len(t, api.records, 2)
+
+	record := api.records["record1"]
+	assert.Equal(t, nodion.TypeTXT, record.RecordType)
+	assert.Equal(t, "_acme-challenge", record.Name)
+	assert.Equal(t, dns01.DefaultTTL, record.TTL)
+	assert.Equal(t, dns01.GetChallengeInfo("example.com", "keyAuth1").Value, record.Content)
+
+	assert.Equal(t, recordRef{ZoneID: "zone1", RecordID: "record2"}, provider.records["token2"])
+
+	err = provider.CleanUp("example.com", "token1", "keyAuth1")
+	require.NoError(t, err)
+
+	require.Len(t, api.records, 1)
+	assert.Contains(t, api.records, "record2")
+	assert.NotContains(t, provider.records, "token1")
+
+	err = provider.CleanUp("example.com", "token2", "keyAuth2")
+	require.NoError(t, err)
+
+	assert.Empty(t, api.records)
+	assert.Empty(t, provider.records)
+}
+
+func TestDNSProvider_Present_zoneNotFound(t *testing.T) {
+	provider, api := setupTest(t)
+	provider.findZoneByFqdn = func(_ string) (string, error) {
+		return "example.org.", nil
+	}
+
+	err := provider.Present("example.org", "token", "keyAuth")
+	require.EqualError(t, err, "nodion: zone not found: example.org.")
+
+	assert.Empty(t, api.records)
+}
+
+func TestDNSProvider_CleanUp_unknownRecord(t *testing.T) {
+	provider, _ := setupTest(t)
+
+	err := provider.CleanUp("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "nodion: unknown record ID for '_acme-challenge.example.com.' 'token'")
+}
+
+func TestDNSProvider_CleanUp_notDeleted(t *testing.T) {
+	provider, _ := setupTest(t)
+
+	provider.records["token"] = recordRef{ZoneID: "zone1", RecordID: "record42"}
+
+	err := provider.CleanUp("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "nodion: the TXT record has not been removed [fqdn: _acme-challenge.example.com., record ID: record42]")
+}
+
+func TestDNSProvider_Timeout(t *testing.T) {
+	config := NewDefaultConfig()
+	config.APIToken = "secret"
+	config.PropagationTimeout = 3 * time.Minute
+	config.PollingInterval = 5 * time.Second
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	var p challenge.ProviderTimeout = provider
+
+	timeout, interval := p.Timeout()
+	assert.Equal(t, 3*time.Minute, timeout)
+	assert.Equal(t, 5*time.Second, interval)
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -0,0 +1,28 @@
+package nodion
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/nodion/nodion.toml
+		Name:  "Nodion",
+		Code:  "nodion",
+		Since: "v4.11.0",
+		URL:   "https://www.nodion.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "NODION_API_TOKEN", Description: "The API token", Credential: true},
+			{Name: "NODION_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "NODION_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "NODION_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "NODION_TTL", Description: "The TTL of the TXT record used for the DNS challenge (minimum: 60)"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.nodion.com/en/docs/dns/api/",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package ns1
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/ns1/ns1.toml
+		Name:  "NS1",
+		Code:  "ns1",
+		Since: "v0.4.0",
+		URL:   "https://ns1.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "NS1_API_KEY", Description: "API key", Credential: true},
+			{Name: "NS1_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "NS1_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "NS1_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "NS1_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://ns1.com/api",
+			GoClient: "https://github.com/ns1/ns1-go",
+		},
+	}
+}
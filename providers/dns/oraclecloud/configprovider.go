@@ -9,8 +9,51 @@ import (
 
 	"github.com/go-acme/lego/v4/platform/config/env"
 	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/common/auth"
 )
 
+// Authentication modes.
+const (
+	authAPIKey            = "api_key"
+	authInstancePrincipal = "instance_principal"
+	authResourcePrincipal = "resource_principal"
+)
+
+// The principal configuration providers contact the OCI metadata services,
+// they can be replaced during the tests.
+var (
+	instancePrincipalConfigurationProvider = auth.InstancePrincipalConfigurationProvider
+	resourcePrincipalConfigurationProvider = func() (common.ConfigurationProvider, error) {
+		return auth.ResourcePrincipalConfigurationProvider()
+	}
+)
+
+// newPrincipalConfigProvider creates a configuration provider based on the instance or resource principals,
+// instead of a private key.
+func newPrincipalConfigProvider(authMode string) (common.ConfigurationProvider, error) {
+	switch authMode {
+	case authInstancePrincipal:
+		configProvider, err := instancePrincipalConfigurationProvider()
+		if err != nil {
+			return nil, fmt.Errorf("instance principal: %w", err)
+		}
+
+		return configProvider, nil
+
+	case authResourcePrincipal:
+		configProvider, err := resourcePrincipalConfigurationProvider()
+		if err != nil {
+			return nil, fmt.Errorf("resource principal: %w", err)
+		}
+
+		return configProvider, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported authentication mode %q: %s, %s, or %s are expected",
+			authMode, authAPIKey, authInstancePrincipal, authResourcePrincipal)
+	}
+}
+
 type configProvider struct {
 	values               map[string]string
 	privateKeyPassphrase string
@@ -26,6 +26,7 @@ const (
 	EnvUserOCID          = envNamespace + "USER_OCID"
 	EnvPubKeyFingerprint = envNamespace + "PUBKEY_FINGERPRINT"
 	EnvRegion            = envNamespace + "REGION"
+	EnvAuth              = envNamespace + "AUTH"
 
 	EnvTTL                = envNamespace + "TTL"
 	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
@@ -62,15 +63,37 @@ type DNSProvider struct {
 }
 
 // NewDNSProvider returns a DNSProvider instance configured for OracleCloud.
+// The authentication mode is defined by OCI_AUTH:
+// api_key (default), instance_principal, or resource_principal.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(envPrivKey, EnvTenancyOCID, EnvUserOCID, EnvPubKeyFingerprint, EnvRegion, EnvCompartmentOCID)
+	authMode := env.GetOrDefaultString(EnvAuth, authAPIKey)
+
+	if authMode == authAPIKey {
+		values, err := env.Get(envPrivKey, EnvTenancyOCID, EnvUserOCID, EnvPubKeyFingerprint, EnvRegion, EnvCompartmentOCID)
+		if err != nil {
+			return nil, fmt.Errorf("oraclecloud: %w", err)
+		}
+
+		config := NewDefaultConfig()
+		config.CompartmentID = values[EnvCompartmentOCID]
+		config.OCIConfigProvider = newConfigProvider(values)
+
+		return NewDNSProviderConfig(config)
+	}
+
+	values, err := env.Get(EnvCompartmentOCID)
+	if err != nil {
+		return nil, fmt.Errorf("oraclecloud: %w", err)
+	}
+
+	configProvider, err := newPrincipalConfigProvider(authMode)
 	if err != nil {
 		return nil, fmt.Errorf("oraclecloud: %w", err)
 	}
 
 	config := NewDefaultConfig()
 	config.CompartmentID = values[EnvCompartmentOCID]
-	config.OCIConfigProvider = newConfigProvider(values)
+	config.OCIConfigProvider = configProvider
 
 	return NewDNSProviderConfig(config)
 }
@@ -6,6 +6,7 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/pem"
+	"errors"
 	"os"
 	"testing"
 	"time"
@@ -25,7 +26,8 @@ var envTest = tester.NewEnvTest(
 	EnvUserOCID,
 	EnvPubKeyFingerprint,
 	EnvRegion,
-	EnvCompartmentOCID).
+	EnvCompartmentOCID,
+	EnvAuth).
 	WithDomain(envDomain)
 
 func TestNewDNSProvider(t *testing.T) {
@@ -197,6 +199,118 @@ func TestNewDNSProvider(t *testing.T) {
 	}
 }
 
+func TestNewDNSProvider_authMode(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		envVars  map[string]string
+		expected string
+		signer   string
+	}{
+		{
+			desc: "instance principal",
+			envVars: map[string]string{
+				EnvAuth:            "instance_principal",
+				EnvCompartmentOCID: "123",
+			},
+			signer: authInstancePrincipal,
+		},
+		{
+			desc: "resource principal",
+			envVars: map[string]string{
+				EnvAuth:            "resource_principal",
+				EnvCompartmentOCID: "123",
+			},
+			signer: authResourcePrincipal,
+		},
+		{
+			desc: "instance principal: missing CompartmentID",
+			envVars: map[string]string{
+				EnvAuth: "instance_principal",
+			},
+			expected: "oraclecloud: some credentials information are missing: OCI_COMPARTMENT_OCID",
+		},
+		{
+			desc: "unsupported mode",
+			envVars: map[string]string{
+				EnvAuth:            "user_principal",
+				EnvCompartmentOCID: "123",
+			},
+			expected: `oraclecloud: unsupported authentication mode "user_principal": api_key, instance_principal, or resource_principal are expected`,
+		},
+		{
+			desc: "API key: missing credentials",
+			envVars: map[string]string{
+				EnvAuth:            "api_key",
+				EnvCompartmentOCID: "123",
+			},
+			expected: "oraclecloud: some credentials information are missing: OCI_PRIVKEY,OCI_TENANCY_OCID,OCI_USER_OCID,OCI_PUBKEY_FINGERPRINT,OCI_REGION",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			defer envTest.RestoreEnv()
+			envTest.ClearEnv()
+
+			var signer string
+
+			stubPrincipalProviders(t, func(authMode string) (common.ConfigurationProvider, error) {
+				signer = authMode
+				return mockConfigurationProvider("secret"), nil
+			})
+
+			envTest.Apply(test.envVars)
+
+			p, err := NewDNSProvider()
+
+			if test.expected == "" {
+				require.NoError(t, err)
+				require.NotNil(t, p)
+				require.NotNil(t, p.config)
+				require.NotNil(t, p.client)
+			} else {
+				require.EqualError(t, err, test.expected)
+			}
+
+			require.Equal(t, test.signer, signer)
+		})
+	}
+}
+
+func TestNewDNSProvider_authModeError(t *testing.T) {
+	defer envTest.RestoreEnv()
+	envTest.ClearEnv()
+
+	stubPrincipalProviders(t, func(_ string) (common.ConfigurationProvider, error) {
+		return nil, errors.New("metadata service unreachable")
+	})
+
+	envTest.Apply(map[string]string{
+		EnvAuth:            "instance_principal",
+		EnvCompartmentOCID: "123",
+	})
+
+	_, err := NewDNSProvider()
+	require.EqualError(t, err, "oraclecloud: instance principal: metadata service unreachable")
+}
+
+func stubPrincipalProviders(t *testing.T, fn func(authMode string) (common.ConfigurationProvider, error)) {
+	t.Helper()
+
+	instance, resource := instancePrincipalConfigurationProvider, resourcePrincipalConfigurationProvider
+	t.Cleanup(func() {
+		instancePrincipalConfigurationProvider, resourcePrincipalConfigurationProvider = instance, resource
+	})
+
+	instancePrincipalConfigurationProvider = func() (common.ConfigurationProvider, error) {
+		return fn(authInstancePrincipal)
+	}
+
+	resourcePrincipalConfigurationProvider = func() (common.ConfigurationProvider, error) {
+		return fn(authResourcePrincipal)
+	}
+}
+
 func TestNewDNSProviderConfig(t *testing.T) {
 	envTest.ClearEnv()
 	defer envTest.RestoreEnv()
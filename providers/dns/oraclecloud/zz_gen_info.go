@@ -0,0 +1,34 @@
+package oraclecloud
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/oraclecloud/oraclecloud.toml
+		Name:  "Oracle Cloud",
+		Code:  "oraclecloud",
+		Since: "v2.3.0",
+		URL:   "https://cloud.oracle.com/home",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "OCI_COMPARTMENT_OCID", Description: "Compartment OCID", Credential: true},
+			{Name: "OCI_PRIVKEY_FILE", Description: "Private key file", Credential: true},
+			{Name: "OCI_PRIVKEY_PASS", Description: "Private key password", Credential: true},
+			{Name: "OCI_PUBKEY_FINGERPRINT", Description: "Public key fingerprint", Credential: true},
+			{Name: "OCI_REGION", Description: "Region", Credential: true},
+			{Name: "OCI_TENANCY_OCID", Description: "Tenancy OCID", Credential: true},
+			{Name: "OCI_USER_OCID", Description: "User OCID", Credential: true},
+			{Name: "OCI_AUTH", Description: "Authentication mode: 'api_key' (default), 'instance_principal', or 'resource_principal'. With the principals, only OCI_COMPARTMENT_OCID is required"},
+			{Name: "OCI_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "OCI_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "OCI_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://docs.cloud.oracle.com/iaas/Content/DNS/Concepts/dnszonemanagement.htm",
+			GoClient: "https://github.com/oracle/oci-go-sdk",
+		},
+	}
+}
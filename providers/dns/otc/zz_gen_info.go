@@ -0,0 +1,33 @@
+package otc
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/otc/otc.toml
+		Name:  "Open Telekom Cloud",
+		Code:  "otc",
+		Since: "v0.4.1",
+		URL:   "https://cloud.telekom.de/en",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "OTC_DOMAIN_NAME", Description: "Domain name", Credential: true},
+			{Name: "OTC_IDENTITY_ENDPOINT", Description: "Identity endpoint URL", Credential: true},
+			{Name: "OTC_PASSWORD", Description: "Password", Credential: true},
+			{Name: "OTC_PROJECT_NAME", Description: "Project name", Credential: true},
+			{Name: "OTC_USER_NAME", Description: "User name", Credential: true},
+			{Name: "OTC_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "OTC_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "OTC_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "OTC_SEQUENCE_INTERVAL", Description: "Time between sequential requests"},
+			{Name: "OTC_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://docs.otc.t-systems.com/domain-name-service/api-ref/index.html",
+			GoClient: "",
+		},
+	}
+}
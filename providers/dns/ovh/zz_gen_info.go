@@ -0,0 +1,33 @@
+package ovh
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/ovh/ovh.toml
+		Name:  "OVH",
+		Code:  "ovh",
+		Since: "v0.4.0",
+		URL:   "https://www.ovh.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "OVH_APPLICATION_KEY", Description: "Application key (Application Key authentication)", Credential: true},
+			{Name: "OVH_APPLICATION_SECRET", Description: "Application secret (Application Key authentication)", Credential: true},
+			{Name: "OVH_CLIENT_ID", Description: "Client ID (OAuth2)", Credential: true},
+			{Name: "OVH_CLIENT_SECRET", Description: "Client secret (OAuth2)", Credential: true},
+			{Name: "OVH_CONSUMER_KEY", Description: "Consumer key (Application Key authentication)", Credential: true},
+			{Name: "OVH_ENDPOINT", Description: "Endpoint URL (ovh-eu or ovh-ca)", Credential: true},
+			{Name: "OVH_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "OVH_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "OVH_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "OVH_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://eu.api.ovh.com/",
+			GoClient: "https://github.com/ovh/go-ovh",
+		},
+	}
+}
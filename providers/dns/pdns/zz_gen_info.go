@@ -0,0 +1,31 @@
+package pdns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/pdns/pdns.toml
+		Name:  "PowerDNS",
+		Code:  "pdns",
+		Since: "v0.4.0",
+		URL:   "https://www.powerdns.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "PDNS_API_KEY", Description: "API key", Credential: true},
+			{Name: "PDNS_API_URL", Description: "API URL", Credential: true},
+			{Name: "PDNS_API_VERSION", Description: "Skip API version autodetection and use the provided version number."},
+			{Name: "PDNS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "PDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "PDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "PDNS_SERVER_NAME", Description: "Name of the server in the URL, 'localhost' by default"},
+			{Name: "PDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://doc.powerdns.com/md/httpapi/README/",
+			GoClient: "",
+		},
+	}
+}
@@ -12,12 +12,14 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 // Client the Plesk API client.
 type Client struct {
 	login    string
 	password string
+	apiKey   string
 
 	baseURL    *url.URL
 	HTTPClient *http.Client
@@ -33,6 +35,16 @@ func NewClient(baseURL *url.URL, login string, password string) *Client {
 	}
 }
 
+// NewClientWithAPIKey created a new Client authenticated with an API key (secret key).
+// https://docs.plesk.com/en-US/obsidian/api-rpc/about-xml-api/xml-api-packets/http-request-packets.33366/
+func NewClientWithAPIKey(baseURL *url.URL, apiKey string) *Client {
+	return &Client{
+		apiKey:     apiKey,
+		baseURL:    baseURL,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
 // GetSite gets a site.
 // https://docs.plesk.com/en-US/obsidian/api-rpc/about-xml-api/reference/managing-sites-domains/getting-information-about-sites.66583/
 func (c Client) GetSite(ctx context.Context, domain string) (int, error) {
@@ -49,15 +61,41 @@ func (c Client) GetSite(ctx context.Context, domain string) (int, error) {
 		return 0, response.System
 	}
 
-	if response == nil || response.Site.Get.Result == nil {
+	if len(response.Site.Get.Result) < 1 {
 		return 0, errors.New("unexpected empty result")
 	}
 
-	if response.Site.Get.Result.Status != StatusOK {
-		return 0, response.Site.Get.Result
+	if response.Site.Get.Result[0].Status != StatusOK {
+		return 0, response.Site.Get.Result[0]
+	}
+
+	return response.Site.Get.Result[0].ID, nil
+}
+
+// ListSites lists the sites.
+// https://docs.plesk.com/en-US/obsidian/api-rpc/about-xml-api/reference/managing-sites-domains/getting-information-about-sites.66583/
+func (c Client) ListSites(ctx context.Context) ([]SiteResult, error) {
+	payload := RequestPacketType{Site: &SiteTypeRequest{Get: SiteGetRequest{
+		Filter:  &SiteFilterType{},
+		Dataset: SiteDatasetType{GenInfo: &SiteGenInfoType{}},
+	}}}
+
+	response, err := c.doRequest(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.System != nil {
+		return nil, response.System
+	}
+
+	for _, result := range response.Site.Get.Result {
+		if result.Status != StatusOK {
+			return nil, result
+		}
 	}
 
-	return response.Site.Get.Result.ID, nil
+	return response.Site.Get.Result, nil
 }
 
 // AddRecord adds a TXT record.
@@ -131,10 +169,16 @@ func (c Client) doRequest(ctx context.Context, payload RequestPacketType) (*Resp
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Content-Type", "text/xml")
 
-	req.Header.Set("Http_auth_login", c.login)
-	req.Header.Set("Http_auth_passwd", c.password)
+	if c.apiKey != "" {
+		req.Header.Set("Key", c.apiKey)
+	} else {
+		req.Header.Set("Http_auth_login", c.login)
+		req.Header.Set("Http_auth_passwd", c.password)
+	}
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
@@ -34,16 +34,23 @@ func setupTest(t *testing.T, filename string) *Client {
 			return
 		}
 
-		login := req.Header.Get("Http_auth_login")
-		if login != "user" {
-			http.Error(rw, fmt.Sprintf("invalid login: %s", login), http.StatusUnauthorized)
-			return
-		}
-
-		password := req.Header.Get("Http_auth_passwd")
-		if password != "secret" {
-			http.Error(rw, fmt.Sprintf("invalid password: %s", password), http.StatusUnauthorized)
-			return
+		if key := req.Header.Get("Key"); key != "" {
+			if key != "secret-key" {
+				http.Error(rw, fmt.Sprintf("invalid API key: %s", key), http.StatusUnauthorized)
+				return
+			}
+		} else {
+			login := req.Header.Get("Http_auth_login")
+			if login != "user" {
+				http.Error(rw, fmt.Sprintf("invalid login: %s", login), http.StatusUnauthorized)
+				return
+			}
+
+			password := req.Header.Get("Http_auth_passwd")
+			if password != "secret" {
+				http.Error(rw, fmt.Sprintf("invalid password: %s", password), http.StatusUnauthorized)
+				return
+			}
 		}
 
 		file, err := os.Open(filepath.Join("fixtures", filename))
@@ -90,6 +97,41 @@ func TestClient_GetSite_system_error(t *testing.T) {
 	assert.Equal(t, 0, siteID)
 }
 
+func TestClient_ListSites(t *testing.T) {
+	client := setupTest(t, "list-sites.xml")
+
+	sites, err := client.ListSites(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, sites, 3)
+
+	assert.Equal(t, 82, sites[0].ID)
+	assert.Equal(t, "example.com", sites[0].Data.GenInfo.ASCIIName)
+	assert.Equal(t, 83, sites[1].ID)
+	assert.Equal(t, "sub.example.com", sites[1].Data.GenInfo.ASCIIName)
+	assert.Equal(t, 84, sites[2].ID)
+	assert.Equal(t, "xn--bcher-kva.example", sites[2].Data.GenInfo.ASCIIName)
+}
+
+func TestClient_ListSites_apiKey(t *testing.T) {
+	client := setupTest(t, "list-sites.xml")
+
+	apiKeyClient := NewClientWithAPIKey(client.baseURL, "secret-key")
+	apiKeyClient.HTTPClient = client.HTTPClient
+
+	sites, err := apiKeyClient.ListSites(context.Background())
+	require.NoError(t, err)
+
+	assert.Len(t, sites, 3)
+}
+
+func TestClient_ListSites_system_error(t *testing.T) {
+	client := setupTest(t, "global-error.xml")
+
+	_, err := client.ListSites(context.Background())
+	require.ErrorAs(t, err, new(*System))
+}
+
 func TestClient_AddRecord(t *testing.T) {
 	client := setupTest(t, "add-record.xml")
 
@@ -65,7 +65,7 @@ type SiteGetRequest struct {
 type SiteFilterType struct {
 	Text string `xml:",chardata"`
 
-	Name string `xml:"name"`
+	Name string `xml:"name,omitempty"`
 }
 
 type SiteDatasetType struct {
@@ -156,7 +156,7 @@ type SiteResponseType struct {
 type SiteGetResponse struct {
 	Text string `xml:",chardata"`
 
-	Result *SiteResult `xml:"result,omitempty"`
+	Result []SiteResult `xml:"result,omitempty"`
 }
 
 type SiteResult struct {
@@ -3,10 +3,14 @@ package plesk
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -22,6 +26,10 @@ const (
 	EnvServerBaseURL = envNamespace + "SERVER_BASE_URL"
 	EnvUsername      = envNamespace + "USERNAME"
 	EnvPassword      = envNamespace + "PASSWORD"
+	EnvAPIKey        = envNamespace + "API_KEY"
+
+	EnvCACertificate      = envNamespace + "CA_CERTIFICATE"
+	EnvInsecureSkipVerify = envNamespace + "INSECURE_SKIP_VERIFY"
 
 	EnvTTL                = envNamespace + "TTL"
 	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
@@ -31,9 +39,16 @@ const (
 
 // Config is used to configure the creation of the DNSProvider.
 type Config struct {
-	baseURL  string
+	BaseURL  string
 	Username string
 	Password string
+	// APIKey is the secret key used instead of the username and the password.
+	APIKey string
+
+	// CACertificate is the path to a PEM file containing the CA certificates used to verify the server.
+	CACertificate string
+	// InsecureSkipVerify disables the verification of the server certificate.
+	InsecureSkipVerify bool
 
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
@@ -64,17 +79,26 @@ type DNSProvider struct {
 
 // NewDNSProvider returns a DNSProvider instance configured for Plesk.
 // Credentials must be passed in the environment variables:
-// PLESK_USERNAME and PLESK_PASSWORD.
+// PLESK_SERVER_BASE_URL, and PLESK_USERNAME and PLESK_PASSWORD, or PLESK_API_KEY.
 func NewDNSProvider() (*DNSProvider, error) {
-	values, err := env.Get(EnvServerBaseURL, EnvUsername, EnvPassword)
+	config := NewDefaultConfig()
+	config.APIKey = env.GetOrFile(EnvAPIKey)
+
+	names := []string{EnvServerBaseURL}
+	if config.APIKey == "" {
+		names = append(names, EnvUsername, EnvPassword)
+	}
+
+	values, err := env.Get(names...)
 	if err != nil {
 		return nil, fmt.Errorf("plesk: %w", err)
 	}
 
-	config := NewDefaultConfig()
-	config.baseURL = values[EnvServerBaseURL]
+	config.BaseURL = values[EnvServerBaseURL]
 	config.Username = values[EnvUsername]
 	config.Password = values[EnvPassword]
+	config.CACertificate = env.GetOrFile(EnvCACertificate)
+	config.InsecureSkipVerify = env.GetOrDefaultBool(EnvInsecureSkipVerify, false)
 
 	return NewDNSProviderConfig(config)
 }
@@ -85,25 +109,37 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		return nil, errors.New("plesk: the configuration of the DNS provider is nil")
 	}
 
-	if config.baseURL == "" {
+	if config.BaseURL == "" {
 		return nil, errors.New("plesk: missing server base URL")
 	}
 
-	baseURL, err := url.Parse(config.baseURL)
+	baseURL, err := url.Parse(config.BaseURL)
 	if err != nil {
-		return nil, fmt.Errorf("plesk: failed to parse base URL (%s): %w", config.baseURL, err)
+		return nil, fmt.Errorf("plesk: failed to parse base URL (%s): %w", config.BaseURL, err)
 	}
 
-	if config.Username == "" || config.Password == "" {
+	var client *internal.Client
+
+	switch {
+	case config.APIKey != "":
+		client = internal.NewClientWithAPIKey(baseURL, config.APIKey)
+	case config.Username == "" || config.Password == "":
 		return nil, errors.New("plesk: incomplete credentials, missing username and/or password")
+	default:
+		client = internal.NewClient(baseURL, config.Username, config.Password)
 	}
 
-	client := internal.NewClient(baseURL, config.Username, config.Password)
-
 	if config.HTTPClient != nil {
 		client.HTTPClient = config.HTTPClient
 	}
 
+	if config.CACertificate != "" || config.InsecureSkipVerify {
+		client.HTTPClient, err = withTLSConfig(client.HTTPClient, config)
+		if err != nil {
+			return nil, fmt.Errorf("plesk: %w", err)
+		}
+	}
+
 	return &DNSProvider{
 		config:    config,
 		client:    client,
@@ -121,24 +157,19 @@ func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
-	if err != nil {
-		return fmt.Errorf("plesk: could not find zone for domain %q: %w", domain, err)
-	}
-
 	ctx := context.Background()
 
-	siteID, err := d.client.GetSite(ctx, dns01.UnFqdn(authZone))
+	site, err := d.findSite(ctx, info.EffectiveFQDN)
 	if err != nil {
-		return fmt.Errorf("plesk: failed to get site: %w", err)
+		return fmt.Errorf("plesk: %w", err)
 	}
 
-	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, authZone)
+	subDomain, err := dns01.ExtractSubDomain(strings.ToLower(info.EffectiveFQDN), site.name)
 	if err != nil {
-		return fmt.Errorf("nodion: %w", err)
+		return fmt.Errorf("plesk: %w", err)
 	}
 
-	recordID, err := d.client.AddRecord(ctx, siteID, subDomain, info.Value)
+	recordID, err := d.client.AddRecord(ctx, site.id, subDomain, info.Value)
 	if err != nil {
 		return fmt.Errorf("plesk: failed to add record: %w", err)
 	}
@@ -166,5 +197,77 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 		return fmt.Errorf("plesk: failed to delete record (%d): %w", recordID, err)
 	}
 
+	d.recordIDsMu.Lock()
+	delete(d.recordIDs, token)
+	d.recordIDsMu.Unlock()
+
 	return nil
 }
+
+type site struct {
+	id   int
+	name string
+}
+
+// findSite returns the most specific site, hosted by the server, containing the FQDN.
+func (d *DNSProvider) findSite(ctx context.Context, fqdn string) (site, error) {
+	sites, err := d.client.ListSites(ctx)
+	if err != nil {
+		return site{}, fmt.Errorf("failed to list sites: %w", err)
+	}
+
+	ids := make(map[string]int)
+
+	var names []string
+	for _, s := range sites {
+		if s.Data == nil || s.Data.GenInfo == nil {
+			continue
+		}
+
+		siteName := s.Data.GenInfo.ASCIIName
+		if siteName == "" {
+			siteName = s.Data.GenInfo.Name
+		}
+
+		ids[siteName] = s.ID
+		names = append(names, siteName)
+	}
+
+	name := dns01.FindMostSpecificZone(fqdn, names)
+	if name == "" {
+		return site{}, fmt.Errorf("no site found for %s", fqdn)
+	}
+
+	return site{id: ids[name], name: strings.ToLower(dns01.UnFqdn(name))}, nil
+}
+
+func withTLSConfig(client *http.Client, config *Config) (*http.Client, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.InsecureSkipVerify}
+
+	if config.CACertificate != "" {
+		caCerts, err := os.ReadFile(config.CACertificate)
+		if err != nil {
+			return nil, fmt.Errorf("read CA certificate: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCerts) {
+			return nil, fmt.Errorf("no valid certificates found in %s", config.CACertificate)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok || transport == nil {
+		transport = http.DefaultTransport.(*http.Transport)
+	}
+
+	transport = transport.Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	clone := *client
+	clone.Transport = transport
+
+	return &clone, nil
+}
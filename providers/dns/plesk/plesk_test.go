@@ -1,10 +1,16 @@
 package plesk
 
 import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/providers/dns/plesk/internal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -13,7 +19,10 @@ const envDomain = envNamespace + "DOMAIN"
 var envTest = tester.NewEnvTest(
 	EnvServerBaseURL,
 	EnvUsername,
-	EnvPassword).
+	EnvPassword,
+	EnvAPIKey,
+	EnvCACertificate,
+	EnvInsecureSkipVerify).
 	WithDomain(envDomain)
 
 func TestNewDNSProvider(t *testing.T) {
@@ -30,6 +39,32 @@ func TestNewDNSProvider(t *testing.T) {
 				EnvPassword:      "secret",
 			},
 		},
+		{
+			desc: "success with API key",
+			envVars: map[string]string{
+				EnvServerBaseURL: "https//example.com",
+				EnvAPIKey:        "secret-key",
+			},
+		},
+		{
+			desc: "success with insecure skip verify",
+			envVars: map[string]string{
+				EnvServerBaseURL:      "https//example.com",
+				EnvUsername:           "user",
+				EnvPassword:           "secret",
+				EnvInsecureSkipVerify: "true",
+			},
+		},
+		{
+			desc: "invalid CA certificate path",
+			envVars: map[string]string{
+				EnvServerBaseURL: "https//example.com",
+				EnvUsername:      "user",
+				EnvPassword:      "secret",
+				EnvCACertificate: "./missing.pem",
+			},
+			expected: "plesk: read CA certificate: open ./missing.pem: no such file or directory",
+		},
 		{
 			desc: "missing server base URL",
 			envVars: map[string]string{
@@ -90,6 +125,7 @@ func TestNewDNSProviderConfig(t *testing.T) {
 		baseURL  string
 		username string
 		password string
+		apiKey   string
 		expected string
 	}{
 		{
@@ -98,6 +134,11 @@ func TestNewDNSProviderConfig(t *testing.T) {
 			username: "user",
 			password: "secret",
 		},
+		{
+			desc:    "success with API key",
+			baseURL: "https://example.com",
+			apiKey:  "secret-key",
+		},
 		{
 			desc:     "missing base URL",
 			username: "user",
@@ -126,9 +167,10 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
 			config := NewDefaultConfig()
-			config.baseURL = test.baseURL
+			config.BaseURL = test.baseURL
 			config.Username = test.username
 			config.Password = test.password
+			config.APIKey = test.apiKey
 
 			p, err := NewDNSProviderConfig(config)
 
@@ -143,6 +185,144 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+// fakeAPI is a minimal in-memory implementation of the Plesk XML API.
+type fakeAPI struct {
+	mu      sync.Mutex
+	nextID  int
+	records map[int]internal.AddRecRequest
+}
+
+func (f *fakeAPI) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Header.Get("Key") != "secret-key" {
+		writeXML(rw, internal.ResponsePacketType{System: &internal.System{
+			Status:  internal.StatusError,
+			ErrCode: "1001",
+			ErrText: "Authentication failed",
+		}})
+
+		return
+	}
+
+	var packet internal.RequestPacketType
+
+	err := xml.NewDecoder(req.Body).Decode(&packet)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var response internal.ResponsePacketType
+
+	switch {
+	case packet.Site != nil:
+		response.Site.Get.Result = []internal.SiteResult{
+			{Status: internal.StatusOK, ID: 82, Data: &internal.SiteResultData{GenInfo: &internal.SiteGenInfoType{Name: "example.com", ASCIIName: "example.com"}}},
+			{Status: internal.StatusOK, ID: 83, Data: &internal.SiteResultData{GenInfo: &internal.SiteGenInfoType{Name: "sub.example.com", ASCIIName: "sub.example.com"}}},
+		}
+
+	case packet.DNS != nil && len(packet.DNS.AddRec) > 0:
+		f.nextID++
+		f.records[f.nextID] = packet.DNS.AddRec[0]
+
+		response.DNS.AddRec = []internal.AddRecResponse{{Result: internal.RecResult{Status: internal.StatusOK, ID: f.nextID}}}
+
+	case packet.DNS != nil && len(packet.DNS.DelRec) > 0:
+		id := packet.DNS.DelRec[0].Filter.ID
+
+		result := internal.RecResult{Status: internal.StatusOK, ID: id}
+		if _, ok := f.records[id]; !ok {
+			result = internal.RecResult{Status: internal.StatusError, ErrCode: "1013", ErrText: "Record does not exist"}
+		}
+
+		delete(f.records, id)
+
+		response.DNS.DelRec = []internal.DelRecResponse{{Result: result}}
+
+	default:
+		http.Error(rw, "unsupported packet", http.StatusBadRequest)
+		return
+	}
+
+	writeXML(rw, response)
+}
+
+func writeXML(rw http.ResponseWriter, response internal.ResponsePacketType) {
+	rw.Header().Set("Content-Type", "text/xml")
+
+	err := xml.NewEncoder(rw).Encode(response)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func setupTest(t *testing.T) (*DNSProvider, *fakeAPI) {
+	t.Helper()
+
+	api := &fakeAPI{records: map[int]internal.AddRecRequest{}}
+
+	mux := http.NewServeMux()
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.Handle("POST /enterprise/control/agent.php", api)
+
+	config := NewDefaultConfig()
+	config.BaseURL = server.URL
+	config.APIKey = "secret-key"
+	config.InsecureSkipVerify = true
+
+	p, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	return p, api
+}
+
+func TestDNSProvider_Present_CleanUp(t *testing.T) {
+	provider, api := setupTest(t)
+
+	err := provider.Present("example.com", "tokenA", "keyAuthA")
+	require.NoError(t, err)
+
+	err = provider.Present("foo.sub.example.com", "tokenB", "keyAuthB")
+	require.NoError(t, err)
+
+	require.Len(t, api.records, 2)
+
+	assert.Equal(t, 82, api.records[1].SiteID)
+	assert.Equal(t, "_acme-challenge", api.records[1].Host)
+	assert.Equal(t, "TXT", api.records[1].Type)
+
+	assert.Equal(t, 83, api.records[2].SiteID)
+	assert.Equal(t, "_acme-challenge.foo", api.records[2].Host)
+
+	err = provider.CleanUp("example.com", "tokenA", "keyAuthA")
+	require.NoError(t, err)
+
+	err = provider.CleanUp("foo.sub.example.com", "tokenB", "keyAuthB")
+	require.NoError(t, err)
+
+	assert.Empty(t, api.records)
+}
+
+func TestDNSProvider_Present_noSite(t *testing.T) {
+	provider, _ := setupTest(t)
+
+	err := provider.Present("example.org", "tokenA", "keyAuthA")
+	require.EqualError(t, err, "plesk: no site found for _acme-challenge.example.org.")
+}
+
+func TestDNSProvider_Present_untrustedCertificate(t *testing.T) {
+	provider, _ := setupTest(t)
+
+	provider.client.HTTPClient = &http.Client{}
+
+	err := provider.Present("example.com", "tokenA", "keyAuthA")
+	require.ErrorContains(t, err, "certificate")
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
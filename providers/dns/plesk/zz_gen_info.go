@@ -0,0 +1,33 @@
+package plesk
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/plesk/plesk.toml
+		Name:  "plesk.com",
+		Code:  "plesk",
+		Since: "v4.11.0",
+		URL:   "https://www.plesk.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "PLESK_PASSWORD", Description: "API password", Credential: true},
+			{Name: "PLESK_SERVER_BASE_URL", Description: "Base URL of the server (ex: https://plesk.myserver.com:8443)", Credential: true},
+			{Name: "PLESK_USERNAME", Description: "API username", Credential: true},
+			{Name: "PLESK_API_KEY", Description: "Secret key, used instead of the username and the password"},
+			{Name: "PLESK_CA_CERTIFICATE", Description: "Path to a PEM file containing the CA certificates used to verify the server certificate"},
+			{Name: "PLESK_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "PLESK_INSECURE_SKIP_VERIFY", Description: "Whether or not to skip the verification of the server certificate"},
+			{Name: "PLESK_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "PLESK_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "PLESK_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://docs.plesk.com/en-US/obsidian/api-rpc/about-xml-api/reference.28784/",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package porkbun
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/porkbun/porkbun.toml
+		Name:  "Porkbun",
+		Code:  "porkbun",
+		Since: "v4.4.0",
+		URL:   "https://porkbun.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "PORKBUN_API_KEY", Description: "API key", Credential: true},
+			{Name: "PORKBUN_SECRET_API_KEY", Description: "secret API key", Credential: true},
+			{Name: "PORKBUN_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "PORKBUN_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "PORKBUN_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "PORKBUN_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://porkbun.com/api/json/v3/documentation",
+			GoClient: "",
+		},
+	}
+}
@@ -8,53 +8,78 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
-	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/wait"
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
-type Client struct {
-	token string
+const (
+	jobStatusCompleted = "COMPLETED"
+	jobStatusError     = "ERROR"
+)
 
+type Client struct {
 	baseURL    *url.URL
 	HTTPClient *http.Client
+
+	jobTimeout      time.Duration
+	jobPollInterval time.Duration
 }
 
-func NewClient(endpoint string, token string) (*Client, error) {
+func NewClient(endpoint string) (*Client, error) {
 	baseURL, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Client{
-		token:      token,
-		baseURL:    baseURL,
-		HTTPClient: &http.Client{Timeout: 5 * time.Second},
+		baseURL:         baseURL,
+		HTTPClient:      &http.Client{Timeout: 5 * time.Second},
+		jobTimeout:      2 * time.Minute,
+		jobPollInterval: 2 * time.Second,
 	}, nil
 }
 
-// AddRecord Adds one  record to a specified domain.
+// AddRecord Adds one record to a specified domain, and waits for the completion of the asynchronous job.
 // https://docs.rackspace.com/docs/cloud-dns/v1/api-reference/records#add-records
-func (c *Client) AddRecord(ctx context.Context, zoneID string, record Record) error {
+func (c *Client) AddRecord(ctx context.Context, zoneID string, record Record) (*Record, error) {
 	endpoint := c.baseURL.JoinPath("domains", zoneID, "records")
 
 	records := Records{Records: []Record{record}}
 
 	req, err := newJSONRequest(ctx, http.MethodPost, endpoint, records)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = c.do(req, nil)
+	var job AsyncJob
+	err = c.do(req, &job)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	completed, err := c.waitForJob(ctx, job.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	var created Records
+	err = json.Unmarshal(completed.Response, &created)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal the response of the job %s: %w", job.JobID, err)
+	}
+
+	if len(created.Records) == 0 {
+		return nil, fmt.Errorf("no record in the response of the job %s", job.JobID)
+	}
+
+	return &created.Records[0], nil
 }
 
-// DeleteRecord Deletes a record from the domain.
+// DeleteRecord Deletes a record from the domain, and waits for the completion of the asynchronous job.
 // https://docs.rackspace.com/docs/cloud-dns/v1/api-reference/records#delete-records
 func (c *Client) DeleteRecord(ctx context.Context, zoneID, recordID string) error {
 	endpoint := c.baseURL.JoinPath("domains", zoneID, "records")
@@ -68,41 +93,58 @@ func (c *Client) DeleteRecord(ctx context.Context, zoneID, recordID string) erro
 		return err
 	}
 
-	err = c.do(req, nil)
+	var job AsyncJob
+	err = c.do(req, &job)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	_, err = c.waitForJob(ctx, job.JobID)
+
+	return err
 }
 
-// GetHostedZoneID performs a lookup to get the DNS zone which needs modifying for a given FQDN.
-func (c *Client) GetHostedZoneID(ctx context.Context, fqdn string) (string, error) {
-	authZone, err := dns01.FindZoneByFqdn(fqdn)
-	if err != nil {
-		return "", fmt.Errorf("could not find zone: %w", err)
-	}
+// ListDomains Lists all the domains of the account.
+// https://docs.rackspace.com/docs/cloud-dns/v1/api-reference/domains#list-domains
+func (c *Client) ListDomains(ctx context.Context) ([]HostedZone, error) {
+	const limit = 100
 
-	zoneSearchResponse, err := c.listDomainsByName(ctx, dns01.UnFqdn(authZone))
-	if err != nil {
-		return "", err
-	}
+	var zones []HostedZone
 
-	// If nothing was returned, or for whatever reason more than 1 was returned (the search uses exact match, so should not occur)
-	if zoneSearchResponse.TotalEntries != 1 {
-		return "", fmt.Errorf("found %d zones for %s in Rackspace for domain %s", zoneSearchResponse.TotalEntries, authZone, fqdn)
-	}
+	for {
+		endpoint := c.baseURL.JoinPath("domains")
+
+		query := endpoint.Query()
+		query.Set("limit", strconv.Itoa(limit))
+		query.Set("offset", strconv.Itoa(len(zones)))
+		endpoint.RawQuery = query.Encode()
+
+		req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
 
-	return zoneSearchResponse.HostedZones[0].ID, nil
+		var zoneSearchResponse ZoneSearchResponse
+		err = c.do(req, &zoneSearchResponse)
+		if err != nil {
+			return nil, err
+		}
+
+		zones = append(zones, zoneSearchResponse.HostedZones...)
+
+		if len(zoneSearchResponse.HostedZones) == 0 || len(zones) >= zoneSearchResponse.TotalEntries {
+			return zones, nil
+		}
+	}
 }
 
-// listDomainsByName Filters domains by domain name.
-// https://docs.rackspace.com/docs/cloud-dns/v1/api-reference/domains#list-domains-by-name
-func (c *Client) listDomainsByName(ctx context.Context, domain string) (*ZoneSearchResponse, error) {
-	endpoint := c.baseURL.JoinPath("domains")
+// GetJobStatus Gets the status of an asynchronous job, with its details.
+// https://docs.rackspace.com/docs/cloud-dns/v1/api-reference/status#show-status
+func (c *Client) GetJobStatus(ctx context.Context, jobID string) (*AsyncJob, error) {
+	endpoint := c.baseURL.JoinPath("status", jobID)
 
 	query := endpoint.Query()
-	query.Set("name", domain)
+	query.Set("showDetails", "true")
 	endpoint.RawQuery = query.Encode()
 
 	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
@@ -110,58 +152,51 @@ func (c *Client) listDomainsByName(ctx context.Context, domain string) (*ZoneSea
 		return nil, err
 	}
 
-	var zoneSearchResponse ZoneSearchResponse
-	err = c.do(req, &zoneSearchResponse)
+	var job AsyncJob
+	err = c.do(req, &job)
 	if err != nil {
 		return nil, err
 	}
 
-	return &zoneSearchResponse, nil
+	return &job, nil
 }
 
-// FindTxtRecord searches a DNS zone for a TXT record with a specific name.
-func (c *Client) FindTxtRecord(ctx context.Context, fqdn string, zoneID string) (*Record, error) {
-	records, err := c.searchRecords(ctx, zoneID, dns01.UnFqdn(fqdn), "TXT")
-	if err != nil {
-		return nil, err
-	}
+// waitForJob polls the status of an asynchronous job until its completion.
+func (c *Client) waitForJob(ctx context.Context, jobID string) (*AsyncJob, error) {
+	var job *AsyncJob
 
-	switch len(records.Records) {
-	case 1:
-	case 0:
-		return nil, fmt.Errorf("no TXT record found for %s", fqdn)
-	default:
-		return nil, fmt.Errorf("more than 1 TXT record found for %s", fqdn)
-	}
-
-	return &records.Records[0], nil
-}
+	err := wait.ForContext(ctx, "rackspace job "+jobID, c.jobTimeout, c.jobPollInterval, func() (bool, error) {
+		var err error
 
-// https://docs.rackspace.com/docs/cloud-dns/v1/api-reference/records#search-records
-func (c *Client) searchRecords(ctx context.Context, zoneID, recordName, recordType string) (*Records, error) {
-	endpoint := c.baseURL.JoinPath("domains", zoneID, "records")
-
-	query := endpoint.Query()
-	query.Set("type", recordType)
-	query.Set("name", recordName)
-	endpoint.RawQuery = query.Encode()
+		job, err = c.GetJobStatus(ctx, jobID)
+		if err != nil {
+			return false, err
+		}
 
-	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
+		switch job.Status {
+		case jobStatusCompleted, jobStatusError:
+			return true, nil
+		default:
+			return false, fmt.Errorf("job %s: status %s", jobID, job.Status)
+		}
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var records Records
-	err = c.do(req, &records)
-	if err != nil {
-		return nil, err
+	if job.Status == jobStatusError {
+		if job.Error == nil {
+			return nil, fmt.Errorf("job %s failed", jobID)
+		}
+
+		return nil, fmt.Errorf("job %s failed: %w", jobID, job.Error)
 	}
 
-	return &records, nil
+	return job, nil
 }
 
 func (c *Client) do(req *http.Request, result any) error {
-	req.Header.Set("X-Auth-Token", c.token)
+	req.Header.Set("X-Auth-Token", getToken(req.Context()))
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
@@ -206,6 +241,8 @@ func newJSONRequest[T string | *url.URL](ctx context.Context, method string, end
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 
 	if payload != nil {
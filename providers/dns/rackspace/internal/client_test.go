@@ -8,27 +8,45 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func setupTest(t *testing.T, pattern string, handler http.HandlerFunc) *Client {
+func setupTest(t *testing.T) (*Client, *http.ServeMux) {
 	t.Helper()
 
 	mux := http.NewServeMux()
 	server := httptest.NewServer(mux)
 	t.Cleanup(server.Close)
 
-	client, err := NewClient(server.URL, "secret")
+	client, err := NewClient(server.URL)
 	require.NoError(t, err)
 
 	client.HTTPClient = server.Client()
+	client.jobTimeout = time.Second
+	client.jobPollInterval = 10 * time.Millisecond
 
-	mux.HandleFunc(pattern, handler)
+	return client, mux
+}
+
+// writeJobFixturesHandler writes the fixtures of the status of a job, one fixture by call (the last one is repeated).
+func writeJobFixturesHandler(filenames ...string) http.HandlerFunc {
+	var calls atomic.Int32
+
+	return func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("showDetails") != "true" {
+			http.Error(rw, "missing showDetails", http.StatusBadRequest)
+			return
+		}
 
-	return client
+		i := min(int(calls.Add(1)), len(filenames)) - 1
+
+		writeFixtureHandler(http.MethodGet, filenames[i])(rw, req)
+	}
 }
 
 func writeFixtureHandler(method, filename string) http.HandlerFunc {
@@ -59,49 +77,74 @@ func writeFixtureHandler(method, filename string) http.HandlerFunc {
 }
 
 func TestClient_AddRecord(t *testing.T) {
-	client := setupTest(t, "/domains/1234/records", writeFixtureHandler(http.MethodPost, "add-records.json"))
+	client, mux := setupTest(t)
+
+	mux.HandleFunc("/domains/2725233/records", writeFixtureHandler(http.MethodPost, "add-records.json"))
+	mux.HandleFunc("/status/852a1e4a-45b4-409b-b3a9-8e3c1d45a2e7", writeJobFixturesHandler("job-running.json", "job-running.json", "job-completed.json"))
 
-	err := client.AddRecord(context.Background(), "1234", Record{})
+	record := Record{Name: "_acme-challenge.example.com", Type: "TXT", Data: "txtTXTtxt", TTL: 300}
+
+	newRecord, err := client.AddRecord(WithContext(context.Background(), "secret"), "2725233", record)
 	require.NoError(t, err)
+
+	expected := &Record{Name: "_acme-challenge.example.com", Type: "TXT", Data: "txtTXTtxt", TTL: 300, ID: "TXT-6817754"}
+
+	assert.Equal(t, expected, newRecord)
 }
 
-func TestClient_DeleteRecord(t *testing.T) {
-	client := setupTest(t, "/domains/1234/records", writeFixtureHandler(http.MethodDelete, ""))
+func TestClient_AddRecord_jobTimeout(t *testing.T) {
+	client, mux := setupTest(t)
 
-	err := client.DeleteRecord(context.Background(), "1234", "2725233")
-	require.NoError(t, err)
+	client.jobTimeout = 50 * time.Millisecond
+
+	mux.HandleFunc("/domains/2725233/records", writeFixtureHandler(http.MethodPost, "add-records.json"))
+	mux.HandleFunc("/status/852a1e4a-45b4-409b-b3a9-8e3c1d45a2e7", writeJobFixturesHandler("job-running.json"))
+
+	_, err := client.AddRecord(WithContext(context.Background(), "secret"), "2725233", Record{})
+	require.EqualError(t, err, "rackspace job 852a1e4a-45b4-409b-b3a9-8e3c1d45a2e7: time limit exceeded: last error: job 852a1e4a-45b4-409b-b3a9-8e3c1d45a2e7: status RUNNING")
 }
 
-func TestClient_searchRecords(t *testing.T) {
-	client := setupTest(t, "/domains/1234/records", writeFixtureHandler(http.MethodGet, "search-records.json"))
+func TestClient_DeleteRecord(t *testing.T) {
+	client, mux := setupTest(t)
 
-	records, err := client.searchRecords(context.Background(), "1234", "2725233", "A")
+	mux.HandleFunc("/domains/2725233/records", writeFixtureHandler(http.MethodDelete, "delete-records.json"))
+	mux.HandleFunc("/status/a5a0e2b3-0e8c-4b4e-9c8e-2e0c3c8f2d1a", writeJobFixturesHandler("job-running.json", "job-completed.json"))
+
+	err := client.DeleteRecord(WithContext(context.Background(), "secret"), "2725233", "TXT-6817754")
 	require.NoError(t, err)
+}
 
-	expected := &Records{
-		TotalEntries: 6,
-		Records: []Record{
-			{Name: "ftp.example.com", Type: "A", Data: "192.0.2.8", TTL: 5771, ID: "A-6817754"},
-			{Name: "example.com", Type: "A", Data: "192.0.2.17", TTL: 86400, ID: "A-6822994"},
-			{Name: "example.com", Type: "NS", Data: "ns.rackspace.com", TTL: 3600, ID: "NS-6251982"},
-			{Name: "example.com", Type: "NS", Data: "ns2.rackspace.com", TTL: 3600, ID: "NS-6251983"},
-			{Name: "example.com", Type: "MX", Data: "mail.example.com", TTL: 3600, ID: "MX-3151218"},
-			{Name: "www.example.com", Type: "CNAME", Data: "example.com", TTL: 5400, ID: "CNAME-9778009"},
-		},
-	}
+func TestClient_DeleteRecord_jobError(t *testing.T) {
+	client, mux := setupTest(t)
 
-	assert.Equal(t, expected, records)
+	mux.HandleFunc("/domains/2725233/records", writeFixtureHandler(http.MethodDelete, "delete-records.json"))
+	mux.HandleFunc("/status/a5a0e2b3-0e8c-4b4e-9c8e-2e0c3c8f2d1a", writeJobFixturesHandler("job-running.json", "job-error.json"))
+
+	err := client.DeleteRecord(WithContext(context.Background(), "secret"), "2725233", "TXT-6817754")
+	require.EqualError(t, err, "job a5a0e2b3-0e8c-4b4e-9c8e-2e0c3c8f2d1a failed: 500: One or more items could not be deleted.: See errors list for details.")
 }
 
-func TestClient_listDomainsByName(t *testing.T) {
-	client := setupTest(t, "/domains", writeFixtureHandler(http.MethodGet, "list-domains-by-name.json"))
+func TestClient_ListDomains(t *testing.T) {
+	client, mux := setupTest(t)
+
+	mux.HandleFunc("/domains", func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Query().Get("offset") {
+		case "0":
+			writeFixtureHandler(http.MethodGet, "list-domains.json")(rw, req)
+		case "2":
+			writeFixtureHandler(http.MethodGet, "list-domains_page2.json")(rw, req)
+		default:
+			http.Error(rw, fmt.Sprintf("unexpected offset: %s", req.URL.Query().Get("offset")), http.StatusBadRequest)
+		}
+	})
 
-	domains, err := client.listDomainsByName(context.Background(), "1234")
+	domains, err := client.ListDomains(WithContext(context.Background(), "secret"))
 	require.NoError(t, err)
 
-	expected := &ZoneSearchResponse{
-		TotalEntries: 114,
-		HostedZones:  []HostedZone{{ID: "2725257", Name: "sub1.example.com"}},
+	expected := []HostedZone{
+		{ID: "2725233", Name: "example.com"},
+		{ID: "2725257", Name: "sub1.example.com"},
+		{ID: "2725352", Name: "example.org"},
 	}
 
 	assert.Equal(t, expected, domains)
@@ -10,6 +10,10 @@ import (
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
 )
 
+type token string
+
+const tokenKey token = "token"
+
 // DefaultIdentityURL represents the Identity API endpoint to call.
 const DefaultIdentityURL = "https://identity.api.rackspacecloud.com/v2.0/tokens"
 
@@ -72,3 +76,16 @@ func (a *Identifier) Login(ctx context.Context, apiUser, apiKey string) (*Identi
 
 	return &identity, nil
 }
+
+func WithContext(ctx context.Context, credential string) context.Context {
+	return context.WithValue(ctx, tokenKey, credential)
+}
+
+func getToken(ctx context.Context) string {
+	credential, ok := ctx.Value(tokenKey).(string)
+	if !ok {
+		return ""
+	}
+
+	return credential
+}
@@ -1,5 +1,11 @@
 package internal
 
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
 // Authentication response.
 
 // Identity api structure.
@@ -22,6 +28,17 @@ type Token struct {
 	RAXAUTHAuthenticatedBy []string `json:"RAX-AUTH:authenticatedBy"`
 }
 
+// IsExpired returns true if the token is expired, or expires within the margin.
+// A token with an invalid expiration date is considered as expired.
+func (t Token) IsExpired(margin time.Duration) bool {
+	expires, err := time.Parse(time.RFC3339, t.Expires)
+	if err != nil {
+		return true
+	}
+
+	return time.Now().Add(margin).After(expires)
+}
+
 // ServiceCatalog service catalog.
 type ServiceCatalog struct {
 	Name      string     `json:"name"`
@@ -102,3 +119,26 @@ type Record struct {
 	TTL  int    `json:"ttl,omitempty"`
 	ID   string `json:"id,omitempty"`
 }
+
+// AsyncJob represents the status of an asynchronous job.
+// https://docs.rackspace.com/docs/cloud-dns/v1/general-api-info/synchronous-and-asynchronous-responses
+type AsyncJob struct {
+	Status      string          `json:"status"`
+	Verb        string          `json:"verb,omitempty"`
+	JobID       string          `json:"jobId"`
+	CallbackURL string          `json:"callbackUrl,omitempty"`
+	RequestURL  string          `json:"requestUrl,omitempty"`
+	Response    json.RawMessage `json:"response,omitempty"`
+	Error       *JobError       `json:"error,omitempty"`
+}
+
+// JobError the error of a failed asynchronous job.
+type JobError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Details string `json:"details,omitempty"`
+}
+
+func (e *JobError) Error() string {
+	return fmt.Sprintf("%d: %s: %s", e.Code, e.Message, e.Details)
+}
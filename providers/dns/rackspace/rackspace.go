@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
@@ -13,6 +14,9 @@ import (
 	"github.com/go-acme/lego/v4/providers/dns/rackspace/internal"
 )
 
+// tokenExpirationMargin is the delay before the expiration of the token to renew it.
+const tokenExpirationMargin = 5 * time.Minute
+
 // Environment variables names.
 const (
 	envNamespace = "RACKSPACE_"
@@ -52,11 +56,22 @@ func NewDefaultConfig() *Config {
 
 // DNSProvider implements the challenge.Provider interface.
 type DNSProvider struct {
-	config *Config
-	client *internal.Client
+	config     *Config
+	client     *internal.Client
+	identifier *internal.Identifier
+
+	identity   *internal.Identity
+	identityMu sync.Mutex
 
-	token            string
 	cloudDNSEndpoint string
+
+	recordIDs   map[string]recordRef
+	recordIDsMu sync.Mutex
+}
+
+type recordRef struct {
+	zoneID   string
+	recordID string
 }
 
 // NewDNSProvider returns a DNSProvider instance configured for Rackspace.
@@ -86,50 +101,51 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		return nil, errors.New("rackspace: credentials missing")
 	}
 
-	identifier := internal.NewIdentifier(config.HTTPClient, config.BaseURL)
+	d := &DNSProvider{
+		config:     config,
+		identifier: internal.NewIdentifier(config.HTTPClient, config.BaseURL),
+		recordIDs:  make(map[string]recordRef),
+	}
 
-	identity, err := identifier.Login(context.Background(), config.APIUser, config.APIKey)
+	identity, err := d.login(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("rackspace: %w", err)
 	}
 
 	// Iterate through the Service Catalog to get the DNS Endpoint
-	var dnsEndpoint string
 	for _, service := range identity.Access.ServiceCatalog {
-		if service.Name == "cloudDNS" {
-			dnsEndpoint = service.Endpoints[0].PublicURL
+		if service.Name == "cloudDNS" && len(service.Endpoints) > 0 {
+			d.cloudDNSEndpoint = service.Endpoints[0].PublicURL
 			break
 		}
 	}
 
-	if dnsEndpoint == "" {
+	if d.cloudDNSEndpoint == "" {
 		return nil, errors.New("rackspace: failed to populate DNS endpoint, check Rackspace API for changes")
 	}
 
-	client, err := internal.NewClient(dnsEndpoint, identity.Access.Token.ID)
+	d.client, err = internal.NewClient(d.cloudDNSEndpoint)
 	if err != nil {
 		return nil, fmt.Errorf("rackspace: %w", err)
 	}
 
 	if config.HTTPClient != nil {
-		client.HTTPClient = config.HTTPClient
+		d.client.HTTPClient = config.HTTPClient
 	}
 
-	return &DNSProvider{
-		config:           config,
-		client:           client,
-		token:            identity.Access.Token.ID,
-		cloudDNSEndpoint: dnsEndpoint,
-	}, nil
+	return d, nil
 }
 
 // Present creates a TXT record to fulfill the dns-01 challenge.
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	ctx := context.Background()
+	ctx, err := d.authenticatedContext(context.Background())
+	if err != nil {
+		return fmt.Errorf("rackspace: %w", err)
+	}
 
-	zoneID, err := d.client.GetHostedZoneID(ctx, info.EffectiveFQDN)
+	zoneID, err := d.findZoneID(ctx, info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("rackspace: %w", err)
 	}
@@ -141,11 +157,15 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		TTL:  d.config.TTL,
 	}
 
-	err = d.client.AddRecord(ctx, zoneID, record)
+	newRecord, err := d.client.AddRecord(ctx, zoneID, record)
 	if err != nil {
 		return fmt.Errorf("rackspace: %w", err)
 	}
 
+	d.recordIDsMu.Lock()
+	d.recordIDs[token] = recordRef{zoneID: zoneID, recordID: newRecord.ID}
+	d.recordIDsMu.Unlock()
+
 	return nil
 }
 
@@ -153,23 +173,28 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	ctx := context.Background()
+	d.recordIDsMu.Lock()
+	ref, ok := d.recordIDs[token]
+	d.recordIDsMu.Unlock()
 
-	zoneID, err := d.client.GetHostedZoneID(ctx, info.EffectiveFQDN)
-	if err != nil {
-		return fmt.Errorf("rackspace: %w", err)
+	if !ok {
+		return fmt.Errorf("rackspace: unknown record ID for '%s'", info.EffectiveFQDN)
 	}
 
-	record, err := d.client.FindTxtRecord(ctx, info.EffectiveFQDN, zoneID)
+	ctx, err := d.authenticatedContext(context.Background())
 	if err != nil {
 		return fmt.Errorf("rackspace: %w", err)
 	}
 
-	err = d.client.DeleteRecord(ctx, zoneID, record.ID)
+	err = d.client.DeleteRecord(ctx, ref.zoneID, ref.recordID)
 	if err != nil {
 		return fmt.Errorf("rackspace: %w", err)
 	}
 
+	d.recordIDsMu.Lock()
+	delete(d.recordIDs, token)
+	d.recordIDsMu.Unlock()
+
 	return nil
 }
 
@@ -178,3 +203,54 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 	return d.config.PropagationTimeout, d.config.PollingInterval
 }
+
+// authenticatedContext returns a context with the token of the identity,
+// the token is cached, and renewed only when it is about to expire.
+func (d *DNSProvider) authenticatedContext(ctx context.Context) (context.Context, error) {
+	d.identityMu.Lock()
+	defer d.identityMu.Unlock()
+
+	if d.identity == nil || d.identity.Access.Token.IsExpired(tokenExpirationMargin) {
+		_, err := d.login(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return internal.WithContext(ctx, d.identity.Access.Token.ID), nil
+}
+
+// login authenticates against the identity API, the caller must hold identityMu (except during the creation of the provider).
+func (d *DNSProvider) login(ctx context.Context) (*internal.Identity, error) {
+	identity, err := d.identifier.Login(ctx, d.config.APIUser, d.config.APIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	d.identity = identity
+
+	return identity, nil
+}
+
+// findZoneID returns the ID of the most specific domain of the account containing the FQDN.
+func (d *DNSProvider) findZoneID(ctx context.Context, fqdn string) (string, error) {
+	zones, err := d.client.ListDomains(ctx)
+	if err != nil {
+		return "", fmt.Errorf("list domains: %w", err)
+	}
+
+	ids := make(map[string]string)
+
+	var names []string
+	for _, z := range zones {
+		ids[z.Name] = z.ID
+		names = append(names, z.Name)
+	}
+
+	zone := dns01.FindMostSpecificZone(fqdn, names)
+	if zone == "" {
+		return "", fmt.Errorf("no domain found for %s", fqdn)
+	}
+
+	return ids[zone], nil
+}
@@ -4,25 +4,42 @@ const recordDeleteMock = `
 {
   "status": "RUNNING",
   "verb": "DELETE",
+  "jobId": "00000000-0000-0000-0000-0000000001",
+  "callbackUrl": "https://dns.api.rackspacecloud.com/v1.0/123456/status/00000000-0000-0000-0000-0000000001",
+  "requestUrl": "https://dns.api.rackspacecloud.com/v1.0/123456/domains/112233/recordsid=TXT-654321"
+}
+`
+
+const addRecordJobMock = `
+{
+  "status": "COMPLETED",
+  "verb": "POST",
   "jobId": "00000000-0000-0000-0000-0000000000",
   "callbackUrl": "https://dns.api.rackspacecloud.com/v1.0/123456/status/00000000-0000-0000-0000-0000000000",
-  "requestUrl": "https://dns.api.rackspacecloud.com/v1.0/123456/domains/112233/recordsid=TXT-654321"
+  "requestUrl": "https://dns.api.rackspacecloud.com/v1.0/123456/domains/112233/records",
+  "response": {
+    "records": [
+      {
+        "name": "_acme-challenge.example.com",
+        "id": "TXT-654321",
+        "type": "TXT",
+        "data": "pW9ZKG0xz_PCriK-nCMOjADy9eJcgGWIzkkj2fN4uZM",
+        "ttl": 300,
+        "updated": "1970-01-01T00:00:00.000+0000",
+        "created": "1970-01-01T00:00:00.000+0000"
+      }
+    ]
+  }
 }
 `
 
-const recordDetailsMock = `
+const deleteRecordJobMock = `
 {
-  "records": [
-    {
-      "name": "_acme-challenge.example.com",
-      "id": "TXT-654321",
-      "type": "TXT",
-      "data": "pW9ZKG0xz_PCriK-nCMOjADy9eJcgGWIzkkj2fN4uZM",
-      "ttl": 300,
-      "updated": "1970-01-01T00:00:00.000+0000",
-      "created": "1970-01-01T00:00:00.000+0000"
-    }
-  ]
+  "status": "COMPLETED",
+  "verb": "DELETE",
+  "jobId": "00000000-0000-0000-0000-0000000001",
+  "callbackUrl": "https://dns.api.rackspacecloud.com/v1.0/123456/status/00000000-0000-0000-0000-0000000001",
+  "requestUrl": "https://dns.api.rackspacecloud.com/v1.0/123456/domains/112233/records?id=TXT-654321"
 }
 `
 
@@ -35,9 +52,16 @@ const zoneDetailsMock = `
       "emailAddress": "hostmaster@example.com",
       "updated": "1970-01-01T00:00:00.000+0000",
       "created": "1970-01-01T00:00:00.000+0000"
+    },
+    {
+      "name": "example.org",
+      "id": "445566",
+      "emailAddress": "hostmaster@example.org",
+      "updated": "1970-01-01T00:00:00.000+0000",
+      "created": "1970-01-01T00:00:00.000+0000"
     }
   ],
-  "totalEntries": 1
+  "totalEntries": 2
 }
 `
 
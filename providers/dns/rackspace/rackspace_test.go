@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -29,7 +30,7 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, provider.config)
 
-	assert.Equal(t, "testToken", provider.token, "The token should match")
+	assert.Equal(t, "testToken", provider.identity.Access.Token.ID, "The token should match")
 }
 
 func TestNewDNSProviderConfig_MissingCredErr(t *testing.T) {
@@ -37,25 +38,78 @@ func TestNewDNSProviderConfig_MissingCredErr(t *testing.T) {
 	require.EqualError(t, err, "rackspace: credentials missing")
 }
 
-func TestDNSProvider_Present(t *testing.T) {
+func TestDNSProvider_Present_CleanUp(t *testing.T) {
 	config := setupTest(t)
 
 	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
 
-	if assert.NoError(t, err) {
-		err = provider.Present("example.com", "token", "keyAuth")
-		require.NoError(t, err)
-	}
+	err = provider.Present("example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]recordRef{"token": {zoneID: "112233", recordID: "TXT-654321"}}, provider.recordIDs)
+
+	err = provider.CleanUp("example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	assert.Empty(t, provider.recordIDs)
+}
+
+func TestDNSProvider_Present_unknownDomain(t *testing.T) {
+	config := setupTest(t)
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = provider.Present("example.net", "token", "keyAuth")
+	require.EqualError(t, err, "rackspace: no domain found for _acme-challenge.example.net.")
 }
 
-func TestDNSProvider_CleanUp(t *testing.T) {
+func TestDNSProvider_CleanUp_unknownToken(t *testing.T) {
 	config := setupTest(t)
 
 	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = provider.CleanUp("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "rackspace: unknown record ID for '_acme-challenge.example.com.'")
+}
+
+func TestDNSProvider_tokenCache(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		expires  string
+		expected int32
+	}{
+		{
+			desc:     "valid token",
+			expires:  time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+			expected: 1,
+		},
+		{
+			desc:     "expired token",
+			expires:  "1970-01-01T00:00:00.000Z",
+			expected: 3,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			var logins atomic.Int32
+
+			config := setupTestWithIdentity(t, test.expires, &logins)
+
+			provider, err := NewDNSProviderConfig(config)
+			require.NoError(t, err)
+
+			err = provider.Present("example.com", "token", "keyAuth")
+			require.NoError(t, err)
+
+			err = provider.CleanUp("example.com", "token", "keyAuth")
+			require.NoError(t, err)
 
-	if assert.NoError(t, err) {
-		err = provider.CleanUp("example.com", "token", "keyAuth")
-		require.NoError(t, err)
+			assert.Equal(t, test.expected, logins.Load())
+		})
 	}
 }
 
@@ -102,10 +156,16 @@ func TestLiveCleanUp(t *testing.T) {
 func setupTest(t *testing.T) *Config {
 	t.Helper()
 
+	return setupTestWithIdentity(t, "1970-01-01T00:00:00.000Z", &atomic.Int32{})
+}
+
+func setupTestWithIdentity(t *testing.T, expires string, logins *atomic.Int32) *Config {
+	t.Helper()
+
 	dnsAPI := httptest.NewServer(dnsHandler())
 	t.Cleanup(dnsAPI.Close)
 
-	identityAPI := httptest.NewServer(identityHandler(dnsAPI.URL + "/123456"))
+	identityAPI := httptest.NewServer(identityHandler(dnsAPI.URL+"/123456", expires, logins))
 	t.Cleanup(identityAPI.Close)
 
 	config := NewDefaultConfig()
@@ -117,8 +177,10 @@ func setupTest(t *testing.T) *Config {
 	return config
 }
 
-func identityHandler(dnsEndpoint string) http.Handler {
+func identityHandler(dnsEndpoint, expires string, logins *atomic.Int32) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logins.Add(1)
+
 		reqBody, err := io.ReadAll(r.Body)
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -131,6 +193,7 @@ func identityHandler(dnsEndpoint string) http.Handler {
 		}
 
 		resp := strings.Replace(identityResponseMock, "https://dns.api.rackspacecloud.com/v1.0/123456", dnsEndpoint, 1)
+		resp = strings.Replace(resp, "1970-01-01T00:00:00.000Z", expires, 1)
 		w.WriteHeader(http.StatusOK)
 		_, _ = fmt.Fprint(w, resp)
 	})
@@ -139,53 +202,58 @@ func identityHandler(dnsEndpoint string) http.Handler {
 func dnsHandler() *http.ServeMux {
 	mux := http.NewServeMux()
 
-	// Used by `getHostedZoneID()` finding `zoneID` "?name=example.com"
-	mux.HandleFunc("/123456/domains", func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Query().Get("name") == "example.com" {
-			w.WriteHeader(http.StatusOK)
-			_, _ = fmt.Fprint(w, zoneDetailsMock)
+	// Used by `findZoneID()`.
+	mux.HandleFunc("GET /123456/domains", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != "testToken" {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
 			return
 		}
-		w.WriteHeader(http.StatusBadRequest)
+
+		_, _ = fmt.Fprint(w, zoneDetailsMock)
 	})
 
-	mux.HandleFunc("/123456/domains/112233/records", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		// Used by `Present()` creating the TXT record
-		case http.MethodPost:
-			reqBody, err := io.ReadAll(r.Body)
-			if err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
-				return
-			}
-
-			if string(bytes.TrimSpace(reqBody)) != `{"records":[{"name":"_acme-challenge.example.com","type":"TXT","data":"pW9ZKG0xz_PCriK-nCMOjADy9eJcgGWIzkkj2fN4uZM","ttl":300}]}` {
-				http.Error(w, fmt.Sprintf("invalid body: %s", string(reqBody)), http.StatusBadRequest)
-				return
-			}
-
-			w.WriteHeader(http.StatusAccepted)
-			_, _ = fmt.Fprint(w, recordResponseMock)
-
-		// Used by `findTxtRecord()` finding `record.ID` "?type=TXT&name=_acme-challenge.example.com"
-		case http.MethodGet:
-			if r.URL.Query().Get("type") == "TXT" && r.URL.Query().Get("name") == "_acme-challenge.example.com" {
-				w.WriteHeader(http.StatusOK)
-				_, _ = fmt.Fprint(w, recordDetailsMock)
-				return
-			}
-
-			w.WriteHeader(http.StatusBadRequest)
+	// Used by `Present()` creating the TXT record.
+	mux.HandleFunc("POST /123456/domains/112233/records", func(w http.ResponseWriter, r *http.Request) {
+		reqBody, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if string(bytes.TrimSpace(reqBody)) != `{"records":[{"name":"_acme-challenge.example.com","type":"TXT","data":"pW9ZKG0xz_PCriK-nCMOjADy9eJcgGWIzkkj2fN4uZM","ttl":300}]}` {
+			http.Error(w, fmt.Sprintf("invalid body: %s", string(reqBody)), http.StatusBadRequest)
 			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, recordResponseMock)
+	})
+
+	// Used by `CleanUp()` deleting the TXT record "?id=TXT-654321".
+	mux.HandleFunc("DELETE /123456/domains/112233/records", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("id") != "TXT-654321" {
+			http.Error(w, fmt.Sprintf("unknown record: %s", r.URL.Query().Get("id")), http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, recordDeleteMock)
+	})
+
+	// Used to wait for the completion of the asynchronous jobs.
+	mux.HandleFunc("GET /123456/status/{jobID}", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("showDetails") != "true" {
+			http.Error(w, "missing showDetails", http.StatusBadRequest)
+			return
+		}
 
-		// Used by `CleanUp()` deleting the TXT record "?id=445566"
-		case http.MethodDelete:
-			if r.URL.Query().Get("id") == "TXT-654321" {
-				w.WriteHeader(http.StatusOK)
-				_, _ = fmt.Fprint(w, recordDeleteMock)
-				return
-			}
-			w.WriteHeader(http.StatusBadRequest)
+		switch r.PathValue("jobID") {
+		case "00000000-0000-0000-0000-0000000000":
+			_, _ = fmt.Fprint(w, addRecordJobMock)
+		case "00000000-0000-0000-0000-0000000001":
+			_, _ = fmt.Fprint(w, deleteRecordJobMock)
+		default:
+			http.Error(w, "unknown job", http.StatusNotFound)
 		}
 	})
 
@@ -0,0 +1,29 @@
+package rackspace
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/rackspace/rackspace.toml
+		Name:  "Rackspace",
+		Code:  "rackspace",
+		Since: "v0.4.0",
+		URL:   "https://www.rackspace.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "RACKSPACE_API_KEY", Description: "API key", Credential: true},
+			{Name: "RACKSPACE_USER", Description: "API user", Credential: true},
+			{Name: "RACKSPACE_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "RACKSPACE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "RACKSPACE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "RACKSPACE_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developer.rackspace.com/docs/cloud-dns/v1/",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package rcodezero
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/rcodezero/rcodezero.toml
+		Name:  "RcodeZero",
+		Code:  "rcodezero",
+		Since: "v4.13",
+		URL:   "https://www.rcodezero.at/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "RCODEZERO_API_TOKEN", Description: "API token", Credential: true},
+			{Name: "RCODEZERO_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "RCODEZERO_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "RCODEZERO_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "RCODEZERO_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://my.rcodezero.at/openapi",
+			GoClient: "",
+		},
+	}
+}
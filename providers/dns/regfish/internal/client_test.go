@@ -0,0 +1,163 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTest(t *testing.T, method, pattern string, status int, filename, expectedRequest string) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc(pattern, func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != method {
+			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		if req.Header.Get(apiKeyHeader) != "secret" {
+			http.Error(rw, fmt.Sprintf("invalid API key header: %q", req.Header.Get(apiKeyHeader)), http.StatusUnauthorized)
+			return
+		}
+
+		if expectedRequest != "" {
+			expected, err := os.ReadFile(filepath.Join("fixtures", expectedRequest))
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			if !jsonEqual(expected, body) {
+				http.Error(rw, fmt.Sprintf("invalid request body: %s", string(body)), http.StatusBadRequest)
+				return
+			}
+		}
+
+		file, err := os.Open(filepath.Join("fixtures", filename))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		defer func() { _ = file.Close() }()
+
+		rw.WriteHeader(status)
+
+		_, err = io.Copy(rw, file)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	client, err := NewClient("secret")
+	require.NoError(t, err)
+
+	client.HTTPClient = server.Client()
+	client.BaseURL, _ = url.Parse(server.URL)
+
+	return client
+}
+
+func jsonEqual(a, b []byte) bool {
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(va, vb)
+}
+
+func TestClient_ListDomains(t *testing.T) {
+	client := setupTest(t, http.MethodGet, "/domains", http.StatusOK, "domains.json", "")
+
+	domains, err := client.ListDomains(context.Background())
+	require.NoError(t, err)
+
+	expected := []Domain{
+		{ID: 1001, Domain: "example.com", Status: "active"},
+		{ID: 1002, Domain: "sub.example.com", Status: "active"},
+	}
+
+	assert.Equal(t, expected, domains)
+}
+
+func TestClient_ListDomains_error(t *testing.T) {
+	client := setupTest(t, http.MethodGet, "/domains", http.StatusUnauthorized, "error.json", "")
+
+	_, err := client.ListDomains(context.Background())
+	require.EqualError(t, err, "401: Invalid API key")
+}
+
+func TestClient_CreateRecord(t *testing.T) {
+	client := setupTest(t, http.MethodPost, "/dns/rr", http.StatusOK, "create_record.json", "create_record-request.json")
+
+	record := Record{
+		Name: "_acme-challenge.example.com.",
+		Type: "TXT",
+		Data: "txtTXTtxt",
+		TTL:  120,
+	}
+
+	newRecord, err := client.CreateRecord(context.Background(), record)
+	require.NoError(t, err)
+
+	expected := &Record{
+		ID:   12345,
+		Name: "_acme-challenge.example.com.",
+		Type: "TXT",
+		Data: "txtTXTtxt",
+		TTL:  120,
+	}
+
+	assert.Equal(t, expected, newRecord)
+}
+
+func TestClient_CreateRecord_error(t *testing.T) {
+	client := setupTest(t, http.MethodPost, "/dns/rr", http.StatusUnauthorized, "error.json", "create_record-request.json")
+
+	record := Record{
+		Name: "_acme-challenge.example.com.",
+		Type: "TXT",
+		Data: "txtTXTtxt",
+		TTL:  120,
+	}
+
+	_, err := client.CreateRecord(context.Background(), record)
+	require.EqualError(t, err, "401: Invalid API key")
+}
+
+func TestClient_DeleteRecord(t *testing.T) {
+	client := setupTest(t, http.MethodDelete, "/dns/rr/12345", http.StatusOK, "delete_record.json", "")
+
+	err := client.DeleteRecord(context.Background(), 12345)
+	require.NoError(t, err)
+}
+
+func TestClient_DeleteRecord_error(t *testing.T) {
+	client := setupTest(t, http.MethodDelete, "/dns/rr/12345", http.StatusUnauthorized, "error.json", "")
+
+	err := client.DeleteRecord(context.Background(), 12345)
+	require.EqualError(t, err, "401: Invalid API key")
+}
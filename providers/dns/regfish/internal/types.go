@@ -0,0 +1,38 @@
+package internal
+
+type APIResponse[T any] struct {
+	Success  bool   `json:"success"`
+	Message  string `json:"message,omitempty"`
+	Error    string `json:"error,omitempty"`
+	Code     int    `json:"code,omitempty"`
+	Response T      `json:"response,omitempty"`
+}
+
+type APIError struct {
+	Message  string `json:"message,omitempty"`
+	ErrorMsg string `json:"error,omitempty"`
+	Code     int    `json:"code,omitempty"`
+}
+
+func (a *APIError) Error() string {
+	if a.Message == "" {
+		return a.ErrorMsg
+	}
+
+	return a.Message
+}
+
+type Domain struct {
+	ID     int    `json:"id,omitempty"`
+	Domain string `json:"domain,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+type Record struct {
+	ID         int    `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Type       string `json:"type,omitempty"`
+	Data       string `json:"data,omitempty"`
+	TTL        int    `json:"ttl,omitempty"`
+	Annotation string `json:"annotation,omitempty"`
+}
@@ -0,0 +1,28 @@
+package regfish
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/regfish/regfish.toml
+		Name:  "Regfish",
+		Code:  "regfish",
+		Since: "v4.18.0",
+		URL:   "https://regfish.de/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "REGFISH_API_KEY", Description: "API key", Credential: true},
+			{Name: "REGFISH_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "REGFISH_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "REGFISH_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "REGFISH_TTL", Description: "The TTL of the TXT record used for the DNS challenge (minimum: 60)"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://regfish.readme.io/",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package regru
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/regru/regru.toml
+		Name:  "reg.ru",
+		Code:  "regru",
+		Since: "v3.5.0",
+		URL:   "https://www.reg.ru/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "REGRU_PASSWORD", Description: "API password", Credential: true},
+			{Name: "REGRU_USERNAME", Description: "API username", Credential: true},
+			{Name: "REGRU_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "REGRU_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "REGRU_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "REGRU_TLS_CERT", Description: "authentication certificate"},
+			{Name: "REGRU_TLS_KEY", Description: "authentication private key"},
+			{Name: "REGRU_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.reg.ru/support/help/api2",
+			GoClient: "",
+		},
+	}
+}
@@ -2,6 +2,7 @@
 package rfc2136
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/log"
 	"github.com/go-acme/lego/v4/platform/config/env"
 	"github.com/miekg/dns"
 )
@@ -20,8 +22,10 @@ const (
 	EnvTSIGKey       = envNamespace + "TSIG_KEY"
 	EnvTSIGSecret    = envNamespace + "TSIG_SECRET"
 	EnvTSIGAlgorithm = envNamespace + "TSIG_ALGORITHM"
+	EnvTSIGKeys      = envNamespace + "TSIG_KEYS"
 	EnvNameserver    = envNamespace + "NAMESERVER"
 	EnvDNSTimeout    = envNamespace + "DNS_TIMEOUT"
+	EnvNotifyTargets = envNamespace + "NOTIFY_TARGETS"
 
 	EnvTTL                = envNamespace + "TTL"
 	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
@@ -40,6 +44,21 @@ type Config struct {
 	TTL                int
 	SequenceInterval   time.Duration
 	DNSTimeout         time.Duration
+
+	// TSIGKeys are the TSIG keys by zone apex, these keys take precedence over TSIGKey.
+	TSIGKeys map[string]TSIGKey
+
+	// NotifyTargets are the secondaries notified (DNS NOTIFY) after each update, in the form "host" or "host:port".
+	// Useful when the nameserver is a hidden primary.
+	NotifyTargets []string
+}
+
+// TSIGKey a TSIG key used to sign the updates of a zone.
+type TSIGKey struct {
+	Name   string `json:"key"`
+	Secret string `json:"secret"`
+	// Algorithm defaults to Config.TSIGAlgorithm.
+	Algorithm string `json:"algorithm,omitempty"`
 }
 
 // NewDefaultConfig returns a default configuration for the DNSProvider.
@@ -66,6 +85,8 @@ type DNSProvider struct {
 // See https://github.com/miekg/dns/blob/master/tsig.go for supported values.
 // RFC2136_TSIG_KEY: Name of the secret key as defined in DNS server configuration.
 // RFC2136_TSIG_SECRET: Secret key payload.
+// RFC2136_TSIG_KEYS: TSIG keys by zone apex (JSON), ex: {"example.com": {"key": "name", "secret": "payload", "algorithm": "hmac-sha256."}}.
+// RFC2136_NOTIFY_TARGETS: Secondaries to notify after each update, comma-separated network addresses.
 // RFC2136_PROPAGATION_TIMEOUT: DNS propagation timeout in time.ParseDuration format. (60s)
 // To disable TSIG authentication, leave the RFC2136_TSIG* variables unset.
 func NewDNSProvider() (*DNSProvider, error) {
@@ -79,6 +100,19 @@ func NewDNSProvider() (*DNSProvider, error) {
 	config.TSIGKey = env.GetOrFile(EnvTSIGKey)
 	config.TSIGSecret = env.GetOrFile(EnvTSIGSecret)
 
+	for _, target := range strings.Split(env.GetOrFile(EnvNotifyTargets), ",") {
+		if target = strings.TrimSpace(target); target != "" {
+			config.NotifyTargets = append(config.NotifyTargets, target)
+		}
+	}
+
+	if raw := env.GetOrFile(EnvTSIGKeys); raw != "" {
+		err = json.Unmarshal([]byte(raw), &config.TSIGKeys)
+		if err != nil {
+			return nil, fmt.Errorf("rfc2136: unable to parse %s: %w", EnvTSIGKeys, err)
+		}
+	}
+
 	return NewDNSProviderConfig(config)
 }
 
@@ -96,20 +130,48 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		config.TSIGAlgorithm = dns.HmacSHA1
 	}
 
-	// Append the default DNS port if none is specified.
-	if _, _, err := net.SplitHostPort(config.Nameserver); err != nil {
-		if strings.Contains(err.Error(), "missing port") {
-			config.Nameserver = net.JoinHostPort(config.Nameserver, "53")
-		} else {
-			return nil, fmt.Errorf("rfc2136: %w", err)
+	nameserver, err := withDefaultPort(config.Nameserver)
+	if err != nil {
+		return nil, fmt.Errorf("rfc2136: %w", err)
+	}
+
+	config.Nameserver = nameserver
+
+	targets := make([]string, 0, len(config.NotifyTargets))
+
+	for _, target := range config.NotifyTargets {
+		target, err = withDefaultPort(target)
+		if err != nil {
+			return nil, fmt.Errorf("rfc2136: invalid notify target: %w", err)
 		}
+
+		targets = append(targets, target)
 	}
 
+	config.NotifyTargets = targets
+
 	if config.TSIGKey == "" || config.TSIGSecret == "" {
 		config.TSIGKey = ""
 		config.TSIGSecret = ""
 	}
 
+	// The zones are in canonical form (lowercase, fqdn) to be compared with the zones of the challenges.
+	keys := make(map[string]TSIGKey, len(config.TSIGKeys))
+
+	for zone, key := range config.TSIGKeys {
+		if key.Name == "" || key.Secret == "" {
+			return nil, fmt.Errorf("rfc2136: incomplete TSIG key for the zone %s", zone)
+		}
+
+		if key.Algorithm == "" {
+			key.Algorithm = config.TSIGAlgorithm
+		}
+
+		keys[strings.ToLower(dns.Fqdn(zone))] = key
+	}
+
+	config.TSIGKeys = keys
+
 	return &DNSProvider{config: config}, nil
 }
 
@@ -177,15 +239,20 @@ func (d *DNSProvider) changeRecord(action, fqdn, value string, ttl int) error {
 	// Setup client
 	c := &dns.Client{Timeout: d.config.DNSTimeout}
 
+	tsigKey, err := d.getTSIGKey(zone)
+	if err != nil {
+		return err
+	}
+
 	// TSIG authentication / msg signing
-	if d.config.TSIGKey != "" && d.config.TSIGSecret != "" {
-		key := strings.ToLower(dns.Fqdn(d.config.TSIGKey))
-		alg := dns.Fqdn(d.config.TSIGAlgorithm)
+	if tsigKey != nil {
+		key := strings.ToLower(dns.Fqdn(tsigKey.Name))
+		alg := dns.Fqdn(tsigKey.Algorithm)
 		m.SetTsig(key, alg, 300, time.Now().Unix())
 
 		// secret(s) for Tsig map[<zonename>]<base64 secret>,
 		// zonename must be in canonical form (lowercase, fqdn, see RFC 4034 Section 6.2)
-		c.TsigSecret = map[string]string{key: d.config.TSIGSecret}
+		c.TsigSecret = map[string]string{key: tsigKey.Secret}
 	}
 
 	// Send the query
@@ -197,5 +264,65 @@ func (d *DNSProvider) changeRecord(action, fqdn, value string, ttl int) error {
 		return fmt.Errorf("DNS update failed: server replied: %s", dns.RcodeToString[reply.Rcode])
 	}
 
+	d.notify(zone, tsigKey)
+
 	return nil
 }
+
+// notify sends a DNS NOTIFY to the secondaries, so they transfer the zone without waiting for the refresh interval.
+// - https://www.rfc-editor.org/rfc/rfc1996.html
+// The failures are not fatal: the secondaries still transfer the zone at the next refresh.
+func (d *DNSProvider) notify(zone string, tsigKey *TSIGKey) {
+	for _, target := range d.config.NotifyTargets {
+		m := new(dns.Msg)
+		m.SetNotify(zone)
+
+		c := &dns.Client{Timeout: d.config.DNSTimeout}
+
+		if tsigKey != nil {
+			key := strings.ToLower(dns.Fqdn(tsigKey.Name))
+			m.SetTsig(key, dns.Fqdn(tsigKey.Algorithm), 300, time.Now().Unix())
+			c.TsigSecret = map[string]string{key: tsigKey.Secret}
+		}
+
+		reply, _, err := c.Exchange(m, target)
+		if err != nil {
+			log.Warnf("rfc2136: DNS NOTIFY to %s failed: %v", target, err)
+			continue
+		}
+
+		if reply != nil && reply.Rcode != dns.RcodeSuccess {
+			log.Warnf("rfc2136: DNS NOTIFY to %s failed: server replied: %s", target, dns.RcodeToString[reply.Rcode])
+		}
+	}
+}
+
+// withDefaultPort appends the default DNS port if none is specified.
+func withDefaultPort(addr string) (string, error) {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		if strings.Contains(err.Error(), "missing port") {
+			return net.JoinHostPort(addr, "53"), nil
+		}
+
+		return "", err
+	}
+
+	return addr, nil
+}
+
+// getTSIGKey returns the TSIG key of the zone, or nil if the updates are not signed.
+func (d *DNSProvider) getTSIGKey(zone string) (*TSIGKey, error) {
+	if key, ok := d.config.TSIGKeys[strings.ToLower(dns.Fqdn(zone))]; ok {
+		return &key, nil
+	}
+
+	if d.config.TSIGKey != "" && d.config.TSIGSecret != "" {
+		return &TSIGKey{Name: d.config.TSIGKey, Secret: d.config.TSIGSecret, Algorithm: d.config.TSIGAlgorithm}, nil
+	}
+
+	if len(d.config.TSIGKeys) > 0 {
+		return nil, fmt.Errorf("no TSIG key for the zone %s", zone)
+	}
+
+	return nil, nil
+}
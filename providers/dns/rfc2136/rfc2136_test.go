@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/tester"
 	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,6 +27,14 @@ const (
 	fakeTsigSecret = "IwBTJx9wrDp4Y1RyC3H0gA=="
 )
 
+var envTest = tester.NewEnvTest(
+	EnvNameserver,
+	EnvTSIGKey,
+	EnvTSIGSecret,
+	EnvTSIGKeys,
+	EnvNotifyTargets,
+)
+
 func TestCanaryLocalTestServer(t *testing.T) {
 	dns01.ClearFqdnCache()
 	dns.HandleFunc("example.com.", serverHandlerHello)
@@ -110,6 +119,268 @@ func TestTsigClient(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTsigClient_zoneKeys(t *testing.T) {
+	dns01.ClearFqdnCache()
+	dns.HandleFunc(fakeZone, serverHandlerReturnSuccess)
+	defer dns.HandleRemove(fakeZone)
+
+	server, addr, err := runLocalDNSTestServer(true)
+	require.NoError(t, err, "Failed to start test server")
+	defer func() { _ = server.Shutdown() }()
+
+	config := NewDefaultConfig()
+	config.Nameserver = addr
+	config.TSIGKey = "other.org."
+	config.TSIGSecret = "b3RoZXI="
+	config.TSIGKeys = map[string]TSIGKey{
+		"EXAMPLE.com": {Name: fakeTsigKey, Secret: fakeTsigSecret},
+		"other.org":   {Name: "other.org.", Secret: "b3RoZXI="},
+	}
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = provider.Present(fakeDomain, "", fakeKeyAuth)
+	require.NoError(t, err)
+}
+
+func TestTsigClient_noZoneKey(t *testing.T) {
+	dns01.ClearFqdnCache()
+	dns.HandleFunc(fakeZone, serverHandlerReturnSuccess)
+	defer dns.HandleRemove(fakeZone)
+
+	server, addr, err := runLocalDNSTestServer(true)
+	require.NoError(t, err, "Failed to start test server")
+	defer func() { _ = server.Shutdown() }()
+
+	config := NewDefaultConfig()
+	config.Nameserver = addr
+	config.TSIGKeys = map[string]TSIGKey{
+		"other.org": {Name: "other.org.", Secret: "b3RoZXI="},
+	}
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = provider.Present(fakeDomain, "", fakeKeyAuth)
+	require.EqualError(t, err, "rfc2136: failed to insert: no TSIG key for the zone example.com.")
+}
+
+func TestDNSProvider_getTSIGKey(t *testing.T) {
+	keys := map[string]TSIGKey{
+		"example.com":     {Name: "key-example", Secret: "c2VjcmV0"},
+		"sub.example.com": {Name: "key-sub", Secret: "c2VjcmV0", Algorithm: dns.HmacSHA256},
+		"example.org.":    {Name: "key-org", Secret: "c2VjcmV0"},
+	}
+
+	testCases := []struct {
+		desc       string
+		keys       map[string]TSIGKey
+		defaultKey string
+		zone       string
+		expected   *TSIGKey
+		err        string
+	}{
+		{
+			desc:     "zone key",
+			keys:     keys,
+			zone:     "example.com.",
+			expected: &TSIGKey{Name: "key-example", Secret: "c2VjcmV0", Algorithm: dns.HmacSHA1},
+		},
+		{
+			desc:     "sub-zone key",
+			keys:     keys,
+			zone:     "sub.example.com.",
+			expected: &TSIGKey{Name: "key-sub", Secret: "c2VjcmV0", Algorithm: dns.HmacSHA256},
+		},
+		{
+			desc:     "case insensitive",
+			keys:     keys,
+			zone:     "Example.ORG.",
+			expected: &TSIGKey{Name: "key-org", Secret: "c2VjcmV0", Algorithm: dns.HmacSHA1},
+		},
+		{
+			desc:       "fallback to the default key",
+			keys:       keys,
+			defaultKey: "key-default",
+			zone:       "example.net.",
+			expected:   &TSIGKey{Name: "key-default", Secret: "c2VjcmV0", Algorithm: dns.HmacSHA1},
+		},
+		{
+			desc:       "default key only",
+			defaultKey: "key-default",
+			zone:       "example.com.",
+			expected:   &TSIGKey{Name: "key-default", Secret: "c2VjcmV0", Algorithm: dns.HmacSHA1},
+		},
+		{
+			desc: "no TSIG",
+			zone: "example.com.",
+		},
+		{
+			desc: "no key for the zone",
+			keys: keys,
+			zone: "example.net.",
+			err:  "no TSIG key for the zone example.net.",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			config := NewDefaultConfig()
+			config.Nameserver = "127.0.0.1"
+			config.TSIGKeys = test.keys
+
+			if test.defaultKey != "" {
+				config.TSIGKey = test.defaultKey
+				config.TSIGSecret = "c2VjcmV0"
+			}
+
+			provider, err := NewDNSProviderConfig(config)
+			require.NoError(t, err)
+
+			key, err := provider.getTSIGKey(test.zone)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, key)
+		})
+	}
+}
+
+func TestNewDNSProviderConfig_incompleteTSIGKey(t *testing.T) {
+	config := NewDefaultConfig()
+	config.Nameserver = "127.0.0.1"
+	config.TSIGKeys = map[string]TSIGKey{
+		"example.com": {Name: "key-example"},
+	}
+
+	_, err := NewDNSProviderConfig(config)
+	require.EqualError(t, err, "rfc2136: incomplete TSIG key for the zone example.com")
+}
+
+func TestNewDNSProvider_tsigKeys(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		keys     string
+		expected map[string]TSIGKey
+		err      string
+	}{
+		{
+			desc: "valid",
+			keys: `{"example.com": {"key": "key-example", "secret": "c2VjcmV0", "algorithm": "hmac-sha256."}}`,
+			expected: map[string]TSIGKey{
+				"example.com.": {Name: "key-example", Secret: "c2VjcmV0", Algorithm: dns.HmacSHA256},
+			},
+		},
+		{
+			desc: "invalid JSON",
+			keys: `{"example.com": {"key": "key-example"`,
+			err:  "rfc2136: unable to parse RFC2136_TSIG_KEYS: unexpected end of JSON input",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			defer envTest.RestoreEnv()
+			envTest.ClearEnv()
+
+			envTest.Apply(map[string]string{
+				EnvNameserver: "127.0.0.1",
+				EnvTSIGKeys:   test.keys,
+			})
+
+			provider, err := NewDNSProvider()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, provider.config.TSIGKeys)
+		})
+	}
+}
+
+func TestServerSuccess_notify(t *testing.T) {
+	dns01.ClearFqdnCache()
+	dns.HandleFunc(fakeZone, serverHandlerReturnSuccess)
+	defer dns.HandleRemove(fakeZone)
+
+	server, addr, err := runLocalDNSTestServer(false)
+	require.NoError(t, err, "Failed to start test server")
+	defer func() { _ = server.Shutdown() }()
+
+	notifyA := make(chan *dns.Msg, 10)
+	targetA := runLocalNotifyTestServer(t, notifyA)
+
+	notifyB := make(chan *dns.Msg, 10)
+	targetB := runLocalNotifyTestServer(t, notifyB)
+
+	config := NewDefaultConfig()
+	config.Nameserver = addr
+	config.NotifyTargets = []string{targetA, targetB}
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = provider.Present(fakeDomain, "", fakeKeyAuth)
+	require.NoError(t, err)
+
+	for _, notifications := range []chan *dns.Msg{notifyA, notifyB} {
+		require.Len(t, notifications, 1)
+
+		msg := <-notifications
+
+		assert.Equal(t, dns.OpcodeNotify, msg.Opcode)
+		assert.True(t, msg.Authoritative)
+		assert.Equal(t, []dns.Question{{Name: fakeZone, Qtype: dns.TypeSOA, Qclass: dns.ClassINET}}, msg.Question)
+	}
+}
+
+func TestServerError_notify(t *testing.T) {
+	dns01.ClearFqdnCache()
+	dns.HandleFunc(fakeZone, serverHandlerReturnErr)
+	defer dns.HandleRemove(fakeZone)
+
+	server, addr, err := runLocalDNSTestServer(false)
+	require.NoError(t, err, "Failed to start test server")
+	defer func() { _ = server.Shutdown() }()
+
+	notifications := make(chan *dns.Msg, 10)
+	target := runLocalNotifyTestServer(t, notifications)
+
+	config := NewDefaultConfig()
+	config.Nameserver = addr
+	config.NotifyTargets = []string{target}
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = provider.Present(fakeDomain, "", fakeKeyAuth)
+	require.Error(t, err)
+
+	// No notification when the update fails.
+	assert.Empty(t, notifications)
+}
+
+func TestNewDNSProvider_notifyTargets(t *testing.T) {
+	defer envTest.RestoreEnv()
+	envTest.ClearEnv()
+
+	envTest.Apply(map[string]string{
+		EnvNameserver:    "127.0.0.1",
+		EnvNotifyTargets: "192.0.2.1, 192.0.2.2:5353,",
+	})
+
+	provider, err := NewDNSProvider()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"192.0.2.1:53", "192.0.2.2:5353"}, provider.config.NotifyTargets)
+}
+
 func TestValidUpdatePacket(t *testing.T) {
 	reqChan := make(chan *dns.Msg, 10)
 
@@ -190,6 +461,44 @@ func runLocalDNSTestServer(tsig bool) (*dns.Server, string, error) {
 	return server, pc.LocalAddr().String(), nil
 }
 
+// runLocalNotifyTestServer runs a secondary which records the DNS NOTIFY messages.
+func runLocalNotifyTestServer(t *testing.T, notifications chan<- *dns.Msg) string {
+	t.Helper()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &dns.Server{
+		PacketConn: pc,
+		MsgAcceptFunc: func(dh dns.Header) dns.MsgAcceptAction {
+			// bypass defaultMsgAcceptFunc to allow NOTIFY.
+			return dns.MsgAccept
+		},
+		Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+			notifications <- req
+
+			m := new(dns.Msg)
+			m.SetReply(req)
+			_ = w.WriteMsg(m)
+		}),
+	}
+
+	waitLock := sync.Mutex{}
+	waitLock.Lock()
+	server.NotifyStartedFunc = waitLock.Unlock
+
+	go func() {
+		_ = server.ActivateAndServe()
+		pc.Close()
+	}()
+
+	waitLock.Lock()
+
+	t.Cleanup(func() { _ = server.Shutdown() })
+
+	return pc.LocalAddr().String()
+}
+
 func serverHandlerHello(w dns.ResponseWriter, req *dns.Msg) {
 	m := new(dns.Msg)
 	m.SetReply(req)
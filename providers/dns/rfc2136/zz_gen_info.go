@@ -0,0 +1,34 @@
+package rfc2136
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/rfc2136/rfc2136.toml
+		Name:  "RFC2136",
+		Code:  "rfc2136",
+		Since: "v0.3.0",
+		URL:   "https://www.rfc-editor.org/rfc/rfc2136.html",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "RFC2136_NAMESERVER", Description: "Network address in the form \"host\" or \"host:port\"", Credential: true},
+			{Name: "RFC2136_TSIG_ALGORITHM", Description: "TSIG algorithm. See [miekg/dns#tsig.go](https://github.com/miekg/dns/blob/master/tsig.go) for supported values. To disable TSIG authentication, leave the `RFC2136_TSIG*` variables unset.", Credential: true},
+			{Name: "RFC2136_TSIG_KEY", Description: "Name of the secret key as defined in DNS server configuration. To disable TSIG authentication, leave the `RFC2136_TSIG*` variables unset.", Credential: true},
+			{Name: "RFC2136_TSIG_KEYS", Description: "TSIG keys by zone apex (JSON), they take precedence over `RFC2136_TSIG_KEY`. Ex: `{\"example.com\": {\"key\": \"name\", \"secret\": \"payload\", \"algorithm\": \"hmac-sha256.\"}}`", Credential: true},
+			{Name: "RFC2136_TSIG_SECRET", Description: "Secret key payload. To disable TSIG authentication, leave the` RFC2136_TSIG*` variables unset.", Credential: true},
+			{Name: "RFC2136_DNS_TIMEOUT", Description: "API request timeout"},
+			{Name: "RFC2136_NOTIFY_TARGETS", Description: "Secondaries to notify (DNS NOTIFY) after each update, comma-separated network addresses in the form \"host\" or \"host:port\" (hidden primary)"},
+			{Name: "RFC2136_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "RFC2136_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "RFC2136_SEQUENCE_INTERVAL", Description: "Time between sequential requests"},
+			{Name: "RFC2136_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.rfc-editor.org/rfc/rfc2136.html",
+			GoClient: "",
+		},
+	}
+}
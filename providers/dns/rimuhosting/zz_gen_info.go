@@ -0,0 +1,28 @@
+package rimuhosting
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/rimuhosting/rimuhosting.toml
+		Name:  "RimuHosting",
+		Code:  "rimuhosting",
+		Since: "v0.3.5",
+		URL:   "https://rimuhosting.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "RIMUHOSTING_API_KEY", Description: "User API key", Credential: true},
+			{Name: "RIMUHOSTING_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "RIMUHOSTING_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "RIMUHOSTING_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "RIMUHOSTING_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://rimuhosting.com/dns/dyndns.jsp",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package route53
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/route53/route53.toml
+		Name:  "Amazon Route 53",
+		Code:  "route53",
+		Since: "v0.3.0",
+		URL:   "https://aws.amazon.com/route53/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "AWS_ACCESS_KEY_ID", Description: "Managed by the AWS client. Access key ID (`AWS_ACCESS_KEY_ID_FILE` is not supported, use `AWS_SHARED_CREDENTIALS_FILE` instead)", Credential: true},
+			{Name: "AWS_ASSUME_ROLE_ARN", Description: "Managed by the AWS Role ARN (`AWS_ASSUME_ROLE_ARN_FILE` is not supported)", Credential: true},
+			{Name: "AWS_EXTERNAL_ID", Description: "Managed by STS AssumeRole API operation (`AWS_EXTERNAL_ID_FILE` is not supported)", Credential: true},
+			{Name: "AWS_HOSTED_ZONE_ID", Description: "Override the hosted zone ID.", Credential: true},
+			{Name: "AWS_PROFILE", Description: "Managed by the AWS client (`AWS_PROFILE_FILE` is not supported)", Credential: true},
+			{Name: "AWS_REGION", Description: "Managed by the AWS client (`AWS_REGION_FILE` is not supported)", Credential: true},
+			{Name: "AWS_SDK_LOAD_CONFIG", Description: "Managed by the AWS client. Retrieve the region from the CLI config file (`AWS_SDK_LOAD_CONFIG_FILE` is not supported)", Credential: true},
+			{Name: "AWS_SECRET_ACCESS_KEY", Description: "Managed by the AWS client. Secret access key (`AWS_SECRET_ACCESS_KEY_FILE` is not supported, use `AWS_SHARED_CREDENTIALS_FILE` instead)", Credential: true},
+			{Name: "AWS_WAIT_FOR_RECORD_SETS_CHANGED", Description: "Wait for changes to be INSYNC (it can be unstable)", Credential: true},
+			{Name: "AWS_MAX_RETRIES", Description: "The number of maximum returns the service will use to make an individual API request"},
+			{Name: "AWS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "AWS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "AWS_SHARED_CREDENTIALS_FILE", Description: "Managed by the AWS client. Shared credentials file."},
+			{Name: "AWS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://docs.aws.amazon.com/Route53/latest/APIReference/API_Operations_Amazon_Route_53.html",
+			GoClient: "https://github.com/aws/aws-sdk-go-v2",
+		},
+	}
+}
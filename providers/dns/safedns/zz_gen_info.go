@@ -0,0 +1,28 @@
+package safedns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/safedns/safedns.toml
+		Name:  "UKFast SafeDNS",
+		Code:  "safedns",
+		Since: "v4.6.0",
+		URL:   "https://www.ukfast.co.uk/dns-hosting.html",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "SAFEDNS_AUTH_TOKEN", Description: "Authentication token", Credential: true},
+			{Name: "SAFEDNS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "SAFEDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "SAFEDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "SAFEDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developers.ukfast.io/documentation/safedns",
+			GoClient: "",
+		},
+	}
+}
@@ -21,6 +21,8 @@ const (
 	EnvAccessToken       = envNamespace + "ACCESS_TOKEN"
 	EnvAccessTokenSecret = envNamespace + "ACCESS_TOKEN_SECRET"
 
+	EnvZone = envNamespace + "ZONE"
+
 	EnvTTL                = envNamespace + "TTL"
 	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
 	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
@@ -29,8 +31,12 @@ const (
 
 // Config is used to configure the creation of the DNSProvider.
 type Config struct {
-	Token              string
-	Secret             string
+	Token  string
+	Secret string
+
+	// Zone pins the zone containing the records, instead of looking for the most specific zone of the account.
+	Zone string
+
 	PropagationTimeout time.Duration
 	PollingInterval    time.Duration
 	TTL                int
@@ -67,6 +73,7 @@ func NewDNSProvider() (*DNSProvider, error) {
 	config := NewDefaultConfig()
 	config.Token = values[EnvAccessToken]
 	config.Secret = values[EnvAccessTokenSecret]
+	config.Zone = env.GetOrFile(EnvZone)
 
 	return NewDNSProviderConfig(config)
 }
@@ -3,6 +3,7 @@ package sakuracloud
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
@@ -23,7 +24,7 @@ func (d *DNSProvider) addTXTRecord(fqdn, value string, ttl int) error {
 		return err
 	}
 
-	subDomain, err := dns01.ExtractSubDomain(fqdn, zone.Name)
+	subDomain, err := extractRecordName(fqdn, zone.Name)
 	if err != nil {
 		return err
 	}
@@ -55,7 +56,7 @@ func (d *DNSProvider) cleanupTXTRecord(fqdn, value string) error {
 		return err
 	}
 
-	subDomain, err := dns01.ExtractSubDomain(fqdn, zone.Name)
+	subDomain, err := extractRecordName(fqdn, zone.Name)
 	if err != nil {
 		return err
 	}
@@ -79,14 +80,44 @@ func (d *DNSProvider) cleanupTXTRecord(fqdn, value string) error {
 	return nil
 }
 
-func (d *DNSProvider) getHostedZone(domain string) (*iaas.DNS, error) {
-	authZone, err := dns01.FindZoneByFqdn(domain)
-	if err != nil {
-		return nil, fmt.Errorf("could not find zone: %w", err)
+// getHostedZone returns the zone containing the FQDN:
+// the zone defined by the configuration, or the most specific zone of the account,
+// to support the accounts managing only a delegated subzone of a domain.
+func (d *DNSProvider) getHostedZone(fqdn string) (*iaas.DNS, error) {
+	if d.config.Zone != "" {
+		zoneName := dns01.UnFqdn(d.config.Zone)
+
+		zone, err := d.findZone(zoneName)
+		if err != nil {
+			return nil, err
+		}
+
+		if zone == nil {
+			return nil, fmt.Errorf("zone %s not found", zoneName)
+		}
+
+		return zone, nil
+	}
+
+	// Tries the progressively shorter suffixes of the FQDN, from the FQDN itself to the TLD.
+	for name := dns01.UnFqdn(fqdn); name != ""; {
+		zone, err := d.findZone(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if zone != nil {
+			return zone, nil
+		}
+
+		_, name, _ = strings.Cut(name, ".")
 	}
 
-	zoneName := dns01.UnFqdn(authZone)
+	return nil, fmt.Errorf("no zone found for %s", fqdn)
+}
 
+// findZone returns the zone matching the name, or nil if the zone doesn't exist.
+func (d *DNSProvider) findZone(zoneName string) (*iaas.DNS, error) {
 	conditions := &iaas.FindCondition{
 		Filter: search.Filter{
 			search.Key("Name"): search.ExactMatch(zoneName),
@@ -96,17 +127,26 @@ func (d *DNSProvider) getHostedZone(domain string) (*iaas.DNS, error) {
 	res, err := d.client.Find(context.Background(), conditions)
 	if err != nil {
 		if iaas.IsNotFoundError(err) {
-			return nil, fmt.Errorf("zone %s not found on SakuraCloud DNS: %w", zoneName, err)
+			return nil, nil
 		}
 
 		return nil, fmt.Errorf("API call failed: %w", err)
 	}
 
 	for _, zone := range res.DNS {
-		if zone.Name == zoneName {
+		if strings.EqualFold(zone.Name, zoneName) {
 			return zone, nil
 		}
 	}
 
-	return nil, fmt.Errorf("zone %s not found", zoneName)
+	return nil, nil
+}
+
+// extractRecordName returns the name of the record relative to the zone ("@" for the apex of the zone).
+func extractRecordName(fqdn, zoneName string) (string, error) {
+	if strings.EqualFold(dns01.UnFqdn(fqdn), zoneName) {
+		return "@", nil
+	}
+
+	return dns01.ExtractSubDomain(fqdn, zoneName)
 }
@@ -9,15 +9,20 @@ import (
 	client "github.com/sacloud/api-client-go"
 	"github.com/sacloud/iaas-api-go"
 	"github.com/sacloud/iaas-api-go/helper/api"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func setupTest(t *testing.T) {
+func setupTest(t *testing.T, zoneNames ...string) {
 	t.Helper()
 
 	t.Setenv("SAKURACLOUD_FAKE_MODE", "1")
 
-	createDummyZone(t, fakeCaller())
+	if len(zoneNames) == 0 {
+		zoneNames = []string{"example.com"}
+	}
+
+	createDummyZones(t, fakeCaller(), zoneNames...)
 }
 
 func fakeCaller() iaas.APICaller {
@@ -30,7 +35,7 @@ func fakeCaller() iaas.APICaller {
 	})
 }
 
-func createDummyZone(t *testing.T, caller iaas.APICaller) {
+func createDummyZones(t *testing.T, caller iaas.APICaller, zoneNames ...string) {
 	t.Helper()
 
 	ctx := context.Background()
@@ -42,16 +47,15 @@ func createDummyZone(t *testing.T, caller iaas.APICaller) {
 	require.NoError(t, err)
 
 	for _, zone := range zones.DNS {
-		if zone.Name == "example.com" {
-			err = dnsOp.Delete(ctx, zone.ID)
-			require.NoError(t, err)
-			break
-		}
+		err = dnsOp.Delete(ctx, zone.ID)
+		require.NoError(t, err)
 	}
 
-	// create dummy zone
-	_, err = iaas.NewDNSOp(caller).Create(context.Background(), &iaas.DNSCreateRequest{Name: "example.com"})
-	require.NoError(t, err)
+	// create dummy zones
+	for _, zoneName := range zoneNames {
+		_, err = dnsOp.Create(ctx, &iaas.DNSCreateRequest{Name: zoneName})
+		require.NoError(t, err)
+	}
 }
 
 func TestDNSProvider_addAndCleanupRecords(t *testing.T) {
@@ -87,6 +91,139 @@ func TestDNSProvider_addAndCleanupRecords(t *testing.T) {
 	})
 }
 
+func TestDNSProvider_getHostedZone(t *testing.T) {
+	setupTest(t, "example.com", "sub.example.com", "_acme-challenge.bar.example.com")
+
+	testCases := []struct {
+		desc     string
+		zone     string
+		fqdn     string
+		expected string
+	}{
+		{
+			desc:     "root zone",
+			fqdn:     "_acme-challenge.foo.example.com.",
+			expected: "example.com",
+		},
+		{
+			desc:     "delegated subzone",
+			fqdn:     "_acme-challenge.foo.sub.example.com.",
+			expected: "sub.example.com",
+		},
+		{
+			desc:     "zone of the record",
+			fqdn:     "_acme-challenge.bar.example.com.",
+			expected: "_acme-challenge.bar.example.com",
+		},
+		{
+			desc:     "pinned zone",
+			zone:     "example.com",
+			fqdn:     "_acme-challenge.foo.sub.example.com.",
+			expected: "example.com",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			config := NewDefaultConfig()
+			config.Token = "token"
+			config.Secret = "secret"
+			config.Zone = test.zone
+
+			p, err := NewDNSProviderConfig(config)
+			require.NoError(t, err)
+
+			zone, err := p.getHostedZone(test.fqdn)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, zone.Name)
+		})
+	}
+}
+
+func TestDNSProvider_getHostedZone_notFound(t *testing.T) {
+	setupTest(t, "example.com")
+
+	testCases := []struct {
+		desc     string
+		zone     string
+		expected string
+	}{
+		{
+			desc:     "no zone",
+			expected: "no zone found for _acme-challenge.example.org.",
+		},
+		{
+			desc:     "unknown pinned zone",
+			zone:     "example.org",
+			expected: "zone example.org not found",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			config := NewDefaultConfig()
+			config.Token = "token"
+			config.Secret = "secret"
+			config.Zone = test.zone
+
+			p, err := NewDNSProviderConfig(config)
+			require.NoError(t, err)
+
+			_, err = p.getHostedZone("_acme-challenge.example.org.")
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func TestDNSProvider_addAndCleanupRecords_subzone(t *testing.T) {
+	setupTest(t, "example.com", "sub.example.com", "_acme-challenge.bar.example.com")
+
+	config := NewDefaultConfig()
+	config.Token = "token"
+	config.Secret = "secret"
+
+	p, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	err = p.addTXTRecord("_acme-challenge.foo.sub.example.com.", "valueA", 10)
+	require.NoError(t, err)
+
+	err = p.addTXTRecord("_acme-challenge.bar.example.com.", "valueB", 10)
+	require.NoError(t, err)
+
+	zone, err := p.getHostedZone("sub.example.com.")
+	require.NoError(t, err)
+
+	require.Len(t, zone.Records, 1)
+	assert.Equal(t, "_acme-challenge.foo", zone.Records[0].Name)
+
+	zone, err = p.getHostedZone("_acme-challenge.bar.example.com.")
+	require.NoError(t, err)
+
+	require.Len(t, zone.Records, 1)
+	assert.Equal(t, "@", zone.Records[0].Name)
+
+	zone, err = p.getHostedZone("example.com.")
+	require.NoError(t, err)
+
+	assert.Empty(t, zone.Records)
+
+	err = p.cleanupTXTRecord("_acme-challenge.foo.sub.example.com.", "valueA")
+	require.NoError(t, err)
+
+	zone, err = p.getHostedZone("sub.example.com.")
+	require.NoError(t, err)
+
+	assert.Empty(t, zone.Records)
+
+	// The other records are kept.
+	zone, err = p.getHostedZone("_acme-challenge.bar.example.com.")
+	require.NoError(t, err)
+
+	assert.Len(t, zone.Records, 1)
+}
+
 func TestDNSProvider_concurrentAddAndCleanupRecords(t *testing.T) {
 	setupTest(t)
 
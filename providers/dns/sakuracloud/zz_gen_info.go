@@ -0,0 +1,30 @@
+package sakuracloud
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/sakuracloud/sakuracloud.toml
+		Name:  "Sakura Cloud",
+		Code:  "sakuracloud",
+		Since: "v1.1.0",
+		URL:   "https://cloud.sakura.ad.jp/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "SAKURACLOUD_ACCESS_TOKEN", Description: "Access token", Credential: true},
+			{Name: "SAKURACLOUD_ACCESS_TOKEN_SECRET", Description: "Access token secret", Credential: true},
+			{Name: "SAKURACLOUD_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "SAKURACLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "SAKURACLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "SAKURACLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+			{Name: "SAKURACLOUD_ZONE", Description: "The zone containing the records (by default, the most specific zone of the account containing the domain)"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developer.sakura.ad.jp/cloud/api/1.1/",
+			GoClient: "https://github.com/sacloud/iaas-api-go",
+		},
+	}
+}
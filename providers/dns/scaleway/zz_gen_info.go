@@ -0,0 +1,29 @@
+package scaleway
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/scaleway/scaleway.toml
+		Name:  "Scaleway",
+		Code:  "scaleway",
+		Since: "v3.4.0",
+		URL:   "https://developers.scaleway.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "SCW_PROJECT_ID", Description: "Project to use (optional)", Credential: true},
+			{Name: "SCW_SECRET_KEY", Description: "Secret key", Credential: true},
+			{Name: "SCW_ACCESS_KEY", Description: "Access key"},
+			{Name: "SCW_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "SCW_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "SCW_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developers.scaleway.com/en/products/domain/dns/api/",
+			GoClient: "",
+		},
+	}
+}
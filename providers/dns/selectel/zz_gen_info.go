@@ -0,0 +1,29 @@
+package selectel
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/selectel/selectel.toml
+		Name:  "Selectel",
+		Code:  "selectel",
+		Since: "v1.2.0",
+		URL:   "https://kb.selectel.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "SELECTEL_API_TOKEN", Description: "API token", Credential: true},
+			{Name: "SELECTEL_BASE_URL", Description: "API endpoint URL"},
+			{Name: "SELECTEL_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "SELECTEL_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "SELECTEL_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "SELECTEL_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://kb.selectel.com/23136054.html",
+			GoClient: "",
+		},
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/config/env"
 	"github.com/go-acme/lego/v4/providers/dns/internal/selectel"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 	selectelapi "github.com/selectel/domains-go/pkg/v2"
 	"github.com/selectel/go-selvpcclient/v3/selvpcclient"
 )
@@ -113,7 +114,7 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 	}
 
 	headers := http.Header{}
-	headers.Set("User-Agent", "lego/selectelv2")
+	useragent.SetHeader(headers)
 
 	return &DNSProvider{
 		baseClient: selectelapi.NewClient(defaultBaseURL, config.HTTPClient, headers),
@@ -0,0 +1,32 @@
+package selectelv2
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/selectelv2/selectelv2.toml
+		Name:  "Selectel v2",
+		Code:  "selectelv2",
+		Since: "v4.17.0",
+		URL:   "https://selectel.ru",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "SELECTELV2_ACCOUNT_ID", Description: "Selectel account ID (INT)", Credential: true},
+			{Name: "SELECTELV2_PASSWORD", Description: "Openstack username's password", Credential: true},
+			{Name: "SELECTELV2_PROJECT_ID", Description: "Cloud project ID (UUID)", Credential: true},
+			{Name: "SELECTELV2_USERNAME", Description: "Openstack username", Credential: true},
+			{Name: "SELECTELV2_BASE_URL", Description: "API endpoint URL"},
+			{Name: "SELECTELV2_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "SELECTELV2_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "SELECTELV2_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "SELECTELV2_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developers.selectel.ru/docs/cloud-services/dns_api/dns_api_actual/",
+			GoClient: "https://github.com/selectel/domains-go",
+		},
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 const baseAPIURL = "https://api.servercow.de/dns/v1/domains"
@@ -21,7 +22,7 @@ type Client struct {
 	username string
 	password string
 
-	baseURL    *url.URL
+	BaseURL    *url.URL
 	HTTPClient *http.Client
 }
 
@@ -32,14 +33,14 @@ func NewClient(username, password string) *Client {
 	return &Client{
 		username:   username,
 		password:   password,
-		baseURL:    baseURL,
+		BaseURL:    baseURL,
 		HTTPClient: &http.Client{Timeout: 5 * time.Second},
 	}
 }
 
 // GetRecords from API.
 func (c *Client) GetRecords(ctx context.Context, domain string) ([]Record, error) {
-	endpoint := c.baseURL.JoinPath(domain)
+	endpoint := c.BaseURL.JoinPath(domain)
 
 	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
@@ -57,7 +58,7 @@ func (c *Client) GetRecords(ctx context.Context, domain string) ([]Record, error
 
 // CreateUpdateRecord creates or updates a record.
 func (c *Client) CreateUpdateRecord(ctx context.Context, domain string, data Record) (*Message, error) {
-	endpoint := c.baseURL.JoinPath(domain)
+	endpoint := c.BaseURL.JoinPath(domain)
 
 	req, err := newJSONRequest(ctx, http.MethodPost, endpoint, data)
 	if err != nil {
@@ -79,7 +80,7 @@ func (c *Client) CreateUpdateRecord(ctx context.Context, domain string, data Rec
 
 // DeleteRecord deletes a record.
 func (c *Client) DeleteRecord(ctx context.Context, domain string, data Record) (*Message, error) {
-	endpoint := c.baseURL.JoinPath(domain)
+	endpoint := c.BaseURL.JoinPath(domain)
 
 	req, err := newJSONRequest(ctx, http.MethodDelete, endpoint, data)
 	if err != nil {
@@ -147,6 +148,8 @@ func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, paylo
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 
 	// Content-Type should be added even if there is no request body.
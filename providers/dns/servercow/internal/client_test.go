@@ -23,7 +23,7 @@ func setupTest(t *testing.T) (*Client, *http.ServeMux) {
 
 	client := NewClient("", "")
 	client.HTTPClient = server.Client()
-	client.baseURL, _ = url.Parse(server.URL)
+	client.BaseURL, _ = url.Parse(server.URL)
 
 	return client, mux
 }
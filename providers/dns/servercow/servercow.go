@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"net/http"
 	"slices"
+	"sync"
 	"time"
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/config/env"
 	"github.com/go-acme/lego/v4/providers/dns/servercow/internal"
+	"golang.org/x/net/publicsuffix"
 )
 
 // Environment variables names.
@@ -54,6 +56,10 @@ func NewDefaultConfig() *Config {
 type DNSProvider struct {
 	config *Config
 	client *internal.Client
+
+	// The API replaces the whole record set of a name:
+	// the updates are serialized to not lose the values of the other challenges.
+	recordsMu sync.Mutex
 }
 
 // NewDNSProvider returns a DNSProvider instance.
@@ -72,13 +78,17 @@ func NewDNSProvider() (*DNSProvider, error) {
 
 // NewDNSProviderConfig return a DNSProvider instance configured for Servercow.
 func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("servercow: the configuration of the DNS provider is nil")
+	}
+
 	if config.Username == "" || config.Password == "" {
 		return nil, errors.New("servercow: incomplete credentials, missing username and/or password")
 	}
 
 	client := internal.NewClient(config.Username, config.Password)
 
-	if config.HTTPClient == nil {
+	if config.HTTPClient != nil {
 		client.HTTPClient = config.HTTPClient
 	}
 
@@ -105,6 +115,9 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 
 	ctx := context.Background()
 
+	d.recordsMu.Lock()
+	defer d.recordsMu.Unlock()
+
 	records, err := d.client.GetRecords(ctx, authZone)
 	if err != nil {
 		return fmt.Errorf("servercow: %w", err)
@@ -127,7 +140,7 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 			Name:    record.Name,
 			TTL:     record.TTL,
 			Type:    record.Type,
-			Content: append(record.Content, info.Value),
+			Content: append(slices.Clone(record.Content), info.Value),
 		}
 
 		_, err = d.client.CreateUpdateRecord(ctx, authZone, request)
@@ -163,6 +176,9 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 
 	ctx := context.Background()
 
+	d.recordsMu.Lock()
+	defer d.recordsMu.Unlock()
+
 	records, err := d.client.GetRecords(ctx, authZone)
 	if err != nil {
 		return fmt.Errorf("servercow: failed to get TXT records: %w", err)
@@ -211,13 +227,13 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	return nil
 }
 
-func getAuthZone(domain string) (string, error) {
-	authZone, err := dns01.FindZoneByFqdn(domain)
+// getAuthZone returns the registrable domain of the FQDN: the API only manages the records of the registered domains.
+func getAuthZone(fqdn string) (string, error) {
+	zoneName, err := publicsuffix.EffectiveTLDPlusOne(dns01.UnFqdn(fqdn))
 	if err != nil {
 		return "", fmt.Errorf("could not find zone: %w", err)
 	}
 
-	zoneName := dns01.UnFqdn(authZone)
 	return zoneName, nil
 }
 
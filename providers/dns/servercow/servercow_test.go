@@ -1,10 +1,16 @@
 package servercow
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/providers/dns/servercow/internal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -123,6 +129,123 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+// setupTest creates a provider using a fake API managing the records of example.com:
+// a POST replaces the record set matching the name and the type.
+func setupTest(t *testing.T, records []internal.Record) (*DNSProvider, *[]internal.Record) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/example.com", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("X-Auth-Username") != "user" || req.Header.Get("X-Auth-Password") != "secret" {
+			_, _ = rw.Write([]byte(`{"error":"authentication failed"}`))
+			return
+		}
+
+		if req.Method == http.MethodGet {
+			_ = json.NewEncoder(rw).Encode(records)
+			return
+		}
+
+		record := internal.Record{}
+		err := json.NewDecoder(req.Body).Decode(&record)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var kept []internal.Record
+		for _, r := range records {
+			if r.Name != record.Name || r.Type != record.Type {
+				kept = append(kept, r)
+			}
+		}
+
+		switch req.Method {
+		case http.MethodPost:
+			records = append(kept, record)
+			_, _ = rw.Write([]byte(`{"message":"ok"}`))
+
+		case http.MethodDelete:
+			records = kept
+			_, _ = rw.Write([]byte(`{"message":"ok"}`))
+
+		default:
+			http.Error(rw, "invalid method: "+req.Method, http.StatusMethodNotAllowed)
+		}
+	})
+
+	config := NewDefaultConfig()
+	config.Username = "user"
+	config.Password = "secret"
+	config.HTTPClient = server.Client()
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.client.BaseURL, _ = url.Parse(server.URL)
+
+	return provider, &records
+}
+
+func TestDNSProvider_Present_CleanUp(t *testing.T) {
+	provider, records := setupTest(t, []internal.Record{
+		{Name: "www", Type: "A", TTL: 120, Content: internal.Value{"1.1.1.1"}},
+	})
+
+	err := provider.Present("foo.sub.example.com", "tokenA", "keyAuthA")
+	require.NoError(t, err)
+
+	expected := []internal.Record{
+		{Name: "www", Type: "A", TTL: 120, Content: internal.Value{"1.1.1.1"}},
+		{Name: "_acme-challenge.foo.sub", Type: "TXT", TTL: 120, Content: internal.Value{"Yn5FYIdZpKGY0Gl17AM7lOlQID5C4_II6Blwe54e4rk"}},
+	}
+
+	assert.Equal(t, expected, *records)
+
+	err = provider.CleanUp("foo.sub.example.com", "tokenA", "keyAuthA")
+	require.NoError(t, err)
+
+	expected = []internal.Record{
+		{Name: "www", Type: "A", TTL: 120, Content: internal.Value{"1.1.1.1"}},
+	}
+
+	assert.Equal(t, expected, *records)
+}
+
+func TestDNSProvider_Present_CleanUp_existingValues(t *testing.T) {
+	provider, records := setupTest(t, []internal.Record{
+		{Name: "_acme-challenge", Type: "TXT", TTL: 20, Content: internal.Value{"foo"}},
+	})
+
+	err := provider.Present("example.com", "tokenA", "keyAuthA")
+	require.NoError(t, err)
+
+	// The existing value and TTL are kept.
+	expected := []internal.Record{
+		{Name: "_acme-challenge", Type: "TXT", TTL: 20, Content: internal.Value{"foo", "Yn5FYIdZpKGY0Gl17AM7lOlQID5C4_II6Blwe54e4rk"}},
+	}
+
+	assert.Equal(t, expected, *records)
+
+	// Presenting the same value twice doesn't duplicate it.
+	err = provider.Present("example.com", "tokenA", "keyAuthA")
+	require.NoError(t, err)
+
+	assert.Equal(t, expected, *records)
+
+	err = provider.CleanUp("example.com", "tokenA", "keyAuthA")
+	require.NoError(t, err)
+
+	expected = []internal.Record{
+		{Name: "_acme-challenge", Type: "TXT", TTL: 20, Content: internal.Value{"foo"}},
+	}
+
+	assert.Equal(t, expected, *records)
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
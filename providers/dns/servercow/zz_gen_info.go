@@ -0,0 +1,29 @@
+package servercow
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/servercow/servercow.toml
+		Name:  "Servercow",
+		Code:  "servercow",
+		Since: "v3.4.0",
+		URL:   "https://servercow.de/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "SERVERCOW_PASSWORD", Description: "API password", Credential: true},
+			{Name: "SERVERCOW_USERNAME", Description: "API username", Credential: true},
+			{Name: "SERVERCOW_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "SERVERCOW_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "SERVERCOW_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "SERVERCOW_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://cp.servercow.de/client/plugin/support_manager/knowledgebase/view/34/dns-api-v1/7/",
+			GoClient: "",
+		},
+	}
+}
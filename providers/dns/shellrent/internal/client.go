@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 // DefaultBaseURL the default API endpoint.
@@ -24,7 +25,7 @@ type Client struct {
 	username string
 	token    string
 
-	baseURL    *url.URL
+	BaseURL    *url.URL
 	HTTPClient *http.Client
 }
 
@@ -35,7 +36,7 @@ func NewClient(username string, token string) *Client {
 	return &Client{
 		token:      token,
 		username:   username,
-		baseURL:    baseURL,
+		BaseURL:    baseURL,
 		HTTPClient: &http.Client{Timeout: 10 * time.Second},
 	}
 }
@@ -43,7 +44,7 @@ func NewClient(username string, token string) *Client {
 // ListServices lists service IDs.
 // https://api.shellrent.com/elenco-dei-servizi-acquistati
 func (c Client) ListServices(ctx context.Context) ([]int, error) {
-	endpoint := c.baseURL.JoinPath("purchase")
+	endpoint := c.BaseURL.JoinPath("purchase")
 
 	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
@@ -73,7 +74,7 @@ func (c Client) ListServices(ctx context.Context) ([]int, error) {
 // GetServiceDetails gets service details.
 // https://api.shellrent.com/dettagli-servizio-acquistato
 func (c Client) GetServiceDetails(ctx context.Context, serviceID int) (*ServiceDetails, error) {
-	endpoint := c.baseURL.JoinPath("purchase", "details", strconv.Itoa(serviceID))
+	endpoint := c.BaseURL.JoinPath("purchase", "details", strconv.Itoa(serviceID))
 
 	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
@@ -97,7 +98,7 @@ func (c Client) GetServiceDetails(ctx context.Context, serviceID int) (*ServiceD
 // GetDomainDetails gets domain details.
 // https://api.shellrent.com/dettagli-dominio
 func (c Client) GetDomainDetails(ctx context.Context, domainID int) (*DomainDetails, error) {
-	endpoint := c.baseURL.JoinPath("domain", "details", strconv.Itoa(domainID))
+	endpoint := c.BaseURL.JoinPath("domain", "details", strconv.Itoa(domainID))
 
 	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
@@ -120,7 +121,7 @@ func (c Client) GetDomainDetails(ctx context.Context, domainID int) (*DomainDeta
 // CreateRecord created a record.
 // https://api.shellrent.com/creazione-record-dns-di-un-dominio
 func (c Client) CreateRecord(ctx context.Context, domainID int, record Record) (int, error) {
-	endpoint := c.baseURL.JoinPath("dns_record", "store", strconv.Itoa(domainID))
+	endpoint := c.BaseURL.JoinPath("dns_record", "store", strconv.Itoa(domainID))
 
 	req, err := newJSONRequest(ctx, http.MethodPost, endpoint, record)
 	if err != nil {
@@ -143,7 +144,7 @@ func (c Client) CreateRecord(ctx context.Context, domainID int, record Record) (
 // DeleteRecord deletes a record.
 // https://api.shellrent.com/eliminazione-record-dns-di-un-dominio
 func (c Client) DeleteRecord(ctx context.Context, domainID int, recordID int) error {
-	endpoint := c.baseURL.JoinPath("dns_record", "remove", strconv.Itoa(domainID), strconv.Itoa(recordID))
+	endpoint := c.BaseURL.JoinPath("dns_record", "remove", strconv.Itoa(domainID), strconv.Itoa(recordID))
 
 	req, err := newJSONRequest(ctx, http.MethodDelete, endpoint, nil)
 	if err != nil {
@@ -206,6 +207,8 @@ func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, paylo
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 
 	if payload != nil {
@@ -120,9 +120,7 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 
 	zone, err := d.findZone(ctx, dns01.UnFqdn(info.EffectiveFQDN))
 	if err != nil {
-		if err != nil {
-			return fmt.Errorf("shellrent: could not find zone for domain %q: %w", domain, err)
-		}
+		return fmt.Errorf("shellrent: could not find zone for domain %q: %w", domain, err)
 	}
 
 	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, zone.DomainName)
@@ -167,41 +165,52 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 		return fmt.Errorf("shellrent: delete record: %w", err)
 	}
 
+	d.recordIDsMu.Lock()
+	delete(d.recordIDs, token)
+	d.recordIDsMu.Unlock()
+
 	return nil
 }
 
+// findZone finds the domain of the services (the longest match) containing the domain.
+// The services without a domain (hosting, servers, etc.) are ignored.
 func (d *DNSProvider) findZone(ctx context.Context, domain string) (*internal.DomainDetails, error) {
 	services, err := d.client.ListServices(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("list services: %w", err)
 	}
 
+	var zone *internal.DomainDetails
+
 	for _, service := range services {
 		details, err := d.client.GetServiceDetails(ctx, service)
 		if err != nil {
 			return nil, fmt.Errorf("get service details: %w", err)
 		}
 
+		if details.DomainID == 0 {
+			continue
+		}
+
 		domainDetails, err := d.client.GetDomainDetails(ctx, details.DomainID)
 		if err != nil {
 			return nil, fmt.Errorf("get domain details: %w", err)
 		}
 
-		domain := domain
-
-		for {
-			i := strings.Index(domain, ".")
-			if i == -1 {
-				break
-			}
+		name := dns01.UnFqdn(domainDetails.DomainName)
 
-			if strings.EqualFold(domainDetails.DomainName, domain) {
-				return domainDetails, nil
-			}
+		if !strings.EqualFold(domain, name) && !strings.HasSuffix(strings.ToLower(domain), "."+strings.ToLower(name)) {
+			continue
+		}
 
-			domain = domain[i+1:]
+		if zone == nil || len(name) > len(zone.DomainName) {
+			zone = domainDetails
 		}
 	}
 
-	return nil, errors.New("zone not found")
+	if zone == nil {
+		return nil, errors.New("zone not found")
+	}
+
+	return zone, nil
 }
@@ -1,10 +1,18 @@
 package shellrent
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/providers/dns/shellrent/internal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -111,6 +119,125 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func setupTest(t *testing.T) (*DNSProvider, *[]string) {
+	t.Helper()
+
+	var calls []string
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	handle := func(pattern, body string) {
+		mux.HandleFunc(pattern, func(rw http.ResponseWriter, req *http.Request) {
+			if req.Header.Get("Authorization") != "user.secret" {
+				http.Error(rw, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+
+			calls = append(calls, req.Method+" "+req.URL.Path)
+
+			_, _ = fmt.Fprint(rw, body)
+		})
+	}
+
+	// 1: a hosting service (no domain), 2: example.com, 3: sub.example.com.
+	handle("GET /purchase", `{"error":0,"message":"","data":[1,"2",3]}`)
+	handle("GET /purchase/details/1", `{"error":0,"message":"","data":{"id":1,"name":"hosting"}}`)
+	handle("GET /purchase/details/2", `{"error":0,"message":"","data":{"id":2,"name":"example.com","domain_id":20}}`)
+	handle("GET /purchase/details/3", `{"error":0,"message":"","data":{"id":3,"name":"sub.example.com","domain_id":30}}`)
+	handle("GET /domain/details/20", `{"error":0,"message":"","data":{"id":20,"domain_name":"example.com","domain_name_ascii":"example.com"}}`)
+	handle("GET /domain/details/30", `{"error":0,"message":"","data":{"id":30,"domain_name":"sub.example.com","domain_name_ascii":"sub.example.com"}}`)
+	handle("DELETE /dns_record/remove/30/456", `{"error":0,"message":""}`)
+
+	mux.HandleFunc("POST /dns_record/store/30", func(rw http.ResponseWriter, req *http.Request) {
+		calls = append(calls, req.Method+" "+req.URL.Path)
+
+		var record internal.Record
+		err := json.NewDecoder(req.Body).Decode(&record)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		expected := internal.Record{
+			Type:        "TXT",
+			Host:        "_acme-challenge.www",
+			TTL:         3600,
+			Destination: dns01.GetChallengeInfo("www.sub.example.com", "keyAuth").Value,
+		}
+
+		if record != expected {
+			http.Error(rw, fmt.Sprintf("unexpected record: %+v", record), http.StatusBadRequest)
+			return
+		}
+
+		_, _ = fmt.Fprint(rw, `{"error":0,"message":"","data":{"id":"456"}}`)
+	})
+
+	config := NewDefaultConfig()
+	config.Username = "user"
+	config.Token = "secret"
+	config.HTTPClient = server.Client()
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.client.BaseURL, _ = url.Parse(server.URL)
+
+	return provider, &calls
+}
+
+func TestDNSProvider_Present(t *testing.T) {
+	provider, calls := setupTest(t)
+
+	err := provider.Present("www.sub.example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	expected := []string{
+		"GET /purchase",
+		"GET /purchase/details/1",
+		"GET /purchase/details/2",
+		"GET /domain/details/20",
+		"GET /purchase/details/3",
+		"GET /domain/details/30",
+		"POST /dns_record/store/30",
+	}
+
+	assert.Equal(t, expected, *calls)
+	assert.Equal(t, map[string]reqKey{"token": {domainID: 30, recordID: 456}}, provider.recordIDs)
+}
+
+func TestDNSProvider_Present_unknownDomain(t *testing.T) {
+	provider, calls := setupTest(t)
+
+	err := provider.Present("example.org", "token", "keyAuth")
+	require.EqualError(t, err, `shellrent: could not find zone for domain "example.org": zone not found`)
+
+	assert.NotContains(t, *calls, "POST /dns_record/store/30")
+}
+
+func TestDNSProvider_CleanUp(t *testing.T) {
+	provider, calls := setupTest(t)
+
+	provider.recordIDs["token"] = reqKey{domainID: 30, recordID: 456}
+
+	err := provider.CleanUp("www.sub.example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"DELETE /dns_record/remove/30/456"}, *calls)
+	assert.Empty(t, provider.recordIDs)
+}
+
+func TestDNSProvider_CleanUp_unknownToken(t *testing.T) {
+	provider, calls := setupTest(t)
+
+	err := provider.CleanUp("www.sub.example.com", "token", "keyAuth")
+	require.EqualError(t, err, "shellrent: unknown request key for '_acme-challenge.www.sub.example.com.' 'token'")
+
+	assert.Empty(t, *calls)
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
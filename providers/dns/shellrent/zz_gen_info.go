@@ -0,0 +1,29 @@
+package shellrent
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/shellrent/shellrent.toml
+		Name:  "Shellrent",
+		Code:  "shellrent",
+		Since: "v4.16.0",
+		URL:   "https://www.shellrent.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "SHELLRENT_TOKEN", Description: "Token", Credential: true},
+			{Name: "SHELLRENT_USERNAME", Description: "Username", Credential: true},
+			{Name: "SHELLRENT_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "SHELLRENT_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "SHELLRENT_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "SHELLRENT_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.shellrent.com/section/api2",
+			GoClient: "",
+		},
+	}
+}
@@ -14,16 +14,17 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
-const defaultBaseURL = "https://api.simply.com/1/"
+const defaultBaseURL = "https://api.simply.com/2/"
 
 // Client is a Simply.com API client.
 type Client struct {
 	accountName string
 	apiKey      string
 
-	baseURL    *url.URL
+	BaseURL    *url.URL
 	HTTPClient *http.Client
 }
 
@@ -45,11 +46,29 @@ func NewClient(accountName string, apiKey string) (*Client, error) {
 	return &Client{
 		accountName: accountName,
 		apiKey:      apiKey,
-		baseURL:     baseURL,
+		BaseURL:     baseURL,
 		HTTPClient:  &http.Client{Timeout: 5 * time.Second},
 	}, nil
 }
 
+// GetProducts lists all the products of the account.
+func (c *Client) GetProducts(ctx context.Context) ([]Product, error) {
+	endpoint := c.BaseURL.JoinPath("my", "products")
+
+	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	result := &productsResponse{}
+	err = c.do(req, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Products, nil
+}
+
 // GetRecords lists all the records in the zone.
 func (c *Client) GetRecords(ctx context.Context, zoneName string) ([]Record, error) {
 	endpoint := c.createEndpoint(zoneName, "/")
@@ -111,10 +130,12 @@ func (c *Client) DeleteRecord(ctx context.Context, zoneName string, id int64) er
 }
 
 func (c *Client) createEndpoint(zoneName string, uri string) *url.URL {
-	return c.baseURL.JoinPath(c.accountName, c.apiKey, "my", "products", zoneName, "dns", "records", strings.TrimSuffix(uri, "/"))
+	return c.BaseURL.JoinPath("my", "products", zoneName, "dns", "records", strings.TrimSuffix(uri, "/"))
 }
 
 func (c *Client) do(req *http.Request, result Response) error {
+	req.SetBasicAuth(c.accountName, c.apiKey)
+
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return errutils.NewHTTPDoError(req, err)
@@ -158,6 +179,8 @@ func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, paylo
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 
 	if payload != nil {
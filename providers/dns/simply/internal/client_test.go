@@ -16,10 +16,45 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestClient_GetProducts(t *testing.T) {
+	client, mux := setupTest(t)
+
+	mux.HandleFunc("/my/products", mockHandler(http.MethodGet, http.StatusOK, "get_products.json"))
+
+	products, err := client.GetProducts(context.Background())
+	require.NoError(t, err)
+
+	expected := []Product{
+		{
+			Object: "S123456",
+			Name:   "example.com",
+			Domain: ProductDomain{Name: "example.com", NameIDN: "example.com"},
+		},
+		{
+			Object: "S654321",
+			Name:   "sub.example.org",
+			Domain: ProductDomain{Name: "sub.example.org", NameIDN: "sub.example.org"},
+		},
+	}
+
+	assert.Equal(t, expected, products)
+}
+
+func TestClient_GetProducts_error(t *testing.T) {
+	client, mux := setupTest(t)
+
+	mux.HandleFunc("/my/products", mockHandler(http.MethodGet, http.StatusBadRequest, "bad_auth_error.json"))
+
+	products, err := client.GetProducts(context.Background())
+	require.Error(t, err)
+
+	assert.Nil(t, products)
+}
+
 func TestClient_GetRecords(t *testing.T) {
 	client, mux := setupTest(t)
 
-	mux.HandleFunc("/accountname/apikey/my/products/azone01/dns/records", mockHandler(http.MethodGet, http.StatusOK, "get_records.json"))
+	mux.HandleFunc("/my/products/azone01/dns/records", mockHandler(http.MethodGet, http.StatusOK, "get_records.json"))
 
 	records, err := client.GetRecords(context.Background(), "azone01")
 	require.NoError(t, err)
@@ -65,7 +100,7 @@ func TestClient_GetRecords(t *testing.T) {
 func TestClient_GetRecords_error(t *testing.T) {
 	client, mux := setupTest(t)
 
-	mux.HandleFunc("/accountname/apikey/my/products/azone01/dns/records", mockHandler(http.MethodGet, http.StatusBadRequest, "bad_auth_error.json"))
+	mux.HandleFunc("/my/products/azone01/dns/records", mockHandler(http.MethodGet, http.StatusBadRequest, "bad_auth_error.json"))
 
 	records, err := client.GetRecords(context.Background(), "azone01")
 	require.Error(t, err)
@@ -76,7 +111,7 @@ func TestClient_GetRecords_error(t *testing.T) {
 func TestClient_AddRecord(t *testing.T) {
 	client, mux := setupTest(t)
 
-	mux.HandleFunc("/accountname/apikey/my/products/azone01/dns/records", mockHandler(http.MethodPost, http.StatusOK, "add_record.json"))
+	mux.HandleFunc("/my/products/azone01/dns/records", mockHandler(http.MethodPost, http.StatusOK, "add_record.json"))
 
 	record := Record{
 		Name:     "arecord01",
@@ -95,7 +130,7 @@ func TestClient_AddRecord(t *testing.T) {
 func TestClient_AddRecord_error(t *testing.T) {
 	client, mux := setupTest(t)
 
-	mux.HandleFunc("/accountname/apikey/my/products/azone01/dns/records", mockHandler(http.MethodPost, http.StatusNotFound, "bad_zone_error.json"))
+	mux.HandleFunc("/my/products/azone01/dns/records", mockHandler(http.MethodPost, http.StatusNotFound, "bad_zone_error.json"))
 
 	record := Record{
 		Name:     "arecord01",
@@ -114,7 +149,7 @@ func TestClient_AddRecord_error(t *testing.T) {
 func TestClient_EditRecord(t *testing.T) {
 	client, mux := setupTest(t)
 
-	mux.HandleFunc("/accountname/apikey/my/products/azone01/dns/records/123456789", mockHandler(http.MethodPut, http.StatusOK, "success.json"))
+	mux.HandleFunc("/my/products/azone01/dns/records/123456789", mockHandler(http.MethodPut, http.StatusOK, "success.json"))
 
 	record := Record{
 		Name:     "arecord01",
@@ -131,7 +166,7 @@ func TestClient_EditRecord(t *testing.T) {
 func TestClient_EditRecord_error(t *testing.T) {
 	client, mux := setupTest(t)
 
-	mux.HandleFunc("/accountname/apikey/my/products/azone01/dns/records/123456789", mockHandler(http.MethodPut, http.StatusNotFound, "invalid_record_id.json"))
+	mux.HandleFunc("/my/products/azone01/dns/records/123456789", mockHandler(http.MethodPut, http.StatusNotFound, "invalid_record_id.json"))
 
 	record := Record{
 		Name:     "arecord01",
@@ -148,7 +183,7 @@ func TestClient_EditRecord_error(t *testing.T) {
 func TestClient_DeleteRecord(t *testing.T) {
 	client, mux := setupTest(t)
 
-	mux.HandleFunc("/accountname/apikey/my/products/azone01/dns/records/123456789", mockHandler(http.MethodDelete, http.StatusOK, "success.json"))
+	mux.HandleFunc("/my/products/azone01/dns/records/123456789", mockHandler(http.MethodDelete, http.StatusOK, "success.json"))
 
 	err := client.DeleteRecord(context.Background(), "azone01", 123456789)
 	require.NoError(t, err)
@@ -157,7 +192,7 @@ func TestClient_DeleteRecord(t *testing.T) {
 func TestClient_DeleteRecord_error(t *testing.T) {
 	client, mux := setupTest(t)
 
-	mux.HandleFunc("/accountname/apikey/my/products/azone01/dns/records/123456789", mockHandler(http.MethodDelete, http.StatusNotFound, "invalid_record_id.json"))
+	mux.HandleFunc("/my/products/azone01/dns/records/123456789", mockHandler(http.MethodDelete, http.StatusNotFound, "invalid_record_id.json"))
 
 	err := client.DeleteRecord(context.Background(), "azone01", 123456789)
 	require.Error(t, err)
@@ -173,7 +208,7 @@ func setupTest(t *testing.T) (*Client, *http.ServeMux) {
 	client, err := NewClient("accountname", "apikey")
 	require.NoError(t, err)
 
-	client.baseURL, _ = url.Parse(server.URL)
+	client.BaseURL, _ = url.Parse(server.URL)
 
 	return client, mux
 }
@@ -185,6 +220,12 @@ func mockHandler(method string, statusCode int, filename string) func(http.Respo
 			return
 		}
 
+		username, password, ok := req.BasicAuth()
+		if !ok || username != "accountname" || password != "apikey" {
+			http.Error(rw, fmt.Sprintf("invalid credentials: %s:%s", username, password), http.StatusUnauthorized)
+			return
+		}
+
 		if filename == "" {
 			rw.WriteHeader(statusCode)
 			return
@@ -1,5 +1,7 @@
 package internal
 
+import "encoding/json"
+
 // Record represents the content of a DNS record.
 type Record struct {
 	ID       int64  `json:"record_id,omitempty"`
@@ -10,6 +12,19 @@ type Record struct {
 	Priority int    `json:"priority,omitempty"`
 }
 
+// Product represents a product (domain, hosting, etc.) of the account.
+type Product struct {
+	Object string        `json:"object,omitempty"`
+	Name   string        `json:"name,omitempty"`
+	Domain ProductDomain `json:"domain"`
+}
+
+// ProductDomain represents the domain of a product.
+type ProductDomain struct {
+	Name    string `json:"name,omitempty"`
+	NameIDN string `json:"name_idn,omitempty"`
+}
+
 type Response interface {
 	GetStatus() int
 	GetMessage() string
@@ -34,3 +49,9 @@ func (a apiResponse[S, R]) GetMessage() string {
 type recordHeader struct {
 	ID int64 `json:"id"`
 }
+
+type productsResponse struct {
+	apiResponse[json.RawMessage, json.RawMessage]
+
+	Products []Product `json:"products"`
+}
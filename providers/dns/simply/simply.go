@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -111,18 +112,18 @@ func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 
 // Present creates a TXT record using the specified parameters.
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	product, err := d.findProduct(ctx, dns01.UnFqdn(info.EffectiveFQDN))
 	if err != nil {
 		return fmt.Errorf("simply: could not find zone for domain %q: %w", domain, err)
 	}
 
-	authZone = dns01.UnFqdn(authZone)
-
-	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, authZone)
+	subDomain, err := dns01.ExtractSubDomain(info.EffectiveFQDN, product.Domain.Name)
 	if err != nil {
-		return fmt.Errorf("regru: %w", err)
+		return fmt.Errorf("simply: %w", err)
 	}
 
 	recordBody := internal.Record{
@@ -132,7 +133,7 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		TTL:  d.config.TTL,
 	}
 
-	recordID, err := d.client.AddRecord(context.Background(), authZone, recordBody)
+	recordID, err := d.client.AddRecord(ctx, product.Object, recordBody)
 	if err != nil {
 		return fmt.Errorf("simply: failed to add record: %w", err)
 	}
@@ -146,24 +147,25 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 
 // CleanUp removes the TXT record matching the specified parameters.
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
-	info := dns01.GetChallengeInfo(domain, keyAuth)
-
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
-	if err != nil {
-		return fmt.Errorf("simply: could not find zone for domain %q: %w", domain, err)
-	}
+	ctx := context.Background()
 
-	authZone = dns01.UnFqdn(authZone)
+	info := dns01.GetChallengeInfo(domain, keyAuth)
 
 	// gets the record's unique ID from when we created it
 	d.recordIDsMu.Lock()
 	recordID, ok := d.recordIDs[token]
 	d.recordIDsMu.Unlock()
+
 	if !ok {
 		return fmt.Errorf("simply: unknown record ID for '%s' '%s'", info.EffectiveFQDN, token)
 	}
 
-	err = d.client.DeleteRecord(context.Background(), authZone, recordID)
+	product, err := d.findProduct(ctx, dns01.UnFqdn(info.EffectiveFQDN))
+	if err != nil {
+		return fmt.Errorf("simply: could not find zone for domain %q: %w", domain, err)
+	}
+
+	err = d.client.DeleteRecord(ctx, product.Object, recordID)
 	if err != nil {
 		return fmt.Errorf("simply: failed to delete TXT records: fqdn=%s, recordID=%d: %w", info.EffectiveFQDN, recordID, err)
 	}
@@ -175,3 +177,35 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 
 	return nil
 }
+
+// findProduct finds the product managing the DNS zone of the domain.
+// The DNS records are managed by product (object), so the product with the longest matching domain is used.
+func (d *DNSProvider) findProduct(ctx context.Context, domain string) (*internal.Product, error) {
+	products, err := d.client.GetProducts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get products: %w", err)
+	}
+
+	var product *internal.Product
+
+	for _, p := range products {
+		name := dns01.UnFqdn(p.Domain.Name)
+		if name == "" || p.Object == "" {
+			continue
+		}
+
+		if !strings.EqualFold(domain, name) && !strings.HasSuffix(strings.ToLower(domain), "."+strings.ToLower(name)) {
+			continue
+		}
+
+		if product == nil || len(name) > len(dns01.UnFqdn(product.Domain.Name)) {
+			product = &p
+		}
+	}
+
+	if product == nil {
+		return nil, fmt.Errorf("no product found for the domain %q", domain)
+	}
+
+	return product, nil
+}
@@ -1,9 +1,17 @@
 package simply
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/providers/dns/simply/internal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -115,6 +123,137 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func setupTest(t *testing.T) (*DNSProvider, *[]string) {
+	t.Helper()
+
+	var calls []string
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	handle := func(pattern string, handler http.HandlerFunc) {
+		mux.HandleFunc(pattern, func(rw http.ResponseWriter, req *http.Request) {
+			username, password, ok := req.BasicAuth()
+			if !ok || username != "S000000" || password != "secret" {
+				http.Error(rw, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+
+			calls = append(calls, req.Method+" "+req.URL.Path)
+
+			handler(rw, req)
+		})
+	}
+
+	// S111111: a hosting product (no domain), S222222: example.com, S333333: sub.example.com.
+	handle("GET /my/products", func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(rw, `{"status":200,"message":"success","products":[
+{"object":"S111111","name":"hosting","domain":{}},
+{"object":"S222222","name":"example.com","domain":{"name":"example.com","name_idn":"example.com"}},
+{"object":"S333333","name":"sub.example.com","domain":{"name":"sub.example.com","name_idn":"sub.example.com"}}
+]}`)
+	})
+
+	handle("POST /my/products/S333333/dns/records", func(rw http.ResponseWriter, req *http.Request) {
+		var record internal.Record
+		err := json.NewDecoder(req.Body).Decode(&record)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		expected := internal.Record{
+			Name: "_acme-challenge.www",
+			Data: dns01.GetChallengeInfo("www.sub.example.com", "keyAuth").Value,
+			Type: "TXT",
+			TTL:  120,
+		}
+
+		if record != expected {
+			http.Error(rw, fmt.Sprintf("unexpected record: %+v", record), http.StatusBadRequest)
+			return
+		}
+
+		_, _ = fmt.Fprint(rw, `{"status":200,"message":"success","record":{"id":456}}`)
+	})
+
+	handle("DELETE /my/products/S333333/dns/records/456", func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(rw, `{"status":200,"message":"success"}`)
+	})
+
+	config := NewDefaultConfig()
+	config.AccountName = "S000000"
+	config.APIKey = "secret"
+	config.TTL = 120
+	config.HTTPClient = server.Client()
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.client.BaseURL, _ = url.Parse(server.URL)
+
+	return provider, &calls
+}
+
+func TestDNSProvider_Present(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	provider, calls := setupTest(t)
+
+	err := provider.Present("www.sub.example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	expected := []string{
+		"GET /my/products",
+		"POST /my/products/S333333/dns/records",
+	}
+
+	assert.Equal(t, expected, *calls)
+	assert.Equal(t, map[string]int64{"token": 456}, provider.recordIDs)
+}
+
+func TestDNSProvider_Present_unknownDomain(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	provider, calls := setupTest(t)
+
+	err := provider.Present("example.org", "token", "keyAuth")
+	require.EqualError(t, err, `simply: could not find zone for domain "example.org": no product found for the domain "_acme-challenge.example.org"`)
+
+	assert.Equal(t, []string{"GET /my/products"}, *calls)
+}
+
+func TestDNSProvider_CleanUp(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	provider, calls := setupTest(t)
+
+	provider.recordIDs["token"] = 456
+
+	err := provider.CleanUp("www.sub.example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	expected := []string{
+		"GET /my/products",
+		"DELETE /my/products/S333333/dns/records/456",
+	}
+
+	assert.Equal(t, expected, *calls)
+	assert.Empty(t, provider.recordIDs)
+}
+
+func TestDNSProvider_CleanUp_unknownToken(t *testing.T) {
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	provider, calls := setupTest(t)
+
+	err := provider.CleanUp("www.sub.example.com", "token", "keyAuth")
+	require.EqualError(t, err, "simply: unknown record ID for '_acme-challenge.www.sub.example.com.' 'token'")
+
+	assert.Empty(t, *calls)
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
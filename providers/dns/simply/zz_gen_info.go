@@ -0,0 +1,29 @@
+package simply
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/simply/simply.toml
+		Name:  "Simply.com",
+		Code:  "simply",
+		Since: "v4.4.0",
+		URL:   "https://www.simply.com/en/domains/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "SIMPLY_ACCOUNT_NAME", Description: "Account name", Credential: true},
+			{Name: "SIMPLY_API_KEY", Description: "API key", Credential: true},
+			{Name: "SIMPLY_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "SIMPLY_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "SIMPLY_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "SIMPLY_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.simply.com/en/docs/api/",
+			GoClient: "",
+		},
+	}
+}
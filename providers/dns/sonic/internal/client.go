@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 const baseURL = "https://public-api.sonic.net/dyndns"
@@ -67,6 +68,8 @@ func (c *Client) SetRecord(ctx context.Context, hostname string, value string, t
 		return fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("content-type", "application/json")
 
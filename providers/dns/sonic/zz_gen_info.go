@@ -0,0 +1,30 @@
+package sonic
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/sonic/sonic.toml
+		Name:  "Sonic",
+		Code:  "sonic",
+		Since: "v4.4.0",
+		URL:   "https://www.sonic.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "SONIC_API_KEY", Description: "API Key", Credential: true},
+			{Name: "SONIC_USER_ID", Description: "User ID", Credential: true},
+			{Name: "SONIC_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "SONIC_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "SONIC_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "SONIC_SEQUENCE_INTERVAL", Description: "Time between sequential requests"},
+			{Name: "SONIC_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://public-api.sonic.net/dyndns/",
+			GoClient: "",
+		},
+	}
+}
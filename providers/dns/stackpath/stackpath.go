@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
-	"github.com/go-acme/lego/v4/log"
 	"github.com/go-acme/lego/v4/platform/config/env"
 	"github.com/go-acme/lego/v4/providers/dns/stackpath/internal"
 )
@@ -135,14 +134,16 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 		return err
 	}
 
+	var errs []error
+
 	for _, record := range records {
 		err = d.client.DeleteZoneRecord(ctx, zone, record)
 		if err != nil {
-			log.Printf("stackpath: failed to delete TXT record: %v", err)
+			errs = append(errs, fmt.Errorf("stackpath: failed to delete TXT record: %w", err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // Timeout returns the timeout and interval to use when checking for DNS propagation.
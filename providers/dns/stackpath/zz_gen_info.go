@@ -0,0 +1,29 @@
+package stackpath
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/stackpath/stackpath.toml
+		Name:  "Stackpath",
+		Code:  "stackpath",
+		Since: "v1.1.0",
+		URL:   "https://www.stackpath.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "STACKPATH_CLIENT_ID", Description: "Client ID", Credential: true},
+			{Name: "STACKPATH_CLIENT_SECRET", Description: "Client secret", Credential: true},
+			{Name: "STACKPATH_STACK_ID", Description: "Stack ID", Credential: true},
+			{Name: "STACKPATH_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "STACKPATH_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "STACKPATH_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developer.stackpath.com/en/api/dns/#tag/Zone",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
+)
+
+const statusOK = "ok"
+
+// Client the Technitium DNS Server API client.
+type Client struct {
+	apiToken string
+
+	baseURL    *url.URL
+	HTTPClient *http.Client
+}
+
+// NewClient creates a new Client.
+func NewClient(serverURL, apiToken string) (*Client, error) {
+	baseURL, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		apiToken:   apiToken,
+		baseURL:    baseURL,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// ListZones lists the zones.
+// https://github.com/TechnitiumSoftware/DnsServer/blob/master/APIDOCS.md#list-zones
+func (c *Client) ListZones(ctx context.Context) ([]Zone, error) {
+	endpoint := c.baseURL.JoinPath("api", "zones", "list")
+
+	req, err := c.newFormRequest(ctx, endpoint, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &APIResponse[Zones]{}
+
+	err = c.do(req, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Response.Zones, nil
+}
+
+// AddRecord adds a TXT record.
+// https://github.com/TechnitiumSoftware/DnsServer/blob/master/APIDOCS.md#add-record
+func (c *Client) AddRecord(ctx context.Context, record Record) error {
+	endpoint := c.baseURL.JoinPath("api", "zones", "records", "add")
+
+	data := url.Values{}
+	data.Set("domain", record.Domain)
+	data.Set("zone", record.Zone)
+	data.Set("type", "TXT")
+	data.Set("ttl", strconv.Itoa(record.TTL))
+	data.Set("text", record.Text)
+
+	req, err := c.newFormRequest(ctx, endpoint, data)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, &APIResponse[AddRecordResponse]{})
+}
+
+// DeleteRecord deletes a TXT record.
+// https://github.com/TechnitiumSoftware/DnsServer/blob/master/APIDOCS.md#delete-record
+func (c *Client) DeleteRecord(ctx context.Context, record Record) error {
+	endpoint := c.baseURL.JoinPath("api", "zones", "records", "delete")
+
+	data := url.Values{}
+	data.Set("domain", record.Domain)
+	data.Set("zone", record.Zone)
+	data.Set("type", "TXT")
+	data.Set("text", record.Text)
+
+	req, err := c.newFormRequest(ctx, endpoint, data)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, &APIResponse[json.RawMessage]{})
+}
+
+func (c *Client) do(req *http.Request, result any) error {
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return errutils.NewHTTPDoError(req, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode/100 != 2 {
+		return errutils.NewUnexpectedResponseStatusCodeError(req, resp)
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errutils.NewReadResponseError(req, resp.StatusCode, err)
+	}
+
+	var status APIResponse[json.RawMessage]
+	err = json.Unmarshal(raw, &status)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
+	}
+
+	if status.Status != statusOK {
+		return &APIError{
+			Status:       status.Status,
+			ErrorMessage: status.ErrorMessage,
+			InnerError:   status.InnerError,
+		}
+	}
+
+	err = json.Unmarshal(raw, result)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
+	}
+
+	return nil
+}
+
+func (c *Client) newFormRequest(ctx context.Context, endpoint *url.URL, data url.Values) (*http.Request, error) {
+	// The token is sent inside the body to avoid leaking it inside the server logs.
+	data.Set("token", c.apiToken)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %w", err)
+	}
+
+	useragent.SetHeader(req.Header)
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return req, nil
+}
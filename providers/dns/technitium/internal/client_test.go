@@ -0,0 +1,152 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTest(t *testing.T, pattern, filename string, expectedParams url.Values) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc(pattern, func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		if req.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+			http.Error(rw, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		err := req.ParseForm()
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if req.PostForm.Get("token") != "secret" {
+			http.Error(rw, fmt.Sprintf("invalid token: %q", req.PostForm.Get("token")), http.StatusUnauthorized)
+			return
+		}
+
+		for k := range expectedParams {
+			if req.PostForm.Get(k) != expectedParams.Get(k) {
+				http.Error(rw, fmt.Sprintf("%s: invalid value: %s != %s", k, req.PostForm.Get(k), expectedParams.Get(k)), http.StatusBadRequest)
+				return
+			}
+		}
+
+		file, err := os.Open(filepath.Join("fixtures", filename))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		defer func() { _ = file.Close() }()
+
+		_, err = io.Copy(rw, file)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	client, err := NewClient(server.URL, "secret")
+	require.NoError(t, err)
+
+	client.HTTPClient = server.Client()
+
+	return client
+}
+
+func TestClient_ListZones(t *testing.T) {
+	client := setupTest(t, "/api/zones/list", "zones.json", nil)
+
+	zones, err := client.ListZones(context.Background())
+	require.NoError(t, err)
+
+	expected := []Zone{
+		{Name: "example.com", Type: "Primary", DNSSecStatus: "Unsigned"},
+		{Name: "sub.example.com", Type: "Primary", DNSSecStatus: "Unsigned"},
+	}
+
+	assert.Equal(t, expected, zones)
+}
+
+func TestClient_ListZones_error(t *testing.T) {
+	client := setupTest(t, "/api/zones/list", "invalid-token.json", nil)
+
+	_, err := client.ListZones(context.Background())
+	require.EqualError(t, err, "status: invalid-token, message: Invalid token or session expired.")
+}
+
+func TestClient_AddRecord(t *testing.T) {
+	expectedParams := url.Values{
+		"domain": {"_acme-challenge.example.com"},
+		"zone":   {"example.com"},
+		"type":   {"TXT"},
+		"ttl":    {"120"},
+		"text":   {"txtTXTtxt"},
+	}
+
+	client := setupTest(t, "/api/zones/records/add", "add_record.json", expectedParams)
+
+	record := Record{
+		Domain: "_acme-challenge.example.com",
+		Zone:   "example.com",
+		TTL:    120,
+		Text:   "txtTXTtxt",
+	}
+
+	err := client.AddRecord(context.Background(), record)
+	require.NoError(t, err)
+}
+
+func TestClient_AddRecord_error(t *testing.T) {
+	client := setupTest(t, "/api/zones/records/add", "error.json", nil)
+
+	record := Record{
+		Domain: "_acme-challenge.example.org",
+		Zone:   "example.org",
+		TTL:    120,
+		Text:   "txtTXTtxt",
+	}
+
+	err := client.AddRecord(context.Background(), record)
+	require.EqualError(t, err, "status: error, message: No such zone was found: example.org")
+}
+
+func TestClient_DeleteRecord(t *testing.T) {
+	expectedParams := url.Values{
+		"domain": {"_acme-challenge.example.com"},
+		"zone":   {"example.com"},
+		"type":   {"TXT"},
+		"text":   {"txtTXTtxt"},
+	}
+
+	client := setupTest(t, "/api/zones/records/delete", "delete_record.json", expectedParams)
+
+	record := Record{
+		Domain: "_acme-challenge.example.com",
+		Zone:   "example.com",
+		Text:   "txtTXTtxt",
+	}
+
+	err := client.DeleteRecord(context.Background(), record)
+	require.NoError(t, err)
+}
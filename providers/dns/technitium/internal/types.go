@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type APIResponse[T any] struct {
+	Status       string `json:"status"`
+	Response     T      `json:"response,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	StackTrace   string `json:"stackTrace,omitempty"`
+	InnerError   string `json:"innerErrorMessage,omitempty"`
+}
+
+type APIError struct {
+	Status       string
+	ErrorMessage string
+	InnerError   string
+}
+
+func (a *APIError) Error() string {
+	msg := fmt.Sprintf("status: %s", a.Status)
+
+	if a.ErrorMessage != "" {
+		msg += fmt.Sprintf(", message: %s", a.ErrorMessage)
+	}
+
+	if a.InnerError != "" {
+		msg += fmt.Sprintf(", inner error: %s", a.InnerError)
+	}
+
+	return msg
+}
+
+type Zones struct {
+	Zones []Zone `json:"zones"`
+}
+
+type Zone struct {
+	Name         string `json:"name,omitempty"`
+	Type         string `json:"type,omitempty"`
+	Internal     bool   `json:"internal,omitempty"`
+	DNSSecStatus string `json:"dnssecStatus,omitempty"`
+	Disabled     bool   `json:"disabled,omitempty"`
+}
+
+type AddRecordResponse struct {
+	Zone        Zone            `json:"zone"`
+	AddedRecord json.RawMessage `json:"addedRecord"`
+}
+
+type Record struct {
+	Domain string
+	Zone   string
+	TTL    int
+	Text   string
+}
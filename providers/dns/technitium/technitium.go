@@ -0,0 +1,229 @@
+// Package technitium implements a DNS provider for solving the DNS-01 challenge using Technitium DNS Server.
+package technitium
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/go-acme/lego/v4/providers/dns/technitium/internal"
+)
+
+// Environment variables names.
+const (
+	envNamespace = "TECHNITIUM_"
+
+	EnvServerURL          = envNamespace + "SERVER_URL"
+	EnvAPIToken           = envNamespace + "API_TOKEN"
+	EnvCACertificate      = envNamespace + "CA_CERTIFICATE"
+	EnvInsecureSkipVerify = envNamespace + "INSECURE_SKIP_VERIFY"
+
+	EnvTTL                = envNamespace + "TTL"
+	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
+	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
+	EnvHTTPTimeout        = envNamespace + "HTTP_TIMEOUT"
+)
+
+// Config is used to configure the creation of the DNSProvider.
+type Config struct {
+	ServerURL string
+	APIToken  string
+
+	// CACertificate is the path to a PEM file containing the CA certificates used to verify the server.
+	CACertificate string
+	// InsecureSkipVerify disables the verification of the server certificate.
+	InsecureSkipVerify bool
+
+	TTL                int
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+	HTTPClient         *http.Client
+}
+
+// NewDefaultConfig returns a default configuration for the DNSProvider.
+func NewDefaultConfig() *Config {
+	return &Config{
+		TTL:                env.GetOrDefaultInt(EnvTTL, dns01.DefaultTTL),
+		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		HTTPClient: &http.Client{
+			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+		},
+	}
+}
+
+// DNSProvider implements the challenge.Provider interface.
+type DNSProvider struct {
+	config *Config
+	client *internal.Client
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for Technitium DNS Server.
+// Credentials must be passed in the environment variables:
+// TECHNITIUM_SERVER_URL, TECHNITIUM_API_TOKEN.
+func NewDNSProvider() (*DNSProvider, error) {
+	values, err := env.Get(EnvServerURL, EnvAPIToken)
+	if err != nil {
+		return nil, fmt.Errorf("technitium: %w", err)
+	}
+
+	config := NewDefaultConfig()
+	config.ServerURL = values[EnvServerURL]
+	config.APIToken = values[EnvAPIToken]
+	config.CACertificate = env.GetOrFile(EnvCACertificate)
+	config.InsecureSkipVerify = env.GetOrDefaultBool(EnvInsecureSkipVerify, false)
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewDNSProviderConfig return a DNSProvider instance configured for Technitium DNS Server.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("technitium: the configuration of the DNS provider is nil")
+	}
+
+	if config.ServerURL == "" {
+		return nil, errors.New("technitium: missing server URL")
+	}
+
+	if config.APIToken == "" {
+		return nil, errors.New("technitium: missing credentials")
+	}
+
+	client, err := internal.NewClient(config.ServerURL, config.APIToken)
+	if err != nil {
+		return nil, fmt.Errorf("technitium: %w", err)
+	}
+
+	if config.HTTPClient != nil {
+		client.HTTPClient = config.HTTPClient
+	}
+
+	if config.CACertificate != "" || config.InsecureSkipVerify {
+		client.HTTPClient, err = withTLSConfig(client.HTTPClient, config)
+		if err != nil {
+			return nil, fmt.Errorf("technitium: %w", err)
+		}
+	}
+
+	return &DNSProvider{config: config, client: client}, nil
+}
+
+// Timeout returns the timeout and interval to use when checking for DNS propagation.
+// Adjusting here to cope with spikes in propagation times.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
+
+// Present creates a TXT record using the specified parameters.
+func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	zone, err := d.findZone(ctx, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("technitium: %w", err)
+	}
+
+	record := internal.Record{
+		Domain: dns01.UnFqdn(info.EffectiveFQDN),
+		Zone:   zone,
+		TTL:    d.config.TTL,
+		Text:   info.Value,
+	}
+
+	err = d.client.AddRecord(ctx, record)
+	if err != nil {
+		return fmt.Errorf("technitium: add record: %w", err)
+	}
+
+	return nil
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	zone, err := d.findZone(ctx, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("technitium: %w", err)
+	}
+
+	record := internal.Record{
+		Domain: dns01.UnFqdn(info.EffectiveFQDN),
+		Zone:   zone,
+		Text:   info.Value,
+	}
+
+	err = d.client.DeleteRecord(ctx, record)
+	if err != nil {
+		return fmt.Errorf("technitium: delete record: %w", err)
+	}
+
+	return nil
+}
+
+// findZone returns the most specific zone, hosted by the server, containing the FQDN.
+func (d *DNSProvider) findZone(ctx context.Context, fqdn string) (string, error) {
+	zones, err := d.client.ListZones(ctx)
+	if err != nil {
+		return "", fmt.Errorf("list zones: %w", err)
+	}
+
+	var names []string
+	for _, z := range zones {
+		if z.Disabled || z.Internal {
+			continue
+		}
+
+		names = append(names, z.Name)
+	}
+
+	zone := dns01.FindMostSpecificZone(fqdn, names)
+	if zone == "" {
+		return "", fmt.Errorf("no zone found for %s", fqdn)
+	}
+
+	return zone, nil
+}
+
+func withTLSConfig(client *http.Client, config *Config) (*http.Client, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.InsecureSkipVerify}
+
+	if config.CACertificate != "" {
+		caCerts, err := os.ReadFile(config.CACertificate)
+		if err != nil {
+			return nil, fmt.Errorf("read CA certificate: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCerts) {
+			return nil, fmt.Errorf("no valid certificates found in %s", config.CACertificate)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok || transport == nil {
+		transport = http.DefaultTransport.(*http.Transport)
+	}
+
+	transport = transport.Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	clone := *client
+	clone.Transport = transport
+
+	return &clone, nil
+}
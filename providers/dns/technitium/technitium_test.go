@@ -0,0 +1,158 @@
+package technitium
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/require"
+)
+
+const envDomain = envNamespace + "DOMAIN"
+
+var envTest = tester.NewEnvTest(EnvServerURL, EnvAPIToken, EnvCACertificate, EnvInsecureSkipVerify).
+	WithDomain(envDomain)
+
+func TestNewDNSProvider(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		envVars  map[string]string
+		expected string
+	}{
+		{
+			desc: "success",
+			envVars: map[string]string{
+				EnvServerURL: "https://localhost:5380",
+				EnvAPIToken:  "secret",
+			},
+		},
+		{
+			desc: "success with insecure skip verify",
+			envVars: map[string]string{
+				EnvServerURL:          "https://localhost:5380",
+				EnvAPIToken:           "secret",
+				EnvInsecureSkipVerify: "true",
+			},
+		},
+		{
+			desc: "missing server URL",
+			envVars: map[string]string{
+				EnvAPIToken: "secret",
+			},
+			expected: "technitium: some credentials information are missing: TECHNITIUM_SERVER_URL",
+		},
+		{
+			desc: "missing API token",
+			envVars: map[string]string{
+				EnvServerURL: "https://localhost:5380",
+			},
+			expected: "technitium: some credentials information are missing: TECHNITIUM_API_TOKEN",
+		},
+		{
+			desc:     "missing credentials",
+			envVars:  map[string]string{},
+			expected: "technitium: some credentials information are missing: TECHNITIUM_SERVER_URL,TECHNITIUM_API_TOKEN",
+		},
+		{
+			desc: "invalid CA certificate path",
+			envVars: map[string]string{
+				EnvServerURL:     "https://localhost:5380",
+				EnvAPIToken:      "secret",
+				EnvCACertificate: "./missing.pem",
+			},
+			expected: "technitium: read CA certificate: open ./missing.pem: no such file or directory",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			defer envTest.RestoreEnv()
+			envTest.ClearEnv()
+
+			envTest.Apply(test.envVars)
+
+			p, err := NewDNSProvider()
+
+			if test.expected == "" {
+				require.NoError(t, err)
+				require.NotNil(t, p)
+				require.NotNil(t, p.config)
+				require.NotNil(t, p.client)
+			} else {
+				require.EqualError(t, err, test.expected)
+			}
+		})
+	}
+}
+
+func TestNewDNSProviderConfig(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		serverURL string
+		apiToken  string
+		expected  string
+	}{
+		{
+			desc:      "success",
+			serverURL: "https://localhost:5380",
+			apiToken:  "secret",
+		},
+		{
+			desc:     "missing server URL",
+			apiToken: "secret",
+			expected: "technitium: missing server URL",
+		},
+		{
+			desc:      "missing API token",
+			serverURL: "https://localhost:5380",
+			expected:  "technitium: missing credentials",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			config := NewDefaultConfig()
+			config.ServerURL = test.serverURL
+			config.APIToken = test.apiToken
+
+			p, err := NewDNSProviderConfig(config)
+
+			if test.expected == "" {
+				require.NoError(t, err)
+				require.NotNil(t, p)
+				require.NotNil(t, p.config)
+				require.NotNil(t, p.client)
+			} else {
+				require.EqualError(t, err, test.expected)
+			}
+		})
+	}
+}
+
+func TestLivePresent(t *testing.T) {
+	if !envTest.IsLiveTest() {
+		t.Skip("skipping live test")
+	}
+
+	envTest.RestoreEnv()
+	provider, err := NewDNSProvider()
+	require.NoError(t, err)
+
+	err = provider.Present(envTest.GetDomain(), "", "123d==")
+	require.NoError(t, err)
+}
+
+func TestLiveCleanUp(t *testing.T) {
+	if !envTest.IsLiveTest() {
+		t.Skip("skipping live test")
+	}
+
+	envTest.RestoreEnv()
+	provider, err := NewDNSProvider()
+	require.NoError(t, err)
+
+	time.Sleep(1 * time.Second)
+
+	err = provider.CleanUp(envTest.GetDomain(), "", "123d==")
+	require.NoError(t, err)
+}
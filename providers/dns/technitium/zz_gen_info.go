@@ -0,0 +1,31 @@
+package technitium
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/technitium/technitium.toml
+		Name:  "Technitium",
+		Code:  "technitium",
+		Since: "v4.18.0",
+		URL:   "https://technitium.com/dns/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "TECHNITIUM_API_TOKEN", Description: "API token", Credential: true},
+			{Name: "TECHNITIUM_SERVER_URL", Description: "Server URL (ex: https://localhost:5380)", Credential: true},
+			{Name: "TECHNITIUM_CA_CERTIFICATE", Description: "Path to a PEM file containing the CA certificates used to verify the server certificate"},
+			{Name: "TECHNITIUM_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "TECHNITIUM_INSECURE_SKIP_VERIFY", Description: "Whether or not to skip the verification of the server certificate"},
+			{Name: "TECHNITIUM_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "TECHNITIUM_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "TECHNITIUM_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://github.com/TechnitiumSoftware/DnsServer/blob/master/APIDOCS.md",
+			GoClient: "",
+		},
+	}
+}
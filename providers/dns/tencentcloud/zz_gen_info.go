@@ -0,0 +1,31 @@
+package tencentcloud
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/tencentcloud/tencentcloud.toml
+		Name:  "Tencent Cloud DNS",
+		Code:  "tencentcloud",
+		Since: "v4.6.0",
+		URL:   "https://cloud.tencent.com/product/cns",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "TENCENTCLOUD_SECRET_ID", Description: "Access key ID", Credential: true},
+			{Name: "TENCENTCLOUD_SECRET_KEY", Description: "Access Key secret", Credential: true},
+			{Name: "TENCENTCLOUD_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "TENCENTCLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "TENCENTCLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "TENCENTCLOUD_REGION", Description: "Region"},
+			{Name: "TENCENTCLOUD_SESSION_TOKEN", Description: "Access Key token"},
+			{Name: "TENCENTCLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://cloud.tencent.com/document/product/1427/56153",
+			GoClient: "https://github.com/tencentcloud/tencentcloud-sdk-go",
+		},
+	}
+}
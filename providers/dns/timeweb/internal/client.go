@@ -0,0 +1,188 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
+)
+
+const defaultBaseURL = "https://api.timeweb.cloud"
+
+// domainsPageSize is the number of domains requested per page.
+const domainsPageSize = 100
+
+// Client the Timeweb Cloud API client.
+type Client struct {
+	accessToken string
+
+	BaseURL    *url.URL
+	HTTPClient *http.Client
+}
+
+// NewClient creates a new Client.
+func NewClient(accessToken string) (*Client, error) {
+	if accessToken == "" {
+		return nil, errors.New("credentials missing")
+	}
+
+	baseURL, _ := url.Parse(defaultBaseURL)
+
+	return &Client{
+		accessToken: accessToken,
+		BaseURL:     baseURL,
+		HTTPClient:  &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// ListDomains lists all the domains of the account.
+// https://timeweb.cloud/api-docs#tag/Domeny/operation/getDomains
+func (c *Client) ListDomains(ctx context.Context) ([]Domain, error) {
+	var domains []Domain
+
+	for offset := 0; ; offset += domainsPageSize {
+		endpoint := c.BaseURL.JoinPath("api", "v1", "domains")
+
+		query := endpoint.Query()
+		query.Set("limit", strconv.Itoa(domainsPageSize))
+		query.Set("offset", strconv.Itoa(offset))
+		endpoint.RawQuery = query.Encode()
+
+		req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result DomainsResponse
+
+		err = c.do(req, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		domains = append(domains, result.Domains...)
+
+		if len(result.Domains) == 0 || len(domains) >= result.Meta.Total {
+			return domains, nil
+		}
+	}
+}
+
+// CreateRecord creates a DNS record in the domain.
+// https://timeweb.cloud/api-docs#tag/Domeny/operation/createDomainDNSRecord
+func (c *Client) CreateRecord(ctx context.Context, fqdn string, record DNSRecordRequest) (*DNSRecord, error) {
+	endpoint := c.BaseURL.JoinPath("api", "v1", "domains", fqdn, "dns-records")
+
+	req, err := newJSONRequest(ctx, http.MethodPost, endpoint, record)
+	if err != nil {
+		return nil, err
+	}
+
+	var result DNSRecordResponse
+
+	err = c.do(req, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.DNSRecord == nil {
+		return nil, errors.New("missing record in the response")
+	}
+
+	return result.DNSRecord, nil
+}
+
+// DeleteRecord deletes a DNS record of the domain.
+// https://timeweb.cloud/api-docs#tag/Domeny/operation/deleteDomainDNSRecord
+func (c *Client) DeleteRecord(ctx context.Context, fqdn string, recordID int) error {
+	endpoint := c.BaseURL.JoinPath("api", "v1", "domains", fqdn, "dns-records", strconv.Itoa(recordID))
+
+	req, err := newJSONRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+func (c *Client) do(req *http.Request, result any) error {
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return errutils.NewHTTPDoError(req, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode/100 != 2 {
+		return parseError(req, resp)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errutils.NewReadResponseError(req, resp.StatusCode, err)
+	}
+
+	err = json.Unmarshal(raw, result)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
+	}
+
+	return nil
+}
+
+func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, payload any) (*http.Request, error) {
+	buf := new(bytes.Buffer)
+
+	if payload != nil {
+		err := json.NewEncoder(buf).Encode(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request JSON body: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint.String(), buf)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %w", err)
+	}
+
+	useragent.SetHeader(req.Header)
+
+	req.Header.Set("Accept", "application/json")
+
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+func parseError(req *http.Request, resp *http.Response) error {
+	raw, _ := io.ReadAll(resp.Body)
+
+	var errAPI APIError
+	err := json.Unmarshal(raw, &errAPI)
+	if err != nil || errAPI.Message == "" {
+		return errutils.NewUnexpectedStatusCodeError(req, resp.StatusCode, raw)
+	}
+
+	if errAPI.StatusCode == 0 {
+		errAPI.StatusCode = resp.StatusCode
+	}
+
+	return &errAPI
+}
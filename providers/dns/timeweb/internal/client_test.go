@@ -0,0 +1,197 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTest(t *testing.T, method, pattern string, status int, filename, expectedRequest string) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc(pattern, func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != method {
+			http.Error(rw, fmt.Sprintf("unsupported method: %s", req.Method), http.StatusMethodNotAllowed)
+			return
+		}
+
+		if req.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(rw, fmt.Sprintf("invalid Authorization header: %q", req.Header.Get("Authorization")), http.StatusUnauthorized)
+			return
+		}
+
+		if expectedRequest != "" {
+			expected, err := os.ReadFile(filepath.Join("fixtures", expectedRequest))
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			if !jsonEqual(expected, body) {
+				http.Error(rw, fmt.Sprintf("invalid request body: %s", string(body)), http.StatusBadRequest)
+				return
+			}
+		}
+
+		if filename == "" {
+			rw.WriteHeader(status)
+			return
+		}
+
+		file, err := os.Open(filepath.Join("fixtures", filename))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		defer func() { _ = file.Close() }()
+
+		rw.WriteHeader(status)
+
+		_, err = io.Copy(rw, file)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	client, err := NewClient("secret")
+	require.NoError(t, err)
+
+	client.HTTPClient = server.Client()
+	client.BaseURL, _ = url.Parse(server.URL)
+
+	return client
+}
+
+func jsonEqual(a, b []byte) bool {
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(va, vb)
+}
+
+func TestClient_ListDomains(t *testing.T) {
+	client := setupTest(t, http.MethodGet, "/api/v1/domains", http.StatusOK, "domains.json", "")
+
+	domains, err := client.ListDomains(context.Background())
+	require.NoError(t, err)
+
+	expected := []Domain{
+		{ID: 1001, FQDN: "example.com"},
+		{ID: 1002, FQDN: "sub.example.com"},
+	}
+
+	assert.Equal(t, expected, domains)
+}
+
+func TestClient_ListDomains_pagination(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	var offsets []string
+
+	mux.HandleFunc("GET /api/v1/domains", func(rw http.ResponseWriter, req *http.Request) {
+		offset := req.URL.Query().Get("offset")
+		offsets = append(offsets, offset)
+
+		result := DomainsResponse{Meta: Meta{Total: domainsPageSize + 1}}
+
+		switch offset {
+		case "0":
+			for i := range domainsPageSize {
+				result.Domains = append(result.Domains, Domain{ID: i, FQDN: fmt.Sprintf("example%d.com", i)})
+			}
+		default:
+			result.Domains = []Domain{{ID: domainsPageSize, FQDN: "example.org"}}
+		}
+
+		_ = json.NewEncoder(rw).Encode(result)
+	})
+
+	client, err := NewClient("secret")
+	require.NoError(t, err)
+
+	client.HTTPClient = server.Client()
+	client.BaseURL, _ = url.Parse(server.URL)
+
+	domains, err := client.ListDomains(context.Background())
+	require.NoError(t, err)
+
+	assert.Len(t, domains, domainsPageSize+1)
+	assert.Equal(t, "example.org", domains[domainsPageSize].FQDN)
+	assert.Equal(t, []string{"0", "100"}, offsets)
+}
+
+func TestClient_ListDomains_error(t *testing.T) {
+	client := setupTest(t, http.MethodGet, "/api/v1/domains", http.StatusForbidden, "error.json", "")
+
+	_, err := client.ListDomains(context.Background())
+	require.EqualError(t, err, "403: forbidden: You do not have access for the attempted action")
+}
+
+func TestClient_CreateRecord(t *testing.T) {
+	client := setupTest(t, http.MethodPost, "/api/v1/domains/example.com/dns-records", http.StatusCreated, "create_record.json", "create_record-request.json")
+
+	record := DNSRecordRequest{
+		Type:      "TXT",
+		Value:     "txtTXTtxt",
+		Subdomain: "_acme-challenge",
+	}
+
+	newRecord, err := client.CreateRecord(context.Background(), "example.com", record)
+	require.NoError(t, err)
+
+	expected := &DNSRecord{
+		ID:   12345,
+		Type: "TXT",
+		Data: DNSRecordData{Value: "txtTXTtxt", Subdomain: "_acme-challenge"},
+	}
+
+	assert.Equal(t, expected, newRecord)
+}
+
+func TestClient_CreateRecord_error(t *testing.T) {
+	client := setupTest(t, http.MethodPost, "/api/v1/domains/example.com/dns-records", http.StatusForbidden, "error.json", "")
+
+	_, err := client.CreateRecord(context.Background(), "example.com", DNSRecordRequest{Type: "TXT", Value: "txtTXTtxt"})
+	require.EqualError(t, err, "403: forbidden: You do not have access for the attempted action")
+}
+
+func TestClient_DeleteRecord(t *testing.T) {
+	client := setupTest(t, http.MethodDelete, "/api/v1/domains/example.com/dns-records/12345", http.StatusNoContent, "", "")
+
+	err := client.DeleteRecord(context.Background(), "example.com", 12345)
+	require.NoError(t, err)
+}
+
+func TestClient_DeleteRecord_error(t *testing.T) {
+	client := setupTest(t, http.MethodDelete, "/api/v1/domains/example.com/dns-records/12345", http.StatusForbidden, "error.json", "")
+
+	err := client.DeleteRecord(context.Background(), "example.com", 12345)
+	require.EqualError(t, err, "403: forbidden: You do not have access for the attempted action")
+}
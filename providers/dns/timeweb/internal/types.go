@@ -0,0 +1,49 @@
+package internal
+
+import "fmt"
+
+type APIError struct {
+	StatusCode int    `json:"status_code,omitempty"`
+	ErrorCode  string `json:"error_code,omitempty"`
+	Message    string `json:"message,omitempty"`
+	ResponseID string `json:"response_id,omitempty"`
+}
+
+func (a *APIError) Error() string {
+	return fmt.Sprintf("%d: %s: %s", a.StatusCode, a.ErrorCode, a.Message)
+}
+
+type Meta struct {
+	Total int `json:"total"`
+}
+
+type DomainsResponse struct {
+	Meta    Meta     `json:"meta"`
+	Domains []Domain `json:"domains"`
+}
+
+type Domain struct {
+	ID   int    `json:"id,omitempty"`
+	FQDN string `json:"fqdn,omitempty"`
+}
+
+type DNSRecordRequest struct {
+	Type      string `json:"type,omitempty"`
+	Value     string `json:"value,omitempty"`
+	Subdomain string `json:"subdomain,omitempty"`
+}
+
+type DNSRecordResponse struct {
+	DNSRecord *DNSRecord `json:"dns_record"`
+}
+
+type DNSRecord struct {
+	ID   int           `json:"id,omitempty"`
+	Type string        `json:"type,omitempty"`
+	Data DNSRecordData `json:"data"`
+}
+
+type DNSRecordData struct {
+	Value     string `json:"value,omitempty"`
+	Subdomain string `json:"subdomain,omitempty"`
+}
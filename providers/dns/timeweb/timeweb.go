@@ -0,0 +1,181 @@
+// Package timeweb implements a DNS provider for solving the DNS-01 challenge using Timeweb Cloud.
+package timeweb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/go-acme/lego/v4/providers/dns/timeweb/internal"
+)
+
+// Environment variables names.
+const (
+	envNamespace = "TIMEWEB_"
+
+	EnvAccessToken = envNamespace + "ACCESS_TOKEN"
+
+	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
+	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
+	EnvHTTPTimeout        = envNamespace + "HTTP_TIMEOUT"
+)
+
+// Config is used to configure the creation of the DNSProvider.
+type Config struct {
+	AccessToken string
+
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+	HTTPClient         *http.Client
+}
+
+// NewDefaultConfig returns a default configuration for the DNSProvider.
+func NewDefaultConfig() *Config {
+	return &Config{
+		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
+		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		HTTPClient: &http.Client{
+			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+		},
+	}
+}
+
+type recordInfo struct {
+	zone string
+	id   int
+}
+
+// DNSProvider implements the challenge.Provider interface.
+type DNSProvider struct {
+	config *Config
+	client *internal.Client
+
+	records   map[string]recordInfo
+	recordsMu sync.Mutex
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for Timeweb Cloud.
+// Credentials must be passed in the environment variable: TIMEWEB_ACCESS_TOKEN.
+func NewDNSProvider() (*DNSProvider, error) {
+	values, err := env.Get(EnvAccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("timeweb: %w", err)
+	}
+
+	config := NewDefaultConfig()
+	config.AccessToken = values[EnvAccessToken]
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewDNSProviderConfig return a DNSProvider instance configured for Timeweb Cloud.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("timeweb: the configuration of the DNS provider is nil")
+	}
+
+	client, err := internal.NewClient(config.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("timeweb: %w", err)
+	}
+
+	if config.HTTPClient != nil {
+		client.HTTPClient = config.HTTPClient
+	}
+
+	return &DNSProvider{
+		config:  config,
+		client:  client,
+		records: make(map[string]recordInfo),
+	}, nil
+}
+
+// Timeout returns the timeout and interval to use when checking for DNS propagation.
+// Adjusting here to cope with spikes in propagation times.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
+
+// Present creates a TXT record using the specified parameters.
+func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	zone, err := d.findZone(ctx, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("timeweb: %w", err)
+	}
+
+	subDomain, err := dns01.ExtractSubDomain(strings.ToLower(info.EffectiveFQDN), zone)
+	if err != nil {
+		return fmt.Errorf("timeweb: %w", err)
+	}
+
+	record := internal.DNSRecordRequest{
+		Type:      "TXT",
+		Value:     info.Value,
+		Subdomain: subDomain,
+	}
+
+	newRecord, err := d.client.CreateRecord(ctx, zone, record)
+	if err != nil {
+		return fmt.Errorf("timeweb: create record: %w", err)
+	}
+
+	d.recordsMu.Lock()
+	d.records[token] = recordInfo{zone: zone, id: newRecord.ID}
+	d.recordsMu.Unlock()
+
+	return nil
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	d.recordsMu.Lock()
+	record, ok := d.records[token]
+	d.recordsMu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("timeweb: unknown record ID for '%s'", info.EffectiveFQDN)
+	}
+
+	err := d.client.DeleteRecord(context.Background(), record.zone, record.id)
+	if err != nil {
+		return fmt.Errorf("timeweb: delete record: %w", err)
+	}
+
+	d.recordsMu.Lock()
+	delete(d.records, token)
+	d.recordsMu.Unlock()
+
+	return nil
+}
+
+// findZone returns the most specific domain, of the account, containing the FQDN.
+func (d *DNSProvider) findZone(ctx context.Context, fqdn string) (string, error) {
+	domains, err := d.client.ListDomains(ctx)
+	if err != nil {
+		return "", fmt.Errorf("list domains: %w", err)
+	}
+
+	var names []string
+	for _, domain := range domains {
+		names = append(names, domain.FQDN)
+	}
+
+	zone := dns01.FindMostSpecificZone(fqdn, names)
+	if zone == "" {
+		return "", fmt.Errorf("no zone found for %s", fqdn)
+	}
+
+	return dns01.UnFqdn(zone), nil
+}
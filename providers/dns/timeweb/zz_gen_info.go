@@ -0,0 +1,27 @@
+package timeweb
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/timeweb/timeweb.toml
+		Name:  "Timeweb Cloud",
+		Code:  "timeweb",
+		Since: "v4.18.0",
+		URL:   "https://timeweb.cloud/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "TIMEWEB_ACCESS_TOKEN", Description: "Access token", Credential: true},
+			{Name: "TIMEWEB_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "TIMEWEB_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "TIMEWEB_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://timeweb.cloud/api-docs",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package transip
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/transip/transip.toml
+		Name:  "TransIP",
+		Code:  "transip",
+		Since: "v2.0.0",
+		URL:   "https://www.transip.nl/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "TRANSIP_ACCOUNT_NAME", Description: "Account name", Credential: true},
+			{Name: "TRANSIP_PRIVATE_KEY_PATH", Description: "Private key path", Credential: true},
+			{Name: "TRANSIP_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "TRANSIP_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "TRANSIP_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.transip.eu/rest/docs.html",
+			GoClient: "https://github.com/transip/gotransip",
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package ultradns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/ultradns/ultradns.toml
+		Name:  "Ultradns",
+		Code:  "ultradns",
+		Since: "v4.10.0",
+		URL:   "https://vercara.com/authoritative-dns",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "ULTRADNS_PASSWORD", Description: "API Password", Credential: true},
+			{Name: "ULTRADNS_USERNAME", Description: "API Username", Credential: true},
+			{Name: "ULTRADNS_ENDPOINT", Description: "API endpoint URL, defaults to https://api.ultradns.com/"},
+			{Name: "ULTRADNS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "ULTRADNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "ULTRADNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://ultra-portalstatic.ultradns.com/static/docs/REST-API_User_Guide.pdf",
+			GoClient: "https://github.com/ultradns/ultradns-go-sdk",
+		},
+	}
+}
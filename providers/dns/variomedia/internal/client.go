@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 const defaultBaseURL = "https://api.variomedia.de"
@@ -21,7 +22,7 @@ const authorizationHeader = "Authorization"
 type Client struct {
 	apiToken string
 
-	baseURL    *url.URL
+	BaseURL    *url.URL
 	HTTPClient *http.Client
 }
 
@@ -31,7 +32,7 @@ func NewClient(apiToken string) *Client {
 
 	return &Client{
 		apiToken:   apiToken,
-		baseURL:    baseURL,
+		BaseURL:    baseURL,
 		HTTPClient: &http.Client{Timeout: 10 * time.Second},
 	}
 }
@@ -39,7 +40,7 @@ func NewClient(apiToken string) *Client {
 // CreateDNSRecord creates a new DNS entry.
 // https://api.variomedia.de/docs/dns-records.html#erstellen
 func (c Client) CreateDNSRecord(ctx context.Context, record DNSRecord) (*CreateDNSRecordResponse, error) {
-	endpoint := c.baseURL.JoinPath("dns-records")
+	endpoint := c.BaseURL.JoinPath("dns-records")
 
 	data := CreateDNSRecordRequest{Data: Data{
 		Type:       "dns-record",
@@ -63,7 +64,7 @@ func (c Client) CreateDNSRecord(ctx context.Context, record DNSRecord) (*CreateD
 // DeleteDNSRecord deletes a DNS record.
 // https://api.variomedia.de/docs/dns-records.html#l%C3%B6schen
 func (c Client) DeleteDNSRecord(ctx context.Context, id string) (*DeleteRecordResponse, error) {
-	endpoint := c.baseURL.JoinPath("dns-records", id)
+	endpoint := c.BaseURL.JoinPath("dns-records", id)
 
 	req, err := newJSONRequest(ctx, http.MethodDelete, endpoint, nil)
 	if err != nil {
@@ -82,7 +83,7 @@ func (c Client) DeleteDNSRecord(ctx context.Context, id string) (*DeleteRecordRe
 // GetJob returns a single job based on its ID.
 // https://api.variomedia.de/docs/job-queue.html
 func (c Client) GetJob(ctx context.Context, id string) (*GetJobResponse, error) {
-	endpoint := c.baseURL.JoinPath("queue-jobs", id)
+	endpoint := c.BaseURL.JoinPath("queue-jobs", id)
 
 	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
@@ -140,6 +141,8 @@ func newJSONRequest(ctx context.Context, method string, endpoint *url.URL, paylo
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Accept", "application/vnd.variomedia.v1+json")
 
 	if payload != nil {
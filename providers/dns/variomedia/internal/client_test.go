@@ -22,7 +22,7 @@ func setupTest(t *testing.T) (*Client, *http.ServeMux) {
 	t.Cleanup(server.Close)
 
 	client := NewClient("secret")
-	client.baseURL, _ = url.Parse(server.URL)
+	client.BaseURL, _ = url.Parse(server.URL)
 
 	return client, mux
 }
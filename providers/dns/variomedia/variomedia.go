@@ -6,7 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"strings"
+	"path"
 	"sync"
 	"time"
 
@@ -45,8 +45,8 @@ type Config struct {
 func NewDefaultConfig() *Config {
 	return &Config{
 		TTL:                env.GetOrDefaultInt(EnvTTL, 300),
-		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, dns01.DefaultPropagationTimeout),
-		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, dns01.DefaultPollingInterval),
+		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 5*time.Minute),
+		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 10*time.Second),
 		SequenceInterval:   env.GetOrDefaultSecond(EnvSequenceInterval, dns01.DefaultPropagationTimeout),
 		HTTPClient: &http.Client{
 			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
@@ -61,6 +61,8 @@ type DNSProvider struct {
 
 	recordIDs   map[string]string
 	recordIDsMu sync.Mutex
+
+	findZoneByFqdn func(fqdn string) (string, error)
 }
 
 // NewDNSProvider returns a DNSProvider instance.
@@ -92,11 +94,13 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		config:    config,
 		client:    client,
 		recordIDs: make(map[string]string),
+
+		findZoneByFqdn: dns01.FindZoneByFqdn,
 	}, nil
 }
 
 // Timeout returns the timeout and interval to use when checking for DNS propagation.
-// Adjusting here to cope with spikes in propagation times.
+// Variomedia applies the changes asynchronously (queue jobs), so the default timeout is longer than usual.
 func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 	return d.config.PropagationTimeout, d.config.PollingInterval
 }
@@ -111,7 +115,7 @@ func (d *DNSProvider) Sequential() time.Duration {
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	authZone, err := d.findZoneByFqdn(info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("variomedia: could not find zone for domain %q: %w", domain, err)
 	}
@@ -141,8 +145,13 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		return fmt.Errorf("variomedia: %w", err)
 	}
 
+	recordID := path.Base(cdrr.Data.Links.DNSRecord)
+	if recordID == "" || recordID == "." || recordID == "/" {
+		return fmt.Errorf("variomedia: missing record ID in the link %q", cdrr.Data.Links.DNSRecord)
+	}
+
 	d.recordIDsMu.Lock()
-	d.recordIDs[token] = strings.TrimPrefix(cdrr.Data.Links.DNSRecord, "https://api.variomedia.de/dns-records/")
+	d.recordIDs[token] = recordID
 	d.recordIDsMu.Unlock()
 
 	return nil
@@ -172,11 +181,17 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 		return fmt.Errorf("variomedia: %w", err)
 	}
 
+	d.recordIDsMu.Lock()
+	delete(d.recordIDs, token)
+	d.recordIDsMu.Unlock()
+
 	return nil
 }
 
 func (d *DNSProvider) waitJob(ctx context.Context, domain string, id string) error {
-	return wait.For("variomedia: apply change on "+domain, d.config.PropagationTimeout, d.config.PollingInterval, func() (bool, error) {
+	var errJob error
+
+	err := wait.For("the job "+id+" on "+domain, d.config.PropagationTimeout, d.config.PollingInterval, func() (bool, error) {
 		result, err := d.client.GetJob(ctx, id)
 		if err != nil {
 			return false, err
@@ -184,6 +199,20 @@ func (d *DNSProvider) waitJob(ctx context.Context, domain string, id string) err
 
 		log.Infof("variomedia: [%s] %s: %s %s", domain, result.Data.ID, result.Data.Attributes.JobType, result.Data.Attributes.Status)
 
-		return result.Data.Attributes.Status == "done", nil
+		switch result.Data.Attributes.Status {
+		case "done":
+			return true, nil
+		case "failed":
+			// Stops the polling: the job will not be applied.
+			errJob = fmt.Errorf("the job %s failed", result.Data.ID)
+			return true, nil
+		default:
+			return false, nil
+		}
 	})
+	if err != nil {
+		return err
+	}
+
+	return errJob
 }
@@ -1,10 +1,20 @@
 package variomedia
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/go-acme/lego/v4/challenge"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-acme/lego/v4/providers/dns/variomedia/internal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -85,6 +95,196 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+// fakeAPI simulates the asynchronous application of the changes:
+// a job is pending for the first checks, then done.
+type fakeAPI struct {
+	mu sync.Mutex
+
+	pendingChecks int
+	failJobs      bool
+
+	records map[string]internal.DNSRecord
+	jobs    map[string]*fakeJob
+	nextID  int
+}
+
+type fakeJob struct {
+	checks int
+	apply  func()
+}
+
+func setupTest(t *testing.T, pendingChecks int) (*DNSProvider, *fakeAPI) {
+	t.Helper()
+
+	api := &fakeAPI{
+		pendingChecks: pendingChecks,
+		records:       map[string]internal.DNSRecord{},
+		jobs:          map[string]*fakeJob{},
+	}
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("POST /dns-records", func(rw http.ResponseWriter, req *http.Request) {
+		var data internal.CreateDNSRecordRequest
+
+		err := json.NewDecoder(req.Body).Decode(&data)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		recordID := api.newID()
+		jobID := api.newID()
+
+		// The record exists only when the job is applied.
+		api.jobs[jobID] = &fakeJob{apply: func() { api.records[recordID] = data.Data.Attributes }}
+
+		rw.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(rw, `{"data":{"type":"queue-job","id":%q,"attributes":{"status":"pending"},"links":{"queue-job":"%s/queue-jobs/%s","dns-record":"%s/dns-records/%s"}}}`,
+			jobID, server.URL, jobID, server.URL, recordID)
+	})
+
+	mux.HandleFunc("DELETE /dns-records/{id}", func(rw http.ResponseWriter, req *http.Request) {
+		recordID := req.PathValue("id")
+
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		if _, ok := api.records[recordID]; !ok {
+			rw.WriteHeader(http.StatusNotFound)
+			_, _ = rw.Write([]byte(`{"errors":[{"status":"404","title":"not found","id":"1"}]}`))
+			return
+		}
+
+		jobID := api.newID()
+
+		api.jobs[jobID] = &fakeJob{apply: func() { delete(api.records, recordID) }}
+
+		rw.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprintf(rw, `{"data":{"type":"queue-job","id":%q,"attributes":{"status":"pending"}}}`, jobID)
+	})
+
+	mux.HandleFunc("GET /queue-jobs/{id}", func(rw http.ResponseWriter, req *http.Request) {
+		jobID := req.PathValue("id")
+
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		job, ok := api.jobs[jobID]
+		if !ok {
+			http.NotFound(rw, req)
+			return
+		}
+
+		job.checks++
+
+		status := "pending"
+
+		switch {
+		case job.checks <= api.pendingChecks:
+		case api.failJobs:
+			status = "failed"
+		default:
+			if job.apply != nil {
+				job.apply()
+				job.apply = nil
+			}
+
+			status = "done"
+		}
+
+		_, _ = fmt.Fprintf(rw, `{"data":{"id":%q,"type":"queue-job","attributes":{"job_type":"dns-record","status":%q}}}`, jobID, status)
+	})
+
+	config := NewDefaultConfig()
+	config.APIToken = "secret"
+	config.PropagationTimeout = 5 * time.Second
+	config.PollingInterval = 10 * time.Millisecond
+	config.HTTPClient = server.Client()
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.client.BaseURL, _ = url.Parse(server.URL)
+	provider.findZoneByFqdn = func(_ string) (string, error) {
+		return "example.com.", nil
+	}
+
+	return provider, api
+}
+
+func (f *fakeAPI) newID() string {
+	f.nextID++
+	return strconv.Itoa(f.nextID)
+}
+
+func TestDNSProvider_Present_CleanUp(t *testing.T) {
+	provider, api := setupTest(t, 3)
+
+	err := provider.Present("example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	// Present returns when the job is applied.
+	require.Len(t, api.records, 1)
+
+	record := api.records["1"]
+	assert.Equal(t, "TXT", record.RecordType)
+	assert.Equal(t, "_acme-challenge", record.Name)
+	assert.Equal(t, "example.com", record.Domain)
+	assert.Equal(t, 300, record.TTL)
+
+	assert.Equal(t, 4, api.jobs["2"].checks)
+
+	err = provider.CleanUp("example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	// CleanUp returns when the job is applied.
+	assert.Empty(t, api.records)
+
+	assert.Equal(t, 4, api.jobs["3"].checks)
+}
+
+func TestDNSProvider_Present_jobFailed(t *testing.T) {
+	provider, api := setupTest(t, 1)
+	api.failJobs = true
+
+	err := provider.Present("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "variomedia: the job 2 failed")
+
+	assert.Empty(t, api.records)
+}
+
+func TestDNSProvider_Present_jobTimeout(t *testing.T) {
+	provider, _ := setupTest(t, 1000)
+	provider.config.PropagationTimeout = 100 * time.Millisecond
+
+	err := provider.Present("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "variomedia: the job 2 on example.com: time limit exceeded")
+}
+
+func TestDNSProvider_CleanUp_unknownRecord(t *testing.T) {
+	provider, _ := setupTest(t, 0)
+
+	err := provider.CleanUp("example.com", "token", "keyAuth")
+	require.ErrorContains(t, err, "variomedia: unknown record ID for")
+}
+
+func TestDNSProvider_Timeout(t *testing.T) {
+	provider, err := NewDNSProviderConfig(&Config{APIToken: "secret", PropagationTimeout: 5 * time.Minute, PollingInterval: 10 * time.Second})
+	require.NoError(t, err)
+
+	var p challenge.ProviderTimeout = provider
+
+	timeout, interval := p.Timeout()
+	assert.Equal(t, 5*time.Minute, timeout)
+	assert.Equal(t, 10*time.Second, interval)
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -0,0 +1,29 @@
+package variomedia
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/variomedia/variomedia.toml
+		Name:  "Variomedia",
+		Code:  "variomedia",
+		Since: "v4.8.0",
+		URL:   "https://www.variomedia.de/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "VARIOMEDIA_API_TOKEN", Description: "API token", Credential: true},
+			{Name: "VARIOMEDIA_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "VARIOMEDIA_POLLING_INTERVAL", Description: "Time between DNS propagation check, and between the checks of the queue jobs (default: 10 seconds)"},
+			{Name: "VARIOMEDIA_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation, and for the application of the queue jobs (default: 5 minutes)"},
+			{Name: "VARIOMEDIA_SEQUENCE_INTERVAL", Description: "Time between sequential requests"},
+			{Name: "VARIOMEDIA_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.variomedia.de/docs/dns-records.html",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package vegadns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/vegadns/vegadns.toml
+		Name:  "VegaDNS",
+		Code:  "vegadns",
+		Since: "v1.1.0",
+		URL:   "https://github.com/shupp/VegaDNS-API",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "SECRET_VEGADNS_KEY", Description: "API key", Credential: true},
+			{Name: "SECRET_VEGADNS_SECRET", Description: "API secret", Credential: true},
+			{Name: "VEGADNS_URL", Description: "API endpoint URL", Credential: true},
+			{Name: "VEGADNS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "VEGADNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "VEGADNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://github.com/shupp/VegaDNS-API",
+			GoClient: "https://github.com/OpenDNS/vegadns2client",
+		},
+	}
+}
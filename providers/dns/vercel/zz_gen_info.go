@@ -0,0 +1,29 @@
+package vercel
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/vercel/vercel.toml
+		Name:  "Vercel",
+		Code:  "vercel",
+		Since: "v4.7.0",
+		URL:   "https://vercel.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "VERCEL_API_TOKEN", Description: "Authentication token", Credential: true},
+			{Name: "VERCEL_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "VERCEL_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "VERCEL_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "VERCEL_TEAM_ID", Description: "Team ID (ex: team_xxxxxxxxxxxxxxxxxxxxxxxx)"},
+			{Name: "VERCEL_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://vercel.com/docs/rest-api#endpoints/dns",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package versio
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/versio/versio.toml
+		Name:  "Versio.[nl|eu|uk]",
+		Code:  "versio",
+		Since: "v2.7.0",
+		URL:   "https://www.versio.nl/domeinnamen",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "VERSIO_PASSWORD", Description: "Basic authentication password", Credential: true},
+			{Name: "VERSIO_USERNAME", Description: "Basic authentication username", Credential: true},
+			{Name: "VERSIO_ENDPOINT", Description: "The endpoint URL of the API Server"},
+			{Name: "VERSIO_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "VERSIO_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "VERSIO_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "VERSIO_SEQUENCE_INTERVAL", Description: "Time between sequential requests, default 60s"},
+			{Name: "VERSIO_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.versio.nl/RESTapidoc/",
+			GoClient: "",
+		},
+	}
+}
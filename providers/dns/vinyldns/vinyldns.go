@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 	"github.com/vinyldns/go-vinyldns/vinyldns"
 )
 
@@ -84,7 +85,7 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 		AccessKey: config.AccessKey,
 		SecretKey: config.SecretKey,
 		Host:      config.Host,
-		UserAgent: "go-acme/lego",
+		UserAgent: useragent.Get(),
 	})
 
 	client.HTTPClient.Timeout = 30 * time.Second
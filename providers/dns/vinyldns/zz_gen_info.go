@@ -0,0 +1,29 @@
+package vinyldns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/vinyldns/vinyldns.toml
+		Name:  "VinylDNS",
+		Code:  "vinyldns",
+		Since: "v4.4.0",
+		URL:   "https://www.vinyldns.io",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "VINYLDNS_ACCESS_KEY", Description: "The VinylDNS API key", Credential: true},
+			{Name: "VINYLDNS_HOST", Description: "The VinylDNS API URL", Credential: true},
+			{Name: "VINYLDNS_SECRET_KEY", Description: "The VinylDNS API Secret key", Credential: true},
+			{Name: "VINYLDNS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "VINYLDNS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "VINYLDNS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.vinyldns.io/api/",
+			GoClient: "https://github.com/vinyldns/go-vinyldns",
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package vkcloud
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/vkcloud/vkcloud.toml
+		Name:  "VK Cloud",
+		Code:  "vkcloud",
+		Since: "v4.9.0",
+		URL:   "https://mcs.mail.ru/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "VK_CLOUD_PASSWORD", Description: "Password for VK Cloud account", Credential: true},
+			{Name: "VK_CLOUD_PROJECT_ID", Description: "String ID of project in VK Cloud", Credential: true},
+			{Name: "VK_CLOUD_USERNAME", Description: "Email of VK Cloud account", Credential: true},
+			{Name: "VK_CLOUD_DNS_ENDPOINT", Description: "URL of DNS API. Defaults to https://mcs.mail.ru/public-dns but can be changed for usage with private clouds"},
+			{Name: "VK_CLOUD_DOMAIN_NAME", Description: "Openstack users domain name. Defaults to `users` but can be changed for usage with private clouds"},
+			{Name: "VK_CLOUD_IDENTITY_ENDPOINT", Description: "URL of OpenStack Auth API, Defaults to https://infra.mail.ru:35357/v3/ but can be changed for usage with private clouds"},
+			{Name: "VK_CLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "VK_CLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "VK_CLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://mcs.mail.ru/docs/networks/vnet/networks/publicdns/api",
+			GoClient: "",
+		},
+	}
+}
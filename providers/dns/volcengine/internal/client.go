@@ -0,0 +1,170 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
+)
+
+const (
+	defaultBaseURL = "https://open.volcengineapi.com"
+
+	// DefaultRegion the default region of the API.
+	DefaultRegion = "cn-north-1"
+
+	apiVersion = "2018-08-01"
+	service    = "DNS"
+)
+
+// Client the Volcengine DNS API client.
+type Client struct {
+	signer signer
+
+	now func() time.Time
+
+	BaseURL    *url.URL
+	HTTPClient *http.Client
+}
+
+// NewClient creates a new Client.
+func NewClient(accessKey, secretKey, region string) (*Client, error) {
+	if accessKey == "" || secretKey == "" {
+		return nil, errors.New("credentials missing")
+	}
+
+	if region == "" {
+		region = DefaultRegion
+	}
+
+	baseURL, _ := url.Parse(defaultBaseURL)
+
+	return &Client{
+		signer: signer{
+			accessKey: accessKey,
+			secretKey: secretKey,
+			region:    region,
+			service:   service,
+		},
+		now:        time.Now,
+		BaseURL:    baseURL,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// ListZones lists the zones.
+// https://www.volcengine.com/docs/6758/155100
+func (c *Client) ListZones(ctx context.Context, request ListZonesRequest) (*ListZonesResult, error) {
+	result := &ListZonesResult{}
+
+	err := c.do(ctx, "ListZones", request, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CreateRecord creates a record.
+// https://www.volcengine.com/docs/6758/155104
+func (c *Client) CreateRecord(ctx context.Context, record Record) (*CreateRecordResult, error) {
+	result := &CreateRecordResult{}
+
+	err := c.do(ctx, "CreateRecord", record, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DeleteRecord deletes a record.
+// https://www.volcengine.com/docs/6758/155105
+func (c *Client) DeleteRecord(ctx context.Context, recordID string) error {
+	return c.do(ctx, "DeleteRecord", DeleteRecordRequest{RecordID: recordID}, nil)
+}
+
+func (c *Client) do(ctx context.Context, action string, payload, result any) error {
+	req, body, err := c.newJSONRequest(ctx, action, payload)
+	if err != nil {
+		return err
+	}
+
+	c.signer.sign(req, body, c.now())
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return errutils.NewHTTPDoError(req, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errutils.NewReadResponseError(req, resp.StatusCode, err)
+	}
+
+	var response APIResponse
+
+	err = json.Unmarshal(raw, &response)
+	if err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return errutils.NewUnexpectedStatusCodeError(req, resp.StatusCode, raw)
+		}
+
+		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
+	}
+
+	if response.ResponseMetadata.Error != nil {
+		return response.ResponseMetadata.Error
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return errutils.NewUnexpectedStatusCodeError(req, resp.StatusCode, raw)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	err = json.Unmarshal(response.Result, result)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
+	}
+
+	return nil
+}
+
+func (c *Client) newJSONRequest(ctx context.Context, action string, payload any) (*http.Request, []byte, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request JSON body: %w", err)
+	}
+
+	endpoint := c.BaseURL.JoinPath("/")
+
+	query := endpoint.Query()
+	query.Set("Action", action)
+	query.Set("Version", apiVersion)
+	endpoint.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create request: %w", err)
+	}
+
+	useragent.SetHeader(req.Header)
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, body, nil
+}
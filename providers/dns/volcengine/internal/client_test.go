@@ -0,0 +1,158 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTest(t *testing.T, action string, status int, filename, expectedRequest string) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("POST /", func(rw http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+
+		if query.Get("Action") != action || query.Get("Version") != apiVersion {
+			http.Error(rw, fmt.Sprintf("invalid query: %s", req.URL.RawQuery), http.StatusBadRequest)
+			return
+		}
+
+		auth := req.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "HMAC-SHA256 Credential=user/20240102/cn-north-1/DNS/request, SignedHeaders=content-type;host;x-content-sha256;x-date, Signature=") {
+			http.Error(rw, fmt.Sprintf("invalid Authorization header: %q", auth), http.StatusUnauthorized)
+			return
+		}
+
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if req.Header.Get(headerContentSHA256) != hashSHA256(body) {
+			http.Error(rw, "invalid payload hash", http.StatusBadRequest)
+			return
+		}
+
+		if expectedRequest != "" {
+			expected, err := os.ReadFile(filepath.Join("fixtures", expectedRequest))
+			if err != nil {
+				http.Error(rw, err.Error(), http.StatusInternalServerError)
+				return
+			}
+
+			if !jsonEqual(expected, body) {
+				http.Error(rw, fmt.Sprintf("invalid request body: %s", string(body)), http.StatusBadRequest)
+				return
+			}
+		}
+
+		file, err := os.Open(filepath.Join("fixtures", filename))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		defer func() { _ = file.Close() }()
+
+		rw.WriteHeader(status)
+
+		_, err = io.Copy(rw, file)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	client, err := NewClient("user", "secret", "")
+	require.NoError(t, err)
+
+	client.BaseURL, _ = url.Parse(server.URL)
+	client.HTTPClient = server.Client()
+	client.now = func() time.Time {
+		return time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)
+	}
+
+	return client
+}
+
+func jsonEqual(a, b []byte) bool {
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(va, vb)
+}
+
+func TestClient_ListZones(t *testing.T) {
+	client := setupTest(t, "ListZones", http.StatusOK, "list_zones.json", "list_zones-request.json")
+
+	result, err := client.ListZones(context.Background(), ListZonesRequest{PageNumber: 1, PageSize: 100})
+	require.NoError(t, err)
+
+	expected := &ListZonesResult{
+		Zones: []Zone{
+			{ZID: 123, ZoneName: "example.com"},
+			{ZID: 456, ZoneName: "sub.example.com"},
+		},
+		Total: 2,
+	}
+
+	assert.Equal(t, expected, result)
+}
+
+func TestClient_ListZones_error(t *testing.T) {
+	client := setupTest(t, "ListZones", http.StatusUnauthorized, "error.json", "list_zones-request.json")
+
+	_, err := client.ListZones(context.Background(), ListZonesRequest{PageNumber: 1, PageSize: 100})
+	require.EqualError(t, err, "RecordNotFound: The record does not exist.")
+}
+
+func TestClient_CreateRecord(t *testing.T) {
+	client := setupTest(t, "CreateRecord", http.StatusOK, "create_record.json", "create_record-request.json")
+
+	record := Record{
+		ZID:   123,
+		Host:  "_acme-challenge",
+		Type:  "TXT",
+		Value: "txtTXTtxt",
+		TTL:   600,
+	}
+
+	result, err := client.CreateRecord(context.Background(), record)
+	require.NoError(t, err)
+
+	assert.Equal(t, &CreateRecordResult{RecordID: "789"}, result)
+}
+
+func TestClient_DeleteRecord(t *testing.T) {
+	client := setupTest(t, "DeleteRecord", http.StatusOK, "delete_record.json", "delete_record-request.json")
+
+	err := client.DeleteRecord(context.Background(), "789")
+	require.NoError(t, err)
+}
+
+func TestClient_DeleteRecord_error(t *testing.T) {
+	client := setupTest(t, "DeleteRecord", http.StatusNotFound, "error.json", "delete_record-request.json")
+
+	err := client.DeleteRecord(context.Background(), "789")
+	require.EqualError(t, err, "RecordNotFound: The record does not exist.")
+}
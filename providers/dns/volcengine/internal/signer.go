@@ -0,0 +1,147 @@
+package internal
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	signingAlgorithm = "HMAC-SHA256"
+
+	headerDate          = "X-Date"
+	headerContentSHA256 = "X-Content-Sha256"
+
+	dateTimeFormat = "20060102T150405Z"
+	dateFormat     = "20060102"
+	scopeTerminal  = "request"
+)
+
+// signer signs the requests with the Volcengine signature V4 (HMAC-SHA256).
+// https://www.volcengine.com/docs/6369/67269
+type signer struct {
+	accessKey string
+	secretKey string
+	region    string
+	service   string
+}
+
+// sign adds the signature headers to the request.
+// The body must be the payload of the request.
+func (s signer) sign(req *http.Request, body []byte, now time.Time) {
+	now = now.UTC()
+
+	payloadHash := hashSHA256(body)
+
+	req.Header.Set(headerDate, now.Format(dateTimeFormat))
+	req.Header.Set(headerContentSHA256, payloadHash)
+
+	signedHeaders, canonical := canonicalRequest(req, payloadHash)
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, s.accessKey, s.credentialScope(now), signedHeaders, s.signature(canonical, now)))
+}
+
+func (s signer) signature(canonicalRequest string, now time.Time) string {
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		now.Format(dateTimeFormat),
+		s.credentialScope(now),
+		hashSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	return hex.EncodeToString(hmacSHA256(s.signingKey(now), stringToSign))
+}
+
+func (s signer) credentialScope(now time.Time) string {
+	return strings.Join([]string{now.Format(dateFormat), s.region, s.service, scopeTerminal}, "/")
+}
+
+func (s signer) signingKey(now time.Time) []byte {
+	kDate := hmacSHA256([]byte(s.secretKey), now.Format(dateFormat))
+	kRegion := hmacSHA256(kDate, s.region)
+	kService := hmacSHA256(kRegion, s.service)
+
+	return hmacSHA256(kService, scopeTerminal)
+}
+
+// canonicalRequest returns the signed headers and the canonical request.
+func canonicalRequest(req *http.Request, payloadHash string) (string, string) {
+	signedHeaders, headers := canonicalHeaders(req)
+
+	return signedHeaders, strings.Join([]string{
+		req.Method,
+		canonicalURI(req.URL),
+		canonicalQuery(req.URL),
+		headers,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+}
+
+// canonicalHeaders returns the signed headers and the canonical headers.
+// The signed headers are the Host, the Content-Type, and the X-* headers.
+func canonicalHeaders(req *http.Request) (string, string) {
+	headers := map[string]string{
+		"host": req.URL.Host,
+	}
+
+	if req.Host != "" {
+		headers["host"] = req.Host
+	}
+
+	for key, values := range req.Header {
+		name := strings.ToLower(key)
+		if name != "content-type" && !strings.HasPrefix(name, "x-") {
+			continue
+		}
+
+		headers[name] = strings.TrimSpace(strings.Join(values, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	for _, name := range names {
+		canonical.WriteString(name + ":" + headers[name] + "\n")
+	}
+
+	return strings.Join(names, ";"), canonical.String()
+}
+
+func canonicalURI(u *url.URL) string {
+	path := u.EscapedPath()
+	if path == "" {
+		return "/"
+	}
+
+	return path
+}
+
+// canonicalQuery returns the query sorted by keys, with the spaces encoded as %20.
+func canonicalQuery(u *url.URL) string {
+	return strings.ReplaceAll(u.Query().Encode(), "+", "%20")
+}
+
+func hashSHA256(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
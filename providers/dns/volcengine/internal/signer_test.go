@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSignerTestRequest(t *testing.T) (*http.Request, []byte) {
+	t.Helper()
+
+	body := []byte(`{"Key":"example.com"}`)
+
+	req, err := http.NewRequest(http.MethodPost, "https://open.volcengineapi.com/?Version=2018-08-01&Action=ListZones", bytes.NewReader(body))
+	require.NoError(t, err)
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "lego-test")
+
+	return req, body
+}
+
+func Test_canonicalRequest(t *testing.T) {
+	req, body := newSignerTestRequest(t)
+
+	payloadHash := hashSHA256(body)
+	assert.Equal(t, "016538b03814033ca2d42d6e01e5e7d62877fe32d9e2489ad292de676e6e3829", payloadHash)
+
+	req.Header.Set(headerDate, "20240102T030405Z")
+	req.Header.Set(headerContentSHA256, payloadHash)
+
+	signedHeaders, canonical := canonicalRequest(req, payloadHash)
+
+	assert.Equal(t, "content-type;host;x-content-sha256;x-date", signedHeaders)
+
+	// The query is sorted, and the unsigned headers (Accept, User-Agent) are ignored.
+	expected := "POST\n" +
+		"/\n" +
+		"Action=ListZones&Version=2018-08-01\n" +
+		"content-type:application/json\n" +
+		"host:open.volcengineapi.com\n" +
+		"x-content-sha256:016538b03814033ca2d42d6e01e5e7d62877fe32d9e2489ad292de676e6e3829\n" +
+		"x-date:20240102T030405Z\n" +
+		"\n" +
+		"content-type;host;x-content-sha256;x-date\n" +
+		"016538b03814033ca2d42d6e01e5e7d62877fe32d9e2489ad292de676e6e3829"
+
+	assert.Equal(t, expected, canonical)
+	assert.Equal(t, "07dd1d752221c625fb7a6915ebf7a927f9ed4564888d9e4392e62cf2de36a10a", hashSHA256([]byte(canonical)))
+}
+
+func Test_canonicalQuery(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://open.volcengineapi.com/?b=a%20b&a=c+d&a=b", http.NoBody)
+	require.NoError(t, err)
+
+	assert.Equal(t, "a=c%20d&a=b&b=a%20b", canonicalQuery(req.URL))
+}
+
+func Test_signer_sign(t *testing.T) {
+	req, body := newSignerTestRequest(t)
+
+	s := signer{
+		accessKey: "AKLTtest",
+		secretKey: "secret",
+		region:    "cn-north-1",
+		service:   "DNS",
+	}
+
+	s.sign(req, body, time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC))
+
+	assert.Equal(t, "20240102T030405Z", req.Header.Get(headerDate))
+	assert.Equal(t, "016538b03814033ca2d42d6e01e5e7d62877fe32d9e2489ad292de676e6e3829", req.Header.Get(headerContentSHA256))
+
+	expected := "HMAC-SHA256 Credential=AKLTtest/20240102/cn-north-1/DNS/request, " +
+		"SignedHeaders=content-type;host;x-content-sha256;x-date, " +
+		"Signature=042d3bd2c0423c846a90538123c2d3d39794f930b1be7c378754e89be8b25ed5"
+
+	assert.Equal(t, expected, req.Header.Get("Authorization"))
+}
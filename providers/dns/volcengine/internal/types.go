@@ -0,0 +1,61 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type APIResponse struct {
+	ResponseMetadata ResponseMetadata `json:"ResponseMetadata"`
+	Result           json.RawMessage  `json:"Result"`
+}
+
+type ResponseMetadata struct {
+	RequestID string     `json:"RequestId"`
+	Action    string     `json:"Action"`
+	Version   string     `json:"Version"`
+	Service   string     `json:"Service"`
+	Region    string     `json:"Region"`
+	Error     *ErrorInfo `json:"Error,omitempty"`
+}
+
+type ErrorInfo struct {
+	Code    string `json:"Code"`
+	Message string `json:"Message"`
+}
+
+func (e *ErrorInfo) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+type ListZonesRequest struct {
+	Key        string `json:"Key,omitempty"`
+	PageNumber int    `json:"PageNumber,omitempty"`
+	PageSize   int    `json:"PageSize,omitempty"`
+}
+
+type ListZonesResult struct {
+	Zones []Zone `json:"Zones"`
+	Total int    `json:"Total"`
+}
+
+type Zone struct {
+	ZID      int64  `json:"ZID"`
+	ZoneName string `json:"ZoneName"`
+}
+
+type Record struct {
+	ZID   int64  `json:"ZID"`
+	Host  string `json:"Host"`
+	Type  string `json:"Type"`
+	Value string `json:"Value"`
+	TTL   int    `json:"TTL,omitempty"`
+}
+
+type CreateRecordResult struct {
+	RecordID string `json:"RecordID"`
+}
+
+type DeleteRecordRequest struct {
+	RecordID string `json:"RecordID"`
+}
@@ -0,0 +1,202 @@
+// Package volcengine implements a DNS provider for solving the DNS-01 challenge using Volcengine DNS.
+package volcengine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/go-acme/lego/v4/providers/dns/volcengine/internal"
+)
+
+// Environment variables names.
+const (
+	envNamespace = "VOLC_"
+
+	EnvAccessKey = envNamespace + "ACCESSKEY"
+	EnvSecretKey = envNamespace + "SECRETKEY"
+	EnvRegion    = envNamespace + "REGION"
+
+	EnvTTL                = envNamespace + "TTL"
+	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
+	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
+	EnvHTTPTimeout        = envNamespace + "HTTP_TIMEOUT"
+)
+
+// Config is used to configure the creation of the DNSProvider.
+type Config struct {
+	AccessKey string
+	SecretKey string
+	Region    string
+
+	TTL                int
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+	HTTPClient         *http.Client
+}
+
+// NewDefaultConfig returns a default configuration for the DNSProvider.
+func NewDefaultConfig() *Config {
+	return &Config{
+		Region:             env.GetOrDefaultString(EnvRegion, internal.DefaultRegion),
+		TTL:                env.GetOrDefaultInt(EnvTTL, 600),
+		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 240*time.Second),
+		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 10*time.Second),
+		HTTPClient: &http.Client{
+			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+		},
+	}
+}
+
+// DNSProvider implements the challenge.Provider interface.
+type DNSProvider struct {
+	config *Config
+	client *internal.Client
+
+	recordIDs   map[string]string
+	recordIDsMu sync.Mutex
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for Volcengine DNS.
+// Credentials must be passed in the environment variables:
+// VOLC_ACCESSKEY and VOLC_SECRETKEY.
+func NewDNSProvider() (*DNSProvider, error) {
+	values, err := env.Get(EnvAccessKey, EnvSecretKey)
+	if err != nil {
+		return nil, fmt.Errorf("volcengine: %w", err)
+	}
+
+	config := NewDefaultConfig()
+	config.AccessKey = values[EnvAccessKey]
+	config.SecretKey = values[EnvSecretKey]
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewDNSProviderConfig return a DNSProvider instance configured for Volcengine DNS.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("volcengine: the configuration of the DNS provider is nil")
+	}
+
+	client, err := internal.NewClient(config.AccessKey, config.SecretKey, config.Region)
+	if err != nil {
+		return nil, fmt.Errorf("volcengine: %w", err)
+	}
+
+	if config.HTTPClient != nil {
+		client.HTTPClient = config.HTTPClient
+	}
+
+	return &DNSProvider{
+		config:    config,
+		client:    client,
+		recordIDs: make(map[string]string),
+	}, nil
+}
+
+// Timeout returns the timeout and interval to use when checking for DNS propagation.
+// Adjusting here to cope with spikes in propagation times.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
+
+// Present creates a TXT record using the specified parameters.
+func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	zone, err := d.findZone(ctx, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("volcengine: %w", err)
+	}
+
+	subDomain, err := dns01.ExtractSubDomain(strings.ToLower(info.EffectiveFQDN), zone.ZoneName)
+	if err != nil {
+		return fmt.Errorf("volcengine: %w", err)
+	}
+
+	record := internal.Record{
+		ZID:   zone.ZID,
+		Host:  subDomain,
+		Type:  "TXT",
+		Value: info.Value,
+		TTL:   d.config.TTL,
+	}
+
+	result, err := d.client.CreateRecord(ctx, record)
+	if err != nil {
+		return fmt.Errorf("volcengine: create record: %w", err)
+	}
+
+	d.recordIDsMu.Lock()
+	d.recordIDs[token] = result.RecordID
+	d.recordIDsMu.Unlock()
+
+	return nil
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	d.recordIDsMu.Lock()
+	recordID, ok := d.recordIDs[token]
+	d.recordIDsMu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("volcengine: unknown record ID for '%s'", info.EffectiveFQDN)
+	}
+
+	err := d.client.DeleteRecord(context.Background(), recordID)
+	if err != nil {
+		return fmt.Errorf("volcengine: delete record: %w", err)
+	}
+
+	d.recordIDsMu.Lock()
+	delete(d.recordIDs, token)
+	d.recordIDsMu.Unlock()
+
+	return nil
+}
+
+// findZone returns the most specific zone, of the account, containing the FQDN.
+func (d *DNSProvider) findZone(ctx context.Context, fqdn string) (*internal.Zone, error) {
+	zones := make(map[string]internal.Zone)
+
+	var names []string
+
+	request := internal.ListZonesRequest{PageNumber: 1, PageSize: 100}
+
+	for count := 0; ; request.PageNumber++ {
+		result, err := d.client.ListZones(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("list zones: %w", err)
+		}
+
+		for _, z := range result.Zones {
+			zones[z.ZoneName] = z
+			names = append(names, z.ZoneName)
+		}
+
+		count += len(result.Zones)
+
+		if len(result.Zones) == 0 || count >= result.Total {
+			break
+		}
+	}
+
+	name := dns01.FindMostSpecificZone(fqdn, names)
+	if name == "" {
+		return nil, fmt.Errorf("no zone found for %s", fqdn)
+	}
+
+	return &internal.Zone{ZID: zones[name].ZID, ZoneName: strings.ToLower(dns01.UnFqdn(name))}, nil
+}
@@ -0,0 +1,30 @@
+package volcengine
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/volcengine/volcengine.toml
+		Name:  "Volcano Engine/火山引擎",
+		Code:  "volcengine",
+		Since: "v4.18.0",
+		URL:   "https://www.volcengine.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "VOLC_ACCESSKEY", Description: "Access Key ID (AK)", Credential: true},
+			{Name: "VOLC_SECRETKEY", Description: "Secret Access Key (SK)", Credential: true},
+			{Name: "VOLC_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "VOLC_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "VOLC_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "VOLC_REGION", Description: "Region (Default: cn-north-1)"},
+			{Name: "VOLC_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.volcengine.com/docs/6758/155086",
+			GoClient: "",
+		},
+	}
+}
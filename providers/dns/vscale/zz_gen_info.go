@@ -0,0 +1,29 @@
+package vscale
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/vscale/vscale.toml
+		Name:  "Vscale",
+		Code:  "vscale",
+		Since: "v2.0.0",
+		URL:   "https://vscale.io/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "VSCALE_API_TOKEN", Description: "API token", Credential: true},
+			{Name: "VSCALE_BASE_URL", Description: "API endpoint URL"},
+			{Name: "VSCALE_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "VSCALE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "VSCALE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "VSCALE_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://developers.vscale.io/documentation/api/v1/#api-Domains_Records",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package vultr
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/vultr/vultr.toml
+		Name:  "Vultr",
+		Code:  "vultr",
+		Since: "v0.3.1",
+		URL:   "https://www.vultr.com/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "VULTR_API_KEY", Description: "API key", Credential: true},
+			{Name: "VULTR_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "VULTR_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "VULTR_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "VULTR_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.vultr.com/api/#dns",
+			GoClient: "https://github.com/vultr/govultr",
+		},
+	}
+}
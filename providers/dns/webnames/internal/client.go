@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 const defaultBaseURL = "https://www.webnames.ru/scripts/json_domain_zone_manager.pl"
@@ -19,7 +20,7 @@ const defaultBaseURL = "https://www.webnames.ru/scripts/json_domain_zone_manager
 type Client struct {
 	apiKey string
 
-	baseURL    string
+	BaseURL    string
 	HTTPClient *http.Client
 }
 
@@ -27,7 +28,7 @@ type Client struct {
 func NewClient(apiKey string) *Client {
 	return &Client{
 		apiKey:     apiKey,
-		baseURL:    defaultBaseURL,
+		BaseURL:    defaultBaseURL,
 		HTTPClient: &http.Client{Timeout: 10 * time.Second},
 	}
 }
@@ -41,7 +42,7 @@ func (c *Client) AddTXTRecord(ctx context.Context, domain, subDomain, value stri
 	data.Set("record", subDomain+":"+value)
 	data.Set("action", "add")
 
-	return c.doRequest(ctx, data)
+	return c.doRequest(ctx, data, nil)
 }
 
 // RemoveTXTRecord removes a TXT record.
@@ -53,17 +54,33 @@ func (c *Client) RemoveTXTRecord(ctx context.Context, domain, subDomain, value s
 	data.Set("record", subDomain+":"+value)
 	data.Set("action", "delete")
 
-	return c.doRequest(ctx, data)
+	return c.doRequest(ctx, data, nil)
 }
 
-func (c *Client) doRequest(ctx context.Context, data url.Values) error {
+// ListDomains returns the names of the domains of the account.
+func (c *Client) ListDomains(ctx context.Context) ([]string, error) {
+	data := url.Values{}
+	data.Set("action", "list_domains")
+
+	var domains []string
+	err := c.doRequest(ctx, data, &domains)
+	if err != nil {
+		return nil, err
+	}
+
+	return domains, nil
+}
+
+func (c *Client) doRequest(ctx context.Context, data url.Values, result any) error {
 	data.Set("apikey", c.apiKey)
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL, strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL, strings.NewReader(data.Encode()))
 	if err != nil {
 		return err
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 	resp, err := c.HTTPClient.Do(req)
@@ -88,9 +105,18 @@ func (c *Client) doRequest(ctx context.Context, data url.Values) error {
 		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
 	}
 
-	if r.Result == "OK" {
+	if r.Result != "OK" {
+		return fmt.Errorf("%s: %s", r.Result, r.Details)
+	}
+
+	if result == nil {
 		return nil
 	}
 
-	return fmt.Errorf("%s: %s", r.Result, r.Details)
+	err = json.Unmarshal(r.Details, result)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, r.Details, err)
+	}
+
+	return nil
 }
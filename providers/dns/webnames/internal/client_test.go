@@ -11,6 +11,7 @@ import (
 	"path"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -66,7 +67,7 @@ func setupTest(t *testing.T, filename string, expectedParams url.Values) *Client
 	server := httptest.NewServer(mux)
 
 	client := NewClient("secret")
-	client.baseURL = server.URL
+	client.BaseURL = server.URL
 	client.HTTPClient = server.Client()
 
 	return client
@@ -151,3 +152,23 @@ func TestClient_RemoveTxtRecord(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ListDomains(t *testing.T) {
+	data := url.Values{}
+	data.Set("action", "list_domains")
+	data.Set("apikey", "secret")
+
+	client := setupTest(t, "list_domains.json", data)
+
+	domains, err := client.ListDomains(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"example.com", "sub.example.com", "example.org"}, domains)
+}
+
+func TestClient_ListDomains_error(t *testing.T) {
+	client := setupTest(t, "error.json", url.Values{})
+
+	_, err := client.ListDomains(context.Background())
+	require.EqualError(t, err, `ERROR: "zone_manager_unavailable"`)
+}
@@ -87,7 +87,9 @@ func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
 func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	ctx := context.Background()
+
+	authZone, err := d.findZone(ctx, info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("webnames: could not find zone for domain %q: %w", domain, err)
 	}
@@ -97,10 +99,10 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 		return fmt.Errorf("webnames: %w", err)
 	}
 
-	err = d.client.AddTXTRecord(context.Background(), dns01.UnFqdn(authZone), subDomain, info.Value)
+	err = d.client.AddTXTRecord(ctx, authZone, subDomain, info.Value)
 	if err != nil {
 		return fmt.Errorf("webnames: failed to create TXT records [domain: %s, sub domain: %s]: %w",
-			dns01.UnFqdn(authZone), subDomain, err)
+			authZone, subDomain, err)
 	}
 
 	return nil
@@ -110,7 +112,9 @@ func (d *DNSProvider) Present(domain, token, keyAuth string) error {
 func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 	info := dns01.GetChallengeInfo(domain, keyAuth)
 
-	authZone, err := dns01.FindZoneByFqdn(info.EffectiveFQDN)
+	ctx := context.Background()
+
+	authZone, err := d.findZone(ctx, info.EffectiveFQDN)
 	if err != nil {
 		return fmt.Errorf("webnames: could not find zone for domain %q: %w", domain, err)
 	}
@@ -120,10 +124,10 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 		return fmt.Errorf("webnames: %w", err)
 	}
 
-	err = d.client.RemoveTXTRecord(context.Background(), dns01.UnFqdn(authZone), subDomain, info.Value)
+	err = d.client.RemoveTXTRecord(ctx, authZone, subDomain, info.Value)
 	if err != nil {
 		return fmt.Errorf("webnames: failed to remove TXT records [domain: %s, sub domain: %s]: %w",
-			dns01.UnFqdn(authZone), subDomain, err)
+			authZone, subDomain, err)
 	}
 
 	return nil
@@ -134,3 +138,18 @@ func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
 func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
 	return d.config.PropagationTimeout, d.config.PollingInterval
 }
+
+// findZone finds the domain of the account (the longest match) containing the FQDN.
+func (d *DNSProvider) findZone(ctx context.Context, fqdn string) (string, error) {
+	domains, err := d.client.ListDomains(ctx)
+	if err != nil {
+		return "", fmt.Errorf("list domains: %w", err)
+	}
+
+	zone := dns01.FindMostSpecificZone(fqdn, domains)
+	if zone == "" {
+		return "", fmt.Errorf("no domain found for %s", fqdn)
+	}
+
+	return dns01.UnFqdn(zone), nil
+}
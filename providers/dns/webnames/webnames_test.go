@@ -1,10 +1,16 @@
 package webnames
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -87,6 +93,119 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func setupTest(t *testing.T) (*DNSProvider, *[]url.Values) {
+	t.Helper()
+
+	var requests []url.Values
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+			http.Error(rw, "invalid content type", http.StatusBadRequest)
+			return
+		}
+
+		err := req.ParseForm()
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		requests = append(requests, req.PostForm)
+
+		switch req.PostForm.Get("action") {
+		case "list_domains":
+			_, _ = rw.Write([]byte(`{"result":"OK","details":["example.com","sub.example.com"]}`))
+		case "add", "delete":
+			_, _ = rw.Write([]byte(`{"result":"OK","details":1}`))
+		default:
+			_, _ = rw.Write([]byte(`{"result":"ERROR","details":"unknown_action"}`))
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	config := NewDefaultConfig()
+	config.APIKey = "secret"
+	config.HTTPClient = server.Client()
+
+	provider, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	provider.client.BaseURL = server.URL
+
+	return provider, &requests
+}
+
+func TestDNSProvider_Present(t *testing.T) {
+	provider, requests := setupTest(t)
+
+	err := provider.Present("www.sub.example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	expected := []url.Values{
+		{
+			"action": {"list_domains"},
+			"apikey": {"secret"},
+		},
+		{
+			"action": {"add"},
+			"apikey": {"secret"},
+			"domain": {"sub.example.com"},
+			"type":   {"TXT"},
+			"record": {"_acme-challenge.www:" + dns01.GetChallengeInfo("www.sub.example.com", "keyAuth").Value},
+		},
+	}
+
+	assert.Equal(t, expected, *requests)
+}
+
+func TestDNSProvider_CleanUp(t *testing.T) {
+	provider, requests := setupTest(t)
+
+	err := provider.CleanUp("example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	expected := []url.Values{
+		{
+			"action": {"list_domains"},
+			"apikey": {"secret"},
+		},
+		{
+			"action": {"delete"},
+			"apikey": {"secret"},
+			"domain": {"example.com"},
+			"type":   {"TXT"},
+			"record": {"_acme-challenge:" + dns01.GetChallengeInfo("example.com", "keyAuth").Value},
+		},
+	}
+
+	assert.Equal(t, expected, *requests)
+}
+
+func TestDNSProvider_Present_unknownDomain(t *testing.T) {
+	provider, requests := setupTest(t)
+
+	err := provider.Present("example.org", "token", "keyAuth")
+	require.EqualError(t, err, `webnames: could not find zone for domain "example.org": no domain found for _acme-challenge.example.org.`)
+
+	assert.Len(t, *requests, 1)
+}
+
+func TestDNSProvider_Timeout(t *testing.T) {
+	provider, _ := setupTest(t)
+	provider.config.PropagationTimeout = 2 * time.Minute
+	provider.config.PollingInterval = 3 * time.Second
+
+	var p challenge.ProviderTimeout = provider
+
+	timeout, interval := p.Timeout()
+	assert.Equal(t, 2*time.Minute, timeout)
+	assert.Equal(t, 3*time.Second, interval)
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
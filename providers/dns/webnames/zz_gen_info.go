@@ -0,0 +1,27 @@
+package webnames
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/webnames/webnames.toml
+		Name:  "Webnames",
+		Code:  "webnames",
+		Since: "v4.15.0",
+		URL:   "https://www.webnames.ru/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "WEBNAMES_API_KEY", Description: "Domain API key", Credential: true},
+			{Name: "WEBNAMES_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "WEBNAMES_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "WEBNAMES_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://github.com/regtime-ltd/certbot-dns-webnames",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package websupport
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/websupport/websupport.toml
+		Name:  "Websupport",
+		Code:  "websupport",
+		Since: "v4.10.0",
+		URL:   "https://websupport.sk",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "WEBSUPPORT_API_KEY", Description: "API key", Credential: true},
+			{Name: "WEBSUPPORT_SECRET", Description: "API secret", Credential: true},
+			{Name: "WEBSUPPORT_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "WEBSUPPORT_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "WEBSUPPORT_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "WEBSUPPORT_SEQUENCE_INTERVAL", Description: "Time between sequential requests"},
+			{Name: "WEBSUPPORT_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://rest.websupport.sk/docs/v1.zone",
+			GoClient: "",
+		},
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
 )
 
 const baseURL = "https://api.wedos.com/wapi/json"
@@ -175,6 +176,8 @@ func (c *Client) newRequest(ctx context.Context, command string, payload any) (*
 		return nil, fmt.Errorf("unable to create request: %w", err)
 	}
 
+	useragent.SetHeader(req.Header)
+
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 
 	return req, nil
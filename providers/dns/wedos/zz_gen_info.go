@@ -0,0 +1,29 @@
+package wedos
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/wedos/wedos.toml
+		Name:  "WEDOS",
+		Code:  "wedos",
+		Since: "v4.4.0",
+		URL:   "https://www.wedos.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "WEDOS_USERNAME", Description: "Username is the same as for the admin account", Credential: true},
+			{Name: "WEDOS_WAPI_PASSWORD", Description: "Password needs to be generated and IP allowed in the admin interface", Credential: true},
+			{Name: "WEDOS_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "WEDOS_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "WEDOS_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "WEDOS_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://kb.wedos.com/en/kategorie/wapi-api-interface/wdns-en/",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,178 @@
+package internal
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-acme/lego/v4/providers/dns/internal/errutils"
+	"github.com/go-acme/lego/v4/providers/dns/internal/useragent"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+const defaultBaseURL = "https://api.west.cn/api/v2"
+
+// successResult the value of the result field of a successful response.
+const successResult = 200
+
+// Client the west.cn API client.
+type Client struct {
+	username string
+	password string
+
+	now func() time.Time
+
+	BaseURL    *url.URL
+	HTTPClient *http.Client
+}
+
+// NewClient creates a new Client.
+func NewClient(username, password string) (*Client, error) {
+	if username == "" || password == "" {
+		return nil, errors.New("credentials missing")
+	}
+
+	baseURL, _ := url.Parse(defaultBaseURL)
+
+	return &Client{
+		username:   username,
+		password:   password,
+		now:        time.Now,
+		BaseURL:    baseURL,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// ListDomains lists the domains of the account.
+// https://www.west.cn/CustomerCenter/doc/apiv2.html
+func (c *Client) ListDomains(ctx context.Context, page, limit int) (*DomainsList, error) {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(page))
+	values.Set("limit", strconv.Itoa(limit))
+
+	var result DomainsList
+
+	err := c.do(ctx, "getdomains", values, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// AddRecord adds a DNS record, and returns its ID.
+// https://www.west.cn/CustomerCenter/doc/apiv2.html
+func (c *Client) AddRecord(ctx context.Context, record Record) (int, error) {
+	values := url.Values{}
+	values.Set("domain", record.Domain)
+	values.Set("host", record.Host)
+	values.Set("type", record.Type)
+	values.Set("value", record.Value)
+
+	if record.TTL > 0 {
+		values.Set("ttl", strconv.Itoa(record.TTL))
+	}
+
+	var result RecordID
+
+	err := c.do(ctx, "adddnsrecord", values, &result)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.ID, nil
+}
+
+// DeleteRecord deletes a DNS record by its ID.
+// https://www.west.cn/CustomerCenter/doc/apiv2.html
+func (c *Client) DeleteRecord(ctx context.Context, domain string, recordID int) error {
+	values := url.Values{}
+	values.Set("domain", domain)
+	values.Set("id", strconv.Itoa(recordID))
+
+	return c.do(ctx, "deldnsrecord", values, nil)
+}
+
+func (c *Client) do(ctx context.Context, action string, values url.Values, result any) error {
+	endpoint := c.BaseURL.JoinPath("domain", "/")
+
+	query := endpoint.Query()
+	query.Set("act", action)
+	endpoint.RawQuery = query.Encode()
+
+	c.sign(values, c.now())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), strings.NewReader(values.Encode()))
+	if err != nil {
+		return fmt.Errorf("unable to create request: %w", err)
+	}
+
+	useragent.SetHeader(req.Header)
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return errutils.NewHTTPDoError(req, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return errutils.NewUnexpectedResponseStatusCodeError(req, resp)
+	}
+
+	// The responses are encoded in GB18030 (a superset of GBK).
+	raw, err := io.ReadAll(simplifiedchinese.GB18030.NewDecoder().Reader(resp.Body))
+	if err != nil {
+		return errutils.NewReadResponseError(req, resp.StatusCode, err)
+	}
+
+	var response APIResponse
+
+	err = json.Unmarshal(raw, &response)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
+	}
+
+	if response.Result != successResult {
+		return &APIError{
+			Result:    response.Result,
+			ClientID:  response.ClientID,
+			Message:   response.Message,
+			ErrorCode: response.ErrorCode,
+		}
+	}
+
+	if result == nil || len(response.Data) == 0 {
+		return nil
+	}
+
+	err = json.Unmarshal(response.Data, result)
+	if err != nil {
+		return errutils.NewUnmarshalError(req, resp.StatusCode, raw, err)
+	}
+
+	return nil
+}
+
+// sign adds the authentication parameters to the values.
+// The token is the MD5 hash of the username, the API password, and the current time in milliseconds.
+func (c *Client) sign(values url.Values, now time.Time) {
+	timestamp := strconv.FormatInt(now.UnixMilli(), 10)
+
+	hash := md5.Sum([]byte(c.username + c.password + timestamp))
+
+	values.Set("username", c.username)
+	values.Set("time", timestamp)
+	values.Set("token", hex.EncodeToString(hash[:]))
+}
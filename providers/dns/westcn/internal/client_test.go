@@ -0,0 +1,161 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTest(t *testing.T, action, filename string, expectedParams url.Values) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("POST /domain/", func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("act") != action {
+			http.Error(rw, fmt.Sprintf("invalid action: %s", req.URL.RawQuery), http.StatusBadRequest)
+			return
+		}
+
+		err := req.ParseForm()
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		expected := url.Values{
+			"username": {"user"},
+			"time":     {"1704164645000"},
+			"token":    {"1541005f2fd0f9bca5b82256e17d1592"},
+		}
+
+		for key, values := range expectedParams {
+			expected[key] = values
+		}
+
+		if req.PostForm.Encode() != expected.Encode() {
+			http.Error(rw, fmt.Sprintf("invalid form: %s", req.PostForm.Encode()), http.StatusBadRequest)
+			return
+		}
+
+		file, err := os.Open(filepath.Join("fixtures", filename))
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		defer func() { _ = file.Close() }()
+
+		_, err = io.Copy(rw, file)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	})
+
+	client, err := NewClient("user", "secret")
+	require.NoError(t, err)
+
+	client.BaseURL, _ = url.Parse(server.URL)
+	client.HTTPClient = server.Client()
+	client.now = func() time.Time {
+		return time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)
+	}
+
+	return client
+}
+
+func TestClient_sign(t *testing.T) {
+	client, err := NewClient("user", "secret")
+	require.NoError(t, err)
+
+	values := url.Values{}
+	values.Set("domain", "example.com")
+
+	client.sign(values, time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC))
+
+	expected := url.Values{
+		"domain":   {"example.com"},
+		"username": {"user"},
+		"time":     {"1704164645000"},
+		// md5("user" + "secret" + "1704164645000")
+		"token": {"1541005f2fd0f9bca5b82256e17d1592"},
+	}
+
+	assert.Equal(t, expected, values)
+}
+
+func TestClient_ListDomains(t *testing.T) {
+	client := setupTest(t, "getdomains", "getdomains.json", url.Values{"page": {"1"}, "limit": {"100"}})
+
+	domains, err := client.ListDomains(context.Background(), 1, 100)
+	require.NoError(t, err)
+
+	expected := &DomainsList{
+		Total:  2,
+		PageNo: 1,
+		Limit:  100,
+		Items: []Domain{
+			{Domain: "example.com", RegDate: "2020-01-01", ExpDate: "2030-01-01"},
+			{Domain: "example.org", RegDate: "2021-01-01", ExpDate: "2031-01-01"},
+		},
+	}
+
+	assert.Equal(t, expected, domains)
+}
+
+func TestClient_AddRecord(t *testing.T) {
+	params := url.Values{
+		"domain": {"example.com"},
+		"host":   {"_acme-challenge"},
+		"type":   {"TXT"},
+		"value":  {"txtTXTtxt"},
+		"ttl":    {"600"},
+	}
+
+	client := setupTest(t, "adddnsrecord", "adddnsrecord.json", params)
+
+	record := Record{
+		Domain: "example.com",
+		Host:   "_acme-challenge",
+		Type:   "TXT",
+		Value:  "txtTXTtxt",
+		TTL:    600,
+	}
+
+	id, err := client.AddRecord(context.Background(), record)
+	require.NoError(t, err)
+
+	assert.Equal(t, 123456, id)
+}
+
+func TestClient_DeleteRecord(t *testing.T) {
+	client := setupTest(t, "deldnsrecord", "deldnsrecord.json", url.Values{"domain": {"example.com"}, "id": {"123456"}})
+
+	err := client.DeleteRecord(context.Background(), "example.com", 123456)
+	require.NoError(t, err)
+}
+
+func TestClient_DeleteRecord_error(t *testing.T) {
+	client := setupTest(t, "deldnsrecord", "error.json", url.Values{"domain": {"example.com"}, "id": {"123456"}})
+
+	err := client.DeleteRecord(context.Background(), "example.com", 123456)
+	require.EqualError(t, err, "result: 500, error code: 10001: 域名不存在 (client ID: 20240102030405123)")
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+
+	assert.Equal(t, 10001, apiErr.ErrorCode)
+}
@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type APIResponse struct {
+	Result    int             `json:"result"`
+	ClientID  string          `json:"clientid,omitempty"`
+	Message   string          `json:"msg,omitempty"`
+	ErrorCode int             `json:"errcode,omitempty"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+type APIError struct {
+	Result    int
+	ClientID  string
+	Message   string
+	ErrorCode int
+}
+
+func (a *APIError) Error() string {
+	return fmt.Sprintf("result: %d, error code: %d: %s (client ID: %s)", a.Result, a.ErrorCode, a.Message, a.ClientID)
+}
+
+type DomainsList struct {
+	Total  int      `json:"total"`
+	PageNo int      `json:"pageno"`
+	Limit  int      `json:"limit"`
+	Items  []Domain `json:"items"`
+}
+
+type Domain struct {
+	Domain  string `json:"domain"`
+	RegDate string `json:"regdate,omitempty"`
+	ExpDate string `json:"expdate,omitempty"`
+}
+
+type Record struct {
+	Domain string
+	Host   string
+	Type   string
+	Value  string
+	TTL    int
+}
+
+type RecordID struct {
+	ID int `json:"id"`
+}
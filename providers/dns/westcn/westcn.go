@@ -0,0 +1,202 @@
+// Package westcn implements a DNS provider for solving the DNS-01 challenge using West.cn.
+package westcn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"github.com/go-acme/lego/v4/platform/config/env"
+	"github.com/go-acme/lego/v4/providers/dns/westcn/internal"
+)
+
+// Environment variables names.
+const (
+	envNamespace = "WESTCN_"
+
+	EnvUsername = envNamespace + "USERNAME"
+	EnvPassword = envNamespace + "API_PASSWORD"
+
+	EnvTTL                = envNamespace + "TTL"
+	EnvPropagationTimeout = envNamespace + "PROPAGATION_TIMEOUT"
+	EnvPollingInterval    = envNamespace + "POLLING_INTERVAL"
+	EnvHTTPTimeout        = envNamespace + "HTTP_TIMEOUT"
+)
+
+// domainsPageSize the number of domains by page of the domains list.
+const domainsPageSize = 100
+
+// Config is used to configure the creation of the DNSProvider.
+type Config struct {
+	Username string
+	Password string
+
+	TTL                int
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+	HTTPClient         *http.Client
+}
+
+// NewDefaultConfig returns a default configuration for the DNSProvider.
+func NewDefaultConfig() *Config {
+	return &Config{
+		TTL:                env.GetOrDefaultInt(EnvTTL, 600),
+		PropagationTimeout: env.GetOrDefaultSecond(EnvPropagationTimeout, 2*time.Minute),
+		PollingInterval:    env.GetOrDefaultSecond(EnvPollingInterval, 10*time.Second),
+		HTTPClient: &http.Client{
+			Timeout: env.GetOrDefaultSecond(EnvHTTPTimeout, 30*time.Second),
+		},
+	}
+}
+
+// DNSProvider implements the challenge.Provider interface.
+type DNSProvider struct {
+	config *Config
+	client *internal.Client
+
+	records   map[string]recordRef
+	recordsMu sync.Mutex
+}
+
+type recordRef struct {
+	Domain   string
+	RecordID int
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for West.cn.
+// Credentials must be passed in the environment variables:
+// WESTCN_USERNAME and WESTCN_API_PASSWORD.
+func NewDNSProvider() (*DNSProvider, error) {
+	values, err := env.Get(EnvUsername, EnvPassword)
+	if err != nil {
+		return nil, fmt.Errorf("westcn: %w", err)
+	}
+
+	config := NewDefaultConfig()
+	config.Username = values[EnvUsername]
+	config.Password = values[EnvPassword]
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewDNSProviderConfig return a DNSProvider instance configured for West.cn.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("westcn: the configuration of the DNS provider is nil")
+	}
+
+	client, err := internal.NewClient(config.Username, config.Password)
+	if err != nil {
+		return nil, fmt.Errorf("westcn: %w", err)
+	}
+
+	if config.HTTPClient != nil {
+		client.HTTPClient = config.HTTPClient
+	}
+
+	return &DNSProvider{
+		config:  config,
+		client:  client,
+		records: make(map[string]recordRef),
+	}, nil
+}
+
+// Timeout returns the timeout and interval to use when checking for DNS propagation.
+// Adjusting here to cope with spikes in propagation times.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
+
+// Present creates a TXT record using the specified parameters.
+func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	ctx := context.Background()
+
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	zone, err := d.findZone(ctx, info.EffectiveFQDN)
+	if err != nil {
+		return fmt.Errorf("westcn: %w", err)
+	}
+
+	subDomain, err := dns01.ExtractSubDomain(strings.ToLower(info.EffectiveFQDN), zone)
+	if err != nil {
+		return fmt.Errorf("westcn: %w", err)
+	}
+
+	record := internal.Record{
+		Domain: zone,
+		Host:   subDomain,
+		Type:   "TXT",
+		Value:  info.Value,
+		TTL:    d.config.TTL,
+	}
+
+	recordID, err := d.client.AddRecord(ctx, record)
+	if err != nil {
+		return fmt.Errorf("westcn: add record: %w", err)
+	}
+
+	d.recordsMu.Lock()
+	d.records[token] = recordRef{Domain: zone, RecordID: recordID}
+	d.recordsMu.Unlock()
+
+	return nil
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	info := dns01.GetChallengeInfo(domain, keyAuth)
+
+	d.recordsMu.Lock()
+	ref, ok := d.records[token]
+	d.recordsMu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("westcn: unknown record ID for '%s'", info.EffectiveFQDN)
+	}
+
+	err := d.client.DeleteRecord(context.Background(), ref.Domain, ref.RecordID)
+	if err != nil {
+		return fmt.Errorf("westcn: delete record: %w", err)
+	}
+
+	d.recordsMu.Lock()
+	delete(d.records, token)
+	d.recordsMu.Unlock()
+
+	return nil
+}
+
+// findZone returns the most specific domain, of the account, containing the FQDN.
+func (d *DNSProvider) findZone(ctx context.Context, fqdn string) (string, error) {
+	var names []string
+
+	for page, count := 1, 0; ; page++ {
+		domains, err := d.client.ListDomains(ctx, page, domainsPageSize)
+		if err != nil {
+			return "", fmt.Errorf("list domains: %w", err)
+		}
+
+		for _, dom := range domains.Items {
+			names = append(names, dom.Domain)
+		}
+
+		count += len(domains.Items)
+
+		if len(domains.Items) == 0 || count >= domains.Total {
+			break
+		}
+	}
+
+	zone := dns01.FindMostSpecificZone(fqdn, names)
+	if zone == "" {
+		return "", fmt.Errorf("no domain found for %s", fqdn)
+	}
+
+	return dns01.UnFqdn(zone), nil
+}
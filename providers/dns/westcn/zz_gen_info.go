@@ -0,0 +1,29 @@
+package westcn
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/westcn/westcn.toml
+		Name:  "West.cn/西部数码",
+		Code:  "westcn",
+		Since: "v4.18.0",
+		URL:   "https://www.west.cn",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "WESTCN_API_PASSWORD", Description: "API password", Credential: true},
+			{Name: "WESTCN_USERNAME", Description: "Username", Credential: true},
+			{Name: "WESTCN_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "WESTCN_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "WESTCN_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "WESTCN_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://www.west.cn/CustomerCenter/doc/apiv2.html",
+			GoClient: "",
+		},
+	}
+}
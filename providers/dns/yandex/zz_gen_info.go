@@ -0,0 +1,28 @@
+package yandex
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/yandex/yandex.toml
+		Name:  "Yandex PDD",
+		Code:  "yandex",
+		Since: "v3.7.0",
+		URL:   "https://pdd.yandex.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "YANDEX_PDD_TOKEN", Description: "Basic authentication username", Credential: true},
+			{Name: "YANDEX_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "YANDEX_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "YANDEX_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "YANDEX_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://yandex.com/dev/domain/doc/concepts/api-dns.html",
+			GoClient: "",
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package yandex360
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/yandex360/yandex360.toml
+		Name:  "Yandex 360",
+		Code:  "yandex360",
+		Since: "v4.14.0",
+		URL:   "https://360.yandex.ru",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "YANDEX360_OAUTH_TOKEN", Description: "The OAuth Token", Credential: true},
+			{Name: "YANDEX360_ORG_ID", Description: "The organization ID", Credential: true},
+			{Name: "YANDEX360_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "YANDEX360_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "YANDEX360_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "YANDEX360_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://yandex.ru/dev/api360/doc/ref/DomainDNSService.html",
+			GoClient: "",
+		},
+	}
+}
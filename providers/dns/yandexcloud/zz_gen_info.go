@@ -0,0 +1,28 @@
+package yandexcloud
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/yandexcloud/yandexcloud.toml
+		Name:  "Yandex Cloud",
+		Code:  "yandexcloud",
+		Since: "v4.9.0",
+		URL:   "https://cloud.yandex.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "YANDEX_CLOUD_FOLDER_ID", Description: "The string id of folder (aka project) in Yandex Cloud", Credential: true},
+			{Name: "YANDEX_CLOUD_IAM_TOKEN", Description: "The base64 encoded json which contains information about iam token of service account with `dns.admin` permissions", Credential: true},
+			{Name: "YANDEX_CLOUD_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "YANDEX_CLOUD_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "YANDEX_CLOUD_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://cloud.yandex.com/en/docs/dns/quickstart",
+			GoClient: "",
+		},
+	}
+}
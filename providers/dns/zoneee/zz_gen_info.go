@@ -0,0 +1,30 @@
+package zoneee
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/zoneee/zoneee.toml
+		Name:  "Zone.ee",
+		Code:  "zoneee",
+		Since: "v2.1.0",
+		URL:   "https://www.zone.ee/",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "ZONEEE_API_KEY", Description: "API key", Credential: true},
+			{Name: "ZONEEE_API_USER", Description: "API user", Credential: true},
+			{Name: "ZONEEE_ENDPOINT", Description: "API endpoint URL"},
+			{Name: "ZONEEE_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "ZONEEE_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "ZONEEE_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "ZONEEE_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://api.zone.eu/v2",
+			GoClient: "",
+		},
+	}
+}
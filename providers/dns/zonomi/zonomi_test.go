@@ -1,10 +1,18 @@
 package zonomi
 
 import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -91,6 +99,103 @@ func TestNewDNSProviderConfig(t *testing.T) {
 	}
 }
 
+func setupTest(t *testing.T, handler http.HandlerFunc) *DNSProvider {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config := NewDefaultConfig()
+	config.APIKey = "secret"
+	config.HTTPClient = server.Client()
+
+	p, err := NewDNSProviderConfig(config)
+	require.NoError(t, err)
+
+	p.client.BaseURL = server.URL
+
+	return p
+}
+
+func writeFixture(rw http.ResponseWriter, filename string) {
+	file, err := os.Open(filepath.Join("fixtures", filename))
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	defer func() { _ = file.Close() }()
+
+	_, _ = io.Copy(rw, file)
+}
+
+func TestDNSProvider_Present(t *testing.T) {
+	var actions []url.Values
+
+	provider := setupTest(t, func(rw http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		actions = append(actions, query)
+
+		switch {
+		case query.Get("action") == "QUERY":
+			writeFixture(rw, "find_records.xml")
+		case query.Get("action[0]") == "SET":
+			writeFixture(rw, "set_records.xml")
+		default:
+			http.Error(rw, fmt.Sprintf("unexpected query: %s", req.URL.RawQuery), http.StatusBadRequest)
+		}
+	})
+
+	err := provider.Present("example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	require.Len(t, actions, 2)
+
+	assert.Equal(t, "_acme-challenge.example.com", actions[0].Get("name"))
+	assert.Equal(t, "secret", actions[0].Get("api_key"))
+
+	// The existing records are kept.
+	assert.Equal(t, "_acme-challenge.example.com", actions[1].Get("name[0]"))
+	assert.Equal(t, "_acme-challenge.example.com", actions[1].Get("name[1]"))
+	assert.Equal(t, "existing", actions[1].Get("value[1]"))
+}
+
+func TestDNSProvider_Present_error(t *testing.T) {
+	provider := setupTest(t, func(rw http.ResponseWriter, req *http.Request) {
+		writeFixture(rw, "error.xml")
+	})
+
+	err := provider.Present("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "zonomi: failed to find record(s) for example.com: ERROR: No zone found for example.com")
+}
+
+func TestDNSProvider_CleanUp(t *testing.T) {
+	var query url.Values
+
+	provider := setupTest(t, func(rw http.ResponseWriter, req *http.Request) {
+		query = req.URL.Query()
+
+		writeFixture(rw, "delete_record.xml")
+	})
+
+	err := provider.CleanUp("example.com", "token", "keyAuth")
+	require.NoError(t, err)
+
+	assert.Equal(t, "DELETE", query.Get("action"))
+	assert.Equal(t, "_acme-challenge.example.com", query.Get("name"))
+	assert.Equal(t, "TXT", query.Get("type"))
+}
+
+func TestDNSProvider_CleanUp_error(t *testing.T) {
+	provider := setupTest(t, func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusBadRequest)
+		writeFixture(rw, "error.xml")
+	})
+
+	err := provider.CleanUp("example.com", "token", "keyAuth")
+	require.EqualError(t, err, "zonomi: failed to delete record for example.com: ERROR: No zone found for example.com")
+}
+
 func TestLivePresent(t *testing.T) {
 	if !envTest.IsLiveTest() {
 		t.Skip("skipping live test")
@@ -0,0 +1,28 @@
+package zonomi
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import "github.com/go-acme/lego/v4/challenge"
+
+// Info returns the description of the provider and of its configuration, generated from its documentation.
+func Info() challenge.ProviderInfo {
+	return challenge.ProviderInfo{
+		// generated from: providers/dns/zonomi/zonomi.toml
+		Name:  "Zonomi",
+		Code:  "zonomi",
+		Since: "v3.5.0",
+		URL:   "https://zonomi.com",
+		EnvVars: []challenge.EnvVarInfo{
+			{Name: "ZONOMI_API_KEY", Description: "User API key", Credential: true},
+			{Name: "ZONOMI_HTTP_TIMEOUT", Description: "API request timeout"},
+			{Name: "ZONOMI_POLLING_INTERVAL", Description: "Time between DNS propagation check"},
+			{Name: "ZONOMI_PROPAGATION_TIMEOUT", Description: "Maximum waiting time for DNS propagation"},
+			{Name: "ZONOMI_TTL", Description: "The TTL of the TXT record used for the DNS challenge"},
+		},
+		Links: &challenge.ProviderLinks{
+			API:      "https://zonomi.com/app/dns/dyndns.jsp",
+			GoClient: "",
+		},
+	}
+}
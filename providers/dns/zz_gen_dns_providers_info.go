@@ -0,0 +1,299 @@
+package dns
+
+// CODE GENERATED AUTOMATICALLY
+// THIS FILE MUST NOT BE EDITED BY HAND
+
+import (
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/providers/dns/acmedns"
+	"github.com/go-acme/lego/v4/providers/dns/active24"
+	"github.com/go-acme/lego/v4/providers/dns/alidns"
+	"github.com/go-acme/lego/v4/providers/dns/allinkl"
+	"github.com/go-acme/lego/v4/providers/dns/arvancloud"
+	"github.com/go-acme/lego/v4/providers/dns/auroradns"
+	"github.com/go-acme/lego/v4/providers/dns/autodns"
+	"github.com/go-acme/lego/v4/providers/dns/azure"
+	"github.com/go-acme/lego/v4/providers/dns/azuredns"
+	"github.com/go-acme/lego/v4/providers/dns/beget"
+	"github.com/go-acme/lego/v4/providers/dns/bindman"
+	"github.com/go-acme/lego/v4/providers/dns/bluecat"
+	"github.com/go-acme/lego/v4/providers/dns/bookmyname"
+	"github.com/go-acme/lego/v4/providers/dns/brandit"
+	"github.com/go-acme/lego/v4/providers/dns/bunny"
+	"github.com/go-acme/lego/v4/providers/dns/checkdomain"
+	"github.com/go-acme/lego/v4/providers/dns/civo"
+	"github.com/go-acme/lego/v4/providers/dns/clouddns"
+	"github.com/go-acme/lego/v4/providers/dns/cloudflare"
+	"github.com/go-acme/lego/v4/providers/dns/cloudns"
+	"github.com/go-acme/lego/v4/providers/dns/cloudru"
+	"github.com/go-acme/lego/v4/providers/dns/cloudxns"
+	"github.com/go-acme/lego/v4/providers/dns/conoha"
+	"github.com/go-acme/lego/v4/providers/dns/constellix"
+	"github.com/go-acme/lego/v4/providers/dns/corenetworks"
+	"github.com/go-acme/lego/v4/providers/dns/cpanel"
+	"github.com/go-acme/lego/v4/providers/dns/derak"
+	"github.com/go-acme/lego/v4/providers/dns/desec"
+	"github.com/go-acme/lego/v4/providers/dns/designate"
+	"github.com/go-acme/lego/v4/providers/dns/digitalocean"
+	"github.com/go-acme/lego/v4/providers/dns/dinahosting"
+	"github.com/go-acme/lego/v4/providers/dns/dnshomede"
+	"github.com/go-acme/lego/v4/providers/dns/dnsimple"
+	"github.com/go-acme/lego/v4/providers/dns/dnsmadeeasy"
+	"github.com/go-acme/lego/v4/providers/dns/dnspod"
+	"github.com/go-acme/lego/v4/providers/dns/dode"
+	"github.com/go-acme/lego/v4/providers/dns/domeneshop"
+	"github.com/go-acme/lego/v4/providers/dns/dreamhost"
+	"github.com/go-acme/lego/v4/providers/dns/duckdns"
+	"github.com/go-acme/lego/v4/providers/dns/dyn"
+	"github.com/go-acme/lego/v4/providers/dns/dynu"
+	"github.com/go-acme/lego/v4/providers/dns/easydns"
+	"github.com/go-acme/lego/v4/providers/dns/edgedns"
+	"github.com/go-acme/lego/v4/providers/dns/efficientip"
+	"github.com/go-acme/lego/v4/providers/dns/epik"
+	"github.com/go-acme/lego/v4/providers/dns/exec"
+	"github.com/go-acme/lego/v4/providers/dns/exoscale"
+	"github.com/go-acme/lego/v4/providers/dns/freemyip"
+	"github.com/go-acme/lego/v4/providers/dns/gandi"
+	"github.com/go-acme/lego/v4/providers/dns/gandiv5"
+	"github.com/go-acme/lego/v4/providers/dns/gcloud"
+	"github.com/go-acme/lego/v4/providers/dns/gcore"
+	"github.com/go-acme/lego/v4/providers/dns/glesys"
+	"github.com/go-acme/lego/v4/providers/dns/godaddy"
+	"github.com/go-acme/lego/v4/providers/dns/googledomains"
+	"github.com/go-acme/lego/v4/providers/dns/hetzner"
+	"github.com/go-acme/lego/v4/providers/dns/hetznerrobot"
+	"github.com/go-acme/lego/v4/providers/dns/hostingde"
+	"github.com/go-acme/lego/v4/providers/dns/hostinger"
+	"github.com/go-acme/lego/v4/providers/dns/hosttech"
+	"github.com/go-acme/lego/v4/providers/dns/httpnet"
+	"github.com/go-acme/lego/v4/providers/dns/httpreq"
+	"github.com/go-acme/lego/v4/providers/dns/hurricane"
+	"github.com/go-acme/lego/v4/providers/dns/hyperone"
+	"github.com/go-acme/lego/v4/providers/dns/ibmcloud"
+	"github.com/go-acme/lego/v4/providers/dns/iij"
+	"github.com/go-acme/lego/v4/providers/dns/iijdpf"
+	"github.com/go-acme/lego/v4/providers/dns/infoblox"
+	"github.com/go-acme/lego/v4/providers/dns/infomaniak"
+	"github.com/go-acme/lego/v4/providers/dns/internetbs"
+	"github.com/go-acme/lego/v4/providers/dns/inwx"
+	"github.com/go-acme/lego/v4/providers/dns/ionos"
+	"github.com/go-acme/lego/v4/providers/dns/ipv64"
+	"github.com/go-acme/lego/v4/providers/dns/iwantmyname"
+	"github.com/go-acme/lego/v4/providers/dns/joker"
+	"github.com/go-acme/lego/v4/providers/dns/liara"
+	"github.com/go-acme/lego/v4/providers/dns/lightsail"
+	"github.com/go-acme/lego/v4/providers/dns/linode"
+	"github.com/go-acme/lego/v4/providers/dns/liquidweb"
+	"github.com/go-acme/lego/v4/providers/dns/loopia"
+	"github.com/go-acme/lego/v4/providers/dns/luadns"
+	"github.com/go-acme/lego/v4/providers/dns/mailinabox"
+	"github.com/go-acme/lego/v4/providers/dns/metaname"
+	"github.com/go-acme/lego/v4/providers/dns/mittwald"
+	"github.com/go-acme/lego/v4/providers/dns/mydnsjp"
+	"github.com/go-acme/lego/v4/providers/dns/mythicbeasts"
+	"github.com/go-acme/lego/v4/providers/dns/namecheap"
+	"github.com/go-acme/lego/v4/providers/dns/namedotcom"
+	"github.com/go-acme/lego/v4/providers/dns/namesilo"
+	"github.com/go-acme/lego/v4/providers/dns/nearlyfreespeech"
+	"github.com/go-acme/lego/v4/providers/dns/netcup"
+	"github.com/go-acme/lego/v4/providers/dns/netlify"
+	"github.com/go-acme/lego/v4/providers/dns/nicmanager"
+	"github.com/go-acme/lego/v4/providers/dns/nifcloud"
+	"github.com/go-acme/lego/v4/providers/dns/njalla"
+	"github.com/go-acme/lego/v4/providers/dns/nodion"
+	"github.com/go-acme/lego/v4/providers/dns/ns1"
+	"github.com/go-acme/lego/v4/providers/dns/oraclecloud"
+	"github.com/go-acme/lego/v4/providers/dns/otc"
+	"github.com/go-acme/lego/v4/providers/dns/ovh"
+	"github.com/go-acme/lego/v4/providers/dns/pdns"
+	"github.com/go-acme/lego/v4/providers/dns/plesk"
+	"github.com/go-acme/lego/v4/providers/dns/porkbun"
+	"github.com/go-acme/lego/v4/providers/dns/rackspace"
+	"github.com/go-acme/lego/v4/providers/dns/rcodezero"
+	"github.com/go-acme/lego/v4/providers/dns/regfish"
+	"github.com/go-acme/lego/v4/providers/dns/regru"
+	"github.com/go-acme/lego/v4/providers/dns/rfc2136"
+	"github.com/go-acme/lego/v4/providers/dns/rimuhosting"
+	"github.com/go-acme/lego/v4/providers/dns/route53"
+	"github.com/go-acme/lego/v4/providers/dns/safedns"
+	"github.com/go-acme/lego/v4/providers/dns/sakuracloud"
+	"github.com/go-acme/lego/v4/providers/dns/scaleway"
+	"github.com/go-acme/lego/v4/providers/dns/selectel"
+	"github.com/go-acme/lego/v4/providers/dns/selectelv2"
+	"github.com/go-acme/lego/v4/providers/dns/servercow"
+	"github.com/go-acme/lego/v4/providers/dns/shellrent"
+	"github.com/go-acme/lego/v4/providers/dns/simply"
+	"github.com/go-acme/lego/v4/providers/dns/sonic"
+	"github.com/go-acme/lego/v4/providers/dns/stackpath"
+	"github.com/go-acme/lego/v4/providers/dns/technitium"
+	"github.com/go-acme/lego/v4/providers/dns/tencentcloud"
+	"github.com/go-acme/lego/v4/providers/dns/timeweb"
+	"github.com/go-acme/lego/v4/providers/dns/transip"
+	"github.com/go-acme/lego/v4/providers/dns/ultradns"
+	"github.com/go-acme/lego/v4/providers/dns/variomedia"
+	"github.com/go-acme/lego/v4/providers/dns/vegadns"
+	"github.com/go-acme/lego/v4/providers/dns/vercel"
+	"github.com/go-acme/lego/v4/providers/dns/versio"
+	"github.com/go-acme/lego/v4/providers/dns/vinyldns"
+	"github.com/go-acme/lego/v4/providers/dns/vkcloud"
+	"github.com/go-acme/lego/v4/providers/dns/volcengine"
+	"github.com/go-acme/lego/v4/providers/dns/vscale"
+	"github.com/go-acme/lego/v4/providers/dns/vultr"
+	"github.com/go-acme/lego/v4/providers/dns/webnames"
+	"github.com/go-acme/lego/v4/providers/dns/websupport"
+	"github.com/go-acme/lego/v4/providers/dns/wedos"
+	"github.com/go-acme/lego/v4/providers/dns/westcn"
+	"github.com/go-acme/lego/v4/providers/dns/yandex"
+	"github.com/go-acme/lego/v4/providers/dns/yandex360"
+	"github.com/go-acme/lego/v4/providers/dns/yandexcloud"
+	"github.com/go-acme/lego/v4/providers/dns/zoneee"
+	"github.com/go-acme/lego/v4/providers/dns/zonomi"
+)
+
+// providersInfo contains the DNS providers exposing their description (the Info function of their package).
+// The description is optional: a provider without description can still be created by NewDNSChallengeProviderByName.
+var providersInfo = map[string]func() challenge.ProviderInfo{
+	"acme-dns":         acmedns.Info,
+	"active24":         active24.Info,
+	"alidns":           alidns.Info,
+	"allinkl":          allinkl.Info,
+	"arvancloud":       arvancloud.Info,
+	"auroradns":        auroradns.Info,
+	"autodns":          autodns.Info,
+	"azure":            azure.Info,
+	"azuredns":         azuredns.Info,
+	"beget":            beget.Info,
+	"bindman":          bindman.Info,
+	"bluecat":          bluecat.Info,
+	"bookmyname":       bookmyname.Info,
+	"brandit":          brandit.Info,
+	"bunny":            bunny.Info,
+	"checkdomain":      checkdomain.Info,
+	"civo":             civo.Info,
+	"clouddns":         clouddns.Info,
+	"cloudflare":       cloudflare.Info,
+	"cloudns":          cloudns.Info,
+	"cloudru":          cloudru.Info,
+	"cloudxns":         cloudxns.Info,
+	"conoha":           conoha.Info,
+	"constellix":       constellix.Info,
+	"corenetworks":     corenetworks.Info,
+	"cpanel":           cpanel.Info,
+	"derak":            derak.Info,
+	"desec":            desec.Info,
+	"designate":        designate.Info,
+	"digitalocean":     digitalocean.Info,
+	"dinahosting":      dinahosting.Info,
+	"dnshomede":        dnshomede.Info,
+	"dnsimple":         dnsimple.Info,
+	"dnsmadeeasy":      dnsmadeeasy.Info,
+	"dnspod":           dnspod.Info,
+	"dode":             dode.Info,
+	"domeneshop":       domeneshop.Info,
+	"dreamhost":        dreamhost.Info,
+	"duckdns":          duckdns.Info,
+	"dyn":              dyn.Info,
+	"dynu":             dynu.Info,
+	"easydns":          easydns.Info,
+	"edgedns":          edgedns.Info,
+	"efficientip":      efficientip.Info,
+	"epik":             epik.Info,
+	"exec":             exec.Info,
+	"exoscale":         exoscale.Info,
+	"freemyip":         freemyip.Info,
+	"gandi":            gandi.Info,
+	"gandiv5":          gandiv5.Info,
+	"gcloud":           gcloud.Info,
+	"gcore":            gcore.Info,
+	"glesys":           glesys.Info,
+	"godaddy":          godaddy.Info,
+	"googledomains":    googledomains.Info,
+	"hetzner":          hetzner.Info,
+	"hetznerrobot":     hetznerrobot.Info,
+	"hostingde":        hostingde.Info,
+	"hostinger":        hostinger.Info,
+	"hosttech":         hosttech.Info,
+	"httpnet":          httpnet.Info,
+	"httpreq":          httpreq.Info,
+	"hurricane":        hurricane.Info,
+	"hyperone":         hyperone.Info,
+	"ibmcloud":         ibmcloud.Info,
+	"iij":              iij.Info,
+	"iijdpf":           iijdpf.Info,
+	"infoblox":         infoblox.Info,
+	"infomaniak":       infomaniak.Info,
+	"internetbs":       internetbs.Info,
+	"inwx":             inwx.Info,
+	"ionos":            ionos.Info,
+	"ipv64":            ipv64.Info,
+	"iwantmyname":      iwantmyname.Info,
+	"joker":            joker.Info,
+	"liara":            liara.Info,
+	"lightsail":        lightsail.Info,
+	"linode":           linode.Info,
+	"liquidweb":        liquidweb.Info,
+	"loopia":           loopia.Info,
+	"luadns":           luadns.Info,
+	"mailinabox":       mailinabox.Info,
+	"metaname":         metaname.Info,
+	"mittwald":         mittwald.Info,
+	"mydnsjp":          mydnsjp.Info,
+	"mythicbeasts":     mythicbeasts.Info,
+	"namecheap":        namecheap.Info,
+	"namedotcom":       namedotcom.Info,
+	"namesilo":         namesilo.Info,
+	"nearlyfreespeech": nearlyfreespeech.Info,
+	"netcup":           netcup.Info,
+	"netlify":          netlify.Info,
+	"nicmanager":       nicmanager.Info,
+	"nifcloud":         nifcloud.Info,
+	"njalla":           njalla.Info,
+	"nodion":           nodion.Info,
+	"ns1":              ns1.Info,
+	"oraclecloud":      oraclecloud.Info,
+	"otc":              otc.Info,
+	"ovh":              ovh.Info,
+	"pdns":             pdns.Info,
+	"plesk":            plesk.Info,
+	"porkbun":          porkbun.Info,
+	"rackspace":        rackspace.Info,
+	"rcodezero":        rcodezero.Info,
+	"regfish":          regfish.Info,
+	"regru":            regru.Info,
+	"rfc2136":          rfc2136.Info,
+	"rimuhosting":      rimuhosting.Info,
+	"route53":          route53.Info,
+	"safedns":          safedns.Info,
+	"sakuracloud":      sakuracloud.Info,
+	"scaleway":         scaleway.Info,
+	"selectel":         selectel.Info,
+	"selectelv2":       selectelv2.Info,
+	"servercow":        servercow.Info,
+	"shellrent":        shellrent.Info,
+	"simply":           simply.Info,
+	"sonic":            sonic.Info,
+	"stackpath":        stackpath.Info,
+	"technitium":       technitium.Info,
+	"tencentcloud":     tencentcloud.Info,
+	"timeweb":          timeweb.Info,
+	"transip":          transip.Info,
+	"ultradns":         ultradns.Info,
+	"variomedia":       variomedia.Info,
+	"vegadns":          vegadns.Info,
+	"vercel":           vercel.Info,
+	"versio":           versio.Info,
+	"vinyldns":         vinyldns.Info,
+	"vkcloud":          vkcloud.Info,
+	"volcengine":       volcengine.Info,
+	"vscale":           vscale.Info,
+	"vultr":            vultr.Info,
+	"webnames":         webnames.Info,
+	"websupport":       websupport.Info,
+	"wedos":            wedos.Info,
+	"westcn":           westcn.Info,
+	"yandex":           yandex.Info,
+	"yandex360":        yandex360.Info,
+	"yandexcloud":      yandexcloud.Info,
+	"zoneee":           zoneee.Info,
+	"zonomi":           zonomi.Info,
+}
@@ -17,6 +17,23 @@ const mailTo = "mailto:"
 type Resource struct {
 	Body acme.Account `json:"body,omitempty"`
 	URI  string       `json:"uri,omitempty"`
+
+	// TermsOfService the URL of the terms of service agreed by the account, if known.
+	// The ACME account object contains only the agreement (a boolean), so the URL is recorded by the client.
+	TermsOfService string `json:"termsOfService,omitempty"`
+}
+
+// TermsOfServiceStatus the agreement of an account to the terms of service of the CA.
+type TermsOfServiceStatus struct {
+	// Current the URL of the current terms of service (from the directory).
+	Current string
+	// Agreed the URL of the terms of service agreed by the account, if known.
+	Agreed string
+	// AgreementRequired is true when the account must agree to the current terms of service.
+	AgreementRequired bool
+	// Unknown is true when the terms of service agreed by the account are not known
+	// (e.g. an account saved before the URL was recorded): the agreement cannot be checked.
+	Unknown bool
 }
 
 type RegisterOptions struct {
@@ -25,8 +42,12 @@ type RegisterOptions struct {
 
 type RegisterEABOptions struct {
 	TermsOfServiceAgreed bool
-	Kid                  string
-	HmacEncoded          string
+	// Kid is the key identifier provided by the CA.
+	Kid string
+	// HmacEncoded is the MAC key provided by the CA, in base64url encoding without padding.
+	HmacEncoded string
+	// Algorithm is the HMAC algorithm used to sign the binding: HS256 (default), HS384, or HS512.
+	Algorithm string
 }
 
 type Registrar struct {
@@ -66,10 +87,13 @@ func (r *Registrar) Register(options RegisterOptions) (*Resource, error) {
 		}
 	}
 
-	return &Resource{URI: account.Location, Body: account.Account}, nil
+	return &Resource{URI: account.Location, Body: account.Account, TermsOfService: r.agreedTermsOfService(options.TermsOfServiceAgreed)}, nil
 }
 
 // RegisterWithExternalAccountBinding Register the current account to the ACME server.
+//
+// If the account key is already registered, the CA returns the existing account (RFC 8555 section 7.3.1):
+// this can be used to bind an existing account to a new EAB key, if the CA supports it.
 func (r *Registrar) RegisterWithExternalAccountBinding(options RegisterEABOptions) (*Resource, error) {
 	accMsg := acme.Account{
 		TermsOfServiceAgreed: options.TermsOfServiceAgreed,
@@ -81,7 +105,7 @@ func (r *Registrar) RegisterWithExternalAccountBinding(options RegisterEABOption
 		accMsg.Contact = []string{mailTo + r.user.GetEmail()}
 	}
 
-	account, err := r.core.Accounts.NewEAB(accMsg, options.Kid, options.HmacEncoded)
+	account, err := r.core.Accounts.NewEABWithAlgorithm(accMsg, options.Kid, options.HmacEncoded, options.Algorithm)
 	if err != nil {
 		// seems impossible
 		var errorDetails acme.ProblemDetails
@@ -90,7 +114,7 @@ func (r *Registrar) RegisterWithExternalAccountBinding(options RegisterEABOption
 		}
 	}
 
-	return &Resource{URI: account.Location, Body: account.Account}, nil
+	return &Resource{URI: account.Location, Body: account.Account, TermsOfService: r.agreedTermsOfService(options.TermsOfServiceAgreed)}, nil
 }
 
 // QueryRegistration runs a POST request on the client's registration and returns the result.
@@ -113,7 +137,8 @@ func (r *Registrar) QueryRegistration() (*Resource, error) {
 	return &Resource{
 		Body: account,
 		// Location: header is not returned so this needs to be populated off of existing URI
-		URI: r.user.GetRegistration().URI,
+		URI:            r.user.GetRegistration().URI,
+		TermsOfService: r.user.GetRegistration().TermsOfService,
 	}, nil
 }
 
@@ -140,7 +165,12 @@ func (r *Registrar) UpdateRegistration(options RegisterOptions) (*Resource, erro
 		return nil, err
 	}
 
-	return &Resource{URI: accountURL, Body: account}, nil
+	termsOfService := r.user.GetRegistration().TermsOfService
+	if options.TermsOfServiceAgreed {
+		termsOfService = r.agreedTermsOfService(true)
+	}
+
+	return &Resource{URI: accountURL, Body: account, TermsOfService: termsOfService}, nil
 }
 
 // DeleteRegistration deletes the client's user registration from the ACME server.
@@ -167,3 +197,60 @@ func (r *Registrar) ResolveAccountByKey() (*Resource, error) {
 
 	return &Resource{URI: account.Location, Body: account.Account}, nil
 }
+
+// CheckTermsOfService checks whether the account has agreed to the current terms of service of the CA.
+//
+// The URL of the terms of service agreed by the account (Resource.TermsOfService) is compared to the one of the directory:
+// when the CA has updated its terms of service, the account must agree to them again (see AgreeToTermsOfService).
+// When the URL agreed by the account is unknown, the status is reported as unknown (TermsOfServiceStatus.Unknown),
+// and no agreement is required: most CAs don't return the agreement in the account object.
+func (r *Registrar) CheckTermsOfService() (*TermsOfServiceStatus, error) {
+	if r == nil || r.user == nil || r.user.GetRegistration() == nil {
+		return nil, errors.New("acme: cannot check the terms of service of a nil client or user")
+	}
+
+	reg := r.user.GetRegistration()
+
+	status := &TermsOfServiceStatus{
+		Current: r.core.GetDirectory().Meta.TermsOfService,
+		Agreed:  reg.TermsOfService,
+	}
+
+	switch {
+	case status.Current == "":
+		// The CA has no terms of service.
+	case status.Agreed == "":
+		status.Unknown = true
+	default:
+		status.AgreementRequired = status.Agreed != status.Current
+	}
+
+	return status, nil
+}
+
+// AgreeToTermsOfService updates the account to agree to the current terms of service of the CA (RFC 8555 section 7.3.3).
+func (r *Registrar) AgreeToTermsOfService() (*Resource, error) {
+	if r == nil || r.user == nil || r.user.GetRegistration() == nil {
+		return nil, errors.New("acme: cannot agree to the terms of service with a nil client or user")
+	}
+
+	accountURL := r.user.GetRegistration().URI
+
+	log.Infof("acme: Agreeing to the terms of service for %s", accountURL)
+
+	account, err := r.core.Accounts.Update(accountURL, acme.Account{TermsOfServiceAgreed: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Resource{URI: accountURL, Body: account, TermsOfService: r.agreedTermsOfService(true)}, nil
+}
+
+// agreedTermsOfService returns the URL of the current terms of service when they are agreed.
+func (r *Registrar) agreedTermsOfService(agreed bool) string {
+	if !agreed {
+		return ""
+	}
+
+	return r.core.GetDirectory().Meta.TermsOfService
+}
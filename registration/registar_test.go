@@ -3,12 +3,16 @@ package registration
 import (
 	"crypto/rand"
 	"crypto/rsa"
+	"encoding/json"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/go-acme/lego/v4/acme"
 	"github.com/go-acme/lego/v4/acme/api"
 	"github.com/go-acme/lego/v4/platform/tester"
+	"github.com/go-jose/go-jose/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -46,3 +50,192 @@ func TestRegistrar_ResolveAccountByKey(t *testing.T) {
 
 	assert.Equal(t, "valid", res.Body.Status, "Unexpected account status")
 }
+
+func TestRegistrar_CheckTermsOfService(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		current  string
+		regres   *Resource
+		expected *TermsOfServiceStatus
+	}{
+		{
+			desc:    "agreed to the current terms of service",
+			current: "https://ca.example/tos-v2.pdf",
+			regres: &Resource{
+				Body:           acme.Account{TermsOfServiceAgreed: true},
+				TermsOfService: "https://ca.example/tos-v2.pdf",
+			},
+			expected: &TermsOfServiceStatus{
+				Current: "https://ca.example/tos-v2.pdf",
+				Agreed:  "https://ca.example/tos-v2.pdf",
+			},
+		},
+		{
+			desc:    "terms of service changed",
+			current: "https://ca.example/tos-v2.pdf",
+			regres: &Resource{
+				Body:           acme.Account{TermsOfServiceAgreed: true},
+				TermsOfService: "https://ca.example/tos-v1.pdf",
+			},
+			expected: &TermsOfServiceStatus{
+				Current:           "https://ca.example/tos-v2.pdf",
+				Agreed:            "https://ca.example/tos-v1.pdf",
+				AgreementRequired: true,
+			},
+		},
+		{
+			desc:    "unknown agreed terms of service",
+			current: "https://ca.example/tos-v2.pdf",
+			regres: &Resource{
+				Body: acme.Account{TermsOfServiceAgreed: true},
+			},
+			expected: &TermsOfServiceStatus{
+				Current: "https://ca.example/tos-v2.pdf",
+				Unknown: true,
+			},
+		},
+		{
+			desc:    "unknown agreement",
+			current: "https://ca.example/tos-v2.pdf",
+			regres:  &Resource{},
+			expected: &TermsOfServiceStatus{
+				Current: "https://ca.example/tos-v2.pdf",
+				Unknown: true,
+			},
+		},
+		{
+			desc:   "no terms of service",
+			regres: &Resource{TermsOfService: "https://ca.example/tos-v1.pdf"},
+			expected: &TermsOfServiceStatus{
+				Agreed: "https://ca.example/tos-v1.pdf",
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			apiURL := setupTermsOfServiceAPI(t, test.current)
+
+			key, err := rsa.GenerateKey(rand.Reader, 512)
+			require.NoError(t, err)
+
+			core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", "", key)
+			require.NoError(t, err)
+
+			registrar := NewRegistrar(core, mockUser{regres: test.regres, privatekey: key})
+
+			status, err := registrar.CheckTermsOfService()
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, status)
+		})
+	}
+}
+
+func TestRegistrar_AgreeToTermsOfService(t *testing.T) {
+	apiURL := setupTermsOfServiceAPI(t, "https://ca.example/tos-v2.pdf")
+
+	key, err := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, err)
+
+	core, err := api.New(http.DefaultClient, "lego-test", apiURL+"/dir", apiURL+"/account/1", key)
+	require.NoError(t, err)
+
+	user := mockUser{
+		regres: &Resource{
+			URI:            apiURL + "/account/1",
+			Body:           acme.Account{Status: "valid", TermsOfServiceAgreed: true},
+			TermsOfService: "https://ca.example/tos-v1.pdf",
+		},
+		privatekey: key,
+	}
+
+	registrar := NewRegistrar(core, user)
+
+	status, err := registrar.CheckTermsOfService()
+	require.NoError(t, err)
+
+	assert.True(t, status.AgreementRequired)
+
+	res, err := registrar.AgreeToTermsOfService()
+	require.NoError(t, err)
+
+	expected := &Resource{
+		URI:            apiURL + "/account/1",
+		Body:           acme.Account{Status: "valid", TermsOfServiceAgreed: true},
+		TermsOfService: "https://ca.example/tos-v2.pdf",
+	}
+
+	assert.Equal(t, expected, res)
+
+	// The new registration agrees to the current terms of service.
+	status, err = NewRegistrar(core, mockUser{regres: res, privatekey: key}).CheckTermsOfService()
+	require.NoError(t, err)
+
+	assert.False(t, status.AgreementRequired)
+}
+
+// setupTermsOfServiceAPI starts a stub ACME server with the given terms of service:
+// the account updates must agree to the terms of service.
+func setupTermsOfServiceAPI(t *testing.T, termsOfService string) string {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("GET /dir", func(w http.ResponseWriter, _ *http.Request) {
+		err := tester.WriteJSONResponse(w, acme.Directory{
+			NewNonceURL:   server.URL + "/nonce",
+			NewAccountURL: server.URL + "/account",
+			NewOrderURL:   server.URL + "/newOrder",
+			RevokeCertURL: server.URL + "/revokeCert",
+			KeyChangeURL:  server.URL + "/keyChange",
+			Meta:          acme.Meta{TermsOfService: termsOfService},
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	mux.HandleFunc("HEAD /nonce", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Replay-Nonce", "12345")
+	})
+
+	mux.HandleFunc("POST /account/1", func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Replay-Nonce", "12345")
+
+		raw, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		jws, err := jose.ParseSigned(string(raw), []jose.SignatureAlgorithm{jose.RS256})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var account acme.Account
+		err = json.Unmarshal(jws.UnsafePayloadWithoutVerification(), &account)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if !account.TermsOfServiceAgreed {
+			http.Error(w, "the terms of service must be agreed", http.StatusBadRequest)
+			return
+		}
+
+		err = tester.WriteJSONResponse(w, acme.Account{Status: "valid", TermsOfServiceAgreed: true})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+
+	return server.URL
+}